@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"time"
+	_ "time/tzdata"
 
 	"github.com/gorilla/mux"
 	"github.com/jmoiron/sqlx"
@@ -16,35 +18,87 @@ import (
 	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/events"
 	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/ipc"
 
+	hCatalog "github.com/thiagotrs/rentalcar-ddd/internal/catalog/adapters/http"
+	ipcCatalog "github.com/thiagotrs/rentalcar-ddd/internal/catalog/adapters/ipc"
+	repoCatalog "github.com/thiagotrs/rentalcar-ddd/internal/catalog/adapters/repository"
+	appCatalog "github.com/thiagotrs/rentalcar-ddd/internal/catalog/application"
+
+	catLogistics "github.com/thiagotrs/rentalcar-ddd/internal/logistics/adapters/catalog"
 	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/adapters/consumer"
 	ehLogistics "github.com/thiagotrs/rentalcar-ddd/internal/logistics/adapters/eventhandler"
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/adapters/geocoder"
 	hLogistics "github.com/thiagotrs/rentalcar-ddd/internal/logistics/adapters/http"
 	ipcLogistics "github.com/thiagotrs/rentalcar-ddd/internal/logistics/adapters/ipc"
+	priceLogistics "github.com/thiagotrs/rentalcar-ddd/internal/logistics/adapters/pricing"
 	repoLogistics "github.com/thiagotrs/rentalcar-ddd/internal/logistics/adapters/repository"
 	appLogistics "github.com/thiagotrs/rentalcar-ddd/internal/logistics/application"
 	domainLogistics "github.com/thiagotrs/rentalcar-ddd/internal/logistics/domain"
 
+	catPricing "github.com/thiagotrs/rentalcar-ddd/internal/pricing/adapters/catalog"
 	hPricing "github.com/thiagotrs/rentalcar-ddd/internal/pricing/adapters/http"
 	ipcPricing "github.com/thiagotrs/rentalcar-ddd/internal/pricing/adapters/ipc"
 	repoPricing "github.com/thiagotrs/rentalcar-ddd/internal/pricing/adapters/repository"
 	appPricing "github.com/thiagotrs/rentalcar-ddd/internal/pricing/application"
 
+	consLoyalty "github.com/thiagotrs/rentalcar-ddd/internal/loyalty/adapters/consumer"
+	ehLoyalty "github.com/thiagotrs/rentalcar-ddd/internal/loyalty/adapters/eventhandler"
+	hLoyalty "github.com/thiagotrs/rentalcar-ddd/internal/loyalty/adapters/http"
+	ipcLoyalty "github.com/thiagotrs/rentalcar-ddd/internal/loyalty/adapters/ipc"
+	repoLoyalty "github.com/thiagotrs/rentalcar-ddd/internal/loyalty/adapters/repository"
+	appLoyalty "github.com/thiagotrs/rentalcar-ddd/internal/loyalty/application"
+	domainLoyalty "github.com/thiagotrs/rentalcar-ddd/internal/loyalty/domain"
+
+	consRental "github.com/thiagotrs/rentalcar-ddd/internal/rental/adapters/consumer"
 	ehRental "github.com/thiagotrs/rentalcar-ddd/internal/rental/adapters/eventhandler"
 	hRental "github.com/thiagotrs/rentalcar-ddd/internal/rental/adapters/http"
 	repoRental "github.com/thiagotrs/rentalcar-ddd/internal/rental/adapters/repository"
 	svcRental "github.com/thiagotrs/rentalcar-ddd/internal/rental/adapters/service"
 	appRental "github.com/thiagotrs/rentalcar-ddd/internal/rental/application"
 	domainRental "github.com/thiagotrs/rentalcar-ddd/internal/rental/domain"
+
+	chNotification "github.com/thiagotrs/rentalcar-ddd/internal/notification/adapters/channel"
+	consNotification "github.com/thiagotrs/rentalcar-ddd/internal/notification/adapters/consumer"
+	ehNotification "github.com/thiagotrs/rentalcar-ddd/internal/notification/adapters/eventhandler"
+	hNotification "github.com/thiagotrs/rentalcar-ddd/internal/notification/adapters/http"
+	repoNotification "github.com/thiagotrs/rentalcar-ddd/internal/notification/adapters/repository"
+	appNotification "github.com/thiagotrs/rentalcar-ddd/internal/notification/application"
+	domainNotification "github.com/thiagotrs/rentalcar-ddd/internal/notification/domain"
+
+	clientWebhook "github.com/thiagotrs/rentalcar-ddd/internal/webhook/adapters/client"
+	consWebhook "github.com/thiagotrs/rentalcar-ddd/internal/webhook/adapters/consumer"
+	ehWebhook "github.com/thiagotrs/rentalcar-ddd/internal/webhook/adapters/eventhandler"
+	hWebhook "github.com/thiagotrs/rentalcar-ddd/internal/webhook/adapters/http"
+	repoWebhook "github.com/thiagotrs/rentalcar-ddd/internal/webhook/adapters/repository"
+	appWebhook "github.com/thiagotrs/rentalcar-ddd/internal/webhook/application"
+	domainWebhook "github.com/thiagotrs/rentalcar-ddd/internal/webhook/domain"
 )
 
-func setupLogistics(db *sqlx.DB, r *mux.Router, e events.Dispatcher, b broker.Subscriber) ipc.LogisticsIPC {
+func setupCatalog(db *sqlx.DB, r *mux.Router) ipc.CatalogIPC {
+	modelRepo := repoCatalog.NewModelRepositorySqlx(context.Background(), db)
+	modelUC := appCatalog.NewModelUseCase(modelRepo)
+	modelController := hCatalog.NewModelController(modelUC)
+
+	r.HandleFunc("/models/{id}", modelController.GetModelById).Methods("GET")
+	r.HandleFunc("/models/{id}", modelController.DeleteModel).Methods("DELETE")
+	r.HandleFunc("/models/", modelController.SearchModels).Methods("GET")
+	r.HandleFunc("/models/", modelController.CreateModel).Methods("POST")
+
+	return ipcCatalog.NewModelIPC(modelUC)
+}
+
+func setupLogistics(db *sqlx.DB, r *mux.Router, e events.Dispatcher, b broker.Broker, c ipc.CatalogIPC, p ipc.PricingIPC, conf config.LogisticsConfig) ipc.LogisticsIPC {
 	// LOGISTICS STATION
 
 	stationRepo := repoLogistics.NewStationRepositorySqlx(context.Background(), db)
-	stationUC := appLogistics.NewStationUseCase(stationRepo)
+	carRepo := repoLogistics.NewCarRepositorySqlx(context.Background(), db, e)
+	stationUC := appLogistics.NewStationUseCase(stationRepo, carRepo, newGeocoder(conf.Geocoder))
 	stationController := hLogistics.NewStationController(stationUC)
 
-	ehStation := ehLogistics.NewStationEventHandler(stationUC)
+	occupancyRepo := repoLogistics.NewOccupancyRepositorySqlx(context.Background(), db)
+	occupancyUC := appLogistics.NewOccupancyUseCase(occupancyRepo, stationRepo, carRepo)
+	occupancyController := hLogistics.NewOccupancyController(occupancyUC)
+
+	ehStation := ehLogistics.NewStationEventHandler(occupancyUC)
 	e.Register(events.EventHandlerFunc(ehStation.HandleCarAdded), domainLogistics.CarAdded{}.Name())
 	e.Register(events.EventHandlerFunc(ehStation.HandleCarParked), domainLogistics.CarParked{}.Name())
 	e.Register(events.EventHandlerFunc(ehStation.HandleCarUnderMaintenance), domainLogistics.CarUnderMaintenance{}.Name())
@@ -57,12 +111,31 @@ func setupLogistics(db *sqlx.DB, r *mux.Router, e events.Dispatcher, b broker.Su
 	chConfirmedOrder := b.Subscribe(string(consumer.OrderConfirmed))
 	chCanceledOrder := b.Subscribe(string(consumer.OrderCanceled))
 	chClosedOrder := b.Subscribe(string(consumer.OrderClosed))
+	chCarSwappedOrder := b.Subscribe(string(consumer.OrderCarSwapped))
 	go broker.Consume(chOpenedOrder, broker.ConsumerFunc(cons.ConsumeOpenedOrder))
 	go broker.Consume(chConfirmedOrder, broker.ConsumerFunc(cons.ConsumeConfirmedOrder))
 	go broker.Consume(chCanceledOrder, broker.ConsumerFunc(cons.ConsumeCanceledOrder))
 	go broker.Consume(chClosedOrder, broker.ConsumerFunc(cons.ConsumeClosedOrder))
+	go broker.Consume(chCarSwappedOrder, broker.ConsumerFunc(cons.ConsumeCarSwappedOrder))
+
+	subscriptionCons := consumer.NewSubscriptionConsumer(e)
+	chStartedSubscription := b.Subscribe(string(consumer.SubscriptionStarted))
+	chCarSwappedSubscription := b.Subscribe(string(consumer.SubscriptionCarSwapped))
+	chPausedSubscription := b.Subscribe(string(consumer.SubscriptionPaused))
+	chResumedSubscription := b.Subscribe(string(consumer.SubscriptionResumed))
+	chCanceledSubscription := b.Subscribe(string(consumer.SubscriptionCanceled))
+	go broker.Consume(chStartedSubscription, broker.ConsumerFunc(subscriptionCons.ConsumeStartedSubscription))
+	go broker.Consume(chCarSwappedSubscription, broker.ConsumerFunc(subscriptionCons.ConsumeCarSwappedSubscription))
+	go broker.Consume(chPausedSubscription, broker.ConsumerFunc(subscriptionCons.ConsumePausedSubscription))
+	go broker.Consume(chResumedSubscription, broker.ConsumerFunc(subscriptionCons.ConsumeResumedSubscription))
+	go broker.Consume(chCanceledSubscription, broker.ConsumerFunc(subscriptionCons.ConsumeCanceledSubscription))
 
 	r.HandleFunc("/stations/{id}/capacity", stationController.UpdateStationCapacity).Methods("PUT")
+	r.HandleFunc("/stations/{id}/location", stationController.UpdateStationLocation).Methods("PUT")
+	r.HandleFunc("/stations/{id}/schedule", stationController.UpdateStationSchedule).Methods("PUT")
+	r.HandleFunc("/stations/nearby", stationController.SearchNearbyStations).Methods("GET")
+	r.HandleFunc("/stations/occupancy/drift", occupancyController.GetOccupancyDrift).Methods("GET")
+	r.HandleFunc("/stations/occupancy/reconcile", occupancyController.ReconcileOccupancy).Methods("POST")
 	r.HandleFunc("/stations/{id}", stationController.GetStationById).Methods("GET")
 	r.HandleFunc("/stations/{id}", stationController.DeleteStation).Methods("DELETE")
 	r.HandleFunc("/stations/", stationController.GetStations).Methods("GET")
@@ -70,31 +143,144 @@ func setupLogistics(db *sqlx.DB, r *mux.Router, e events.Dispatcher, b broker.Su
 
 	// LOGISTICS CAR
 
-	carRepo := repoLogistics.NewCarRepositorySqlx(context.Background(), db, e)
-	carUC := appLogistics.NewCarUseCase(carRepo, stationRepo)
+	carUC := appLogistics.NewCarUseCase(carRepo, stationRepo, catLogistics.NewModelCatalogIPC(c))
 	carController := hLogistics.NewCarController(carUC)
 
-	carIPC := ipcLogistics.NewCarIPC(carUC)
-
 	ehCar := ehLogistics.NewCarEventHandler(carUC)
 	e.Register(events.EventHandlerFunc(ehCar.HandleSyncCarInTransit), domainLogistics.SyncCarInTransit{}.Name())
 	e.Register(events.EventHandlerFunc(ehCar.HandleSyncCarParked), domainLogistics.SyncCarParked{}.Name())
 	e.Register(events.EventHandlerFunc(ehCar.HandleSyncCarReserved), domainLogistics.SyncCarReserved{}.Name())
 
+	ehAvailability := ehLogistics.NewAvailabilityEventHandler(carUC, b)
+	e.Register(events.EventHandlerFunc(ehAvailability.HandleCarParked), events.Committed{Event: domainLogistics.CarParked{}}.Name())
+	e.Register(events.EventHandlerFunc(ehAvailability.HandleSyncCarParked), domainLogistics.SyncCarParked{}.Name())
+
+	ehFleet := ehLogistics.NewFleetEventHandler(b)
+	e.Register(events.EventHandlerFunc(ehFleet.HandleCarInTransfer), domainLogistics.CarInTransfer{}.Name())
+	e.Register(events.EventHandlerFunc(ehFleet.HandleMaintenanceDue), domainLogistics.MaintenanceDue{}.Name())
+	e.Register(events.EventHandlerFunc(ehFleet.HandleDocumentExpiring), domainLogistics.DocumentExpiry{}.Name())
+	e.Register(events.EventHandlerFunc(ehFleet.HandleTelemetryRecorded), domainLogistics.TelemetryRecorded{}.Name())
+
+	waitlistCons := consumer.NewWaitlistConsumer(e)
+	chHeldWaitlist := b.Subscribe(string(consumer.WaitlistCarHeld))
+	chReleasedWaitlist := b.Subscribe(string(consumer.WaitlistHoldReleased))
+	go broker.Consume(chHeldWaitlist, broker.ConsumerFunc(waitlistCons.ConsumeHeldWaitlist))
+	go broker.Consume(chReleasedWaitlist, broker.ConsumerFunc(waitlistCons.ConsumeReleasedWaitlist))
+
 	r.HandleFunc("/cars/{id}/maintenance/", carController.UpdateCarToMaintenance).Methods("PUT")
 	r.HandleFunc("/cars/{id}/park/", carController.UpdateCarToPark).Methods("PUT")
 	r.HandleFunc("/cars/{id}/transfer/", carController.UpdateCarToTransfer).Methods("PUT")
+	r.HandleFunc("/cars/import", carController.ImportCars).Methods("POST")
+	r.HandleFunc("/cars/export", carController.ExportCars).Methods("GET")
 	r.HandleFunc("/cars/{id}", carController.GetCarById).Methods("GET")
-	r.HandleFunc("/cars/{id}", carController.DeleteCar).Methods("DELETE")
 	r.HandleFunc("/cars/", carController.SearchCars).Methods("GET")
 	r.HandleFunc("/cars/", carController.CreateCar).Methods("POST")
 
-	return carIPC
+	// LOGISTICS LIFECYCLE
+
+	lifecycleRepo := repoLogistics.NewLifecycleRepositorySqlx(context.Background(), db, e)
+	lifecycleUC := appLogistics.NewLifecycleUseCase(lifecycleRepo, carRepo, stationRepo)
+	lifecycleController := hLogistics.NewLifecycleController(lifecycleUC)
+
+	r.HandleFunc("/cars/{id}/lifecycle", lifecycleController.GetCarLifecycle).Methods("GET")
+	r.HandleFunc("/cars/{id}/acquisition/", lifecycleController.UpdateCarAcquisition).Methods("PUT")
+	r.HandleFunc("/cars/{id}/depreciation", lifecycleController.GetCarDepreciation).Methods("GET")
+	r.HandleFunc("/cars/{id}/for-sale/", lifecycleController.UpdateCarToForSale).Methods("PUT")
+	r.HandleFunc("/cars/{id}/sold/", lifecycleController.UpdateCarToSold).Methods("PUT")
+	r.HandleFunc("/cars/{id}", lifecycleController.RetireCar).Methods("DELETE")
+	r.HandleFunc("/reports/fleet-age", lifecycleController.GetFleetAgeReport).Methods("GET")
+
+	// LOGISTICS TELEMETRY
+
+	telemetryRepo := repoLogistics.NewTelemetryRepositorySqlx(context.Background(), db, e)
+	telemetryUC := appLogistics.NewTelemetryUseCase(telemetryRepo, carRepo)
+	telemetryController := hLogistics.NewTelemetryController(telemetryUC)
+
+	r.HandleFunc("/cars/{id}/track", telemetryController.GetCarTrack).Methods("GET")
+	r.HandleFunc("/telemetry/", telemetryController.IngestTelemetry).Methods("POST")
+
+	// LOGISTICS MAINTENANCE
+
+	workOrderRepo := repoLogistics.NewWorkOrderRepositorySqlx(context.Background(), db, e)
+	planRepo := repoLogistics.NewMaintenancePlanRepositorySqlx(context.Background(), db)
+	maintenanceUC := appLogistics.NewMaintenanceUseCase(workOrderRepo, planRepo, carRepo, lifecycleRepo)
+	maintenanceController := hLogistics.NewMaintenanceController(maintenanceUC)
+
+	go runDaily(func() {
+		dues := []events.Event{}
+		for _, d := range maintenanceUC.GetDueMaintenance(maintenanceDueDays) {
+			dues = append(dues, d)
+		}
+		if err := e.Dispatch(dues); err != nil {
+			log.Println(err)
+		}
+	})
+
+	// LOGISTICS DOCUMENTS
+
+	documentRepo := repoLogistics.NewDocumentRepositorySqlx(context.Background(), db)
+	documentUC := appLogistics.NewDocumentUseCase(documentRepo, carRepo)
+	documentController := hLogistics.NewDocumentController(documentUC)
+
+	go runDaily(func() {
+		flagged, err := documentUC.FlagExpiringDocuments(documentExpiryDays)
+		if err != nil {
+			log.Println(err)
+		}
+		expiries := []events.Event{}
+		for _, d := range flagged {
+			expiries = append(expiries, d)
+		}
+		if err := e.Dispatch(expiries); err != nil {
+			log.Println(err)
+		}
+	})
+
+	r.HandleFunc("/cars/{id}/documents", documentController.GetCarDocuments).Methods("GET")
+	r.HandleFunc("/cars/{id}/documents", documentController.CreateCarDocument).Methods("POST")
+	r.HandleFunc("/documents/expiring", documentController.GetExpiringDocuments).Methods("GET")
+	r.HandleFunc("/documents/{id}/renew/", documentController.UpdateToRenewCarDocument).Methods("PUT")
+
+	// LOGISTICS ASSIGNMENT
+
+	criteria, err := domainLogistics.ParseAssignmentCriteria(conf.Assignment)
+	if err != nil {
+		log.Fatal(err)
+	}
+	assignmentUC := appLogistics.NewAssignmentUseCase(
+		carRepo, occupancyRepo, maintenanceUC, documentUC,
+		domainLogistics.NewCriteriaAssignment(criteria...), priceLogistics.NewUpgradeCatalogIPC(p))
+	assignmentController := hLogistics.NewAssignmentController(assignmentUC)
+
+	r.HandleFunc("/stations/{id}/assignment", assignmentController.GetAssignmentCandidates).Methods("GET")
+
+	carIPC := ipcLogistics.NewCarIPC(assignmentUC, carUC, e)
+
+	e.Register(events.EventHandlerFunc(ehCar.HandleWorkOrderCompleted), domainLogistics.WorkOrderCompleted{}.Name())
+
+	r.HandleFunc("/cars/{id}/maintenance-history", maintenanceController.GetMaintenanceHistory).Methods("GET")
+	r.HandleFunc("/maintenance/work-orders/{id}/complete/", maintenanceController.UpdateToCompleteWorkOrder).Methods("PUT")
+	r.HandleFunc("/maintenance/work-orders/{id}", maintenanceController.GetWorkOrderById).Methods("GET")
+	r.HandleFunc("/maintenance/work-orders/", maintenanceController.CreateWorkOrder).Methods("POST")
+	r.HandleFunc("/maintenance/plans/{id}", maintenanceController.DeleteMaintenancePlan).Methods("DELETE")
+	r.HandleFunc("/maintenance/plans/", maintenanceController.GetMaintenancePlans).Methods("GET")
+	r.HandleFunc("/maintenance/plans/", maintenanceController.CreateMaintenancePlan).Methods("POST")
+	r.HandleFunc("/maintenance/due", maintenanceController.GetDueMaintenance).Methods("GET")
+
+	// LOGISTICS REBALANCE
+
+	rebalanceUC := appLogistics.NewRebalanceUseCase(carUC, carRepo, stationRepo)
+	rebalanceController := hLogistics.NewRebalanceController(rebalanceUC)
+
+	r.HandleFunc("/logistics/rebalance-plan/execute", rebalanceController.ExecuteRebalancePlan).Methods("POST")
+	r.HandleFunc("/logistics/rebalance-plan", rebalanceController.GetRebalancePlan).Methods("GET")
+
+	return ipcLogistics.NewLogisticsIPC(carIPC, ipcLogistics.NewStationIPC(stationUC))
 }
 
-func setupPricing(db *sqlx.DB, r *mux.Router, e events.Dispatcher, b broker.Publisher) ipc.PricingIPC {
+func setupPricing(db *sqlx.DB, r *mux.Router, e events.Dispatcher, b broker.Publisher, c ipc.CatalogIPC) ipc.PricingIPC {
 	categoryRepo := repoPricing.NewCategoryRepositorySqlx(context.Background(), db)
-	categoryUC := appPricing.NewCategoryUseCase(categoryRepo)
+	categoryUC := appPricing.NewCategoryUseCase(categoryRepo, catPricing.NewModelCatalogIPC(c))
 	categoryController := hPricing.NewCategoryController(categoryUC)
 
 	categoryIPC := ipcPricing.NewCategoryIPC(categoryUC)
@@ -103,6 +289,8 @@ func setupPricing(db *sqlx.DB, r *mux.Router, e events.Dispatcher, b broker.Publ
 	r.HandleFunc("/categories/{id}/model/", categoryController.UpdateDelModelInCategory).Methods("DELETE")
 	r.HandleFunc("/categories/{id}/policy/", categoryController.UpdateAddPolicyInCategory).Methods("PUT")
 	r.HandleFunc("/categories/{id}/policy/{policyId}", categoryController.UpdateDelPolicyInCategory).Methods("DELETE")
+	r.HandleFunc("/categories/{id}/energy-prices/", categoryController.UpdateCategoryEnergyPrices).Methods("PUT")
+	r.HandleFunc("/categories/{id}/tier/", categoryController.UpdateCategoryTier).Methods("PUT")
 	r.HandleFunc("/categories/{id}", categoryController.GetCategoryById).Methods("GET")
 	r.HandleFunc("/categories/{id}", categoryController.DeleteCategory).Methods("DELETE")
 	r.HandleFunc("/categories/", categoryController.GetCategories).Methods("GET")
@@ -111,10 +299,39 @@ func setupPricing(db *sqlx.DB, r *mux.Router, e events.Dispatcher, b broker.Publ
 	return categoryIPC
 }
 
-func setupRental(db *sqlx.DB, r *mux.Router, e events.Dispatcher, b broker.Publisher, l ipc.LogisticsIPC, p ipc.PricingIPC) {
-	orderSvc := svcRental.NewOrderServiceIPC(l, p)
+func setupLoyalty(db *sqlx.DB, r *mux.Router, e events.Dispatcher, b broker.Subscriber) ipc.LoyaltyIPC {
+	memberRepo := repoLoyalty.NewMemberRepositorySqlx(context.Background(), db)
+	rateRepo := repoLoyalty.NewEarnRateRepositorySqlx(context.Background(), db)
+	memberUC := appLoyalty.NewMemberUseCase(memberRepo, rateRepo)
+	memberController := hLoyalty.NewMemberController(memberUC)
+
+	ehMember := ehLoyalty.NewMemberEventHandler(memberUC)
+	e.Register(events.EventHandlerFunc(ehMember.HandleSyncOrderClosed), domainLoyalty.SyncOrderClosed{}.Name())
+	e.Register(events.EventHandlerFunc(ehMember.HandleSyncOrderCanceled), domainLoyalty.SyncOrderCanceled{}.Name())
+
+	cons := consLoyalty.NewOrderConsumer(e)
+	chClosedOrder := b.Subscribe(string(consLoyalty.OrderClosed))
+	chCanceledOrder := b.Subscribe(string(consLoyalty.OrderCanceled))
+	go broker.Consume(chClosedOrder, broker.ConsumerFunc(cons.ConsumeClosedOrder))
+	go broker.Consume(chCanceledOrder, broker.ConsumerFunc(cons.ConsumeCanceledOrder))
+
+	r.HandleFunc("/loyalty/members/{id}/benefits", memberController.GetMemberBenefits).Methods("GET")
+	r.HandleFunc("/loyalty/members/{id}", memberController.GetMemberById).Methods("GET")
+	r.HandleFunc("/loyalty/members/", memberController.GetMembers).Methods("GET")
+	r.HandleFunc("/loyalty/members/", memberController.CreateMember).Methods("POST")
+	r.HandleFunc("/loyalty/earn-rates/{categoryId}", memberController.UpdateEarnRate).Methods("PUT")
+	r.HandleFunc("/loyalty/earn-rates/", memberController.GetEarnRates).Methods("GET")
+
+	return ipcLoyalty.NewMemberIPC(memberUC)
+}
+
+func setupRental(db *sqlx.DB, r *mux.Router, e events.Dispatcher, b broker.Broker, l ipc.LogisticsIPC, p ipc.PricingIPC, y ipc.LoyaltyIPC) {
+	orderSvc := svcRental.NewOrderServiceIPC(l, p, y)
 	orderRepo := repoRental.NewOrderRepositorySqlx(context.Background(), db, e)
-	orderUC := appRental.NewOrderUseCase(orderRepo, orderSvc)
+	accountRepo := repoRental.NewAccountRepositorySqlx(context.Background(), db)
+	eligibilityRepo := repoRental.NewEligibilityRepositorySqlx(context.Background(), db)
+	waitlistRepo := repoRental.NewWaitlistRepositorySqlx(context.Background(), db, e)
+	orderUC := appRental.NewOrderUseCase(orderRepo, orderSvc, accountRepo, eligibilityRepo, waitlistRepo)
 	orderController := hRental.NewOrderController(orderUC)
 
 	ehOrder := ehRental.NewOrderEventHandler(b)
@@ -122,12 +339,276 @@ func setupRental(db *sqlx.DB, r *mux.Router, e events.Dispatcher, b broker.Publi
 	e.Register(events.EventHandlerFunc(ehOrder.HandleConfirmedOrder), domainRental.ConfirmedOrder{}.Name())
 	e.Register(events.EventHandlerFunc(ehOrder.HandleClosedOrder), domainRental.ClosedOrder{}.Name())
 	e.Register(events.EventHandlerFunc(ehOrder.HandleCanceledOrder), domainRental.CanceledOrder{}.Name())
+	e.Register(events.EventHandlerFunc(ehOrder.HandleCarSwappedOrder), domainRental.CarSwappedOrder{}.Name())
 
 	r.HandleFunc("/orders/{id}/confirm/", orderController.UpdateToComfirmOrder).Methods("PUT")
 	r.HandleFunc("/orders/{id}/close/", orderController.UpdateToCloseOrder).Methods("PUT")
 	r.HandleFunc("/orders/{id}/cancel/", orderController.UpdateToCancelOrder).Methods("PUT")
+	r.HandleFunc("/orders/{id}/swap-car/", orderController.UpdateToSwapCarOrder).Methods("PUT")
 	r.HandleFunc("/orders/{id}", orderController.GetOrderById).Methods("GET")
 	r.HandleFunc("/orders/", orderController.CreateOrder).Methods("POST")
+
+	bookingRepo := repoRental.NewBookingRepositorySqlx(context.Background(), db, e)
+	bookingUC := appRental.NewBookingUseCase(bookingRepo, orderSvc, eligibilityRepo)
+	bookingController := hRental.NewBookingController(bookingUC)
+
+	r.HandleFunc("/bookings/quote", bookingController.QuoteBooking).Methods("POST")
+	r.HandleFunc("/bookings/{id}/invoice", bookingController.GetBookingInvoice).Methods("GET")
+	r.HandleFunc("/bookings/{id}", bookingController.GetBookingById).Methods("GET")
+	r.HandleFunc("/bookings/", bookingController.CreateBooking).Methods("POST")
+
+	subscriptionRepo := repoRental.NewSubscriptionRepositorySqlx(context.Background(), db, e)
+	subscriptionUC := appRental.NewSubscriptionUseCase(subscriptionRepo, orderSvc, eligibilityRepo)
+	subscriptionController := hRental.NewSubscriptionController(subscriptionUC)
+
+	go runDaily(func() {
+		if err := subscriptionUC.BillDueSubscriptions(time.Now()); err != nil {
+			log.Println(err)
+		}
+	})
+
+	ehSubscription := ehRental.NewSubscriptionEventHandler(b)
+	e.Register(events.EventHandlerFunc(ehSubscription.HandleStartedSubscription), domainRental.SubscriptionStarted{}.Name())
+	e.Register(events.EventHandlerFunc(ehSubscription.HandleCarSwappedSubscription), domainRental.SubscriptionCarSwapped{}.Name())
+	e.Register(events.EventHandlerFunc(ehSubscription.HandlePausedSubscription), domainRental.SubscriptionPaused{}.Name())
+	e.Register(events.EventHandlerFunc(ehSubscription.HandleResumedSubscription), domainRental.SubscriptionResumed{}.Name())
+	e.Register(events.EventHandlerFunc(ehSubscription.HandleCanceledSubscription), domainRental.SubscriptionCanceled{}.Name())
+
+	r.HandleFunc("/subscriptions/{id}/bill/", subscriptionController.UpdateToBillSubscription).Methods("PUT")
+	r.HandleFunc("/subscriptions/{id}/swap-car/", subscriptionController.UpdateToSwapCarSubscription).Methods("PUT")
+	r.HandleFunc("/subscriptions/{id}/pause/", subscriptionController.UpdateToPauseSubscription).Methods("PUT")
+	r.HandleFunc("/subscriptions/{id}/resume/", subscriptionController.UpdateToResumeSubscription).Methods("PUT")
+	r.HandleFunc("/subscriptions/{id}/cancel/", subscriptionController.UpdateToCancelSubscription).Methods("PUT")
+	r.HandleFunc("/subscriptions/{id}/invoice", subscriptionController.GetSubscriptionInvoice).Methods("GET")
+	r.HandleFunc("/subscriptions/{id}", subscriptionController.GetSubscriptionById).Methods("GET")
+	r.HandleFunc("/subscriptions/", subscriptionController.CreateSubscription).Methods("POST")
+
+	accountUC := appRental.NewAccountUseCase(accountRepo)
+	accountController := hRental.NewAccountController(accountUC)
+
+	r.HandleFunc("/accounts/{id}/credit-limit/", accountController.UpdateAccountCreditLimit).Methods("PUT")
+	r.HandleFunc("/accounts/{id}/drivers/", accountController.UpdateAddDriverInAccount).Methods("PUT")
+	r.HandleFunc("/accounts/{id}/drivers/{license}", accountController.UpdateDelDriverInAccount).Methods("DELETE")
+	r.HandleFunc("/accounts/{id}/rates/{categoryId}/{unit}", accountController.UpdateAccountRate).Methods("PUT")
+	r.HandleFunc("/accounts/{id}/rates/{categoryId}/{unit}", accountController.UpdateDelRateInAccount).Methods("DELETE")
+	r.HandleFunc("/accounts/{id}/invoices/{year}/{month}", accountController.GetAccountMonthlyInvoice).Methods("GET")
+	r.HandleFunc("/accounts/{id}", accountController.GetAccountById).Methods("GET")
+	r.HandleFunc("/accounts/", accountController.GetAccounts).Methods("GET")
+	r.HandleFunc("/accounts/", accountController.CreateAccount).Methods("POST")
+
+	eligibilityUC := appRental.NewEligibilityUseCase(eligibilityRepo)
+	eligibilityController := hRental.NewEligibilityController(eligibilityUC)
+
+	r.HandleFunc("/eligibility/rules/{categoryId}", eligibilityController.GetRuleByCategory).Methods("GET")
+	r.HandleFunc("/eligibility/rules/{categoryId}", eligibilityController.UpdateRuleOfCategory).Methods("PUT")
+	r.HandleFunc("/eligibility/rules/{categoryId}", eligibilityController.DeleteRuleOfCategory).Methods("DELETE")
+	r.HandleFunc("/eligibility/rules/", eligibilityController.GetRules).Methods("GET")
+	r.HandleFunc("/eligibility/blocked-drivers/{license}", eligibilityController.UpdateToBlockDriver).Methods("PUT")
+	r.HandleFunc("/eligibility/blocked-drivers/{license}", eligibilityController.UpdateToUnblockDriver).Methods("DELETE")
+	r.HandleFunc("/eligibility/blocked-drivers/", eligibilityController.GetBlockedDrivers).Methods("GET")
+
+	waitlistUC := appRental.NewWaitlistUseCase(waitlistRepo, orderSvc)
+	waitlistController := hRental.NewWaitlistController(waitlistUC, orderUC)
+
+	ehWaitlist := ehRental.NewWaitlistEventHandler(waitlistUC, b)
+	e.Register(events.EventHandlerFunc(ehWaitlist.HandleSyncCarAvailable), domainRental.SyncCarAvailable{}.Name())
+	e.Register(events.EventHandlerFunc(ehWaitlist.HandleWaitlistCarHeld), domainRental.WaitlistCarHeld{}.Name())
+	e.Register(events.EventHandlerFunc(ehWaitlist.HandleWaitlistHoldReleased), domainRental.WaitlistHoldReleased{}.Name())
+
+	carCons := consRental.NewCarConsumer(e)
+	chAvailableCar := b.Subscribe(string(consRental.CarAvailable))
+	go broker.Consume(chAvailableCar, broker.ConsumerFunc(carCons.ConsumeAvailableCar))
+
+	go runEvery(waitlistCheckInterval, func() {
+		for _, entry := range waitlistUC.ExpireEntries(time.Now()) {
+			log.Printf("waitlist %s expired", entry.ID)
+		}
+	})
+
+	r.HandleFunc("/waitlist/{id}/withdraw/", waitlistController.UpdateToWithdrawWaitlistEntry).Methods("PUT")
+	r.HandleFunc("/waitlist/{id}/book/", waitlistController.UpdateToBookWaitlistEntry).Methods("PUT")
+	r.HandleFunc("/waitlist/{id}", waitlistController.GetWaitlistEntryById).Methods("GET")
+	r.HandleFunc("/waitlist/", waitlistController.CreateWaitlistEntry).Methods("POST")
+
+	geofenceRepo := repoRental.NewGeofenceRepositorySqlx(context.Background(), db)
+	positionRepo := repoRental.NewPositionRepositorySqlx(context.Background(), db)
+	alertRepo := repoRental.NewAlertRepositorySqlx(context.Background(), db, e)
+	geofenceUC := appRental.NewGeofenceUseCase(geofenceRepo, positionRepo, alertRepo, orderRepo, orderSvc)
+	geofenceController := hRental.NewGeofenceController(geofenceUC)
+
+	ehGeofence := ehRental.NewGeofenceEventHandler(geofenceUC, b)
+	e.Register(events.EventHandlerFunc(ehGeofence.HandleSyncCarPositionRecorded), domainRental.SyncCarPositionRecorded{}.Name())
+	e.Register(events.EventHandlerFunc(ehGeofence.HandleCarLeftZone), domainRental.CarLeftZone{}.Name())
+	e.Register(events.EventHandlerFunc(ehGeofence.HandleCarOverdueOutsideStation), domainRental.CarOverdueOutsideStation{}.Name())
+
+	telemetryCons := consRental.NewTelemetryConsumer(e)
+	chTelemetry := b.Subscribe(string(consRental.TelemetryRecorded))
+	go broker.Consume(chTelemetry, broker.ConsumerFunc(telemetryCons.ConsumeTelemetryRecorded))
+
+	go runEvery(geofenceCheckInterval, func() {
+		if err := geofenceUC.CheckOverdueReturns(time.Now()); err != nil {
+			log.Println(err)
+		}
+	})
+
+	r.HandleFunc("/geofences/{id}", geofenceController.DeleteGeofence).Methods("DELETE")
+	r.HandleFunc("/geofences/", geofenceController.GetGeofences).Methods("GET")
+	r.HandleFunc("/geofences/", geofenceController.CreateGeofence).Methods("POST")
+	r.HandleFunc("/alerts/", geofenceController.GetAlerts).Methods("GET")
+}
+
+func setupNotification(db *sqlx.DB, r *mux.Router, e events.Dispatcher, b broker.Subscriber, conf config.NotificationConfig) {
+	senders := map[domainNotification.Channel]appNotification.Sender{
+		domainNotification.Outbox:  chNotification.NewOutboxSender(conf.Outbox),
+		domainNotification.Webhook: chNotification.NewWebhookSender(),
+	}
+	if conf.SMTP.Host != "" {
+		senders[domainNotification.Email] = chNotification.NewSMTPSender(conf.SMTP.Host, conf.SMTP.Port, conf.SMTP.User, conf.SMTP.Pass, conf.SMTP.From)
+	}
+	if conf.SMSGateway != "" {
+		senders[domainNotification.SMS] = chNotification.NewSMSSender(conf.SMSGateway)
+	}
+
+	templateRepo := repoNotification.NewTemplateRepositorySqlx(context.Background(), db)
+	recipientRepo := repoNotification.NewRecipientRepositorySqlx(context.Background(), db)
+	notificationRepo := repoNotification.NewNotificationRepositorySqlx(context.Background(), db)
+	templateUC := appNotification.NewTemplateUseCase(templateRepo)
+	recipientUC := appNotification.NewRecipientUseCase(recipientRepo)
+	notificationUC := appNotification.NewNotificationUseCase(notificationRepo, recipientRepo, templateRepo, senders)
+	templateController := hNotification.NewTemplateController(templateUC)
+	recipientController := hNotification.NewRecipientController(recipientUC)
+	notificationController := hNotification.NewNotificationController(notificationUC)
+
+	ehNotice := ehNotification.NewNoticeEventHandler(notificationUC, recipientUC)
+	e.Register(events.EventHandlerFunc(ehNotice.HandleSyncNoticeReceived), domainNotification.SyncNoticeReceived{}.Name())
+
+	cons := consNotification.NewEventConsumer(e)
+	chOpenedOrder := b.Subscribe(string(consNotification.OrderOpened))
+	chConfirmedOrder := b.Subscribe(string(consNotification.OrderConfirmed))
+	chClosedOrder := b.Subscribe(string(consNotification.OrderClosed))
+	chCanceledOrder := b.Subscribe(string(consNotification.OrderCanceled))
+	chCarInTransfer := b.Subscribe(string(consNotification.CarInTransfer))
+	chMaintenanceDue := b.Subscribe(string(consNotification.MaintenanceDue))
+	chDocumentExpiring := b.Subscribe(string(consNotification.DocumentExpiring))
+	chHeldWaitlistCar := b.Subscribe(string(consNotification.WaitlistCarHeld))
+	go broker.Consume(chOpenedOrder, broker.ConsumerFunc(cons.ConsumeOpenedOrder))
+	go broker.Consume(chConfirmedOrder, broker.ConsumerFunc(cons.ConsumeConfirmedOrder))
+	go broker.Consume(chClosedOrder, broker.ConsumerFunc(cons.ConsumeClosedOrder))
+	go broker.Consume(chCanceledOrder, broker.ConsumerFunc(cons.ConsumeCanceledOrder))
+	go broker.Consume(chCarInTransfer, broker.ConsumerFunc(cons.ConsumeCarInTransfer))
+	go broker.Consume(chMaintenanceDue, broker.ConsumerFunc(cons.ConsumeMaintenanceDue))
+	go broker.Consume(chDocumentExpiring, broker.ConsumerFunc(cons.ConsumeDocumentExpiring))
+	go broker.Consume(chHeldWaitlistCar, broker.ConsumerFunc(cons.ConsumeWaitlistCarHeld))
+
+	go runEvery(notificationRetryInterval, func() {
+		for _, n := range notificationUC.DeliverDue(time.Now()) {
+			if n.Status == domainNotification.Failed {
+				log.Printf("notification %s to %s failed: %s", n.ID, n.Address, n.LastError)
+			}
+		}
+	})
+
+	r.HandleFunc("/notifications/templates/{id}", templateController.GetTemplateById).Methods("GET")
+	r.HandleFunc("/notifications/templates/{id}", templateController.DeleteTemplate).Methods("DELETE")
+	r.HandleFunc("/notifications/templates/", templateController.GetTemplates).Methods("GET")
+	r.HandleFunc("/notifications/templates/", templateController.UpdateTemplate).Methods("PUT")
+	r.HandleFunc("/notifications/recipients/{id}", recipientController.GetRecipientById).Methods("GET")
+	r.HandleFunc("/notifications/recipients/{id}", recipientController.DeleteRecipient).Methods("DELETE")
+	r.HandleFunc("/notifications/recipients/", recipientController.GetRecipients).Methods("GET")
+	r.HandleFunc("/notifications/recipients/", recipientController.CreateRecipient).Methods("POST")
+	r.HandleFunc("/notifications/{id}/retry/", notificationController.RetryNotification).Methods("PUT")
+	r.HandleFunc("/notifications/{id}", notificationController.GetNotificationById).Methods("GET")
+	r.HandleFunc("/notifications/", notificationController.GetNotifications).Methods("GET")
+}
+
+func setupWebhook(db *sqlx.DB, r *mux.Router, e events.Dispatcher, b broker.Subscriber) {
+	subscriptionRepo := repoWebhook.NewSubscriptionRepositorySqlx(context.Background(), db)
+	deliveryRepo := repoWebhook.NewDeliveryRepositorySqlx(context.Background(), db)
+	subscriptionUC := appWebhook.NewSubscriptionUseCase(subscriptionRepo)
+	deliveryUC := appWebhook.NewDeliveryUseCase(deliveryRepo, subscriptionRepo, clientWebhook.NewHTTPClient(webhookTimeout))
+	subscriptionController := hWebhook.NewSubscriptionController(subscriptionUC)
+	deliveryController := hWebhook.NewDeliveryController(deliveryUC)
+
+	ehDelivery := ehWebhook.NewDeliveryEventHandler(deliveryUC)
+	e.Register(events.EventHandlerFunc(ehDelivery.HandleSyncBrokerEventReceived), domainWebhook.SyncBrokerEventReceived{}.Name())
+
+	cons := consWebhook.NewEventConsumer(e)
+	chOpenedOrder := b.Subscribe(string(consWebhook.OrderOpened))
+	chConfirmedOrder := b.Subscribe(string(consWebhook.OrderConfirmed))
+	chClosedOrder := b.Subscribe(string(consWebhook.OrderClosed))
+	chCanceledOrder := b.Subscribe(string(consWebhook.OrderCanceled))
+	chCarSwappedOrder := b.Subscribe(string(consWebhook.OrderCarSwapped))
+	chAvailableCar := b.Subscribe(string(consWebhook.CarAvailable))
+	chCarInTransfer := b.Subscribe(string(consWebhook.CarInTransfer))
+	go broker.Consume(chOpenedOrder, broker.ConsumerFunc(cons.ConsumeOpenedOrder))
+	go broker.Consume(chConfirmedOrder, broker.ConsumerFunc(cons.ConsumeConfirmedOrder))
+	go broker.Consume(chClosedOrder, broker.ConsumerFunc(cons.ConsumeClosedOrder))
+	go broker.Consume(chCanceledOrder, broker.ConsumerFunc(cons.ConsumeCanceledOrder))
+	go broker.Consume(chCarSwappedOrder, broker.ConsumerFunc(cons.ConsumeCarSwappedOrder))
+	go broker.Consume(chAvailableCar, broker.ConsumerFunc(cons.ConsumeAvailableCar))
+	go broker.Consume(chCarInTransfer, broker.ConsumerFunc(cons.ConsumeCarInTransfer))
+
+	go runEvery(webhookRetryInterval, func() {
+		for _, d := range deliveryUC.DeliverDue(time.Now()) {
+			if d.Status == domainWebhook.Failed {
+				log.Printf("webhook delivery %s of %s given up", d.ID, d.EventId)
+			}
+		}
+	})
+
+	r.HandleFunc("/webhooks/event-types", subscriptionController.GetEventTypes).Methods("GET")
+	r.HandleFunc("/webhooks/deliveries/{id}/redeliver/", deliveryController.RedeliverDelivery).Methods("PUT")
+	r.HandleFunc("/webhooks/deliveries/{id}", deliveryController.GetDeliveryById).Methods("GET")
+	r.HandleFunc("/webhooks/{id}/deliveries", deliveryController.GetDeliveries).Methods("GET")
+	r.HandleFunc("/webhooks/{id}", subscriptionController.GetSubscriptionById).Methods("GET")
+	r.HandleFunc("/webhooks/{id}", subscriptionController.UpdateSubscription).Methods("PUT")
+	r.HandleFunc("/webhooks/{id}", subscriptionController.DeleteSubscription).Methods("DELETE")
+	r.HandleFunc("/webhooks/", subscriptionController.GetSubscriptions).Methods("GET")
+	r.HandleFunc("/webhooks/", subscriptionController.CreateSubscription).Methods("POST")
+}
+
+const documentExpiryDays = 30
+
+const maintenanceDueDays = 7
+
+const notificationRetryInterval = time.Minute
+
+const (
+	webhookRetryInterval = 15 * time.Second
+	webhookTimeout       = 10 * time.Second
+)
+
+const waitlistCheckInterval = 5 * time.Minute
+
+const geofenceCheckInterval = 5 * time.Minute
+
+const geocoderTimeout = 5 * time.Second
+
+func newGeocoder(conf config.GeocoderConfig) appLogistics.Geocoder {
+	switch {
+	case conf.URL != "":
+		return geocoder.NewHTTPGeocoder(conf.URL, geocoderTimeout)
+	case conf.CepTable != "":
+		entries, err := geocoder.LoadCepTable(conf.CepTable)
+		if err != nil {
+			log.Fatal(err)
+		}
+		return geocoder.NewCepTableGeocoder(entries)
+	}
+
+	log.Fatal("no geocoder configured, set logistics.geocoder.url or logistics.geocoder.ceptable")
+	return nil
+}
+
+func runDaily(job func()) {
+	runEvery(24*time.Hour, job)
+}
+
+func runEvery(interval time.Duration, job func()) {
+	job()
+	for range time.Tick(interval) {
+		job()
+	}
 }
 
 func runAPI(r *mux.Router, c config.AppConfig) {
@@ -152,9 +633,13 @@ func main() {
 
 	router := mux.NewRouter()
 
-	logisticsIPC := setupLogistics(db, router, dispatcher, pubsub)
-	pricingIPC := setupPricing(db, router, dispatcher, pubsub)
-	setupRental(db, router, dispatcher, pubsub, logisticsIPC, pricingIPC)
+	catalogIPC := setupCatalog(db, router)
+	pricingIPC := setupPricing(db, router, dispatcher, pubsub, catalogIPC)
+	logisticsIPC := setupLogistics(db, router, dispatcher, pubsub, catalogIPC, pricingIPC, config.Logistics)
+	loyaltyIPC := setupLoyalty(db, router, dispatcher, pubsub)
+	setupRental(db, router, dispatcher, pubsub, logisticsIPC, pricingIPC, loyaltyIPC)
+	setupNotification(db, router, dispatcher, pubsub, config.Notification)
+	setupWebhook(db, router, dispatcher, pubsub)
 
 	// API
 
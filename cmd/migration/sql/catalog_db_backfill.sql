@@ -0,0 +1,28 @@
+-- Run once, after logistics_db_up.sql, pricing_db_up.sql and
+-- catalog_db_up.sql, on a database whose cars and categories still reference
+-- models by name. Register the fleet models in the catalog first: cars are
+-- matched by make and model, category models by model name, and the first
+-- trim is taken when the catalog has several.
+
+UPDATE cars SET "modelId" = (
+    SELECT vmodels.id FROM vmodels
+    WHERE LOWER(vmodels.make) = LOWER(cars.make) AND LOWER(vmodels.model) = LOWER(cars.model)
+    ORDER BY vmodels.trim, vmodels.id LIMIT 1
+)
+WHERE "modelId" = '' AND EXISTS (
+    SELECT 1 FROM vmodels
+    WHERE LOWER(vmodels.make) = LOWER(cars.make) AND LOWER(vmodels.model) = LOWER(cars.model)
+);
+
+UPDATE cmodels SET name = (
+    SELECT vmodels.id FROM vmodels
+    WHERE LOWER(vmodels.model) = LOWER(cmodels.name)
+    ORDER BY vmodels.make, vmodels.trim, vmodels.id LIMIT 1
+)
+WHERE name NOT IN (SELECT id FROM vmodels) AND EXISTS (
+    SELECT 1 FROM vmodels WHERE LOWER(vmodels.model) = LOWER(cmodels.name)
+);
+
+-- Rows left without a catalog model, to add to the catalog and run again:
+-- SELECT id, make, model FROM cars WHERE "modelId" = '';
+-- SELECT "categoryId", name FROM cmodels WHERE name NOT IN (SELECT id FROM vmodels);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS vimages;
+DROP TABLE IF EXISTS vmodels;
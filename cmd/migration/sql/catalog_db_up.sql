@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS vmodels (
+    id TEXT NOT NULL PRIMARY KEY,
+    make TEXT NOT NULL,
+    model TEXT NOT NULL,
+    trim TEXT NOT NULL DEFAULT '',
+    seats INT NOT NULL,
+    doors INT NOT NULL DEFAULT 0,
+    transmission INT NOT NULL,
+    "fuelType" INT NOT NULL,
+    luggage INT NOT NULL DEFAULT 0,
+    retired BOOLEAN NOT NULL DEFAULT FALSE,
+    UNIQUE (make, model, trim)
+);
+
+CREATE TABLE IF NOT EXISTS vimages (
+    id SERIAL PRIMARY KEY,
+    "modelId" TEXT NOT NULL,
+    url TEXT NOT NULL,
+    FOREIGN KEY ("modelId") REFERENCES vmodels(id)
+);
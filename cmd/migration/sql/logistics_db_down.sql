@@ -1,2 +1,12 @@
+DROP TABLE IF EXISTS clifecycles;
+DROP TABLE IF EXISTS dattachments;
+DROP TABLE IF EXISTS cdocuments;
+DROP TABLE IF EXISTS telemetry;
+DROP TABLE IF EXISTS occupancy;
+DROP TABLE IF EXISTS mplans;
+DROP TABLE IF EXISTS wparts;
+DROP TABLE IF EXISTS workorders;
 DROP TABLE IF EXISTS cars;
+DROP TABLE IF EXISTS sholidays;
+DROP TABLE IF EXISTS sschedules;
 DROP TABLE IF EXISTS stations;
\ No newline at end of file
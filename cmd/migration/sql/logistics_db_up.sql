@@ -7,7 +7,11 @@ CREATE TABLE IF NOT EXISTS cars (
     make TEXT NOT NULL,
     "stationId" TEXT NOT NULL,
     km INT NOT NULL,
-    status INT NOT NULL
+    status INT NOT NULL,
+    energy INT NOT NULL DEFAULT 1,
+    "fuelLevel" INT NOT NULL DEFAULT 100,
+    "modelId" TEXT NOT NULL DEFAULT '',
+    "lastReportedKM" INT NOT NULL DEFAULT 0
 );
 
 CREATE TABLE IF NOT EXISTS stations (
@@ -19,5 +23,120 @@ CREATE TABLE IF NOT EXISTS stations (
     city TEXT NOT NULL,
     cep TEXT NOT NULL,
     capacity INT NOT NULL,
-    idle INT NOT NULL
-);
\ No newline at end of file
+    idle INT NOT NULL,
+    latitude FLOAT NOT NULL DEFAULT 0,
+    longitude FLOAT NOT NULL DEFAULT 0,
+    "keyDrop" BOOLEAN NOT NULL DEFAULT FALSE,
+    timezone TEXT NOT NULL DEFAULT ''
+);
+
+ALTER TABLE cars ADD COLUMN IF NOT EXISTS energy INT NOT NULL DEFAULT 1;
+ALTER TABLE cars ADD COLUMN IF NOT EXISTS "fuelLevel" INT NOT NULL DEFAULT 100;
+ALTER TABLE cars ADD COLUMN IF NOT EXISTS "modelId" TEXT NOT NULL DEFAULT '';
+ALTER TABLE cars ADD COLUMN IF NOT EXISTS "lastReportedKM" INT NOT NULL DEFAULT 0;
+ALTER TABLE stations ADD COLUMN IF NOT EXISTS latitude FLOAT NOT NULL DEFAULT 0;
+ALTER TABLE stations ADD COLUMN IF NOT EXISTS longitude FLOAT NOT NULL DEFAULT 0;
+ALTER TABLE stations ADD COLUMN IF NOT EXISTS "keyDrop" BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE stations ADD COLUMN IF NOT EXISTS timezone TEXT NOT NULL DEFAULT '';
+
+CREATE TABLE IF NOT EXISTS sschedules (
+    id SERIAL PRIMARY KEY,
+    "stationId" TEXT NOT NULL,
+    weekday INT NOT NULL,
+    opens TEXT NOT NULL,
+    closes TEXT NOT NULL,
+    FOREIGN KEY ("stationId") REFERENCES stations(id)
+);
+
+CREATE TABLE IF NOT EXISTS sholidays (
+    id SERIAL PRIMARY KEY,
+    "stationId" TEXT NOT NULL,
+    date TEXT NOT NULL,
+    name TEXT NOT NULL,
+    FOREIGN KEY ("stationId") REFERENCES stations(id)
+);
+
+CREATE TABLE IF NOT EXISTS workorders (
+    id TEXT NOT NULL PRIMARY KEY,
+    "carId" TEXT NOT NULL,
+    "stationId" TEXT NOT NULL,
+    reason TEXT NOT NULL,
+    type INT NOT NULL,
+    workshop TEXT NOT NULL,
+    cost FLOAT NOT NULL DEFAULT 0,
+    km INT NOT NULL,
+    "dateStart" TIMESTAMP NOT NULL,
+    "dateEnd" TIMESTAMP,
+    status INT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS wparts (
+    id SERIAL PRIMARY KEY,
+    "workOrderId" TEXT NOT NULL,
+    name TEXT NOT NULL,
+    quantity INT NOT NULL,
+    price FLOAT NOT NULL,
+    FOREIGN KEY ("workOrderId") REFERENCES workorders(id)
+);
+
+CREATE TABLE IF NOT EXISTS mplans (
+    id TEXT NOT NULL PRIMARY KEY,
+    "modelId" TEXT NOT NULL UNIQUE,
+    "intervalKM" INT NOT NULL DEFAULT 0,
+    "intervalMonths" INT NOT NULL DEFAULT 0,
+    "createdAt" TIMESTAMP NOT NULL
+);
+CREATE TABLE IF NOT EXISTS occupancy (
+    "eventId" TEXT NOT NULL PRIMARY KEY,
+    "stationId" TEXT NOT NULL,
+    "carId" TEXT NOT NULL DEFAULT '',
+    movement INT NOT NULL,
+    delta INT NOT NULL,
+    date TIMESTAMP NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS telemetry (
+    "carId" TEXT NOT NULL,
+    "recordedAt" TIMESTAMP NOT NULL,
+    latitude FLOAT NOT NULL DEFAULT 0,
+    longitude FLOAT NOT NULL DEFAULT 0,
+    km INT NOT NULL,
+    "fuelLevel" INT NOT NULL,
+    PRIMARY KEY ("carId", "recordedAt")
+);
+
+CREATE TABLE IF NOT EXISTS cdocuments (
+    id TEXT NOT NULL PRIMARY KEY,
+    "carId" TEXT NOT NULL,
+    type INT NOT NULL,
+    number TEXT NOT NULL,
+    "issuedAt" TIMESTAMP NOT NULL,
+    "expiresAt" TIMESTAMP NOT NULL,
+    "flaggedAt" TIMESTAMP,
+    FOREIGN KEY ("carId") REFERENCES cars(id)
+);
+
+CREATE TABLE IF NOT EXISTS dattachments (
+    id SERIAL PRIMARY KEY,
+    "documentId" TEXT NOT NULL,
+    name TEXT NOT NULL,
+    url TEXT NOT NULL,
+    FOREIGN KEY ("documentId") REFERENCES cdocuments(id)
+);
+
+CREATE TABLE IF NOT EXISTS clifecycles (
+    "carId" TEXT NOT NULL PRIMARY KEY,
+    "purchasedAt" TIMESTAMP NULL,
+    "purchaseKM" INT NOT NULL DEFAULT 0,
+    "purchasePrice" REAL NOT NULL DEFAULT 0,
+    supplier TEXT NOT NULL DEFAULT '',
+    "usefulLife" INT NOT NULL DEFAULT 0,
+    "residualValue" REAL NOT NULL DEFAULT 0,
+    "retiredAt" TIMESTAMP NULL,
+    "listedAt" TIMESTAMP NULL,
+    "askingPrice" REAL NOT NULL DEFAULT 0,
+    "soldAt" TIMESTAMP NULL,
+    buyer TEXT NOT NULL DEFAULT '',
+    "salePrice" REAL NOT NULL DEFAULT 0,
+    FOREIGN KEY ("carId") REFERENCES cars(id)
+);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS lrates;
+DROP TABLE IF EXISTS lentries;
+DROP TABLE IF EXISTS lmembers;
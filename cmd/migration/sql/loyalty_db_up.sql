@@ -0,0 +1,23 @@
+CREATE TABLE IF NOT EXISTS lmembers (
+    id TEXT NOT NULL PRIMARY KEY,
+    name TEXT NOT NULL,
+    document TEXT NOT NULL,
+    balance INTEGER NOT NULL DEFAULT 0,
+    lifetime INTEGER NOT NULL DEFAULT 0,
+    tier INTEGER NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS lentries (
+    "memberId" TEXT NOT NULL,
+    "orderId" TEXT NOT NULL,
+    kind INTEGER NOT NULL,
+    points INTEGER NOT NULL,
+    date timestamp NOT NULL, -- datetime
+    PRIMARY KEY ("memberId", "orderId", kind),
+    FOREIGN KEY ("memberId") REFERENCES lmembers(id)
+);
+
+CREATE TABLE IF NOT EXISTS lrates (
+    "categoryId" TEXT NOT NULL PRIMARY KEY,
+    "pointsPerUnit" REAL NOT NULL
+);
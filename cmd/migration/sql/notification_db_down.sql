@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS notifications;
+DROP TABLE IF EXISTS nevents;
+DROP TABLE IF EXISTS nrecipients;
+DROP TABLE IF EXISTS ntemplates;
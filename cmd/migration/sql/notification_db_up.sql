@@ -0,0 +1,52 @@
+CREATE TABLE IF NOT EXISTS ntemplates (
+    id TEXT NOT NULL PRIMARY KEY,
+    event TEXT NOT NULL,
+    locale TEXT NOT NULL,
+    channel TEXT NOT NULL,
+    subject TEXT NOT NULL DEFAULT '',
+    body TEXT NOT NULL,
+    UNIQUE (event, locale, channel)
+);
+
+CREATE TABLE IF NOT EXISTS nrecipients (
+    id TEXT NOT NULL PRIMARY KEY,
+    name TEXT NOT NULL,
+    channel TEXT NOT NULL,
+    address TEXT NOT NULL,
+    locale TEXT NOT NULL,
+    reference TEXT NOT NULL DEFAULT ''
+);
+
+CREATE TABLE IF NOT EXISTS nevents (
+    "recipientId" TEXT NOT NULL,
+    event TEXT NOT NULL,
+    PRIMARY KEY ("recipientId", event),
+    FOREIGN KEY ("recipientId") REFERENCES nrecipients(id)
+);
+
+CREATE TABLE IF NOT EXISTS notifications (
+    id TEXT NOT NULL PRIMARY KEY,
+    "eventId" TEXT NOT NULL,
+    event TEXT NOT NULL,
+    "recipientId" TEXT NOT NULL,
+    channel TEXT NOT NULL,
+    address TEXT NOT NULL,
+    subject TEXT NOT NULL DEFAULT '',
+    body TEXT NOT NULL,
+    status INTEGER NOT NULL,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    "lastError" TEXT NOT NULL DEFAULT '',
+    "createdAt" timestamp NOT NULL, -- datetime
+    "nextAttempt" timestamp, -- datetime
+    "sentAt" timestamp, -- datetime
+    UNIQUE ("eventId", "recipientId")
+);
+
+-- The customer of a waitlist entry is told of the car held for it.
+INSERT INTO ntemplates (id, event, locale, channel, subject, body) VALUES
+    ('7c064dea-4dae-4786-be3a-5cc3fa651415', 'waitlist.car-held', 'en', 'email',
+     'Your {{.carModel}} is held for you',
+     'A {{.carModel}}, plate {{.plate}}, is held for you at station {{.stationId}} from {{.dateFrom}} to {{.dateTo}}. Book it before {{.heldUntil}} or it goes to the next customer.'),
+    ('903adb23-52f7-432b-85bb-e959fc1df7cc', 'waitlist.car-held', 'en', 'sms', '',
+     'A {{.carModel}} ({{.plate}}) is held for you at station {{.stationId}}. Book it before {{.heldUntil}}.')
+ON CONFLICT (event, locale, channel) DO NOTHING;
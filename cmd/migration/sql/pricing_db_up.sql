@@ -3,7 +3,11 @@
 CREATE TABLE IF NOT EXISTS categories (
     id TEXT NOT NULL PRIMARY KEY,
     name TEXT NOT NULL,
-    description TEXT
+    description TEXT,
+    "refuelPrice" FLOAT NOT NULL DEFAULT 0,
+    "rechargePrice" FLOAT NOT NULL DEFAULT 0,
+    "prepaidFuelPrice" FLOAT NOT NULL DEFAULT 0,
+    tier INTEGER NOT NULL DEFAULT 0
 );
 
 CREATE TABLE IF NOT EXISTS cmodels (
@@ -20,5 +24,14 @@ CREATE TABLE IF NOT EXISTS cpolicies (
     unit INT NOT NULL,
     "minUnit" INT NOT NULL,
     "categoryId" TEXT NOT NULL,
+    "kmAllowance" INT NOT NULL DEFAULT 0,
+    "excessKMPrice" FLOAT NOT NULL DEFAULT 0,
     FOREIGN KEY ("categoryId") REFERENCES categories(id) ON DELETE CASCADE
-);
\ No newline at end of file
+);
+
+ALTER TABLE categories ADD COLUMN IF NOT EXISTS "refuelPrice" FLOAT NOT NULL DEFAULT 0;
+ALTER TABLE categories ADD COLUMN IF NOT EXISTS "rechargePrice" FLOAT NOT NULL DEFAULT 0;
+ALTER TABLE categories ADD COLUMN IF NOT EXISTS "prepaidFuelPrice" FLOAT NOT NULL DEFAULT 0;
+ALTER TABLE categories ADD COLUMN IF NOT EXISTS tier INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE cpolicies ADD COLUMN IF NOT EXISTS "kmAllowance" INT NOT NULL DEFAULT 0;
+ALTER TABLE cpolicies ADD COLUMN IF NOT EXISTS "excessKMPrice" FLOAT NOT NULL DEFAULT 0;
@@ -1,3 +1,23 @@
+DROP TABLE IF EXISTS wcars;
+DROP TABLE IF EXISTS waitlist;
+DROP TABLE IF EXISTS eblocked;
+DROP TABLE IF EXISTS elicenses;
+DROP TABLE IF EXISTS erules;
+DROP TABLE IF EXISTS sswaps;
+DROP TABLE IF EXISTS scycles;
+DROP TABLE IF EXISTS spolicies;
+DROP TABLE IF EXISTS scars;
+DROP TABLE IF EXISTS subscriptions;
+DROP TABLE IF EXISTS arates;
+DROP TABLE IF EXISTS adrivers;
+DROP TABLE IF EXISTS accounts;
+DROP TABLE IF EXISTS bookings;
+DROP TABLE IF EXISTS oswaps;
+DROP TABLE IF EXISTS alerts;
+DROP TABLE IF EXISTS positions;
+DROP TABLE IF EXISTS gpoints;
+DROP TABLE IF EXISTS geofences;
+DROP TABLE IF EXISTS ocharges;
 DROP TABLE IF EXISTS ocars;
 DROP TABLE IF EXISTS opolicies;
 DROP TABLE IF EXISTS orders;
\ No newline at end of file
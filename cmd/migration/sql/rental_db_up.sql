@@ -8,7 +8,46 @@ CREATE TABLE IF NOT EXISTS orders (
     "stationFromId" TEXT NOT NULL,
     "stationToId" TEXT NOT NULL,
     discount REAL,
-    tax REAL
+    tax REAL,
+    "prepaidFuel" BOOLEAN NOT NULL DEFAULT FALSE,
+    "keyDropReturn" BOOLEAN NOT NULL DEFAULT FALSE,
+    "bookingId" TEXT NOT NULL DEFAULT '',
+    "accountId" TEXT NOT NULL DEFAULT '',
+    "driverLicense" TEXT NOT NULL DEFAULT '',
+    "memberId" TEXT NOT NULL DEFAULT '',
+    "waitlistId" TEXT NOT NULL DEFAULT ''
+);
+
+CREATE TABLE IF NOT EXISTS bookings (
+    id TEXT NOT NULL PRIMARY KEY,
+    "dateReservFrom" timestamp NOT NULL, -- datetime
+    "dateReservTo" timestamp NOT NULL, -- datetime
+    "stationFromId" TEXT NOT NULL,
+    "stationToId" TEXT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS accounts (
+    id TEXT NOT NULL PRIMARY KEY,
+    name TEXT NOT NULL,
+    document TEXT NOT NULL,
+    "creditLimit" REAL NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS adrivers (
+    "accountId" TEXT NOT NULL,
+    license TEXT NOT NULL,
+    name TEXT NOT NULL,
+    PRIMARY KEY ("accountId", license),
+    FOREIGN KEY ("accountId") REFERENCES accounts(id)
+);
+
+CREATE TABLE IF NOT EXISTS arates (
+    "accountId" TEXT NOT NULL,
+    "categoryId" TEXT NOT NULL,
+    unit INTEGER NOT NULL,
+    price REAL NOT NULL,
+    PRIMARY KEY ("accountId", "categoryId", unit),
+    FOREIGN KEY ("accountId") REFERENCES accounts(id)
 );
 
 CREATE TABLE IF NOT EXISTS ocars (
@@ -22,6 +61,10 @@ CREATE TABLE IF NOT EXISTS ocars (
     "finalKM" INTEGER NOT NULL,
     status INTEGER NOT NULL,
     "stationId" TEXT NOT NULL,
+    energy INTEGER NOT NULL DEFAULT 1,
+    "initialFuel" INTEGER NOT NULL DEFAULT 0,
+    "finalFuel" INTEGER NOT NULL DEFAULT 0,
+    upgraded BOOLEAN NOT NULL DEFAULT FALSE,
     FOREIGN KEY ("orderId") REFERENCES orders(id),
     PRIMARY KEY (id, "orderId")
 );
@@ -35,6 +78,220 @@ CREATE TABLE IF NOT EXISTS opolicies (
     "minUnit" INTEGER NOT NULL,
     "carModel" TEXT NOT NULL,
     "categoryId" TEXT NOT NULL,
+    "refuelPrice" REAL NOT NULL DEFAULT 0,
+    "rechargePrice" REAL NOT NULL DEFAULT 0,
+    "prepaidFuelPrice" REAL NOT NULL DEFAULT 0,
+    "kmAllowance" INTEGER NOT NULL DEFAULT 0,
+    "excessKMPrice" REAL NOT NULL DEFAULT 0,
     FOREIGN KEY ("orderId") REFERENCES orders(id),
     PRIMARY KEY (id, "orderId")
-);
\ No newline at end of file
+);
+
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS "prepaidFuel" BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS "keyDropReturn" BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS "bookingId" TEXT NOT NULL DEFAULT '';
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS "accountId" TEXT NOT NULL DEFAULT '';
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS "driverLicense" TEXT NOT NULL DEFAULT '';
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS "memberId" TEXT NOT NULL DEFAULT '';
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS "waitlistId" TEXT NOT NULL DEFAULT '';
+ALTER TABLE ocars ADD COLUMN IF NOT EXISTS energy INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE ocars ADD COLUMN IF NOT EXISTS "initialFuel" INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE ocars ADD COLUMN IF NOT EXISTS "finalFuel" INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE ocars ADD COLUMN IF NOT EXISTS upgraded BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE opolicies ADD COLUMN IF NOT EXISTS "refuelPrice" REAL NOT NULL DEFAULT 0;
+ALTER TABLE opolicies ADD COLUMN IF NOT EXISTS "rechargePrice" REAL NOT NULL DEFAULT 0;
+ALTER TABLE opolicies ADD COLUMN IF NOT EXISTS "prepaidFuelPrice" REAL NOT NULL DEFAULT 0;
+ALTER TABLE opolicies ADD COLUMN IF NOT EXISTS "kmAllowance" INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE opolicies ADD COLUMN IF NOT EXISTS "excessKMPrice" REAL NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS ocharges (
+    id SERIAL PRIMARY KEY, -- INTEGER AUTOINCREMENT
+    "orderId" TEXT NOT NULL,
+    kind INTEGER NOT NULL,
+    description TEXT NOT NULL,
+    amount REAL NOT NULL,
+    FOREIGN KEY ("orderId") REFERENCES orders(id)
+);
+
+CREATE TABLE IF NOT EXISTS oswaps (
+    id SERIAL PRIMARY KEY, -- INTEGER AUTOINCREMENT
+    "orderId" TEXT NOT NULL,
+    "fromCarId" TEXT NOT NULL,
+    "toCarId" TEXT NOT NULL,
+    "fromCarModel" TEXT NOT NULL,
+    "toCarModel" TEXT NOT NULL,
+    repriced BOOLEAN NOT NULL DEFAULT FALSE,
+    reason TEXT NOT NULL,
+    date timestamp NOT NULL, -- datetime
+    FOREIGN KEY ("orderId") REFERENCES orders(id)
+);
+
+CREATE TABLE IF NOT EXISTS geofences (
+    id TEXT PRIMARY KEY,
+    name TEXT NOT NULL,
+    kind INTEGER NOT NULL,
+    "categoryId" TEXT NOT NULL DEFAULT '',
+    "orderId" TEXT NOT NULL DEFAULT '',
+    "stationId" TEXT NOT NULL DEFAULT '',
+    latitude REAL NOT NULL DEFAULT 0,
+    longitude REAL NOT NULL DEFAULT 0,
+    radius REAL NOT NULL DEFAULT 0
+);
+
+CREATE TABLE IF NOT EXISTS gpoints (
+    id SERIAL PRIMARY KEY, -- INTEGER AUTOINCREMENT
+    "geofenceId" TEXT NOT NULL,
+    seq INTEGER NOT NULL,
+    latitude REAL NOT NULL,
+    longitude REAL NOT NULL,
+    FOREIGN KEY ("geofenceId") REFERENCES geofences(id)
+);
+
+CREATE TABLE IF NOT EXISTS positions (
+    "orderId" TEXT NOT NULL,
+    "carId" TEXT NOT NULL,
+    latitude REAL NOT NULL,
+    longitude REAL NOT NULL,
+    "recordedAt" timestamp NOT NULL, -- datetime
+    FOREIGN KEY ("orderId") REFERENCES orders(id),
+    PRIMARY KEY ("orderId", "recordedAt")
+);
+
+CREATE TABLE IF NOT EXISTS alerts (
+    id TEXT PRIMARY KEY,
+    "orderId" TEXT NOT NULL,
+    "carId" TEXT NOT NULL,
+    kind INTEGER NOT NULL,
+    latitude REAL NOT NULL,
+    longitude REAL NOT NULL,
+    date timestamp NOT NULL, -- datetime
+    FOREIGN KEY ("orderId") REFERENCES orders(id)
+);
+
+CREATE TABLE IF NOT EXISTS subscriptions (
+    id TEXT NOT NULL PRIMARY KEY,
+    status INTEGER NOT NULL,
+    "dateFrom" timestamp NOT NULL, -- datetime
+    "dateTo" timestamp, -- datetime
+    "pausedAt" timestamp, -- datetime
+    pauses INTEGER NOT NULL DEFAULT 0,
+    "stationId" TEXT NOT NULL,
+    "lastKM" INTEGER NOT NULL DEFAULT 0,
+    "terminationFee" REAL NOT NULL DEFAULT 0
+);
+
+CREATE TABLE IF NOT EXISTS scars (
+    id TEXT NOT NULL,
+    "subscriptionId" TEXT NOT NULL,
+    age TEXT NOT NULL,
+    plate TEXT NOT NULL,
+    document TEXT NOT NULL,
+    "carModel" TEXT NOT NULL,
+    "initialKM" INTEGER NOT NULL,
+    "finalKM" INTEGER NOT NULL,
+    status INTEGER NOT NULL,
+    "stationId" TEXT NOT NULL,
+    energy INTEGER NOT NULL DEFAULT 1,
+    "initialFuel" INTEGER NOT NULL DEFAULT 0,
+    "finalFuel" INTEGER NOT NULL DEFAULT 0,
+    FOREIGN KEY ("subscriptionId") REFERENCES subscriptions(id),
+    PRIMARY KEY (id, "subscriptionId")
+);
+
+CREATE TABLE IF NOT EXISTS spolicies (
+    id TEXT NOT NULL,
+    "subscriptionId" TEXT NOT NULL,
+    name TEXT NOT NULL,
+    price REAL NOT NULL,
+    unit INTEGER NOT NULL,
+    "minUnit" INTEGER NOT NULL,
+    "carModel" TEXT NOT NULL,
+    "categoryId" TEXT NOT NULL,
+    "refuelPrice" REAL NOT NULL DEFAULT 0,
+    "rechargePrice" REAL NOT NULL DEFAULT 0,
+    "prepaidFuelPrice" REAL NOT NULL DEFAULT 0,
+    "kmAllowance" INTEGER NOT NULL DEFAULT 0,
+    "excessKMPrice" REAL NOT NULL DEFAULT 0,
+    FOREIGN KEY ("subscriptionId") REFERENCES subscriptions(id),
+    PRIMARY KEY (id, "subscriptionId")
+);
+
+CREATE TABLE IF NOT EXISTS scycles (
+    "subscriptionId" TEXT NOT NULL,
+    number INTEGER NOT NULL,
+    "dateFrom" timestamp NOT NULL, -- datetime
+    "dateTo" timestamp NOT NULL, -- datetime
+    km INTEGER NOT NULL DEFAULT 0,
+    fee REAL NOT NULL,
+    overage REAL NOT NULL DEFAULT 0,
+    billed BOOLEAN NOT NULL DEFAULT FALSE,
+    FOREIGN KEY ("subscriptionId") REFERENCES subscriptions(id),
+    PRIMARY KEY ("subscriptionId", number)
+);
+
+CREATE TABLE IF NOT EXISTS sswaps (
+    id SERIAL PRIMARY KEY, -- INTEGER AUTOINCREMENT
+    "subscriptionId" TEXT NOT NULL,
+    "fromCarId" TEXT NOT NULL,
+    "toCarId" TEXT NOT NULL,
+    "fromCarModel" TEXT NOT NULL,
+    "toCarModel" TEXT NOT NULL,
+    repriced BOOLEAN NOT NULL DEFAULT FALSE,
+    reason TEXT NOT NULL,
+    date timestamp NOT NULL, -- datetime
+    FOREIGN KEY ("subscriptionId") REFERENCES subscriptions(id)
+);
+
+CREATE TABLE IF NOT EXISTS erules (
+    "categoryId" TEXT NOT NULL PRIMARY KEY,
+    "minAge" INTEGER NOT NULL DEFAULT 0,
+    "minLicenseYears" INTEGER NOT NULL DEFAULT 0,
+    "youngDriverAge" INTEGER NOT NULL DEFAULT 0,
+    "youngDriverFee" REAL NOT NULL DEFAULT 0
+);
+
+CREATE TABLE IF NOT EXISTS elicenses (
+    "categoryId" TEXT NOT NULL,
+    "licenseCategory" TEXT NOT NULL,
+    PRIMARY KEY ("categoryId", "licenseCategory"),
+    FOREIGN KEY ("categoryId") REFERENCES erules("categoryId")
+);
+
+CREATE TABLE IF NOT EXISTS eblocked (
+    license TEXT NOT NULL PRIMARY KEY,
+    reason TEXT NOT NULL,
+    date timestamp NOT NULL -- datetime
+);
+
+CREATE TABLE IF NOT EXISTS waitlist (
+    id TEXT NOT NULL PRIMARY KEY,
+    status INTEGER NOT NULL,
+    "stationId" TEXT NOT NULL,
+    "categoryId" TEXT NOT NULL,
+    "carModel" TEXT NOT NULL,
+    "policyId" TEXT NOT NULL,
+    "dateFrom" timestamp NOT NULL, -- datetime
+    "dateTo" timestamp NOT NULL, -- datetime
+    contact TEXT NOT NULL,
+    "createdAt" timestamp NOT NULL, -- datetime
+    "heldUntil" timestamp, -- datetime
+    "orderId" TEXT NOT NULL DEFAULT ''
+);
+
+CREATE TABLE IF NOT EXISTS wcars (
+    id TEXT NOT NULL,
+    "waitlistId" TEXT NOT NULL,
+    age TEXT NOT NULL,
+    plate TEXT NOT NULL,
+    document TEXT NOT NULL,
+    "carModel" TEXT NOT NULL,
+    "initialKM" INTEGER NOT NULL,
+    "finalKM" INTEGER NOT NULL,
+    status INTEGER NOT NULL,
+    "stationId" TEXT NOT NULL,
+    energy INTEGER NOT NULL DEFAULT 1,
+    "initialFuel" INTEGER NOT NULL DEFAULT 0,
+    "finalFuel" INTEGER NOT NULL DEFAULT 0,
+    FOREIGN KEY ("waitlistId") REFERENCES waitlist(id),
+    PRIMARY KEY (id, "waitlistId")
+);
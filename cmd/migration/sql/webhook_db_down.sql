@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS hattempts;
+DROP TABLE IF EXISTS hdeliveries;
+DROP TABLE IF EXISTS hevents;
+DROP TABLE IF EXISTS hsubscriptions;
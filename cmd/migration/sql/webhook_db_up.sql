@@ -0,0 +1,40 @@
+CREATE TABLE IF NOT EXISTS hsubscriptions (
+    id TEXT NOT NULL PRIMARY KEY,
+    partner TEXT NOT NULL,
+    url TEXT NOT NULL,
+    secret TEXT NOT NULL,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    "createdAt" timestamp NOT NULL -- datetime
+);
+
+CREATE TABLE IF NOT EXISTS hevents (
+    "subscriptionId" TEXT NOT NULL,
+    event TEXT NOT NULL,
+    PRIMARY KEY ("subscriptionId", event),
+    FOREIGN KEY ("subscriptionId") REFERENCES hsubscriptions(id)
+);
+
+CREATE TABLE IF NOT EXISTS hdeliveries (
+    id TEXT NOT NULL PRIMARY KEY,
+    "subscriptionId" TEXT NOT NULL,
+    "eventId" TEXT NOT NULL,
+    event TEXT NOT NULL,
+    payload TEXT NOT NULL,
+    status INTEGER NOT NULL,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    "createdAt" timestamp NOT NULL, -- datetime
+    "nextAttempt" timestamp, -- datetime
+    "deliveredAt" timestamp, -- datetime
+    UNIQUE ("eventId", "subscriptionId"),
+    FOREIGN KEY ("subscriptionId") REFERENCES hsubscriptions(id)
+);
+
+CREATE TABLE IF NOT EXISTS hattempts (
+    "deliveryId" TEXT NOT NULL,
+    number INTEGER NOT NULL,
+    at timestamp NOT NULL, -- datetime
+    "statusCode" INTEGER NOT NULL DEFAULT 0,
+    error TEXT NOT NULL DEFAULT '',
+    PRIMARY KEY ("deliveryId", number),
+    FOREIGN KEY ("deliveryId") REFERENCES hdeliveries(id)
+);
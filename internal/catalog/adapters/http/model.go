@@ -0,0 +1,158 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/thiagotrs/rentalcar-ddd/internal/catalog/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/catalog/domain"
+)
+
+type modelController struct {
+	modelUC application.ModelUseCase
+}
+
+func NewModelController(modelUC application.ModelUseCase) *modelController {
+	return &modelController{modelUC}
+}
+
+func (c *modelController) SearchModels(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	params, err := searchModelParamsFromQuery(r.URL.Query())
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, application.ErrInvalidEntity)
+		return
+	}
+	models := c.modelUC.SearchModels(params)
+
+	json, _ := json.Marshal(models)
+	w.WriteHeader(http.StatusOK)
+	w.Write(json)
+}
+
+func searchModelParamsFromQuery(query url.Values) (application.SearchModelParams, error) {
+	params := application.SearchModelParams{
+		Make:  query.Get("make"),
+		Model: query.Get("model"),
+		Trim:  query.Get("trim"),
+	}
+
+	parse := func(name string, bitSize int) (uint64, error) {
+		if param := query.Get(name); param != "" {
+			return strconv.ParseUint(param, 10, bitSize)
+		}
+		return 0, nil
+	}
+
+	seats, err := parse("minSeats", 8)
+	if err != nil {
+		return params, err
+	}
+	doors, err := parse("doors", 8)
+	if err != nil {
+		return params, err
+	}
+	transmission, err := parse("transmission", 32)
+	if err != nil {
+		return params, err
+	}
+	fuelType, err := parse("fuelType", 32)
+	if err != nil {
+		return params, err
+	}
+	luggage, err := parse("minLuggage", 16)
+	if err != nil {
+		return params, err
+	}
+
+	params.MinSeats = uint8(seats)
+	params.Doors = uint8(doors)
+	params.Transmission = domain.Transmission(transmission)
+	params.FuelType = domain.FuelType(fuelType)
+	params.MinLuggage = uint16(luggage)
+
+	return params, nil
+}
+
+func (c *modelController) GetModelById(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	vars := mux.Vars(r)
+	model, err := c.modelUC.GetModelById(vars["id"])
+
+	switch err {
+	case application.ErrInvalidId:
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case application.ErrNotFoundModel:
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case nil:
+		json, _ := json.Marshal(model)
+		w.WriteHeader(http.StatusOK)
+		w.Write(json)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	}
+}
+
+func (c *modelController) CreateModel(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	var params struct {
+		Make         string   `json:"make"`
+		Model        string   `json:"model"`
+		Trim         string   `json:"trim"`
+		Seats        uint8    `json:"seats"`
+		Doors        uint8    `json:"doors"`
+		Transmission uint     `json:"transmission"`
+		FuelType     uint     `json:"fuelType"`
+		Luggage      uint16   `json:"luggage"`
+		Images       []string `json:"images"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, application.ErrInvalidEntity)
+		return
+	}
+	err := c.modelUC.AddModel(
+		params.Make, params.Model, params.Trim, params.Seats, params.Doors,
+		domain.Transmission(params.Transmission), domain.FuelType(params.FuelType), params.Luggage, params.Images)
+	switch err {
+	case application.ErrInvalidEntity:
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case application.ErrModelExists:
+		w.WriteHeader(http.StatusConflict)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case nil:
+		w.WriteHeader(http.StatusCreated)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	}
+}
+
+func (c *modelController) DeleteModel(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	vars := mux.Vars(r)
+	err := c.modelUC.DeleteModel(vars["id"])
+
+	switch err {
+	case application.ErrInvalidId:
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case application.ErrNotFoundModel:
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case nil:
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	}
+}
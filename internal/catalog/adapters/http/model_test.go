@@ -0,0 +1,216 @@
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/thiagotrs/rentalcar-ddd/internal/catalog/adapters/repository"
+	"github.com/thiagotrs/rentalcar-ddd/internal/catalog/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/catalog/domain"
+)
+
+func newVehicleModelFixture() *domain.VehicleModel {
+	m, _ := domain.NewVehicleModel("FIAT", "Uno", "Way", 5, 4, domain.Manual, domain.Flex, 290, nil)
+	return m
+}
+
+func TestModelController_SearchModels(t *testing.T) {
+	uno := newVehicleModelFixture()
+	leaf, _ := domain.NewVehicleModel("Nissan", "Leaf", "", 5, 4, domain.Automatic, domain.Electric, 435, nil)
+
+	modelRepo := repository.NewModelRepositoryInMemory([]domain.VehicleModel{*uno, *leaf})
+	modelController := NewModelController(application.NewModelUseCase(modelRepo))
+
+	testCases := []struct {
+		name           string
+		queryArg       string
+		wantStatusCode int
+		wantBody       interface{}
+	}{
+		{
+			name:           "correct req",
+			queryArg:       "?fuelType=6&minLuggage=300",
+			wantStatusCode: http.StatusOK,
+			wantBody:       []domain.VehicleModel{*leaf},
+		},
+		{
+			name:           "correct make req",
+			queryArg:       "?make=fiat",
+			wantStatusCode: http.StatusOK,
+			wantBody:       []domain.VehicleModel{*uno},
+		},
+		{
+			name:           "incorrect seats req",
+			queryArg:       "?minSeats=many",
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       map[string]string{"error": application.ErrInvalidEntity.Error()},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/models/"+tc.queryArg, nil)
+			res := httptest.NewRecorder()
+
+			router := mux.NewRouter()
+			router.HandleFunc("/models/", modelController.SearchModels).Methods("GET")
+			router.ServeHTTP(res, req)
+
+			if res.Code != tc.wantStatusCode {
+				t.Error("wrong response code", res.Code)
+			}
+
+			json, _ := json.Marshal(tc.wantBody)
+			expectedBody := strings.Trim(string(json), "\n")
+
+			if strings.Trim(res.Body.String(), "\n") != expectedBody {
+				t.Error("wrong response body", strings.Trim(res.Body.String(), "\n"), expectedBody)
+			}
+		})
+	}
+}
+
+func TestModelController_CreateModel(t *testing.T) {
+	modelRepo := repository.NewModelRepositoryInMemory([]domain.VehicleModel{*newVehicleModelFixture()})
+	modelController := NewModelController(application.NewModelUseCase(modelRepo))
+
+	type params struct {
+		Make, Model, Trim string
+		Seats, Doors      uint8
+		Transmission      uint
+		FuelType          uint
+		Luggage           uint16
+		Images            []string
+	}
+
+	testCases := []struct {
+		name           string
+		bodyArg        interface{}
+		wantStatusCode int
+		wantBody       interface{}
+	}{
+		{
+			name:           "correct req",
+			wantStatusCode: http.StatusCreated,
+			bodyArg: params{
+				Make: "FIAT", Model: "Uno", Trim: "Attractive", Seats: 5, Doors: 4,
+				Transmission: uint(domain.Manual), FuelType: uint(domain.Flex), Luggage: 290,
+				Images: []string{"https://cdn.example.com/uno.png"},
+			},
+			wantBody: nil,
+		},
+		{
+			name:           "incorrect existing model req",
+			wantStatusCode: http.StatusConflict,
+			bodyArg: params{
+				Make: "fiat", Model: "uno", Trim: "way", Seats: 5, Doors: 4,
+				Transmission: uint(domain.Manual), FuelType: uint(domain.Flex),
+			},
+			wantBody: map[string]string{"error": application.ErrModelExists.Error()},
+		},
+		{
+			name:           "incorrect fuel type req",
+			wantStatusCode: http.StatusBadRequest,
+			bodyArg: params{
+				Make: "FIAT", Model: "Mobi", Seats: 5, Doors: 4,
+				Transmission: uint(domain.Manual), FuelType: 9,
+			},
+			wantBody: map[string]string{"error": application.ErrInvalidEntity.Error()},
+		},
+		{
+			name:           "incorrect malformed body req",
+			wantStatusCode: http.StatusBadRequest,
+			bodyArg:        "",
+			wantBody:       map[string]string{"error": application.ErrInvalidEntity.Error()},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			reqJSON, _ := json.Marshal(tc.bodyArg)
+
+			req := httptest.NewRequest("POST", "/models/", bytes.NewBuffer(reqJSON))
+			res := httptest.NewRecorder()
+
+			router := mux.NewRouter()
+			router.HandleFunc("/models/", modelController.CreateModel).Methods("POST")
+			router.ServeHTTP(res, req)
+
+			if res.Code != tc.wantStatusCode {
+				t.Error("wrong response code", res.Code)
+			}
+
+			var expectedBody string
+			if tc.wantBody != nil {
+				json, _ := json.Marshal(tc.wantBody)
+				expectedBody = strings.Trim(string(json), "\n")
+			}
+
+			if strings.Trim(res.Body.String(), "\n") != expectedBody {
+				t.Error("wrong response body", strings.Trim(res.Body.String(), "\n"), expectedBody)
+			}
+		})
+	}
+}
+
+func TestModelController_DeleteModel(t *testing.T) {
+	model := newVehicleModelFixture()
+	modelRepo := repository.NewModelRepositoryInMemory([]domain.VehicleModel{*model})
+	modelController := NewModelController(application.NewModelUseCase(modelRepo))
+
+	testCases := []struct {
+		name           string
+		idArg          string
+		wantStatusCode int
+		wantBody       interface{}
+	}{
+		{
+			name:           "correct req",
+			idArg:          model.ID,
+			wantStatusCode: http.StatusNoContent,
+			wantBody:       nil,
+		},
+		{
+			name:           "incorrect deleted id req",
+			idArg:          model.ID,
+			wantStatusCode: http.StatusNotFound,
+			wantBody:       map[string]string{"error": application.ErrNotFoundModel.Error()},
+		},
+		{
+			name:           "incorrect invalid id req",
+			idArg:          "invalid-id",
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       map[string]string{"error": application.ErrInvalidId.Error()},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest("DELETE", "/models/"+tc.idArg, nil)
+			res := httptest.NewRecorder()
+
+			router := mux.NewRouter()
+			router.HandleFunc("/models/{id}", modelController.DeleteModel).Methods("DELETE")
+			router.ServeHTTP(res, req)
+
+			if res.Code != tc.wantStatusCode {
+				t.Error("wrong response code", res.Code)
+			}
+
+			var expectedBody string
+			if tc.wantBody != nil {
+				json, _ := json.Marshal(tc.wantBody)
+				expectedBody = strings.Trim(string(json), "\n")
+			}
+
+			if strings.Trim(res.Body.String(), "\n") != expectedBody {
+				t.Error("wrong response body", strings.Trim(res.Body.String(), "\n"), expectedBody)
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package ipc
+
+import (
+	"errors"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/catalog/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/ipc"
+)
+
+type modelIPC struct {
+	modelUC application.ModelUseCase
+}
+
+func NewModelIPC(modelUC application.ModelUseCase) *modelIPC {
+	return &modelIPC{modelUC}
+}
+
+func (uc modelIPC) GetModel(modelId string) (*ipc.ModelData, error) {
+	model, err := uc.modelUC.GetModelById(modelId)
+	if errors.Is(err, application.ErrInvalidId) || errors.Is(err, application.ErrNotFoundModel) {
+		return nil, ipc.ErrNotFoundModel
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	modelData := &ipc.ModelData{
+		ID:           model.ID,
+		Make:         model.Make,
+		Model:        model.Model,
+		Trim:         model.Trim,
+		Seats:        model.Seats,
+		Transmission: uint(model.Transmission),
+		FuelType:     uint(model.FuelType),
+		Electric:     model.Electric(),
+		Retired:      model.Retired,
+	}
+
+	return modelData, nil
+}
@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/catalog/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/catalog/domain"
+)
+
+type modelRepositoryInMemory struct {
+	models map[string]domain.VehicleModel
+	*sync.RWMutex
+}
+
+func NewModelRepositoryInMemory(models []domain.VehicleModel) *modelRepositoryInMemory {
+	modelsMap := make(map[string]domain.VehicleModel)
+	for _, v := range models {
+		modelsMap[v.ID] = v
+	}
+	return &modelRepositoryInMemory{modelsMap, &sync.RWMutex{}}
+}
+
+func (repo modelRepositoryInMemory) Find(search application.SearchModelParams) []domain.VehicleModel {
+	repo.Lock()
+	defer repo.Unlock()
+
+	models := []domain.VehicleModel{}
+	for _, v := range repo.models {
+		if search.Matches(v) {
+			models = append(models, v)
+		}
+	}
+
+	sort.Slice(models, func(i, j int) bool {
+		if models[i].Make != models[j].Make {
+			return models[i].Make < models[j].Make
+		}
+		if models[i].Model != models[j].Model {
+			return models[i].Model < models[j].Model
+		}
+		return models[i].Trim < models[j].Trim
+	})
+
+	return models
+}
+
+func (repo modelRepositoryInMemory) FindOne(id string) (*domain.VehicleModel, error) {
+	repo.Lock()
+	defer repo.Unlock()
+
+	m, exists := repo.models[id]
+	if !exists {
+		return nil, application.ErrNotFoundModel
+	}
+
+	return &m, nil
+}
+
+func (repo *modelRepositoryInMemory) Save(model domain.VehicleModel) error {
+	repo.Lock()
+	defer repo.Unlock()
+
+	repo.models[model.ID] = model
+
+	return nil
+}
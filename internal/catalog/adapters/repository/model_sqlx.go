@@ -0,0 +1,143 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/thiagotrs/rentalcar-ddd/internal/catalog/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/catalog/domain"
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/validation"
+)
+
+const (
+	findModels  = `SELECT * FROM vmodels`
+	orderModels = ` ORDER BY make, model, trim`
+	findModel   = `SELECT * FROM vmodels WHERE id = $1 LIMIT 1`
+	upsertModel = `
+	INSERT INTO vmodels VALUES (:id, :make, :model, :trim, :seats, :doors, :transmission, :fuelType, :luggage, :retired) 
+	ON CONFLICT(id) DO UPDATE SET make = :make, model = :model, trim = :trim, seats = :seats, doors = :doors, transmission = :transmission, "fuelType" = :fuelType, luggage = :luggage, retired = :retired 
+	WHERE vmodels.id = :id`
+
+	findImagesByModel = `SELECT url FROM vimages WHERE "modelId" = $1 ORDER BY id`
+	deleteImagesModel = `DELETE FROM vimages WHERE "modelId" = $1`
+	insertImageModel  = `INSERT INTO vimages ("modelId", url) VALUES ($1, $2)`
+)
+
+type modelRepositorySqlx struct {
+	ctx context.Context
+	DB  *sqlx.DB
+}
+
+func NewModelRepositorySqlx(ctx context.Context, DB *sqlx.DB) *modelRepositorySqlx {
+	return &modelRepositorySqlx{ctx, DB}
+}
+
+func (repo *modelRepositorySqlx) Find(search application.SearchModelParams) []domain.VehicleModel {
+	models := []domain.VehicleModel{}
+
+	var args []string
+	var values []interface{}
+
+	where := func(cond string, value interface{}) {
+		values = append(values, value)
+		args = append(args, fmt.Sprintf(cond, len(values)))
+	}
+
+	if len(search.Make) > 0 {
+		where(`LOWER(make) = LOWER($%v)`, search.Make)
+	}
+	if len(search.Model) > 0 {
+		where(`LOWER(model) = LOWER($%v)`, search.Model)
+	}
+	if len(search.Trim) > 0 {
+		where(`LOWER(trim) = LOWER($%v)`, search.Trim)
+	}
+	if search.MinSeats > 0 {
+		where(`seats >= $%v`, search.MinSeats)
+	}
+	if search.Doors > 0 {
+		where(`doors = $%v`, search.Doors)
+	}
+	if search.Transmission > 0 {
+		where(`transmission = $%v`, search.Transmission)
+	}
+	if search.FuelType > 0 {
+		where(`"fuelType" = $%v`, search.FuelType)
+	}
+	if search.MinLuggage > 0 {
+		where(`luggage >= $%v`, search.MinLuggage)
+	}
+	if !search.IncludeRetired {
+		where(`retired = $%v`, false)
+	}
+
+	findModelsWithFilter := findModels
+	if len(args) > 0 {
+		findModelsWithFilter = findModelsWithFilter + ` WHERE ` + strings.Join(args, ` AND `)
+	}
+
+	if err := repo.DB.SelectContext(repo.ctx, &models, findModelsWithFilter+orderModels, values...); err != nil {
+		return []domain.VehicleModel{}
+	}
+
+	for i := range models {
+		models[i].Images = []string{}
+		if err := repo.DB.SelectContext(repo.ctx, &models[i].Images, findImagesByModel, models[i].ID); err != nil {
+			return []domain.VehicleModel{}
+		}
+	}
+
+	return models
+}
+
+func (repo *modelRepositorySqlx) FindOne(id string) (*domain.VehicleModel, error) {
+	var model domain.VehicleModel
+
+	if err := repo.DB.GetContext(repo.ctx, &model, findModel, id); err != nil {
+		return nil, application.ErrNotFoundModel
+	}
+
+	model.Images = []string{}
+	if err := repo.DB.SelectContext(repo.ctx, &model.Images, findImagesByModel, model.ID); err != nil {
+		return nil, application.ErrNotFoundModel
+	}
+
+	return &model, nil
+}
+
+func (repo *modelRepositorySqlx) Save(model domain.VehicleModel) error {
+	if err := validation.ValidateEntity(model); err != nil {
+		return application.ErrInvalidModel
+	}
+
+	tx, err := repo.DB.BeginTxx(repo.ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.NamedExecContext(repo.ctx, upsertModel, model); err != nil {
+		tx.Rollback()
+		return application.ErrInvalidModel
+	}
+
+	if _, err := tx.ExecContext(repo.ctx, deleteImagesModel, model.ID); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	for _, url := range model.Images {
+		if _, err := tx.ExecContext(repo.ctx, insertImageModel, model.ID, url); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,120 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/catalog/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/catalog/domain"
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/database/dbtest"
+)
+
+func GetDBConn(t *testing.T) *sqlx.DB {
+	t.Helper()
+	db, err := sqlx.Open("sqlite3", dbtest.Copy(t, "data/catalog_test.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return db
+}
+
+func ClearModelDB(t *testing.T, db *sqlx.DB) {
+	t.Helper()
+	const deleteAllImages = "DELETE FROM vimages"
+	const deleteAllModels = "DELETE FROM vmodels"
+
+	if _, err := db.Exec(deleteAllImages); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec(deleteAllModels); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestModelRepositorySqlx_Save(t *testing.T) {
+	db := GetDBConn(t)
+	defer db.Close()
+
+	ClearModelDB(t, db)
+	defer ClearModelDB(t, db)
+
+	repo := NewModelRepositorySqlx(context.Background(), db)
+
+	uno, _ := domain.NewVehicleModel("FIAT", "Uno", "Way", 5, 4, domain.Manual, domain.Flex, 290, []string{"https://cdn.example.com/uno.png"})
+	leaf, _ := domain.NewVehicleModel("Nissan", "Leaf", "", 5, 4, domain.Automatic, domain.Electric, 435, nil)
+
+	for _, model := range []domain.VehicleModel{*uno, *leaf} {
+		if err := repo.Save(model); err != nil {
+			t.Fatal("unexpected error", err)
+		}
+	}
+
+	uno.Images = append(uno.Images, "https://cdn.example.com/uno-back.png")
+	if err := repo.Save(*uno); err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	found, err := repo.FindOne(uno.ID)
+	if err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	if len(found.Images) != 2 || found.Images[1] != "https://cdn.example.com/uno-back.png" {
+		t.Error("unexpected model", found)
+	}
+
+	if models := repo.Find(application.SearchModelParams{Make: "fiat"}); len(models) != 1 || models[0].ID != uno.ID {
+		t.Error("unexpected models", models)
+	}
+
+	if models := repo.Find(application.SearchModelParams{MinLuggage: 300, FuelType: domain.Electric}); len(models) != 1 || models[0].ID != leaf.ID {
+		t.Error("unexpected models", models)
+	}
+
+	if models := repo.Find(application.SearchModelParams{}); len(models) != 2 {
+		t.Error("unexpected models", models)
+	}
+
+	uno.ID = ""
+	if err := repo.Save(*uno); !errors.Is(err, application.ErrInvalidModel) {
+		t.Error("unexpected error", err)
+	}
+}
+
+func TestModelRepositorySqlx_SaveRetired(t *testing.T) {
+	db := GetDBConn(t)
+	defer db.Close()
+
+	ClearModelDB(t, db)
+	defer ClearModelDB(t, db)
+
+	repo := NewModelRepositorySqlx(context.Background(), db)
+
+	uno, _ := domain.NewVehicleModel("FIAT", "Uno", "Way", 5, 4, domain.Manual, domain.Flex, 290, nil)
+	if err := repo.Save(*uno); err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	uno.Retire()
+	if err := repo.Save(*uno); err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	found, err := repo.FindOne(uno.ID)
+	if err != nil || !found.Retired {
+		t.Error("unexpected model", found, err)
+	}
+
+	if models := repo.Find(application.SearchModelParams{Make: "fiat"}); len(models) != 0 {
+		t.Error("unexpected models", models)
+	}
+
+	if models := repo.Find(application.SearchModelParams{Make: "fiat", IncludeRetired: true}); len(models) != 1 {
+		t.Error("unexpected models", models)
+	}
+}
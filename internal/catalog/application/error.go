@@ -0,0 +1,17 @@
+package application
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/catalog/domain"
+)
+
+var (
+	ErrInvalidEntity = fmt.Errorf("%w", domain.ErrInvalidEntity)
+	ErrInvalidModel  = fmt.Errorf("%w", domain.ErrInvalidModel)
+
+	ErrInvalidId     = errors.New("invalid vehicle model id")
+	ErrNotFoundModel = errors.New("not found vehicle model")
+	ErrModelExists   = errors.New("vehicle model already exists")
+)
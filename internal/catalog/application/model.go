@@ -0,0 +1,104 @@
+package application
+
+import (
+	"strings"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/catalog/domain"
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/validation"
+)
+
+type ModelUseCase interface {
+	SearchModels(search SearchModelParams) []domain.VehicleModel
+	GetModelById(id string) (*domain.VehicleModel, error)
+	AddModel(make, model, trim string, seats, doors uint8, transmission domain.Transmission, fuelType domain.FuelType, luggage uint16, images []string) error
+	DeleteModel(id string) error
+}
+
+type modelUseCase struct {
+	modelRepo ModelRepository
+}
+
+func NewModelUseCase(modelRepo ModelRepository) *modelUseCase {
+	return &modelUseCase{modelRepo}
+}
+
+type SearchModelParams struct {
+	Make           string              `json:"make"`
+	Model          string              `json:"model"`
+	Trim           string              `json:"trim"`
+	MinSeats       uint8               `json:"minSeats"`
+	Doors          uint8               `json:"doors"`
+	Transmission   domain.Transmission `json:"transmission"`
+	FuelType       domain.FuelType     `json:"fuelType"`
+	MinLuggage     uint16              `json:"minLuggage"`
+	IncludeRetired bool                `json:"includeRetired"`
+}
+
+func (s SearchModelParams) Matches(m domain.VehicleModel) bool {
+	return (s.Make == "" || strings.EqualFold(s.Make, m.Make)) &&
+		(s.Model == "" || strings.EqualFold(s.Model, m.Model)) &&
+		(s.Trim == "" || strings.EqualFold(s.Trim, m.Trim)) &&
+		m.Seats >= s.MinSeats &&
+		(s.Doors == 0 || s.Doors == m.Doors) &&
+		(s.Transmission == 0 || s.Transmission == m.Transmission) &&
+		(s.FuelType == 0 || s.FuelType == m.FuelType) &&
+		m.Luggage >= s.MinLuggage &&
+		(s.IncludeRetired || !m.Retired)
+}
+
+func (uc modelUseCase) SearchModels(search SearchModelParams) []domain.VehicleModel {
+	return uc.modelRepo.Find(search)
+}
+
+func (uc modelUseCase) GetModelById(id string) (*domain.VehicleModel, error) {
+	if err := validation.ValidId(id); err != nil {
+		return nil, ErrInvalidId
+	}
+
+	model, err := uc.modelRepo.FindOne(id)
+	if err != nil {
+		return nil, ErrNotFoundModel
+	}
+
+	return model, nil
+}
+
+func (uc modelUseCase) AddModel(make, model, trim string, seats, doors uint8, transmission domain.Transmission, fuelType domain.FuelType, luggage uint16, images []string) error {
+	newModel, err := domain.NewVehicleModel(make, model, trim, seats, doors, transmission, fuelType, luggage, images)
+	if err != nil {
+		return ErrInvalidEntity
+	}
+
+	for _, m := range uc.modelRepo.Find(SearchModelParams{Make: newModel.Make, Model: newModel.Model, IncludeRetired: true}) {
+		if m.Same(*newModel) {
+			return ErrModelExists
+		}
+	}
+
+	if err := uc.modelRepo.Save(*newModel); err != nil {
+		return ErrInvalidModel
+	}
+
+	return nil
+}
+
+func (uc modelUseCase) DeleteModel(id string) error {
+	if err := validation.ValidId(id); err != nil {
+		return ErrInvalidId
+	}
+
+	model, err := uc.modelRepo.FindOne(id)
+	if err != nil {
+		return ErrNotFoundModel
+	}
+
+	if err := model.Retire(); err != nil {
+		return ErrNotFoundModel
+	}
+
+	if err := uc.modelRepo.Save(*model); err != nil {
+		return ErrInvalidModel
+	}
+
+	return nil
+}
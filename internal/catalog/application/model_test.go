@@ -0,0 +1,286 @@
+package application
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/catalog/domain"
+)
+
+func newVehicleModelFixture() *domain.VehicleModel {
+	return &domain.VehicleModel{
+		ID:           "0b1b5f2a-3f4e-4b7b-9c41-7d8a6c1f2e3d",
+		Make:         "FIAT",
+		Model:        "Uno",
+		Trim:         "Way",
+		Seats:        5,
+		Doors:        4,
+		Transmission: domain.Manual,
+		FuelType:     domain.Flex,
+		Luggage:      290,
+		Images:       []string{},
+	}
+}
+
+type modelRepositoryMock struct {
+	expectedFindModels []domain.VehicleModel
+	expectedFindOne    *domain.VehicleModel
+	expectedFindOneErr error
+	expectedSaveErr    error
+	calls              map[string]uint
+}
+
+func (m *modelRepositoryMock) Find(search SearchModelParams) []domain.VehicleModel {
+	m.calls["Find"] = m.calls["Find"] + 1
+	return m.expectedFindModels
+}
+
+func (m *modelRepositoryMock) FindOne(id string) (*domain.VehicleModel, error) {
+	m.calls["FindOne"] = m.calls["FindOne"] + 1
+	return m.expectedFindOne, m.expectedFindOneErr
+}
+
+func (m *modelRepositoryMock) Save(model domain.VehicleModel) error {
+	m.calls["Save"] = m.calls["Save"] + 1
+	return m.expectedSaveErr
+}
+
+func TestSearchModelParams_Matches(t *testing.T) {
+	model := *newVehicleModelFixture()
+
+	testCases := []struct {
+		name      string
+		searchArg SearchModelParams
+		retired   bool
+		want      bool
+	}{
+		{
+			name:      "empty search",
+			searchArg: SearchModelParams{},
+			want:      true,
+		},
+		{
+			name:      "make ignoring case and min seats",
+			searchArg: SearchModelParams{Make: "fiat", MinSeats: 5},
+			want:      true,
+		},
+		{
+			name:      "more seats",
+			searchArg: SearchModelParams{MinSeats: 7},
+			want:      false,
+		},
+		{
+			name:      "other transmission",
+			searchArg: SearchModelParams{Transmission: domain.Automatic},
+			want:      false,
+		},
+		{
+			name:      "retired model",
+			searchArg: SearchModelParams{},
+			retired:   true,
+			want:      false,
+		},
+		{
+			name:      "retired model included",
+			searchArg: SearchModelParams{IncludeRetired: true},
+			retired:   true,
+			want:      true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			model.Retired = tc.retired
+			if got := tc.searchArg.Matches(model); got != tc.want {
+				t.Error("unexpected result", got)
+			}
+		})
+	}
+}
+
+func TestModelUseCase_AddModel(t *testing.T) {
+	type setup struct {
+		repoModels  []domain.VehicleModel
+		repoSaveErr error
+	}
+
+	type args struct {
+		make  string
+		model string
+		trim  string
+		seats uint8
+	}
+
+	type want struct {
+		err       error
+		saveCalls uint
+	}
+
+	testCases := []struct {
+		name  string
+		setup setup
+		args  args
+		want  want
+	}{
+		{
+			name:  "correct input",
+			setup: setup{repoModels: []domain.VehicleModel{*newVehicleModelFixture()}},
+			args:  args{make: "FIAT", model: "Uno", trim: "Attractive", seats: 5},
+			want:  want{err: nil, saveCalls: 1},
+		},
+		{
+			name:  "incorrect seats input",
+			setup: setup{},
+			args:  args{make: "FIAT", model: "Uno", trim: "Way", seats: 0},
+			want:  want{err: ErrInvalidEntity, saveCalls: 0},
+		},
+		{
+			name:  "incorrect existing model input",
+			setup: setup{repoModels: []domain.VehicleModel{*newVehicleModelFixture()}},
+			args:  args{make: "fiat", model: "UNO", trim: "way", seats: 5},
+			want:  want{err: ErrModelExists, saveCalls: 0},
+		},
+		{
+			name:  "incorrect save input",
+			setup: setup{repoSaveErr: ErrInvalidModel},
+			args:  args{make: "FIAT", model: "Uno", trim: "Way", seats: 5},
+			want:  want{err: ErrInvalidModel, saveCalls: 1},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			modelRepo := &modelRepositoryMock{
+				expectedFindModels: tc.setup.repoModels,
+				expectedSaveErr:    tc.setup.repoSaveErr,
+				calls:              make(map[string]uint),
+			}
+			modelUC := NewModelUseCase(modelRepo)
+			err := modelUC.AddModel(tc.args.make, tc.args.model, tc.args.trim, tc.args.seats, 4, domain.Manual, domain.Flex, 290, nil)
+
+			if modelRepo.calls["Save"] != tc.want.saveCalls {
+				t.Error("invalid repo call", modelRepo.calls["Save"])
+			}
+
+			if !errors.Is(err, tc.want.err) {
+				t.Error("unexpected error", err)
+			}
+		})
+	}
+}
+
+func TestModelUseCase_GetModelById(t *testing.T) {
+	model := newVehicleModelFixture()
+
+	testCases := []struct {
+		name          string
+		idArg         string
+		repoModel     *domain.VehicleModel
+		repoErr       error
+		wantErr       error
+		wantFindCalls uint
+	}{
+		{
+			name:          "correct input",
+			idArg:         model.ID,
+			repoModel:     model,
+			wantErr:       nil,
+			wantFindCalls: 1,
+		},
+		{
+			name:          "incorrect id input",
+			idArg:         "invalid-id",
+			wantErr:       ErrInvalidId,
+			wantFindCalls: 0,
+		},
+		{
+			name:          "incorrect not found input",
+			idArg:         model.ID,
+			repoErr:       ErrNotFoundModel,
+			wantErr:       ErrNotFoundModel,
+			wantFindCalls: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			modelRepo := &modelRepositoryMock{
+				expectedFindOne:    tc.repoModel,
+				expectedFindOneErr: tc.repoErr,
+				calls:              make(map[string]uint),
+			}
+			modelUC := NewModelUseCase(modelRepo)
+			_, err := modelUC.GetModelById(tc.idArg)
+
+			if modelRepo.calls["FindOne"] != tc.wantFindCalls {
+				t.Error("invalid repo call", modelRepo.calls["FindOne"])
+			}
+
+			if !errors.Is(err, tc.wantErr) {
+				t.Error("unexpected error", err)
+			}
+		})
+	}
+}
+
+func TestModelUseCase_DeleteModel(t *testing.T) {
+	retired := newVehicleModelFixture()
+	retired.Retired = true
+
+	testCases := []struct {
+		name          string
+		idArg         string
+		repoModel     *domain.VehicleModel
+		repoErr       error
+		wantErr       error
+		wantSaveCalls uint
+	}{
+		{
+			name:          "correct input",
+			idArg:         newVehicleModelFixture().ID,
+			repoModel:     newVehicleModelFixture(),
+			wantErr:       nil,
+			wantSaveCalls: 1,
+		},
+		{
+			name:          "incorrect id input",
+			idArg:         "invalid-id",
+			wantErr:       ErrInvalidId,
+			wantSaveCalls: 0,
+		},
+		{
+			name:          "incorrect not found input",
+			idArg:         newVehicleModelFixture().ID,
+			repoErr:       ErrNotFoundModel,
+			wantErr:       ErrNotFoundModel,
+			wantSaveCalls: 0,
+		},
+		{
+			name:          "incorrect retired input",
+			idArg:         retired.ID,
+			repoModel:     retired,
+			wantErr:       ErrNotFoundModel,
+			wantSaveCalls: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			modelRepo := &modelRepositoryMock{
+				expectedFindOne:    tc.repoModel,
+				expectedFindOneErr: tc.repoErr,
+				calls:              make(map[string]uint),
+			}
+			modelUC := NewModelUseCase(modelRepo)
+			err := modelUC.DeleteModel(tc.idArg)
+
+			if modelRepo.calls["Save"] != tc.wantSaveCalls {
+				t.Error("invalid repo call", modelRepo.calls["Save"])
+			}
+
+			if !errors.Is(err, tc.wantErr) {
+				t.Error("unexpected error", err)
+			}
+		})
+	}
+}
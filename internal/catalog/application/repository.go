@@ -0,0 +1,17 @@
+package application
+
+import "github.com/thiagotrs/rentalcar-ddd/internal/catalog/domain"
+
+type ModelReadRepository interface {
+	Find(search SearchModelParams) []domain.VehicleModel
+	FindOne(id string) (*domain.VehicleModel, error)
+}
+
+type ModelWriteRepository interface {
+	Save(model domain.VehicleModel) error
+}
+
+type ModelRepository interface {
+	ModelReadRepository
+	ModelWriteRepository
+}
@@ -0,0 +1,9 @@
+package domain
+
+import "errors"
+
+var (
+	ErrInvalidEntity = errors.New("invalid entity")
+	ErrInvalidModel  = errors.New("invalid vehicle model")
+	ErrRetiredModel  = errors.New("retired vehicle model")
+)
@@ -0,0 +1,85 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/validation"
+)
+
+type Transmission uint
+
+const (
+	Manual Transmission = iota + 1
+	Automatic
+)
+
+type FuelType uint
+
+const (
+	Gasoline FuelType = iota + 1
+	Ethanol
+	Flex
+	Diesel
+	Hybrid
+	Electric
+)
+
+type VehicleModel struct {
+	ID           string       `json:"id" validate:"required,uuid4" db:"id"`
+	Make         string       `json:"make" validate:"required" db:"make"`
+	Model        string       `json:"model" validate:"required" db:"model"`
+	Trim         string       `json:"trim" db:"trim"`
+	Seats        uint8        `json:"seats" validate:"required,min=1,max=60" db:"seats"`
+	Doors        uint8        `json:"doors" validate:"max=6" db:"doors"`
+	Transmission Transmission `json:"transmission" validate:"required,max=2" db:"transmission"`
+	FuelType     FuelType     `json:"fuelType" validate:"required,max=6" db:"fuelType"`
+	Luggage      uint16       `json:"luggage" db:"luggage"`
+	Images       []string     `json:"images" validate:"dive,url"`
+	Retired      bool         `json:"retired" db:"retired"`
+}
+
+func NewVehicleModel(make, model, trim string, seats, doors uint8, transmission Transmission, fuelType FuelType, luggage uint16, images []string) (*VehicleModel, error) {
+	if images == nil {
+		images = []string{}
+	}
+
+	vehicleModel := &VehicleModel{
+		ID:           validation.NewId(),
+		Make:         strings.TrimSpace(make),
+		Model:        strings.TrimSpace(model),
+		Trim:         strings.TrimSpace(trim),
+		Seats:        seats,
+		Doors:        doors,
+		Transmission: transmission,
+		FuelType:     fuelType,
+		Luggage:      luggage,
+		Images:       images,
+	}
+
+	if err := validation.ValidateEntity(vehicleModel); err != nil {
+		return nil, fmt.Errorf("%w\n%v", ErrInvalidEntity, err)
+	}
+
+	return vehicleModel, nil
+}
+
+func (m VehicleModel) Electric() bool {
+	return m.FuelType == Electric
+}
+
+func (m *VehicleModel) Retire() error {
+	if m.Retired {
+		return ErrRetiredModel
+	}
+
+	m.Retired = true
+
+	return nil
+}
+
+func (m VehicleModel) Same(other VehicleModel) bool {
+	return strings.EqualFold(m.Make, other.Make) &&
+		strings.EqualFold(m.Model, other.Model) &&
+		strings.EqualFold(m.Trim, other.Trim)
+}
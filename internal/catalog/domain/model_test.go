@@ -0,0 +1,186 @@
+package domain
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func newVehicleModelFixture() *VehicleModel {
+	return &VehicleModel{
+		ID:           "0b1b5f2a-3f4e-4b7b-9c41-7d8a6c1f2e3d",
+		Make:         "FIAT",
+		Model:        "Uno",
+		Trim:         "Way",
+		Seats:        5,
+		Doors:        4,
+		Transmission: Manual,
+		FuelType:     Flex,
+		Luggage:      290,
+		Images:       []string{},
+	}
+}
+
+func TestNewVehicleModel(t *testing.T) {
+	type args struct {
+		make         string
+		model        string
+		trim         string
+		seats        uint8
+		doors        uint8
+		transmission Transmission
+		fuelType     FuelType
+		images       []string
+	}
+
+	type want struct {
+		isModel bool
+		err     error
+	}
+
+	testCases := []struct {
+		name string
+		args args
+		want want
+	}{
+		{
+			name: "correct input",
+			args: args{
+				make:         "FIAT",
+				model:        "Uno",
+				trim:         "Way",
+				seats:        5,
+				doors:        4,
+				transmission: Manual,
+				fuelType:     Flex,
+				images:       []string{"https://cdn.example.com/uno.png"},
+			},
+			want: want{
+				isModel: true,
+				err:     nil,
+			},
+		},
+		{
+			name: "incorrect make input",
+			args: args{
+				make:         " ",
+				model:        "Uno",
+				seats:        5,
+				doors:        4,
+				transmission: Manual,
+				fuelType:     Flex,
+			},
+			want: want{
+				isModel: false,
+				err:     ErrInvalidEntity,
+			},
+		},
+		{
+			name: "incorrect seats input",
+			args: args{
+				make:         "FIAT",
+				model:        "Uno",
+				seats:        0,
+				doors:        4,
+				transmission: Manual,
+				fuelType:     Flex,
+			},
+			want: want{
+				isModel: false,
+				err:     ErrInvalidEntity,
+			},
+		},
+		{
+			name: "incorrect fuel type input",
+			args: args{
+				make:         "FIAT",
+				model:        "Uno",
+				seats:        5,
+				doors:        4,
+				transmission: Manual,
+				fuelType:     7,
+			},
+			want: want{
+				isModel: false,
+				err:     ErrInvalidEntity,
+			},
+		},
+		{
+			name: "incorrect image input",
+			args: args{
+				make:         "FIAT",
+				model:        "Uno",
+				seats:        5,
+				doors:        4,
+				transmission: Manual,
+				fuelType:     Flex,
+				images:       []string{"uno.png"},
+			},
+			want: want{
+				isModel: false,
+				err:     ErrInvalidEntity,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m, err := NewVehicleModel(
+				tc.args.make,
+				tc.args.model,
+				tc.args.trim,
+				tc.args.seats,
+				tc.args.doors,
+				tc.args.transmission,
+				tc.args.fuelType,
+				0,
+				tc.args.images,
+			)
+
+			if reflect.ValueOf(m).IsNil() == tc.want.isModel {
+				t.Error("unexpected result")
+			}
+
+			if !errors.Is(err, tc.want.err) {
+				t.Error("unexpected error", err)
+			}
+		})
+	}
+}
+
+func TestVehicleModel_Same(t *testing.T) {
+	model := newVehicleModelFixture()
+
+	other := newVehicleModelFixture()
+	other.ID = "6f0c1d2e-8a4b-4c3d-9e5f-1a2b3c4d5e6f"
+	other.Make = "fiat"
+	other.Model = "UNO"
+
+	otherTrim := newVehicleModelFixture()
+	otherTrim.Trim = "Attractive"
+
+	testCases := []struct {
+		name     string
+		otherArg VehicleModel
+		want     bool
+	}{
+		{
+			name:     "same model ignoring case",
+			otherArg: *other,
+			want:     true,
+		},
+		{
+			name:     "other trim",
+			otherArg: *otherTrim,
+			want:     false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := model.Same(tc.otherArg); got != tc.want {
+				t.Error("unexpected result", got)
+			}
+		})
+	}
+}
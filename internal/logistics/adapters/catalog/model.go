@@ -0,0 +1,34 @@
+package catalog
+
+import (
+	"errors"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/domain"
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/ipc"
+)
+
+type modelCatalogIPC struct {
+	catalog ipc.CatalogIPC
+}
+
+func NewModelCatalogIPC(catalog ipc.CatalogIPC) *modelCatalogIPC {
+	return &modelCatalogIPC{catalog}
+}
+
+func (svc modelCatalogIPC) GetModel(modelId string) (make, model string, energy domain.EnergyType, err error) {
+	m, err := svc.catalog.GetModel(modelId)
+	if errors.Is(err, ipc.ErrNotFoundModel) || (err == nil && m.Retired) {
+		return "", "", 0, application.ErrNotFoundModel
+	}
+	if err != nil {
+		return "", "", 0, err
+	}
+
+	energy = domain.Combustion
+	if m.Electric {
+		energy = domain.Electric
+	}
+
+	return m.Make, m.Model, energy, nil
+}
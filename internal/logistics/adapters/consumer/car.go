@@ -2,6 +2,7 @@ package consumer
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/domain"
 	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/events"
@@ -10,16 +11,19 @@ import (
 type Topic string
 
 const (
-	OrderOpened    Topic = "order.opened"
-	OrderConfirmed Topic = "order.confirmed"
-	OrderClosed    Topic = "order.closed"
-	OrderCanceled  Topic = "order.canceled"
+	OrderOpened     Topic = "order.opened"
+	OrderConfirmed  Topic = "order.confirmed"
+	OrderClosed     Topic = "order.closed"
+	OrderCanceled   Topic = "order.canceled"
+	OrderCarSwapped Topic = "order.car-swapped"
 )
 
 type openedOrderMsg struct {
-	ID        string `json:"id"`
-	CarId     string `json:"carId"`
-	StationId string `json:"stationId"`
+	ID         string `json:"id"`
+	CarId      string `json:"carId"`
+	StationId  string `json:"stationId"`
+	WaitlistId string `json:"waitlistId"`
+	BookingId  string `json:"bookingId"`
 }
 
 type confirmedOrderMsg struct {
@@ -32,6 +36,7 @@ type canceledOrderMsg struct {
 	CarId     string `json:"carId"`
 	StationId string `json:"stationId"`
 	FinalKM   uint64 `json:"finalKM"`
+	FinalFuel uint8  `json:"finalFuel"`
 }
 
 type closedOrderMsg struct {
@@ -39,6 +44,25 @@ type closedOrderMsg struct {
 	CarId     string `json:"carId"`
 	StationId string `json:"stationId"`
 	FinalKM   uint64 `json:"finalKM"`
+	FinalFuel uint8  `json:"finalFuel"`
+}
+
+type carSwappedOrderMsg struct {
+	ID        string `json:"id"`
+	Swap      int    `json:"swap"`
+	FromCarId string `json:"fromCarId"`
+	ToCarId   string `json:"toCarId"`
+	StationId string `json:"stationId"`
+	FinalKM   uint64 `json:"finalKM"`
+	FinalFuel uint8  `json:"finalFuel"`
+}
+
+func eventId(topic Topic, orderId string) string {
+	return fmt.Sprintf("%s:%s", topic, orderId)
+}
+
+func swapId(orderId string, n int, carId string) string {
+	return fmt.Sprintf("%s:%d:%s", orderId, n, carId)
 }
 
 type orderConsumer struct {
@@ -54,7 +78,11 @@ func (c *orderConsumer) ConsumeOpenedOrder(data interface{}) {
 		var order openedOrderMsg
 		json.Unmarshal(orderB, &order)
 
-		c.disp.Dispatch([]events.Event{domain.SyncCarReserved{ID: order.CarId, StationId: order.StationId}})
+		if order.WaitlistId != "" || order.BookingId != "" {
+			return
+		}
+
+		c.disp.Dispatch([]events.Event{domain.SyncCarReserved{EventId: eventId(OrderOpened, order.ID), ID: order.CarId, StationId: order.StationId}})
 	}
 }
 
@@ -72,7 +100,7 @@ func (c *orderConsumer) ConsumeCanceledOrder(data interface{}) {
 		var order canceledOrderMsg
 		json.Unmarshal(orderB, &order)
 
-		c.disp.Dispatch([]events.Event{domain.SyncCarParked{ID: order.CarId, StationId: order.StationId, KM: order.FinalKM}})
+		c.disp.Dispatch([]events.Event{domain.SyncCarParked{EventId: eventId(OrderCanceled, order.ID), ID: order.CarId, StationId: order.StationId, KM: order.FinalKM, FuelLevel: order.FinalFuel}})
 	}
 }
 
@@ -81,6 +109,18 @@ func (c *orderConsumer) ConsumeClosedOrder(data interface{}) {
 		var order closedOrderMsg
 		json.Unmarshal(orderB, &order)
 
-		c.disp.Dispatch([]events.Event{domain.SyncCarParked{ID: order.CarId, StationId: order.StationId, KM: order.FinalKM}})
+		c.disp.Dispatch([]events.Event{domain.SyncCarParked{EventId: eventId(OrderClosed, order.ID), ID: order.CarId, StationId: order.StationId, KM: order.FinalKM, FuelLevel: order.FinalFuel}})
+	}
+}
+
+func (c *orderConsumer) ConsumeCarSwappedOrder(data interface{}) {
+	if orderB, ok := data.([]byte); ok {
+		var order carSwappedOrderMsg
+		json.Unmarshal(orderB, &order)
+
+		c.disp.Dispatch([]events.Event{
+			domain.SyncCarParked{EventId: eventId(OrderCarSwapped, swapId(order.ID, order.Swap, order.FromCarId)), ID: order.FromCarId, StationId: order.StationId, KM: order.FinalKM, FuelLevel: order.FinalFuel},
+			domain.SyncCarReserved{EventId: eventId(OrderCarSwapped, swapId(order.ID, order.Swap, order.ToCarId)), ID: order.ToCarId, StationId: order.StationId},
+		})
 	}
 }
@@ -0,0 +1,106 @@
+package consumer
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/domain"
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/events"
+)
+
+const (
+	SubscriptionStarted    Topic = "subscription.started"
+	SubscriptionCarSwapped Topic = "subscription.car-swapped"
+	SubscriptionPaused     Topic = "subscription.paused"
+	SubscriptionResumed    Topic = "subscription.resumed"
+	SubscriptionCanceled   Topic = "subscription.canceled"
+)
+
+type handedOverSubscriptionMsg struct {
+	ID        string    `json:"id"`
+	CarId     string    `json:"carId"`
+	StationId string    `json:"stationId"`
+	Date      time.Time `json:"date"`
+}
+
+type returnedSubscriptionMsg struct {
+	ID        string    `json:"id"`
+	CarId     string    `json:"carId"`
+	StationId string    `json:"stationId"`
+	FinalKM   uint64    `json:"finalKM"`
+	FinalFuel uint8     `json:"finalFuel"`
+	Date      time.Time `json:"date"`
+}
+
+type carSwappedSubscriptionMsg struct {
+	ID        string    `json:"id"`
+	FromCarId string    `json:"fromCarId"`
+	ToCarId   string    `json:"toCarId"`
+	StationId string    `json:"stationId"`
+	FinalKM   uint64    `json:"finalKM"`
+	FinalFuel uint8     `json:"finalFuel"`
+	Date      time.Time `json:"date"`
+}
+
+func handoverId(subscriptionId, carId string, at time.Time) string {
+	return fmt.Sprintf("%s:%s:%d", subscriptionId, carId, at.UnixNano())
+}
+
+type subscriptionConsumer struct {
+	disp events.Dispatcher
+}
+
+func NewSubscriptionConsumer(disp events.Dispatcher) *subscriptionConsumer {
+	return &subscriptionConsumer{disp}
+}
+
+func (c *subscriptionConsumer) ConsumeStartedSubscription(data interface{}) {
+	c.consumeHandedOver(SubscriptionStarted, data)
+}
+
+func (c *subscriptionConsumer) ConsumeResumedSubscription(data interface{}) {
+	c.consumeHandedOver(SubscriptionResumed, data)
+}
+
+func (c *subscriptionConsumer) ConsumePausedSubscription(data interface{}) {
+	c.consumeReturned(SubscriptionPaused, data)
+}
+
+func (c *subscriptionConsumer) ConsumeCanceledSubscription(data interface{}) {
+	c.consumeReturned(SubscriptionCanceled, data)
+}
+
+func (c *subscriptionConsumer) ConsumeCarSwappedSubscription(data interface{}) {
+	if subscriptionB, ok := data.([]byte); ok {
+		var subscription carSwappedSubscriptionMsg
+		json.Unmarshal(subscriptionB, &subscription)
+
+		c.disp.Dispatch([]events.Event{
+			domain.SyncCarParked{EventId: eventId(SubscriptionCarSwapped, handoverId(subscription.ID, subscription.FromCarId, subscription.Date)), ID: subscription.FromCarId, StationId: subscription.StationId, KM: subscription.FinalKM, FuelLevel: subscription.FinalFuel},
+			domain.SyncCarReserved{EventId: eventId(SubscriptionCarSwapped, handoverId(subscription.ID, subscription.ToCarId, subscription.Date)), ID: subscription.ToCarId, StationId: subscription.StationId},
+			domain.SyncCarInTransit{ID: subscription.ToCarId},
+		})
+	}
+}
+
+func (c *subscriptionConsumer) consumeHandedOver(topic Topic, data interface{}) {
+	if subscriptionB, ok := data.([]byte); ok {
+		var subscription handedOverSubscriptionMsg
+		json.Unmarshal(subscriptionB, &subscription)
+
+		c.disp.Dispatch([]events.Event{
+			domain.SyncCarReserved{EventId: eventId(topic, handoverId(subscription.ID, subscription.CarId, subscription.Date)), ID: subscription.CarId, StationId: subscription.StationId},
+			domain.SyncCarInTransit{ID: subscription.CarId},
+		})
+	}
+}
+
+func (c *subscriptionConsumer) consumeReturned(topic Topic, data interface{}) {
+	if subscriptionB, ok := data.([]byte); ok {
+		var subscription returnedSubscriptionMsg
+		json.Unmarshal(subscriptionB, &subscription)
+
+		c.disp.Dispatch([]events.Event{domain.SyncCarParked{EventId: eventId(topic, handoverId(subscription.ID, subscription.CarId, subscription.Date)), ID: subscription.CarId, StationId: subscription.StationId, KM: subscription.FinalKM, FuelLevel: subscription.FinalFuel}})
+	}
+}
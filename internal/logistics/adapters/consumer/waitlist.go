@@ -0,0 +1,53 @@
+package consumer
+
+import (
+	"encoding/json"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/domain"
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/events"
+)
+
+const (
+	WaitlistCarHeld      Topic = "waitlist.car-held"
+	WaitlistHoldReleased Topic = "waitlist.hold-released"
+)
+
+type heldWaitlistMsg struct {
+	ID        string `json:"id"`
+	CarId     string `json:"carId"`
+	StationId string `json:"stationId"`
+}
+
+type releasedWaitlistMsg struct {
+	ID        string `json:"id"`
+	CarId     string `json:"carId"`
+	StationId string `json:"stationId"`
+	FinalKM   uint64 `json:"finalKM"`
+	FinalFuel uint8  `json:"finalFuel"`
+}
+
+type waitlistConsumer struct {
+	disp events.Dispatcher
+}
+
+func NewWaitlistConsumer(disp events.Dispatcher) *waitlistConsumer {
+	return &waitlistConsumer{disp}
+}
+
+func (c *waitlistConsumer) ConsumeHeldWaitlist(data interface{}) {
+	if entryB, ok := data.([]byte); ok {
+		var entry heldWaitlistMsg
+		json.Unmarshal(entryB, &entry)
+
+		c.disp.Dispatch([]events.Event{domain.SyncCarReserved{EventId: eventId(WaitlistCarHeld, entry.ID+":"+entry.CarId), ID: entry.CarId, StationId: entry.StationId}})
+	}
+}
+
+func (c *waitlistConsumer) ConsumeReleasedWaitlist(data interface{}) {
+	if entryB, ok := data.([]byte); ok {
+		var entry releasedWaitlistMsg
+		json.Unmarshal(entryB, &entry)
+
+		c.disp.Dispatch([]events.Event{domain.SyncCarParked{EventId: eventId(WaitlistHoldReleased, entry.ID+":"+entry.CarId), ID: entry.CarId, StationId: entry.StationId, KM: entry.FinalKM, FuelLevel: entry.FinalFuel}})
+	}
+}
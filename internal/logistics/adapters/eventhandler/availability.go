@@ -0,0 +1,82 @@
+package eventhandler
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/domain"
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/broker"
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/events"
+)
+
+const CarAvailable = "car.available"
+
+type availableCarMsg struct {
+	EventId   string            `json:"eventId"`
+	ID        string            `json:"id"`
+	Age       uint16            `json:"age"`
+	Plate     string            `json:"plate"`
+	Document  string            `json:"document"`
+	ModelId   string            `json:"modelId"`
+	StationId string            `json:"stationId"`
+	KM        uint64            `json:"km"`
+	Energy    domain.EnergyType `json:"energy"`
+	FuelLevel uint8             `json:"fuelLevel"`
+}
+
+type availabilityEventHandler struct {
+	carUC  application.CarUseCase
+	broker broker.Publisher
+}
+
+func NewAvailabilityEventHandler(carUC application.CarUseCase, broker broker.Publisher) *availabilityEventHandler {
+	return &availabilityEventHandler{carUC, broker}
+}
+
+func (h availabilityEventHandler) HandleCarParked(e events.Event) error {
+	committed, ok := e.(events.Committed)
+	if !ok {
+		return errors.New("wrong event")
+	}
+
+	event, ok := committed.Event.(domain.CarParked)
+	if !ok {
+		return errors.New("wrong event")
+	}
+
+	return h.publish(event.EventId, event.ID, event.StationId, event.KM, event.FuelLevel)
+}
+
+func (h availabilityEventHandler) HandleSyncCarParked(e events.Event) error {
+	event, ok := e.(domain.SyncCarParked)
+
+	if !ok {
+		return errors.New("wrong event")
+	}
+
+	return h.publish(event.EventId, event.ID, event.StationId, event.KM, event.FuelLevel)
+}
+
+func (h availabilityEventHandler) publish(eventId, id, stationId string, km uint64, fuelLevel uint8) error {
+	car, err := h.carUC.GetCarById(id)
+	if err != nil {
+		return err
+	}
+
+	data, _ := json.Marshal(availableCarMsg{
+		EventId:   eventId,
+		ID:        car.ID,
+		Age:       car.Age,
+		Plate:     car.Plate,
+		Document:  car.Document,
+		ModelId:   car.ModelId,
+		StationId: stationId,
+		KM:        km,
+		Energy:    car.Energy,
+		FuelLevel: fuelLevel,
+	})
+	h.broker.Publish(CarAvailable, data)
+
+	return nil
+}
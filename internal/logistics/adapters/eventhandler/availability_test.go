@@ -0,0 +1,112 @@
+package eventhandler
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/adapters/repository"
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/domain"
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/broker"
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/events"
+)
+
+func TestAvailabilityEventHandler_HandleSyncCarParked(t *testing.T) {
+	dispatcher := events.NewEventDispatcher()
+	pubsub := broker.NewPubSub()
+	defer pubsub.Close()
+
+	cars := []domain.Car{*newCarFixture()}
+	stations := []domain.Station{*newStationFixture()}
+
+	stationRepo := repository.NewStationRepositoryInMemory(stations)
+	carRepo := repository.NewCarRepositoryInMemory(cars)
+	carUC := application.NewCarUseCase(carRepo, stationRepo, nil)
+	availabilityEH := NewAvailabilityEventHandler(carUC, pubsub)
+
+	dispatcher.Register(
+		events.EventHandlerFunc(availabilityEH.HandleSyncCarParked),
+		domain.SyncCarParked{}.Name())
+
+	channel := pubsub.Subscribe(CarAvailable)
+
+	testCases := []struct {
+		name     string
+		eventArg events.Event
+		errWant  error
+	}{
+		{
+			name: "correct input",
+			eventArg: domain.SyncCarParked{
+				EventId:   "order.closed:5ce5a1a1-f324-4c8b-8c92-d7e820cbb238",
+				ID:        cars[0].ID,
+				StationId: cars[0].StationId,
+				KM:        cars[0].KM + 50,
+				FuelLevel: 60,
+			},
+			errWant: nil,
+		},
+		{
+			name: "incorrect car id input",
+			eventArg: domain.SyncCarParked{
+				ID:        "5ce5a1a1-f324-4c8b-8c92-d7e820cbb238",
+				StationId: cars[0].StationId,
+			},
+			errWant: application.ErrNotFoundCar,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := dispatcher.Dispatch([]events.Event{tc.eventArg})
+
+			if !errors.Is(err, tc.errWant) {
+				t.Fatal("wrong err", err, tc.errWant)
+			}
+
+			if err != nil {
+				return
+			}
+
+			var msg availableCarMsg
+			json.Unmarshal((<-channel).([]byte), &msg)
+
+			if msg.ID != cars[0].ID || msg.Plate != cars[0].Plate || msg.KM != cars[0].KM+50 || msg.FuelLevel != 60 {
+				t.Error("unexpected message", msg)
+			}
+		})
+	}
+}
+
+func TestAvailabilityEventHandler_HandleCarParked(t *testing.T) {
+	pubsub := broker.NewPubSub()
+	defer pubsub.Close()
+
+	cars := []domain.Car{*newCarFixture()}
+	stations := []domain.Station{*newStationFixture()}
+
+	stationRepo := repository.NewStationRepositoryInMemory(stations)
+	carRepo := repository.NewCarRepositoryInMemory(cars)
+	carUC := application.NewCarUseCase(carRepo, stationRepo, nil)
+	availabilityEH := NewAvailabilityEventHandler(carUC, pubsub)
+
+	channel := pubsub.Subscribe(CarAvailable)
+
+	parked := domain.CarParked{EventId: "c1d2e3f4-a5b6-4c7d-8e9f-0a1b2c3d4e5f", ID: cars[0].ID, StationId: cars[0].StationId, KM: cars[0].KM, FuelLevel: 40}
+
+	if err := availabilityEH.HandleCarParked(parked); err == nil {
+		t.Fatal("unexpected car published before commit")
+	}
+
+	if err := availabilityEH.HandleCarParked(events.Committed{Event: parked}); err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	var msg availableCarMsg
+	json.Unmarshal((<-channel).([]byte), &msg)
+
+	if msg.ID != cars[0].ID || msg.EventId != parked.EventId || msg.FuelLevel != 40 {
+		t.Error("unexpected message", msg)
+	}
+}
@@ -23,7 +23,7 @@ func (h carEventHandler) HandleSyncCarParked(e events.Event) error {
 		return errors.New("wrong event")
 	}
 
-	if err := h.carUC.SyncParkCar(event.ID, event.StationId, event.KM); err != nil {
+	if err := h.carUC.SyncParkCar(event.ID, event.StationId, event.KM, event.FuelLevel); err != nil {
 		return err
 	}
 
@@ -57,3 +57,17 @@ func (h carEventHandler) HandleSyncCarInTransit(e events.Event) error {
 
 	return nil
 }
+
+func (h carEventHandler) HandleWorkOrderCompleted(e events.Event) error {
+	event, ok := e.(domain.WorkOrderCompleted)
+
+	if !ok {
+		return errors.New("wrong event")
+	}
+
+	if err := h.carUC.ReleaseCarFromMaintenance(event.CarId, event.StationId, event.KM); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -33,7 +33,7 @@ func TestCarEventHandler_HandleSyncCarParked(t *testing.T) {
 
 	stationRepo := repository.NewStationRepositoryInMemory(stations)
 	carRepo := repository.NewCarRepositoryInMemory(cars)
-	carUC := application.NewCarUseCase(carRepo, stationRepo)
+	carUC := application.NewCarUseCase(carRepo, stationRepo, nil)
 	carEH := NewCarEventHandler(carUC)
 
 	dispatcher.Register(
@@ -96,7 +96,7 @@ func TestCarEventHandler_HandleSyncCarReserved(t *testing.T) {
 
 	stationRepo := repository.NewStationRepositoryInMemory(stations)
 	carRepo := repository.NewCarRepositoryInMemory(cars)
-	carUC := application.NewCarUseCase(carRepo, stationRepo)
+	carUC := application.NewCarUseCase(carRepo, stationRepo, nil)
 	carEH := NewCarEventHandler(carUC)
 
 	dispatcher.Register(
@@ -156,7 +156,7 @@ func TestCarEventHandler_HandleSyncCarInTransit(t *testing.T) {
 
 	stationRepo := repository.NewStationRepositoryInMemory(stations)
 	carRepo := repository.NewCarRepositoryInMemory(cars)
-	carUC := application.NewCarUseCase(carRepo, stationRepo)
+	carUC := application.NewCarUseCase(carRepo, stationRepo, nil)
 	carEH := NewCarEventHandler(carUC)
 
 	dispatcher.Register(
@@ -0,0 +1,70 @@
+package eventhandler
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/domain"
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/broker"
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/events"
+)
+
+type fleetEventHandler struct {
+	broker broker.Publisher
+}
+
+func NewFleetEventHandler(broker broker.Publisher) *fleetEventHandler {
+	return &fleetEventHandler{broker}
+}
+
+func (h fleetEventHandler) HandleCarInTransfer(e events.Event) error {
+	event, ok := e.(domain.CarInTransfer)
+
+	if !ok {
+		return errors.New("wrong event")
+	}
+
+	data, _ := json.Marshal(event)
+	h.broker.Publish(e.Name(), data)
+
+	return nil
+}
+
+func (h fleetEventHandler) HandleMaintenanceDue(e events.Event) error {
+	event, ok := e.(domain.MaintenanceDue)
+
+	if !ok {
+		return errors.New("wrong event")
+	}
+
+	data, _ := json.Marshal(event)
+	h.broker.Publish(e.Name(), data)
+
+	return nil
+}
+
+func (h fleetEventHandler) HandleDocumentExpiring(e events.Event) error {
+	event, ok := e.(domain.DocumentExpiry)
+
+	if !ok {
+		return errors.New("wrong event")
+	}
+
+	data, _ := json.Marshal(event)
+	h.broker.Publish(e.Name(), data)
+
+	return nil
+}
+
+func (h fleetEventHandler) HandleTelemetryRecorded(e events.Event) error {
+	event, ok := e.(domain.TelemetryRecorded)
+
+	if !ok {
+		return errors.New("wrong event")
+	}
+
+	data, _ := json.Marshal(event)
+	h.broker.Publish(e.Name(), data)
+
+	return nil
+}
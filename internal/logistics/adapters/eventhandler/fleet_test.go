@@ -0,0 +1,50 @@
+package eventhandler
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/domain"
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/broker"
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/events"
+)
+
+func TestFleetEventHandler_HandleMaintenanceDue(t *testing.T) {
+	dispatcher := events.NewEventDispatcher()
+	pubsub := broker.NewPubSub()
+	defer pubsub.Close()
+
+	fleetEH := NewFleetEventHandler(pubsub)
+
+	dispatcher.Register(
+		events.EventHandlerFunc(fleetEH.HandleMaintenanceDue),
+		domain.MaintenanceDue{}.Name())
+
+	channel := pubsub.Subscribe(domain.MaintenanceDue{}.Name())
+
+	dueDate := time.Date(2022, time.March, 1, 0, 0, 0, 0, time.UTC)
+	due := domain.MaintenanceDue{
+		CarId:   "5ce5a1a1-f324-4c8b-8c92-d7e820cbb238",
+		Plate:   "ABC1234",
+		PlanId:  "7f1d2c6e-54b1-4f7a-9d0e-2b5b1a4a4c11",
+		KM:      19500,
+		DueKM:   20000,
+		DueDate: &dueDate,
+	}
+
+	if err := dispatcher.Dispatch([]events.Event{due}); err != nil {
+		t.Fatal("wrong err", err)
+	}
+
+	var msg domain.MaintenanceDue
+	json.Unmarshal((<-channel).([]byte), &msg)
+
+	if msg.CarId != due.CarId || msg.PlanId != due.PlanId || msg.DueKM != due.DueKM || !msg.DueDate.Equal(dueDate) {
+		t.Error("unexpected message", msg)
+	}
+
+	if err := fleetEH.HandleMaintenanceDue(domain.CarInTransfer{}); err == nil {
+		t.Error("expected wrong event error")
+	}
+}
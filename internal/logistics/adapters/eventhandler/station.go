@@ -9,11 +9,11 @@ import (
 )
 
 type stationEventHandler struct {
-	stationUC application.StationUseCase
+	occupancyUC application.OccupancyUseCase
 }
 
-func NewStationEventHandler(stationUC application.StationUseCase) *stationEventHandler {
-	return &stationEventHandler{stationUC}
+func NewStationEventHandler(occupancyUC application.OccupancyUseCase) *stationEventHandler {
+	return &stationEventHandler{occupancyUC}
 }
 
 func (h stationEventHandler) HandleCarAdded(e events.Event) error {
@@ -23,7 +23,7 @@ func (h stationEventHandler) HandleCarAdded(e events.Event) error {
 		return errors.New("wrong event")
 	}
 
-	if err := h.stationUC.ChangeStationIdle(event.StationId, "add"); err != nil {
+	if err := h.occupancyUC.RecordOccupancy(event.EventId, event.StationId, event.ID, domain.Arrived); err != nil {
 		return err
 	}
 
@@ -37,7 +37,7 @@ func (h stationEventHandler) HandleCarParked(e events.Event) error {
 		return errors.New("wrong event")
 	}
 
-	if err := h.stationUC.ChangeStationIdle(event.StationId, "add"); err != nil {
+	if err := h.occupancyUC.RecordOccupancy(event.EventId, event.StationId, event.ID, domain.Arrived); err != nil {
 		return err
 	}
 
@@ -55,7 +55,7 @@ func (h stationEventHandler) HandleCarUnderMaintenance(e events.Event) error {
 		return nil
 	}
 
-	if err := h.stationUC.ChangeStationIdle(event.StationId, "del"); err != nil {
+	if err := h.occupancyUC.RecordOccupancy(event.EventId, event.StationId, event.ID, domain.Left); err != nil {
 		return err
 	}
 
@@ -69,7 +69,7 @@ func (h stationEventHandler) HandleCarInTransfer(e events.Event) error {
 		return errors.New("wrong event")
 	}
 
-	if err := h.stationUC.ChangeStationIdle(event.StationIdFrom, "del"); err != nil {
+	if err := h.occupancyUC.RecordOccupancy(event.EventId, event.StationIdFrom, event.ID, domain.Left); err != nil {
 		return err
 	}
 
@@ -83,7 +83,7 @@ func (h stationEventHandler) HandleSyncCarParked(e events.Event) error {
 		return errors.New("wrong event")
 	}
 
-	if err := h.stationUC.ChangeStationIdle(event.StationId, "add"); err != nil {
+	if err := h.occupancyUC.RecordOccupancy(event.EventId, event.StationId, event.ID, domain.Arrived); err != nil {
 		return err
 	}
 
@@ -97,7 +97,7 @@ func (h stationEventHandler) HandleSyncCarReserved(e events.Event) error {
 		return errors.New("wrong event")
 	}
 
-	if err := h.stationUC.ChangeStationIdle(event.StationId, "del"); err != nil {
+	if err := h.occupancyUC.RecordOccupancy(event.EventId, event.StationId, event.ID, domain.Left); err != nil {
 		return err
 	}
 
@@ -30,8 +30,8 @@ func TestStationEventHandler_HandleCarAdded(t *testing.T) {
 	stations := []domain.Station{*newStationFixture()}
 
 	stationRepo := repository.NewStationRepositoryInMemory(stations)
-	stationUC := application.NewStationUseCase(stationRepo)
-	stationEH := NewStationEventHandler(stationUC)
+	occupancyUC := application.NewOccupancyUseCase(repository.NewOccupancyRepositoryInMemory([]domain.OccupancyEntry{}), stationRepo, repository.NewCarRepositoryInMemory([]domain.Car{}))
+	stationEH := NewStationEventHandler(occupancyUC)
 
 	dispatcher.Register(
 		events.EventHandlerFunc(stationEH.HandleCarAdded),
@@ -45,6 +45,7 @@ func TestStationEventHandler_HandleCarAdded(t *testing.T) {
 		{
 			name: "correct input",
 			eventArg: domain.CarAdded{
+				EventId:   "event:1",
 				ID:        "5ce5a1a1-f324-4c8b-8c92-d7e820cbb238",
 				StationId: stations[0].ID,
 			},
@@ -53,6 +54,7 @@ func TestStationEventHandler_HandleCarAdded(t *testing.T) {
 		{
 			name: "incorrect station id input",
 			eventArg: domain.CarAdded{
+				EventId:   "event:2",
 				ID:        "5ce5a1a1-f324-4c8b-8c92-d7e820cbb238",
 				StationId: "5ce5a1a1-f324-4c8b-8c92-d7e820cbb238",
 			},
@@ -61,6 +63,7 @@ func TestStationEventHandler_HandleCarAdded(t *testing.T) {
 		{
 			name: "incorrect event input",
 			eventArg: domain.CarParked{
+				EventId:   "event:3",
 				ID:        "5ce5a1a1-f324-4c8b-8c92-d7e820cbb238",
 				StationId: stations[0].ID,
 			},
@@ -85,8 +88,8 @@ func TestStationEventHandler_HandleCarParked(t *testing.T) {
 	stations := []domain.Station{*newStationFixture()}
 
 	stationRepo := repository.NewStationRepositoryInMemory(stations)
-	stationUC := application.NewStationUseCase(stationRepo)
-	stationEH := NewStationEventHandler(stationUC)
+	occupancyUC := application.NewOccupancyUseCase(repository.NewOccupancyRepositoryInMemory([]domain.OccupancyEntry{}), stationRepo, repository.NewCarRepositoryInMemory([]domain.Car{}))
+	stationEH := NewStationEventHandler(occupancyUC)
 
 	dispatcher.Register(
 		events.EventHandlerFunc(stationEH.HandleCarParked),
@@ -100,6 +103,7 @@ func TestStationEventHandler_HandleCarParked(t *testing.T) {
 		{
 			name: "correct input",
 			eventArg: domain.CarParked{
+				EventId:   "event:4",
 				ID:        "5ce5a1a1-f324-4c8b-8c92-d7e820cbb238",
 				StationId: stations[0].ID,
 			},
@@ -108,6 +112,7 @@ func TestStationEventHandler_HandleCarParked(t *testing.T) {
 		{
 			name: "incorrect station id input",
 			eventArg: domain.CarParked{
+				EventId:   "event:5",
 				ID:        "5ce5a1a1-f324-4c8b-8c92-d7e820cbb238",
 				StationId: "5ce5a1a1-f324-4c8b-8c92-d7e820cbb238",
 			},
@@ -116,6 +121,7 @@ func TestStationEventHandler_HandleCarParked(t *testing.T) {
 		{
 			name: "incorrect event input",
 			eventArg: domain.CarAdded{
+				EventId:   "event:6",
 				ID:        "5ce5a1a1-f324-4c8b-8c92-d7e820cbb238",
 				StationId: stations[0].ID,
 			},
@@ -140,8 +146,8 @@ func TestStationEventHandler_HandleCarUnderMaintenance(t *testing.T) {
 	stations := []domain.Station{*newStationFixture()}
 
 	stationRepo := repository.NewStationRepositoryInMemory(stations)
-	stationUC := application.NewStationUseCase(stationRepo)
-	stationEH := NewStationEventHandler(stationUC)
+	occupancyUC := application.NewOccupancyUseCase(repository.NewOccupancyRepositoryInMemory([]domain.OccupancyEntry{}), stationRepo, repository.NewCarRepositoryInMemory([]domain.Car{}))
+	stationEH := NewStationEventHandler(occupancyUC)
 
 	dispatcher.Register(
 		events.EventHandlerFunc(stationEH.HandleCarUnderMaintenance),
@@ -155,6 +161,7 @@ func TestStationEventHandler_HandleCarUnderMaintenance(t *testing.T) {
 		{
 			name: "correct input",
 			eventArg: domain.CarUnderMaintenance{
+				EventId:   "event:7",
 				ID:        "5ce5a1a1-f324-4c8b-8c92-d7e820cbb238",
 				StationId: stations[0].ID,
 				CarStatus: domain.Parked,
@@ -164,6 +171,7 @@ func TestStationEventHandler_HandleCarUnderMaintenance(t *testing.T) {
 		{
 			name: "incorrect station id input",
 			eventArg: domain.CarUnderMaintenance{
+				EventId:   "event:8",
 				ID:        "5ce5a1a1-f324-4c8b-8c92-d7e820cbb238",
 				StationId: "5ce5a1a1-f324-4c8b-8c92-d7e820cbb238",
 				CarStatus: domain.Parked,
@@ -173,6 +181,7 @@ func TestStationEventHandler_HandleCarUnderMaintenance(t *testing.T) {
 		{
 			name: "incorrect event input",
 			eventArg: domain.CarParked{
+				EventId:   "event:9",
 				ID:        "5ce5a1a1-f324-4c8b-8c92-d7e820cbb238",
 				StationId: stations[0].ID,
 			},
@@ -197,8 +206,8 @@ func TestStationEventHandler_HandleCarInTransfer(t *testing.T) {
 	stations := []domain.Station{*newStationFixture()}
 
 	stationRepo := repository.NewStationRepositoryInMemory(stations)
-	stationUC := application.NewStationUseCase(stationRepo)
-	stationEH := NewStationEventHandler(stationUC)
+	occupancyUC := application.NewOccupancyUseCase(repository.NewOccupancyRepositoryInMemory([]domain.OccupancyEntry{}), stationRepo, repository.NewCarRepositoryInMemory([]domain.Car{}))
+	stationEH := NewStationEventHandler(occupancyUC)
 
 	dispatcher.Register(
 		events.EventHandlerFunc(stationEH.HandleCarInTransfer),
@@ -212,6 +221,7 @@ func TestStationEventHandler_HandleCarInTransfer(t *testing.T) {
 		{
 			name: "correct input",
 			eventArg: domain.CarInTransfer{
+				EventId:       "event:10",
 				ID:            "5ce5a1a1-f324-4c8b-8c92-d7e820cbb238",
 				StationIdFrom: stations[0].ID,
 				StationIdTo:   "5ce5a1a1-f324-4c8b-8c92-d7e820cbb238",
@@ -221,6 +231,7 @@ func TestStationEventHandler_HandleCarInTransfer(t *testing.T) {
 		{
 			name: "incorrect station id input",
 			eventArg: domain.CarInTransfer{
+				EventId:       "event:11",
 				ID:            "5ce5a1a1-f324-4c8b-8c92-d7e820cbb238",
 				StationIdFrom: "5ce5a1a1-f324-4c8b-8c92-d7e820cbb238",
 				StationIdTo:   "5ce5a1a1-f324-4c8b-8c92-d7e820cbb238",
@@ -230,6 +241,7 @@ func TestStationEventHandler_HandleCarInTransfer(t *testing.T) {
 		{
 			name: "incorrect event input",
 			eventArg: domain.CarAdded{
+				EventId:   "event:12",
 				ID:        "5ce5a1a1-f324-4c8b-8c92-d7e820cbb238",
 				StationId: stations[0].ID,
 			},
@@ -254,8 +266,8 @@ func TestStationEventHandler_HandleSyncCarParked(t *testing.T) {
 	stations := []domain.Station{*newStationFixture()}
 
 	stationRepo := repository.NewStationRepositoryInMemory(stations)
-	stationUC := application.NewStationUseCase(stationRepo)
-	stationEH := NewStationEventHandler(stationUC)
+	occupancyUC := application.NewOccupancyUseCase(repository.NewOccupancyRepositoryInMemory([]domain.OccupancyEntry{}), stationRepo, repository.NewCarRepositoryInMemory([]domain.Car{}))
+	stationEH := NewStationEventHandler(occupancyUC)
 
 	dispatcher.Register(
 		events.EventHandlerFunc(stationEH.HandleSyncCarParked),
@@ -269,6 +281,7 @@ func TestStationEventHandler_HandleSyncCarParked(t *testing.T) {
 		{
 			name: "correct input",
 			eventArg: domain.SyncCarParked{
+				EventId:   "event:13",
 				ID:        "5ce5a1a1-f324-4c8b-8c92-d7e820cbb238",
 				StationId: stations[0].ID,
 			},
@@ -277,6 +290,7 @@ func TestStationEventHandler_HandleSyncCarParked(t *testing.T) {
 		{
 			name: "incorrect station id input",
 			eventArg: domain.SyncCarParked{
+				EventId:   "event:14",
 				ID:        "5ce5a1a1-f324-4c8b-8c92-d7e820cbb238",
 				StationId: "5ce5a1a1-f324-4c8b-8c92-d7e820cbb238",
 			},
@@ -285,6 +299,7 @@ func TestStationEventHandler_HandleSyncCarParked(t *testing.T) {
 		{
 			name: "incorrect event input",
 			eventArg: domain.CarAdded{
+				EventId:   "event:15",
 				ID:        "5ce5a1a1-f324-4c8b-8c92-d7e820cbb238",
 				StationId: stations[0].ID,
 			},
@@ -309,8 +324,8 @@ func TestStationEventHandler_HandleCarReserved(t *testing.T) {
 	stations := []domain.Station{*newStationFixture()}
 
 	stationRepo := repository.NewStationRepositoryInMemory(stations)
-	stationUC := application.NewStationUseCase(stationRepo)
-	stationEH := NewStationEventHandler(stationUC)
+	occupancyUC := application.NewOccupancyUseCase(repository.NewOccupancyRepositoryInMemory([]domain.OccupancyEntry{}), stationRepo, repository.NewCarRepositoryInMemory([]domain.Car{}))
+	stationEH := NewStationEventHandler(occupancyUC)
 
 	dispatcher.Register(
 		events.EventHandlerFunc(stationEH.HandleSyncCarReserved),
@@ -324,6 +339,7 @@ func TestStationEventHandler_HandleCarReserved(t *testing.T) {
 		{
 			name: "correct input",
 			eventArg: domain.SyncCarReserved{
+				EventId:   "event:16",
 				ID:        "5ce5a1a1-f324-4c8b-8c92-d7e820cbb238",
 				StationId: stations[0].ID,
 			},
@@ -332,6 +348,7 @@ func TestStationEventHandler_HandleCarReserved(t *testing.T) {
 		{
 			name: "incorrect station id input",
 			eventArg: domain.SyncCarReserved{
+				EventId:   "event:17",
 				ID:        "5ce5a1a1-f324-4c8b-8c92-d7e820cbb238",
 				StationId: "5ce5a1a1-f324-4c8b-8c92-d7e820cbb238",
 			},
@@ -340,6 +357,7 @@ func TestStationEventHandler_HandleCarReserved(t *testing.T) {
 		{
 			name: "incorrect event input",
 			eventArg: domain.CarAdded{
+				EventId:   "event:18",
 				ID:        "5ce5a1a1-f324-4c8b-8c92-d7e820cbb238",
 				StationId: stations[0].ID,
 			},
@@ -0,0 +1,124 @@
+package geocoder
+
+import (
+	"encoding/csv"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/application"
+)
+
+type Coordinates struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+type cepRange struct {
+	from, to uint
+	Coordinates
+}
+
+var cepRanges = []cepRange{
+	{1000, 19999, Coordinates{-23.5505, -46.6333}},  // SP
+	{20000, 28999, Coordinates{-22.9068, -43.1729}}, // RJ
+	{29000, 29999, Coordinates{-20.3155, -40.3128}}, // ES
+	{30000, 39999, Coordinates{-19.9167, -43.9345}}, // MG
+	{40000, 48999, Coordinates{-12.9714, -38.5014}}, // BA
+	{49000, 49999, Coordinates{-10.9472, -37.0731}}, // SE
+	{50000, 56999, Coordinates{-8.0476, -34.8770}},  // PE
+	{57000, 57999, Coordinates{-9.6658, -35.7353}},  // AL
+	{58000, 58999, Coordinates{-7.1195, -34.8450}},  // PB
+	{59000, 59999, Coordinates{-5.7945, -35.2110}},  // RN
+	{60000, 63999, Coordinates{-3.7319, -38.5267}},  // CE
+	{64000, 64999, Coordinates{-5.0920, -42.8038}},  // PI
+	{65000, 65999, Coordinates{-2.5307, -44.3068}},  // MA
+	{66000, 68899, Coordinates{-1.4558, -48.4902}},  // PA
+	{68900, 68999, Coordinates{0.0349, -51.0694}},   // AP
+	{69000, 69299, Coordinates{-3.1190, -60.0217}},  // AM
+	{69300, 69399, Coordinates{2.8235, -60.6758}},   // RR
+	{69400, 69899, Coordinates{-3.1190, -60.0217}},  // AM
+	{69900, 69999, Coordinates{-9.9754, -67.8249}},  // AC
+	{70000, 72799, Coordinates{-15.7939, -47.8828}}, // DF
+	{72800, 72999, Coordinates{-16.6869, -49.2648}}, // GO
+	{73000, 73699, Coordinates{-15.7939, -47.8828}}, // DF
+	{73700, 76799, Coordinates{-16.6869, -49.2648}}, // GO
+	{76800, 76999, Coordinates{-8.7612, -63.9004}},  // RO
+	{77000, 77999, Coordinates{-10.1840, -48.3336}}, // TO
+	{78000, 78899, Coordinates{-15.6014, -56.0979}}, // MT
+	{79000, 79999, Coordinates{-20.4697, -54.6201}}, // MS
+	{80000, 87999, Coordinates{-25.4284, -49.2733}}, // PR
+	{88000, 89999, Coordinates{-27.5954, -48.5480}}, // SC
+	{90000, 99999, Coordinates{-30.0346, -51.2177}}, // RS
+}
+
+type cepTableGeocoder struct {
+	entries map[string]Coordinates
+}
+
+func NewCepTableGeocoder(entries map[string]Coordinates) *cepTableGeocoder {
+	if entries == nil {
+		entries = make(map[string]Coordinates)
+	}
+	return &cepTableGeocoder{entries}
+}
+
+func LoadCepTable(path string) (map[string]Coordinates, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return readCepTable(f)
+}
+
+func readCepTable(r io.Reader) (map[string]Coordinates, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = 3
+	reader.Comment = '#'
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make(map[string]Coordinates, len(records))
+	for _, record := range records {
+		latitude, err := strconv.ParseFloat(record[1], 64)
+		if err != nil {
+			return nil, err
+		}
+		longitude, err := strconv.ParseFloat(record[2], 64)
+		if err != nil {
+			return nil, err
+		}
+		entries[record[0]] = Coordinates{latitude, longitude}
+	}
+
+	return entries, nil
+}
+
+func (g cepTableGeocoder) Geocode(cep string) (float64, float64, error) {
+	if c, exists := g.entries[cep]; exists {
+		return c.Latitude, c.Longitude, nil
+	}
+
+	if len(cep) != 8 {
+		return 0, 0, application.ErrNotFoundCep
+	}
+
+	n, err := strconv.ParseUint(cep, 10, 32)
+	if err != nil {
+		return 0, 0, application.ErrNotFoundCep
+	}
+	prefix := n / 1000
+
+	for _, r := range cepRanges {
+		if uint(prefix) >= r.from && uint(prefix) <= r.to {
+			return r.Latitude, r.Longitude, nil
+		}
+	}
+
+	return 0, 0, application.ErrNotFoundCep
+}
@@ -0,0 +1,101 @@
+package geocoder
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/application"
+)
+
+func TestCepTableGeocoder_Geocode(t *testing.T) {
+	geocoder := NewCepTableGeocoder(map[string]Coordinates{
+		"01310100": {-23.5614, -46.6559},
+	})
+
+	testCases := []struct {
+		name    string
+		cepArg  string
+		wantLat float64
+		wantLng float64
+		wantErr error
+	}{
+		{
+			name:    "exact cep",
+			cepArg:  "01310100",
+			wantLat: -23.5614,
+			wantLng: -46.6559,
+			wantErr: nil,
+		},
+		{
+			name:    "cep range",
+			cepArg:  "20778990",
+			wantLat: -22.9068,
+			wantLng: -43.1729,
+			wantErr: nil,
+		},
+		{
+			name:    "incorrect cep",
+			cepArg:  "2077899A",
+			wantErr: application.ErrNotFoundCep,
+		},
+		{
+			name:    "cep out of range",
+			cepArg:  "00000100",
+			wantErr: application.ErrNotFoundCep,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			lat, lng, err := geocoder.Geocode(tc.cepArg)
+
+			if !errors.Is(err, tc.wantErr) {
+				t.Error("unexpected error", err)
+			}
+
+			if lat != tc.wantLat || lng != tc.wantLng {
+				t.Error("unexpected coordinates", lat, lng)
+			}
+		})
+	}
+}
+
+func TestReadCepTable(t *testing.T) {
+	testCases := []struct {
+		name     string
+		tableArg string
+		wantLen  int
+		wantErr  bool
+	}{
+		{
+			name:     "correct table",
+			tableArg: "# cep,latitude,longitude\n01310100,-23.5614,-46.6559\n20040002,-22.9035,-43.1775\n",
+			wantLen:  2,
+		},
+		{
+			name:     "incorrect coordinates",
+			tableArg: "01310100,south,-46.6559\n",
+			wantErr:  true,
+		},
+		{
+			name:     "missing column",
+			tableArg: "01310100,-23.5614\n",
+			wantErr:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			entries, err := readCepTable(strings.NewReader(tc.tableArg))
+
+			if (err != nil) != tc.wantErr {
+				t.Error("unexpected error", err)
+			}
+
+			if len(entries) != tc.wantLen {
+				t.Error("unexpected result", entries)
+			}
+		})
+	}
+}
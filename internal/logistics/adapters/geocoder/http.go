@@ -0,0 +1,42 @@
+package geocoder
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/application"
+)
+
+type httpGeocoder struct {
+	url    string
+	client *http.Client
+}
+
+func NewHTTPGeocoder(url string, timeout time.Duration) *httpGeocoder {
+	return &httpGeocoder{url, &http.Client{Timeout: timeout}}
+}
+
+func (g httpGeocoder) Geocode(cep string) (float64, float64, error) {
+	resp, err := g.client.Get(strings.ReplaceAll(g.url, "{cep}", cep))
+	if err != nil {
+		return 0, 0, err
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return 0, 0, application.ErrNotFoundCep
+	case resp.StatusCode != http.StatusOK:
+		return 0, 0, fmt.Errorf("geocoder answered %d", resp.StatusCode)
+	}
+
+	var c Coordinates
+	if err := json.NewDecoder(resp.Body).Decode(&c); err != nil {
+		return 0, 0, err
+	}
+
+	return c.Latitude, c.Longitude, nil
+}
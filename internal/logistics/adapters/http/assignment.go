@@ -0,0 +1,37 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/application"
+)
+
+type assignmentController struct {
+	assignmentUC application.AssignmentUseCase
+}
+
+func NewAssignmentController(assignmentUC application.AssignmentUseCase) *assignmentController {
+	return &assignmentController{assignmentUC}
+}
+
+func (c *assignmentController) GetAssignmentCandidates(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	vars := mux.Vars(r)
+	candidates, err := c.assignmentUC.GetAssignmentCandidates(vars["id"], r.URL.Query().Get("modelId"))
+
+	switch err {
+	case application.ErrInvalidId, application.ErrInvalidModel:
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case nil:
+		json, _ := json.Marshal(candidates)
+		w.WriteHeader(http.StatusOK)
+		w.Write(json)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	}
+}
@@ -4,11 +4,16 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
 
 	"github.com/gorilla/mux"
 	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/application"
 )
 
+const totalCountHeader = "X-Total-Count"
+
 type carController struct {
 	carUC application.CarUseCase
 }
@@ -19,14 +24,15 @@ func NewCarController(carUC application.CarUseCase) *carController {
 
 func (c *carController) SearchCars(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("content-type", "application/json")
-	var params application.SearchCarParams
-	if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+	params, err := searchCarParamsFromQuery(r.URL.Query())
+	if err != nil {
 		w.WriteHeader(http.StatusBadRequest)
-		fmt.Fprintf(w, "{error: %v}", application.ErrInvalidEntity)
+		fmt.Fprintf(w, `{"error":"%v"}`, application.ErrInvalidEntity)
 		return
 	}
 	cars := c.carUC.SearchCars(params)
 
+	w.Header().Set(totalCountHeader, strconv.Itoa(c.carUC.CountCars(params)))
 	w.WriteHeader(http.StatusOK)
 	json, err := json.Marshal(cars)
 	if err != nil {
@@ -37,6 +43,76 @@ func (c *carController) SearchCars(w http.ResponseWriter, r *http.Request) {
 	w.Write(json)
 }
 
+func searchCarParamsFromQuery(query url.Values) (application.SearchCarParams, error) {
+	params := application.SearchCarParams{
+		PlateLike:  query.Get("plate"),
+		Document:   query.Get("document"),
+		ModelId:    query.Get("modelId"),
+		Model:      query.Get("model"),
+		Make:       query.Get("make"),
+		StationIds: queryList(query, "stationId"),
+		Sort:       query.Get("sort"),
+	}
+
+	parse := func(name string, bitSize int) (uint64, error) {
+		if param := query.Get(name); param != "" {
+			return strconv.ParseUint(param, 10, bitSize)
+		}
+		return 0, nil
+	}
+
+	var err error
+	uints := map[string]uint64{}
+	for _, p := range []struct {
+		name    string
+		bitSize int
+	}{
+		{"age", 16}, {"minAge", 16}, {"maxAge", 16},
+		{"km", 64}, {"minKm", 64}, {"maxKm", 64},
+		{"minFuel", 8}, {"limit", 32}, {"offset", 32},
+	} {
+		if uints[p.name], err = parse(p.name, p.bitSize); err != nil {
+			return params, err
+		}
+	}
+
+	for _, s := range queryList(query, "status") {
+		status, err := strconv.ParseUint(s, 10, 32)
+		if err != nil {
+			return params, err
+		}
+		params.Statuses = append(params.Statuses, uint(status))
+	}
+
+	params.Age = uint16(uints["age"])
+	params.MinAge = uint16(uints["minAge"])
+	params.MaxAge = uint16(uints["maxAge"])
+	params.KM = uints["km"]
+	params.MinKM = uints["minKm"]
+	params.MaxKM = uints["maxKm"]
+	params.MinFuel = uint8(uints["minFuel"])
+	params.Limit = uint(uints["limit"])
+	params.Offset = uint(uints["offset"])
+
+	if !params.Valid() {
+		return params, application.ErrInvalidEntity
+	}
+
+	return params, nil
+}
+
+func queryList(query url.Values, name string) []string {
+	var values []string
+	for _, param := range query[name] {
+		for _, v := range strings.Split(param, ",") {
+			if v = strings.TrimSpace(v); v != "" {
+				values = append(values, v)
+			}
+		}
+	}
+	return values
+}
+
 func (c *carController) GetCarById(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("content-type", "application/json")
 	vars := mux.Vars(r)
@@ -67,43 +143,27 @@ func (c *carController) CreateCar(w http.ResponseWriter, r *http.Request) {
 		Plate     string `json:"plate"`
 		Document  string `json:"document"`
 		StationId string `json:"stationId"`
-		Model     string `json:"model"`
-		Make      string `json:"make"`
+		ModelId   string `json:"modelId"`
+		FuelLevel uint8  `json:"fuelLevel"`
 	}
 	if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
 		w.WriteHeader(http.StatusBadRequest)
 		fmt.Fprintf(w, `{"error":"%v"}`, application.ErrInvalidEntity)
 		return
 	}
-	err := c.carUC.AddCar(params.Age, params.KM, params.Plate, params.Document, params.StationId, params.Model, params.Make)
+	err := c.carUC.AddCar(params.Age, params.KM, params.Plate, params.Document, params.StationId, params.ModelId, params.FuelLevel)
 	switch err {
-	case application.ErrInvalidEntity, application.ErrStationMaxCapacity:
+	case application.ErrInvalidEntity, application.ErrStationMaxCapacity, application.ErrInvalidModel:
 		w.WriteHeader(http.StatusBadRequest)
 		fmt.Fprintf(w, `{"error":"%v"}`, err)
 		return
-	case nil:
-		w.WriteHeader(http.StatusCreated)
-		return
-	default:
-		w.WriteHeader(http.StatusInternalServerError)
-		fmt.Fprintf(w, `{"error":"%v"}`, err)
-	}
-}
-
-func (c *carController) DeleteCar(w http.ResponseWriter, r *http.Request) {
-	w.Header().Set("content-type", "application/json")
-	vars := mux.Vars(r)
-	err := c.carUC.DeleteCar(vars["id"])
-
-	switch err {
-	case application.ErrInvalidId, application.ErrCarNotInMaintenance:
-		w.WriteHeader(http.StatusBadRequest)
-		fmt.Fprintf(w, `{"error":"%v"}`, err)
-	case application.ErrInvalidCar:
+	case application.ErrNotFoundModel:
 		w.WriteHeader(http.StatusNotFound)
 		fmt.Fprintf(w, `{"error":"%v"}`, err)
+		return
 	case nil:
-		w.WriteHeader(http.StatusNoContent)
+		w.WriteHeader(http.StatusCreated)
+		return
 	default:
 		w.WriteHeader(http.StatusInternalServerError)
 		fmt.Fprintf(w, `{"error":"%v"}`, err)
@@ -144,13 +204,14 @@ func (c *carController) UpdateCarToPark(w http.ResponseWriter, r *http.Request)
 	var params struct {
 		StationId string `json:"stationId"`
 		KM        uint64 `json:"km"`
+		FuelLevel *uint8 `json:"fuelLevel"`
 	}
 	if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
 		w.WriteHeader(http.StatusBadRequest)
 		fmt.Fprintf(w, `{"error":"%v"}`, err)
 		return
 	}
-	err := c.carUC.ParkCar(vars["id"], params.StationId, params.KM)
+	err := c.carUC.ParkCar(vars["id"], params.StationId, params.KM, params.FuelLevel)
 	switch err {
 	case application.ErrInvalidId, application.ErrStationMaxCapacity, application.ErrInvalidEntity, application.ErrInvalidPark:
 		w.WriteHeader(http.StatusBadRequest)
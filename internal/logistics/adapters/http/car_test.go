@@ -14,8 +14,19 @@ import (
 	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/domain"
 )
 
+const modelIdFixture = "0b1b5f2a-3f4e-4b7b-9c41-7d8a6c1f2e3d"
+
+type modelCatalogStub struct{}
+
+func (modelCatalogStub) GetModel(modelId string) (string, string, domain.EnergyType, error) {
+	if modelId != modelIdFixture {
+		return "", "", 0, application.ErrNotFoundModel
+	}
+	return "FIAT", "Uno", domain.Combustion, nil
+}
+
 func newCarFixture() *domain.Car {
-	c, _ := domain.NewCar(2020, 12000, "KST-9016", "abc.123.op-x", "83369771-f9a4-48b7-b87b-463f19f7b187", "Uno", "FIAT")
+	c, _ := domain.NewCar(2020, 12000, "KST-9016", "abc.123.op-x", "83369771-f9a4-48b7-b87b-463f19f7b187", modelIdFixture, "Uno", "FIAT", domain.Combustion, domain.FullLevel)
 	return c
 }
 
@@ -25,7 +36,7 @@ func TestCarController_GetCarById(t *testing.T) {
 
 	carRepo := repository.NewCarRepositoryInMemory(cars)
 	stationRepo := repository.NewStationRepositoryInMemory(stations)
-	carUC := application.NewCarUseCase(carRepo, stationRepo)
+	carUC := application.NewCarUseCase(carRepo, stationRepo, nil)
 	carController := NewCarController(carUC)
 
 	testCases := []struct {
@@ -77,67 +88,87 @@ func TestCarController_GetCarById(t *testing.T) {
 	}
 }
 
-func TestCarController_DeleteCar(t *testing.T) {
-	cars := []domain.Car{*newCarFixture(), *newCarFixture()}
-	cars[0].Status = domain.Maintenance
+func TestCarController_SearchCars(t *testing.T) {
+	cars := []domain.Car{*newCarFixture(), *newCarFixture(), *newCarFixture()}
+	cars[1].FuelLevel = 20
+	cars[1].Plate = "ABC-1234"
+	cars[2].KM = 90000
+	cars[2].Plate = "KST-0001"
 	stations := []domain.Station{}
 
 	carRepo := repository.NewCarRepositoryInMemory(cars)
 	stationRepo := repository.NewStationRepositoryInMemory(stations)
-	carUC := application.NewCarUseCase(carRepo, stationRepo)
+	carUC := application.NewCarUseCase(carRepo, stationRepo, nil)
 	carController := NewCarController(carUC)
 
 	testCases := []struct {
 		name           string
-		idArg          string
+		queryArg       string
 		wantStatusCode int
+		wantTotal      string
 		wantBody       interface{}
 	}{
 		{
-			name:           "correct req",
-			idArg:          cars[0].ID,
-			wantStatusCode: http.StatusNoContent,
-			wantBody:       nil,
+			name:           "correct min fuel req",
+			queryArg:       "?minFuel=50&model=Uno&sort=km",
+			wantStatusCode: http.StatusOK,
+			wantTotal:      "2",
+			wantBody:       []domain.Car{cars[0], cars[2]},
 		},
 		{
-			name:           "incorrect invalid id req",
-			idArg:          "invalid-id",
+			name:           "correct partial plate and range req",
+			queryArg:       "?plate=kst&maxKm=50000",
+			wantStatusCode: http.StatusOK,
+			wantTotal:      "1",
+			wantBody:       []domain.Car{cars[0]},
+		},
+		{
+			name:           "correct sorted page req",
+			queryArg:       "?status=1,3&sort=-plate&limit=2&offset=1",
+			wantStatusCode: http.StatusOK,
+			wantTotal:      "3",
+			wantBody:       []domain.Car{cars[2], cars[1]},
+		},
+		{
+			name:           "incorrect min fuel req",
+			queryArg:       "?minFuel=full",
 			wantStatusCode: http.StatusBadRequest,
-			wantBody:       map[string]string{"error": application.ErrInvalidId.Error()},
+			wantBody:       map[string]string{"error": application.ErrInvalidEntity.Error()},
 		},
 		{
-			name:           "incorrect id req",
-			idArg:          "e4ce866a-f5b7-4774-8f9d-5eb74c3900cc",
-			wantStatusCode: http.StatusNotFound,
-			wantBody:       map[string]string{"error": application.ErrInvalidCar.Error()},
+			name:           "incorrect range req",
+			queryArg:       "?minKm=5000&maxKm=100",
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       map[string]string{"error": application.ErrInvalidEntity.Error()},
 		},
 		{
-			name:           "incorrect station req",
-			idArg:          cars[1].ID,
+			name:           "incorrect sort req",
+			queryArg:       "?sort=document",
 			wantStatusCode: http.StatusBadRequest,
-			wantBody:       map[string]string{"error": application.ErrCarNotInMaintenance.Error()},
+			wantBody:       map[string]string{"error": application.ErrInvalidEntity.Error()},
 		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			req := httptest.NewRequest("DELETE", "/cars/"+tc.idArg, nil)
+			req := httptest.NewRequest("GET", "/cars/"+tc.queryArg, nil)
 			res := httptest.NewRecorder()
 
 			router := mux.NewRouter()
-			router.HandleFunc("/cars/{id}", carController.DeleteCar).Methods("DELETE")
+			router.HandleFunc("/cars/", carController.SearchCars).Methods("GET")
 			router.ServeHTTP(res, req)
 
 			if res.Code != tc.wantStatusCode {
 				t.Error("wrong response code", res.Code)
 			}
 
-			var expectedBody string
-			if tc.wantBody != nil {
-				json, _ := json.Marshal(tc.wantBody)
-				expectedBody = strings.Trim(string(json), "\n")
+			if total := res.Header().Get("X-Total-Count"); total != tc.wantTotal {
+				t.Error("wrong total count", total, tc.wantTotal)
 			}
 
+			json, _ := json.Marshal(tc.wantBody)
+			expectedBody := strings.Trim(string(json), "\n")
+
 			if strings.Trim(res.Body.String(), "\n") != expectedBody {
 				t.Error("wrong response body", strings.Trim(res.Body.String(), "\n"), expectedBody)
 			}
@@ -151,13 +182,14 @@ func TestCarController_CreateCar(t *testing.T) {
 	cars[0].StationId = stations[0].ID
 	carRepo := repository.NewCarRepositoryInMemory(cars)
 	stationRepo := repository.NewStationRepositoryInMemory(stations)
-	carUC := application.NewCarUseCase(carRepo, stationRepo)
+	carUC := application.NewCarUseCase(carRepo, stationRepo, modelCatalogStub{})
 	carController := NewCarController(carUC)
 
 	type params struct {
-		Age                                     uint16
-		KM                                      uint64
-		Plate, Document, StationId, Model, Make string
+		Age                                 uint16
+		KM                                  uint64
+		Plate, Document, StationId, ModelId string
+		FuelLevel                           uint8
 	}
 
 	testCases := []struct {
@@ -174,9 +206,9 @@ func TestCarController_CreateCar(t *testing.T) {
 				KM:        12000,
 				Plate:     "KST-9016",
 				Document:  "abc.123.op-x",
-				Model:     "Uno",
-				Make:      "FIAT",
+				ModelId:   modelIdFixture,
 				StationId: stations[0].ID,
+				FuelLevel: 80,
 			},
 			wantBody: nil,
 		},
@@ -188,12 +220,24 @@ func TestCarController_CreateCar(t *testing.T) {
 				KM:        12000,
 				Plate:     "KST-9016",
 				Document:  "abc.123.op-x",
-				Model:     "Uno",
-				Make:      "FIAT",
+				ModelId:   modelIdFixture,
 				StationId: "83369771-f9a4-48b7-b87b-463f19f7b187",
 			},
 			wantBody: map[string]string{"error": application.ErrInvalidEntity.Error()},
 		},
+		{
+			name:           "incorrect model not in catalog body req",
+			wantStatusCode: http.StatusNotFound,
+			bodyArg: params{
+				Age:       2020,
+				KM:        12000,
+				Plate:     "KST-9017",
+				Document:  "abc.123.op-x",
+				ModelId:   "2c9e4a1b-7d3f-4e8a-b5c6-0f1e2d3c4b5a",
+				StationId: stations[0].ID,
+			},
+			wantBody: map[string]string{"error": application.ErrNotFoundModel.Error()},
+		},
 		{
 			name:           "incorrect malformed body req",
 			wantStatusCode: http.StatusBadRequest,
@@ -208,8 +252,7 @@ func TestCarController_CreateCar(t *testing.T) {
 				KM:        12000,
 				Plate:     "",
 				Document:  "abc.123.op-x",
-				Model:     "Uno",
-				Make:      "FIAT",
+				ModelId:   modelIdFixture,
 				StationId: "83369771-f9a4-48b7-b87b-463f19f7b187",
 			},
 			wantBody: map[string]string{"error": application.ErrInvalidEntity.Error()},
@@ -253,7 +296,7 @@ func TestCarController_UpdateCarToMaintenance(t *testing.T) {
 	cars[0].StationId = stations[0].ID
 	carRepo := repository.NewCarRepositoryInMemory(cars)
 	stationRepo := repository.NewStationRepositoryInMemory(stations)
-	carUC := application.NewCarUseCase(carRepo, stationRepo)
+	carUC := application.NewCarUseCase(carRepo, stationRepo, nil)
 	carController := NewCarController(carUC)
 
 	type params struct {
@@ -348,7 +391,7 @@ func TestCarController_UpdateCarToPark(t *testing.T) {
 	cars := []domain.Car{transferCar, parkedCar}
 	carRepo := repository.NewCarRepositoryInMemory(cars)
 	stationRepo := repository.NewStationRepositoryInMemory(stations)
-	carUC := application.NewCarUseCase(carRepo, stationRepo)
+	carUC := application.NewCarUseCase(carRepo, stationRepo, nil)
 	carController := NewCarController(carUC)
 
 	type params struct {
@@ -443,7 +486,7 @@ func TestCarController_UpdateCarToTransfer(t *testing.T) {
 	cars := []domain.Car{transferCar, parkedCar}
 	carRepo := repository.NewCarRepositoryInMemory(cars)
 	stationRepo := repository.NewStationRepositoryInMemory(stations)
-	carUC := application.NewCarUseCase(carRepo, stationRepo)
+	carUC := application.NewCarUseCase(carRepo, stationRepo, nil)
 	carController := NewCarController(carUC)
 
 	type params struct {
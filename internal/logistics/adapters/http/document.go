@@ -0,0 +1,128 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/domain"
+)
+
+type documentController struct {
+	documentUC application.DocumentUseCase
+}
+
+func NewDocumentController(documentUC application.DocumentUseCase) *documentController {
+	return &documentController{documentUC}
+}
+
+func (c *documentController) GetCarDocuments(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	vars := mux.Vars(r)
+	documents, err := c.documentUC.GetCarDocuments(vars["id"])
+
+	switch err {
+	case application.ErrInvalidId:
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case application.ErrNotFoundCar:
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case nil:
+		json, _ := json.Marshal(documents)
+		w.WriteHeader(http.StatusOK)
+		w.Write(json)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	}
+}
+
+func (c *documentController) CreateCarDocument(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	vars := mux.Vars(r)
+	var params struct {
+		Type        uint                `json:"type"`
+		Number      string              `json:"number"`
+		IssuedAt    time.Time           `json:"issuedAt"`
+		ExpiresAt   time.Time           `json:"expiresAt"`
+		Attachments []domain.Attachment `json:"attachments"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, application.ErrInvalidEntity)
+		return
+	}
+	err := c.documentUC.AddCarDocument(vars["id"], params.Number, domain.DocumentType(params.Type), params.IssuedAt, params.ExpiresAt, params.Attachments)
+	switch err {
+	case application.ErrInvalidId, application.ErrInvalidEntity, application.ErrInvalidDocument:
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case application.ErrNotFoundCar:
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case nil:
+		w.WriteHeader(http.StatusCreated)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	}
+}
+
+func (c *documentController) UpdateToRenewCarDocument(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	vars := mux.Vars(r)
+	var params struct {
+		Number      string              `json:"number"`
+		IssuedAt    time.Time           `json:"issuedAt"`
+		ExpiresAt   time.Time           `json:"expiresAt"`
+		Attachments []domain.Attachment `json:"attachments"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, application.ErrInvalidEntity)
+		return
+	}
+	err := c.documentUC.RenewCarDocument(vars["id"], params.Number, params.IssuedAt, params.ExpiresAt, params.Attachments)
+	switch err {
+	case application.ErrInvalidId, application.ErrInvalidDocument:
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case application.ErrNotFoundDocument:
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case nil:
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	}
+}
+
+func (c *documentController) GetExpiringDocuments(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	var within uint64
+	if param := r.URL.Query().Get("within"); param != "" {
+		n, err := strconv.ParseUint(param, 10, 32)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, `{"error":"%v"}`, application.ErrInvalidEntity)
+			return
+		}
+		within = n
+	}
+	expiries := c.documentUC.GetExpiringDocuments(uint(within))
+
+	json, err := json.Marshal(expiries)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(json)
+}
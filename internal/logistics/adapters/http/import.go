@@ -0,0 +1,214 @@
+package http
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/application"
+)
+
+const (
+	csvContentType  = "text/csv"
+	csvFormat       = "csv"
+	jsonLinesFormat = "jsonl"
+)
+
+var carColumns = []string{"plate", "document", "modelId", "age", "km", "stationId", "fuelLevel"}
+
+func (c *carController) ImportCars(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+
+	dryRun := false
+	if param := r.URL.Query().Get("dryRun"); param != "" {
+		b, err := strconv.ParseBool(param)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, `{"error":"%v"}`, application.ErrInvalidEntity)
+			return
+		}
+		dryRun = b
+	}
+
+	var rows []application.CarImportRow
+	var err error
+	if strings.HasPrefix(r.Header.Get("content-type"), ndjsonContentType) {
+		rows, err = readJSONLinesCars(r.Body)
+	} else {
+		rows, err = readCSVCars(r.Body)
+	}
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+		return
+	}
+
+	report := c.carUC.ImportCars(rows, dryRun)
+
+	json, err := json.Marshal(report)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+		return
+	}
+
+	switch {
+	case dryRun:
+		w.WriteHeader(http.StatusOK)
+	case report.Imported > 0:
+		w.WriteHeader(http.StatusCreated)
+	default:
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	w.Write(json)
+}
+
+func readCSVCars(body io.Reader) ([]application.CarImportRow, error) {
+	reader := csv.NewReader(body)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, application.ErrInvalidEntity
+	}
+
+	index := make(map[string]int)
+	for i, h := range header {
+		index[strings.TrimSpace(h)] = i
+	}
+	for _, column := range carColumns {
+		if _, exists := index[column]; !exists {
+			return nil, application.ErrInvalidEntity
+		}
+	}
+
+	rows := []application.CarImportRow{}
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+
+		row := application.CarImportRow{Line: line}
+		if err != nil {
+			row.Err = application.ErrInvalidEntity
+			rows = append(rows, row)
+			continue
+		}
+
+		field := func(column string) string {
+			if i := index[column]; i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+
+		row.Plate = field("plate")
+		row.Document = field("document")
+		row.ModelId = field("modelId")
+		row.StationId = field("stationId")
+
+		age, errAge := strconv.ParseUint(field("age"), 10, 16)
+		km, errKM := strconv.ParseUint(field("km"), 10, 64)
+		fuelLevel, errFuel := strconv.ParseUint(field("fuelLevel"), 10, 8)
+		if errAge != nil || errKM != nil || errFuel != nil {
+			row.Err = application.ErrInvalidEntity
+		}
+
+		row.Age = uint16(age)
+		row.KM = km
+		row.FuelLevel = uint8(fuelLevel)
+
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+func readJSONLinesCars(body io.Reader) ([]application.CarImportRow, error) {
+	scanner := bufio.NewScanner(body)
+	rows := []application.CarImportRow{}
+
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+
+		var row application.CarImportRow
+		if err := json.Unmarshal([]byte(text), &row); err != nil {
+			row = application.CarImportRow{Err: application.ErrInvalidEntity}
+		}
+		row.Line = line
+
+		rows = append(rows, row)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, application.ErrInvalidEntity
+	}
+
+	return rows, nil
+}
+
+func (c *carController) ExportCars(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	format := query.Get("format")
+	if format == "" {
+		format = csvFormat
+	}
+
+	params, err := searchCarParamsFromQuery(query)
+	if err != nil || (format != csvFormat && format != jsonLinesFormat) {
+		w.Header().Set("content-type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, application.ErrInvalidEntity)
+		return
+	}
+
+	cars := c.carUC.SearchCars(params)
+
+	if format == jsonLinesFormat {
+		w.Header().Set("content-type", ndjsonContentType)
+		w.Header().Set("content-disposition", `attachment; filename="cars.jsonl"`)
+		w.WriteHeader(http.StatusOK)
+
+		encoder := json.NewEncoder(w)
+		for _, car := range cars {
+			encoder.Encode(car)
+		}
+		return
+	}
+
+	w.Header().Set("content-type", csvContentType)
+	w.Header().Set("content-disposition", `attachment; filename="cars.csv"`)
+	w.WriteHeader(http.StatusOK)
+
+	writer := csv.NewWriter(w)
+	header := append([]string{"id", "status"}, carColumns...)
+	writer.Write(append(header, "make", "model", "energy"))
+	for _, car := range cars {
+		writer.Write([]string{
+			car.ID,
+			strconv.FormatUint(uint64(car.Status), 10),
+			car.Plate,
+			car.Document,
+			car.ModelId,
+			strconv.FormatUint(uint64(car.Age), 10),
+			strconv.FormatUint(car.KM, 10),
+			car.StationId,
+			strconv.FormatUint(uint64(car.FuelLevel), 10),
+			car.Make,
+			car.Model,
+			strconv.FormatUint(uint64(car.Energy), 10),
+		})
+	}
+	writer.Flush()
+}
@@ -0,0 +1,204 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/adapters/repository"
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/domain"
+)
+
+func TestCarController_ImportCars(t *testing.T) {
+	station, _ := domain.NewStation("Station 1", "Farway Av.", "45, Ap. 50", "Polar", "Nort City", "20778990", 2, 0)
+	csvHeader := "plate,document,modelId,age,km,stationId,fuelLevel\n"
+	csvRow := func(plate string) string {
+		return plate + ",abc.123.op-x," + modelIdFixture + ",2020,12000," + station.ID + ",100\n"
+	}
+
+	testCases := []struct {
+		name           string
+		queryArg       string
+		contentType    string
+		bodyArg        string
+		wantStatusCode int
+		wantImported   int
+		wantErrors     map[int]string
+		wantCars       int
+	}{
+		{
+			name:           "correct csv req",
+			contentType:    "text/csv",
+			bodyArg:        csvHeader + csvRow("KST-9016") + csvRow("KST-9017"),
+			wantStatusCode: http.StatusCreated,
+			wantImported:   2,
+			wantErrors:     map[int]string{},
+			wantCars:       2,
+		},
+		{
+			name:           "dry run csv req",
+			queryArg:       "?dryRun=true",
+			contentType:    "text/csv",
+			bodyArg:        csvHeader + csvRow("KST-9016") + csvRow("KST-9017"),
+			wantStatusCode: http.StatusOK,
+			wantImported:   2,
+			wantErrors:     map[int]string{},
+			wantCars:       0,
+		},
+		{
+			name:           "invalid rows csv req",
+			contentType:    "text/csv",
+			bodyArg:        csvHeader + csvRow("KST-9016") + csvRow("KST-9016") + csvRow("") + "KST-9018,abc," + modelIdFixture + ",old,12000," + station.ID + ",100\n" + csvRow("KST-9019") + csvRow("KST-9020"),
+			wantStatusCode: http.StatusCreated,
+			wantImported:   2,
+			wantErrors: map[int]string{
+				3: application.ErrDuplicatedPlate.Error(),
+				4: application.ErrInvalidEntity.Error(),
+				5: application.ErrInvalidEntity.Error(),
+				7: application.ErrStationMaxCapacity.Error(),
+			},
+			wantCars: 2,
+		},
+		{
+			name:           "correct jsonl req",
+			contentType:    "application/x-ndjson",
+			bodyArg:        `{"plate":"KST-9016","document":"abc","modelId":"` + modelIdFixture + `","age":2020,"km":12000,"stationId":"` + station.ID + `","fuelLevel":80}` + "\nnot json\n",
+			wantStatusCode: http.StatusCreated,
+			wantImported:   1,
+			wantErrors:     map[int]string{2: application.ErrInvalidEntity.Error()},
+			wantCars:       1,
+		},
+		{
+			name:           "model not in catalog jsonl req",
+			contentType:    "application/x-ndjson",
+			bodyArg:        `{"plate":"KST-9016","document":"abc","modelId":"2c9e4a1b-7d3f-4e8a-b5c6-0f1e2d3c4b5a","age":2020,"km":12000,"stationId":"` + station.ID + `","fuelLevel":80}` + "\n",
+			wantStatusCode: http.StatusBadRequest,
+			wantImported:   0,
+			wantErrors:     map[int]string{1: application.ErrNotFoundModel.Error()},
+			wantCars:       0,
+		},
+		{
+			name:           "incorrect header csv req",
+			contentType:    "text/csv",
+			bodyArg:        "plate,document\nKST-9016,abc\n",
+			wantStatusCode: http.StatusBadRequest,
+			wantImported:   0,
+			wantErrors:     map[int]string{},
+			wantCars:       0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			carRepo := repository.NewCarRepositoryInMemory([]domain.Car{})
+			stationRepo := repository.NewStationRepositoryInMemory([]domain.Station{*station})
+			carController := NewCarController(application.NewCarUseCase(carRepo, stationRepo, modelCatalogStub{}))
+
+			req := httptest.NewRequest("POST", "/cars/import"+tc.queryArg, strings.NewReader(tc.bodyArg))
+			req.Header.Set("content-type", tc.contentType)
+			res := httptest.NewRecorder()
+
+			router := mux.NewRouter()
+			router.HandleFunc("/cars/import", carController.ImportCars).Methods("POST")
+			router.ServeHTTP(res, req)
+
+			if res.Code != tc.wantStatusCode {
+				t.Error("wrong response code", res.Code)
+			}
+
+			if cars := carRepo.Find(application.SearchCarParams{StationId: station.ID}); len(cars) != tc.wantCars {
+				t.Error("unexpected imported cars", len(cars))
+			}
+
+			if res.Code == http.StatusBadRequest && tc.wantImported == 0 {
+				return
+			}
+
+			var report application.CarImportReport
+			if err := json.Unmarshal(res.Body.Bytes(), &report); err != nil {
+				t.Fatal("wrong response body", res.Body.String())
+			}
+
+			if report.Imported != tc.wantImported || report.Failed != len(tc.wantErrors) {
+				t.Error("unexpected report", report)
+			}
+
+			for _, row := range report.Rows {
+				if row.Error != tc.wantErrors[row.Line] {
+					t.Error("unexpected row error", row)
+				}
+			}
+		})
+	}
+}
+
+func TestCarController_ExportCars(t *testing.T) {
+	car := newCarFixture()
+	other := newCarFixture()
+	other.StationId = "2520aade-a397-4e3c-a589-39c6ae5c2eff"
+
+	carRepo := repository.NewCarRepositoryInMemory([]domain.Car{*car, *other})
+	stationRepo := repository.NewStationRepositoryInMemory([]domain.Station{})
+	carController := NewCarController(application.NewCarUseCase(carRepo, stationRepo, nil))
+
+	testCases := []struct {
+		name            string
+		queryArg        string
+		wantStatusCode  int
+		wantContentType string
+		wantBody        string
+	}{
+		{
+			name:            "correct csv req",
+			queryArg:        "?format=csv&stationId=" + car.StationId,
+			wantStatusCode:  http.StatusOK,
+			wantContentType: "text/csv",
+			wantBody: "id,status,plate,document,modelId,age,km,stationId,fuelLevel,make,model,energy\n" +
+				car.ID + ",3,KST-9016,abc.123.op-x," + modelIdFixture + ",2020,12000," + car.StationId + ",100,FIAT,Uno,1\n",
+		},
+		{
+			name:            "correct jsonl req",
+			queryArg:        "?format=jsonl&stationId=" + other.StationId,
+			wantStatusCode:  http.StatusOK,
+			wantContentType: "application/x-ndjson",
+			wantBody: func() string {
+				b, _ := json.Marshal(other)
+				return string(b) + "\n"
+			}(),
+		},
+		{
+			name:            "incorrect format req",
+			queryArg:        "?format=xml",
+			wantStatusCode:  http.StatusBadRequest,
+			wantContentType: "application/json",
+			wantBody:        `{"error":"` + application.ErrInvalidEntity.Error() + `"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/cars/export"+tc.queryArg, nil)
+			res := httptest.NewRecorder()
+
+			router := mux.NewRouter()
+			router.HandleFunc("/cars/export", carController.ExportCars).Methods("GET")
+			router.ServeHTTP(res, req)
+
+			if res.Code != tc.wantStatusCode {
+				t.Error("wrong response code", res.Code)
+			}
+
+			if res.Header().Get("content-type") != tc.wantContentType {
+				t.Error("wrong content type", res.Header().Get("content-type"))
+			}
+
+			if res.Body.String() != tc.wantBody {
+				t.Error("wrong response body", res.Body.String(), tc.wantBody)
+			}
+		})
+	}
+}
@@ -0,0 +1,182 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/application"
+)
+
+type lifecycleController struct {
+	lifecycleUC application.LifecycleUseCase
+}
+
+func NewLifecycleController(lifecycleUC application.LifecycleUseCase) *lifecycleController {
+	return &lifecycleController{lifecycleUC}
+}
+
+func (c *lifecycleController) GetCarLifecycle(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	vars := mux.Vars(r)
+	lifecycle, err := c.lifecycleUC.GetCarLifecycle(vars["id"])
+
+	switch err {
+	case application.ErrInvalidId:
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case application.ErrNotFoundCar:
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case nil:
+		json, _ := json.Marshal(lifecycle)
+		w.WriteHeader(http.StatusOK)
+		w.Write(json)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	}
+}
+
+func (c *lifecycleController) UpdateCarAcquisition(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	vars := mux.Vars(r)
+	var params struct {
+		PurchasedAt   time.Time `json:"purchasedAt"`
+		PurchaseKM    uint64    `json:"purchaseKM"`
+		Price         float64   `json:"price"`
+		Supplier      string    `json:"supplier"`
+		UsefulLife    uint8     `json:"usefulLife"`
+		ResidualValue float64   `json:"residualValue"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, application.ErrInvalidEntity)
+		return
+	}
+	err := c.lifecycleUC.RegisterAcquisition(vars["id"], params.PurchasedAt, params.PurchaseKM, params.Price, params.Supplier, params.UsefulLife, params.ResidualValue)
+	switch err {
+	case application.ErrInvalidId, application.ErrInvalidAcquisition:
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case application.ErrNotFoundCar:
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case nil:
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	}
+}
+
+func (c *lifecycleController) GetCarDepreciation(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	vars := mux.Vars(r)
+
+	at := time.Now()
+	if param := r.URL.Query().Get("at"); param != "" {
+		t, err := time.Parse(time.RFC3339, param)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, `{"error":"%v"}`, application.ErrInvalidEntity)
+			return
+		}
+		at = t
+	}
+
+	depreciation, err := c.lifecycleUC.GetCarDepreciation(vars["id"], at)
+	switch err {
+	case application.ErrInvalidId:
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case application.ErrNotFoundCar, application.ErrNotFoundAcquisition:
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case nil:
+		json, _ := json.Marshal(depreciation)
+		w.WriteHeader(http.StatusOK)
+		w.Write(json)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	}
+}
+
+func (c *lifecycleController) RetireCar(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	vars := mux.Vars(r)
+	err := c.lifecycleUC.RetireCar(vars["id"])
+
+	switch err {
+	case application.ErrInvalidId, application.ErrCarNotInMaintenance, application.ErrInvalidRetirement:
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case application.ErrInvalidCar:
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case nil:
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	}
+}
+
+func (c *lifecycleController) UpdateCarToForSale(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	vars := mux.Vars(r)
+	var params struct {
+		AskingPrice float64 `json:"askingPrice"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, application.ErrInvalidEntity)
+		return
+	}
+	err := c.lifecycleUC.PutCarForSale(vars["id"], params.AskingPrice)
+	c.writeSaleResult(w, err)
+}
+
+func (c *lifecycleController) UpdateCarToSold(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	vars := mux.Vars(r)
+	var params struct {
+		Buyer string  `json:"buyer"`
+		Price float64 `json:"price"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, application.ErrInvalidEntity)
+		return
+	}
+	err := c.lifecycleUC.SellCar(vars["id"], params.Buyer, params.Price)
+	c.writeSaleResult(w, err)
+}
+
+func (c *lifecycleController) writeSaleResult(w http.ResponseWriter, err error) {
+	switch err {
+	case application.ErrInvalidId, application.ErrInvalidSale:
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case application.ErrInvalidCar:
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case nil:
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	}
+}
+
+func (c *lifecycleController) GetFleetAgeReport(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	report := c.lifecycleUC.GetFleetAgeReport()
+
+	json, _ := json.Marshal(report)
+	w.WriteHeader(http.StatusOK)
+	w.Write(json)
+}
@@ -0,0 +1,182 @@
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/adapters/repository"
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/domain"
+)
+
+func TestLifecycleController_RetireCar(t *testing.T) {
+	cars := []domain.Car{*newCarFixture(), *newCarFixture()}
+	cars[0].Status = domain.Maintenance
+	stations := []domain.Station{}
+
+	carRepo := repository.NewCarRepositoryInMemory(cars)
+	stationRepo := repository.NewStationRepositoryInMemory(stations)
+	lifecycleRepo := repository.NewLifecycleRepositoryInMemory([]domain.CarLifecycle{}, carRepo)
+	lifecycleUC := application.NewLifecycleUseCase(lifecycleRepo, carRepo, stationRepo)
+	lifecycleController := NewLifecycleController(lifecycleUC)
+
+	testCases := []struct {
+		name           string
+		idArg          string
+		wantStatusCode int
+		wantBody       interface{}
+	}{
+		{
+			name:           "correct req",
+			idArg:          cars[0].ID,
+			wantStatusCode: http.StatusNoContent,
+			wantBody:       nil,
+		},
+		{
+			name:           "incorrect invalid id req",
+			idArg:          "invalid-id",
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       map[string]string{"error": application.ErrInvalidId.Error()},
+		},
+		{
+			name:           "incorrect id req",
+			idArg:          "e4ce866a-f5b7-4774-8f9d-5eb74c3900cc",
+			wantStatusCode: http.StatusNotFound,
+			wantBody:       map[string]string{"error": application.ErrInvalidCar.Error()},
+		},
+		{
+			name:           "incorrect station req",
+			idArg:          cars[1].ID,
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       map[string]string{"error": application.ErrCarNotInMaintenance.Error()},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest("DELETE", "/cars/"+tc.idArg, nil)
+			res := httptest.NewRecorder()
+
+			router := mux.NewRouter()
+			router.HandleFunc("/cars/{id}", lifecycleController.RetireCar).Methods("DELETE")
+			router.ServeHTTP(res, req)
+
+			if res.Code != tc.wantStatusCode {
+				t.Error("wrong response code", res.Code)
+			}
+
+			var expectedBody string
+			if tc.wantBody != nil {
+				json, _ := json.Marshal(tc.wantBody)
+				expectedBody = strings.Trim(string(json), "\n")
+			}
+
+			if strings.Trim(res.Body.String(), "\n") != expectedBody {
+				t.Error("wrong response body", strings.Trim(res.Body.String(), "\n"), expectedBody)
+			}
+		})
+	}
+
+	if car, err := carRepo.FindOne(cars[0].ID); err != nil || car.Status != domain.Retired {
+		t.Error("unexpected retired car", car, err)
+	}
+}
+
+func TestLifecycleController_UpdateCarAcquisition(t *testing.T) {
+	cars := []domain.Car{*newCarFixture()}
+
+	carRepo := repository.NewCarRepositoryInMemory(cars)
+	stationRepo := repository.NewStationRepositoryInMemory([]domain.Station{})
+	lifecycleRepo := repository.NewLifecycleRepositoryInMemory([]domain.CarLifecycle{}, carRepo)
+	lifecycleController := NewLifecycleController(application.NewLifecycleUseCase(lifecycleRepo, carRepo, stationRepo))
+
+	type params struct {
+		PurchasedAt   time.Time
+		PurchaseKM    uint64
+		Price         float64
+		Supplier      string
+		UsefulLife    uint8
+		ResidualValue float64
+	}
+
+	purchasedAt := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name           string
+		idArg          string
+		bodyArg        interface{}
+		wantStatusCode int
+		wantBody       interface{}
+	}{
+		{
+			name:           "correct req",
+			idArg:          cars[0].ID,
+			bodyArg:        params{purchasedAt, 0, 60000, "Fiat Dealer", 4, 20000},
+			wantStatusCode: http.StatusNoContent,
+			wantBody:       nil,
+		},
+		{
+			name:           "incorrect residual value req",
+			idArg:          cars[0].ID,
+			bodyArg:        params{purchasedAt, 0, 60000, "Fiat Dealer", 4, 80000},
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       map[string]string{"error": application.ErrInvalidAcquisition.Error()},
+		},
+		{
+			name:           "incorrect id req",
+			idArg:          "35098f2d-6351-4509-87a2-896bab961a25",
+			bodyArg:        params{purchasedAt, 0, 60000, "Fiat Dealer", 4, 20000},
+			wantStatusCode: http.StatusNotFound,
+			wantBody:       map[string]string{"error": application.ErrNotFoundCar.Error()},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			reqJSON, _ := json.Marshal(tc.bodyArg)
+
+			req := httptest.NewRequest("PUT", "/cars/"+tc.idArg+"/acquisition/", bytes.NewBuffer(reqJSON))
+			res := httptest.NewRecorder()
+
+			router := mux.NewRouter()
+			router.HandleFunc("/cars/{id}/acquisition/", lifecycleController.UpdateCarAcquisition).Methods("PUT")
+			router.ServeHTTP(res, req)
+
+			if res.Code != tc.wantStatusCode {
+				t.Error("wrong response code", res.Code)
+			}
+
+			var expectedBody string
+			if tc.wantBody != nil {
+				json, _ := json.Marshal(tc.wantBody)
+				expectedBody = strings.Trim(string(json), "\n")
+			}
+
+			if strings.Trim(res.Body.String(), "\n") != expectedBody {
+				t.Error("wrong response body", strings.Trim(res.Body.String(), "\n"), expectedBody)
+			}
+		})
+	}
+
+	req := httptest.NewRequest("GET", "/cars/"+cars[0].ID+"/depreciation?at=2021-12-31T12:00:00Z", nil)
+	res := httptest.NewRecorder()
+
+	router := mux.NewRouter()
+	router.HandleFunc("/cars/{id}/depreciation", lifecycleController.GetCarDepreciation).Methods("GET")
+	router.ServeHTTP(res, req)
+
+	var depreciation domain.Depreciation
+	if err := json.Unmarshal(res.Body.Bytes(), &depreciation); err != nil || res.Code != http.StatusOK {
+		t.Fatal("wrong response", res.Code, res.Body.String())
+	}
+
+	if depreciation.BookValue != 40000 {
+		t.Error("unexpected depreciation", depreciation)
+	}
+}
@@ -0,0 +1,216 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/domain"
+)
+
+type maintenanceController struct {
+	maintenanceUC application.MaintenanceUseCase
+}
+
+func NewMaintenanceController(maintenanceUC application.MaintenanceUseCase) *maintenanceController {
+	return &maintenanceController{maintenanceUC}
+}
+
+func (c *maintenanceController) GetMaintenanceHistory(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	vars := mux.Vars(r)
+	workOrders, err := c.maintenanceUC.GetMaintenanceHistory(vars["id"])
+
+	switch err {
+	case application.ErrInvalidId:
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case application.ErrNotFoundCar:
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case nil:
+		json, _ := json.Marshal(workOrders)
+		w.WriteHeader(http.StatusOK)
+		w.Write(json)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	}
+}
+
+func (c *maintenanceController) GetWorkOrderById(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	vars := mux.Vars(r)
+	workOrder, err := c.maintenanceUC.GetWorkOrderById(vars["id"])
+
+	switch err {
+	case application.ErrInvalidId:
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case application.ErrNotFoundWorkOrder:
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case nil:
+		json, _ := json.Marshal(workOrder)
+		w.WriteHeader(http.StatusOK)
+		w.Write(json)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	}
+}
+
+func (c *maintenanceController) CreateWorkOrder(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	var params struct {
+		CarId     string    `json:"carId"`
+		StationId string    `json:"stationId"`
+		Reason    string    `json:"reason"`
+		Type      uint      `json:"type"`
+		Workshop  string    `json:"workshop"`
+		KM        uint64    `json:"km"`
+		DateStart time.Time `json:"dateStart"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, application.ErrInvalidEntity)
+		return
+	}
+	err := c.maintenanceUC.OpenWorkOrder(params.CarId, params.StationId, params.Reason, params.Workshop, domain.MaintenanceType(params.Type), params.KM, params.DateStart)
+	switch err {
+	case application.ErrInvalidId, application.ErrInvalidEntity, application.ErrInvalidMaintenance, application.ErrCarInMaintenance:
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case application.ErrInvalidCar:
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case nil:
+		w.WriteHeader(http.StatusCreated)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	}
+}
+
+func (c *maintenanceController) UpdateToCompleteWorkOrder(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	vars := mux.Vars(r)
+	var params struct {
+		DateEnd time.Time     `json:"dateEnd"`
+		Cost    float32       `json:"cost"`
+		Parts   []domain.Part `json:"parts"`
+		KM      uint64        `json:"km"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, application.ErrInvalidEntity)
+		return
+	}
+	if params.Parts == nil {
+		params.Parts = []domain.Part{}
+	}
+	err := c.maintenanceUC.CompleteWorkOrder(vars["id"], params.DateEnd, params.Cost, params.Parts, params.KM)
+	switch err {
+	case application.ErrInvalidId, application.ErrInvalidWorkOrder:
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case application.ErrNotFoundWorkOrder:
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case nil:
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	}
+}
+
+func (c *maintenanceController) GetMaintenancePlans(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	plans := c.maintenanceUC.GetMaintenancePlans()
+
+	json, err := json.Marshal(plans)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(json)
+}
+
+func (c *maintenanceController) CreateMaintenancePlan(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	var params struct {
+		ModelId        string `json:"modelId"`
+		IntervalKM     uint64 `json:"intervalKM"`
+		IntervalMonths uint   `json:"intervalMonths"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, application.ErrInvalidEntity)
+		return
+	}
+	err := c.maintenanceUC.AddMaintenancePlan(params.ModelId, params.IntervalKM, params.IntervalMonths)
+	switch err {
+	case application.ErrInvalidEntity, application.ErrInvalidModel, application.ErrInvalidMaintenancePlan:
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case application.ErrMaintenancePlanExists:
+		w.WriteHeader(http.StatusConflict)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case nil:
+		w.WriteHeader(http.StatusCreated)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	}
+}
+
+func (c *maintenanceController) DeleteMaintenancePlan(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	vars := mux.Vars(r)
+	err := c.maintenanceUC.DeleteMaintenancePlan(vars["id"])
+
+	switch err {
+	case application.ErrInvalidId:
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case application.ErrNotFoundMaintenancePlan:
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case nil:
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	}
+}
+
+func (c *maintenanceController) GetDueMaintenance(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	var within uint64
+	if param := r.URL.Query().Get("within"); param != "" {
+		n, err := strconv.ParseUint(param, 10, 32)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, `{"error":"%v"}`, application.ErrInvalidEntity)
+			return
+		}
+		within = n
+	}
+	dues := c.maintenanceUC.GetDueMaintenance(uint(within))
+
+	json, err := json.Marshal(dues)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(json)
+}
@@ -0,0 +1,136 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/adapters/repository"
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/domain"
+)
+
+func newWorkOrderFixture(carId string) *domain.WorkOrder {
+	w, _ := domain.NewWorkOrder(carId, "83369771-f9a4-48b7-b87b-463f19f7b187", "oil change", "Auto Center", domain.Scheduled, 12000, time.Date(2022, time.March, 1, 8, 0, 0, 0, time.UTC))
+	return w
+}
+
+func TestMaintenanceController_GetMaintenanceHistory(t *testing.T) {
+	cars := []domain.Car{*newCarFixture()}
+	workOrders := []domain.WorkOrder{*newWorkOrderFixture(cars[0].ID)}
+
+	carRepo := repository.NewCarRepositoryInMemory(cars)
+	workOrderRepo := repository.NewWorkOrderRepositoryInMemory(workOrders, carRepo)
+	planRepo := repository.NewMaintenancePlanRepositoryInMemory([]domain.MaintenancePlan{})
+	lifecycleRepo := repository.NewLifecycleRepositoryInMemory([]domain.CarLifecycle{}, carRepo)
+	maintenanceUC := application.NewMaintenanceUseCase(workOrderRepo, planRepo, carRepo, lifecycleRepo)
+	maintenanceController := NewMaintenanceController(maintenanceUC)
+
+	testCases := []struct {
+		name           string
+		idArg          string
+		wantStatusCode int
+		wantBody       interface{}
+	}{
+		{
+			name:           "correct req",
+			idArg:          cars[0].ID,
+			wantStatusCode: http.StatusOK,
+			wantBody:       workOrders,
+		},
+		{
+			name:           "incorrect id req",
+			idArg:          "invalid-id",
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       map[string]string{"error": application.ErrInvalidId.Error()},
+		},
+		{
+			name:           "not found car req",
+			idArg:          "35098f2d-6351-4509-87a2-896bab961a25",
+			wantStatusCode: http.StatusNotFound,
+			wantBody:       map[string]string{"error": application.ErrNotFoundCar.Error()},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/cars/"+tc.idArg+"/maintenance-history", nil)
+			res := httptest.NewRecorder()
+
+			router := mux.NewRouter()
+			router.HandleFunc("/cars/{id}/maintenance-history", maintenanceController.GetMaintenanceHistory).Methods("GET")
+			router.ServeHTTP(res, req)
+
+			if res.Code != tc.wantStatusCode {
+				t.Error("wrong response code", res.Code)
+			}
+
+			json, _ := json.Marshal(tc.wantBody)
+			expectedBody := strings.Trim(string(json), "\n")
+
+			if strings.Trim(res.Body.String(), "\n") != expectedBody {
+				t.Error("wrong response body", strings.Trim(res.Body.String(), "\n"), expectedBody)
+			}
+		})
+	}
+}
+
+func TestMaintenanceController_GetDueMaintenance(t *testing.T) {
+	cars := []domain.Car{*newCarFixture()}
+	plan, _ := domain.NewMaintenancePlan(cars[0].ModelId, 10000, 0)
+
+	carRepo := repository.NewCarRepositoryInMemory(cars)
+	workOrderRepo := repository.NewWorkOrderRepositoryInMemory([]domain.WorkOrder{}, carRepo)
+	planRepo := repository.NewMaintenancePlanRepositoryInMemory([]domain.MaintenancePlan{*plan})
+	acquisition := domain.NewCarLifecycle(cars[0].ID)
+	acquisition.Acquire(time.Now().AddDate(-1, 0, 0), 0, 60000, "Fiat Dealer", 4, 20000)
+	lifecycleRepo := repository.NewLifecycleRepositoryInMemory([]domain.CarLifecycle{*acquisition}, carRepo)
+	maintenanceUC := application.NewMaintenanceUseCase(workOrderRepo, planRepo, carRepo, lifecycleRepo)
+	maintenanceController := NewMaintenanceController(maintenanceUC)
+
+	testCases := []struct {
+		name           string
+		queryArg       string
+		wantStatusCode int
+		wantBody       interface{}
+	}{
+		{
+			name:           "correct req",
+			queryArg:       "?within=30",
+			wantStatusCode: http.StatusOK,
+			wantBody:       []domain.MaintenanceDue{plan.Due(cars[0], nil, acquisition, time.Now())},
+		},
+		{
+			name:           "incorrect within req",
+			queryArg:       "?within=month",
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       map[string]string{"error": application.ErrInvalidEntity.Error()},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/maintenance/due"+tc.queryArg, nil)
+			res := httptest.NewRecorder()
+
+			router := mux.NewRouter()
+			router.HandleFunc("/maintenance/due", maintenanceController.GetDueMaintenance).Methods("GET")
+			router.ServeHTTP(res, req)
+
+			if res.Code != tc.wantStatusCode {
+				t.Error("wrong response code", res.Code)
+			}
+
+			json, _ := json.Marshal(tc.wantBody)
+			expectedBody := strings.Trim(string(json), "\n")
+
+			if strings.Trim(res.Body.String(), "\n") != expectedBody {
+				t.Error("wrong response body", strings.Trim(res.Body.String(), "\n"), expectedBody)
+			}
+		})
+	}
+}
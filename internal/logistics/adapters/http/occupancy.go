@@ -0,0 +1,45 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/application"
+)
+
+type occupancyController struct {
+	occupancyUC application.OccupancyUseCase
+}
+
+func NewOccupancyController(occupancyUC application.OccupancyUseCase) *occupancyController {
+	return &occupancyController{occupancyUC}
+}
+
+func (c *occupancyController) GetOccupancyDrift(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	drifts := c.occupancyUC.GetOccupancyDrift()
+
+	json, err := json.Marshal(drifts)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(json)
+}
+
+func (c *occupancyController) ReconcileOccupancy(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	drifts, err := c.occupancyUC.ReconcileOccupancy()
+	switch err {
+	case nil:
+		json, _ := json.Marshal(drifts)
+		w.WriteHeader(http.StatusOK)
+		w.Write(json)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	}
+}
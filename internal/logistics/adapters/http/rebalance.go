@@ -0,0 +1,55 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/domain"
+)
+
+type rebalanceController struct {
+	rebalanceUC application.RebalanceUseCase
+}
+
+func NewRebalanceController(rebalanceUC application.RebalanceUseCase) *rebalanceController {
+	return &rebalanceController{rebalanceUC}
+}
+
+func (c *rebalanceController) GetRebalancePlan(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	plan := c.rebalanceUC.GetRebalancePlan()
+
+	json, err := json.Marshal(plan)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(json)
+}
+
+func (c *rebalanceController) ExecuteRebalancePlan(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	var plan domain.RebalancePlan
+	if err := json.NewDecoder(r.Body).Decode(&plan); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, application.ErrInvalidEntity)
+		return
+	}
+	results, err := c.rebalanceUC.ExecuteRebalancePlan(plan)
+	switch err {
+	case application.ErrInvalidRebalancePlan:
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case nil:
+		json, _ := json.Marshal(results)
+		w.WriteHeader(http.StatusOK)
+		w.Write(json)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	}
+}
@@ -4,11 +4,15 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/gorilla/mux"
 	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/domain"
 )
 
+const defaultNearbyRadius = 10.0
+
 type stationController struct {
 	stationUC application.StationUseCase
 }
@@ -124,3 +128,102 @@ func (c *stationController) UpdateStationCapacity(w http.ResponseWriter, r *http
 		fmt.Fprintf(w, `{"error":"%v"}`, err)
 	}
 }
+
+func (c *stationController) UpdateStationLocation(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	vars := mux.Vars(r)
+	var params struct {
+		Latitude  float64 `json:"latitude"`
+		Longitude float64 `json:"longitude"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+		return
+	}
+	err := c.stationUC.ChangeStationLocation(vars["id"], params.Latitude, params.Longitude)
+	switch err {
+	case application.ErrInvalidId, application.ErrInvalidLocation:
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case application.ErrInvalidStation:
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case nil:
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	}
+}
+
+func (c *stationController) SearchNearbyStations(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	query := r.URL.Query()
+	latitude, errLat := strconv.ParseFloat(query.Get("lat"), 64)
+	longitude, errLng := strconv.ParseFloat(query.Get("lng"), 64)
+	if errLat != nil || errLng != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, application.ErrInvalidLocation)
+		return
+	}
+	radius := defaultNearbyRadius
+	if param := query.Get("radius"); param != "" {
+		n, err := strconv.ParseFloat(param, 64)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, `{"error":"%v"}`, application.ErrInvalidLocation)
+			return
+		}
+		radius = n
+	}
+	stations, err := c.stationUC.SearchNearbyStations(latitude, longitude, radius, query.Get("model"))
+	switch err {
+	case application.ErrInvalidLocation:
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case nil:
+		json, _ := json.Marshal(stations)
+		w.WriteHeader(http.StatusOK)
+		w.Write(json)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	}
+}
+
+func (c *stationController) UpdateStationSchedule(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	vars := mux.Vars(r)
+	var params struct {
+		Schedule []domain.OpeningHours `json:"schedule"`
+		Holidays []domain.Holiday      `json:"holidays"`
+		Timezone string                `json:"timezone"`
+		KeyDrop  bool                  `json:"keyDrop"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, application.ErrInvalidSchedule)
+		return
+	}
+	if params.Schedule == nil {
+		params.Schedule = []domain.OpeningHours{}
+	}
+	if params.Holidays == nil {
+		params.Holidays = []domain.Holiday{}
+	}
+	err := c.stationUC.ChangeStationSchedule(vars["id"], params.Schedule, params.Holidays, params.Timezone, params.KeyDrop)
+	switch err {
+	case application.ErrInvalidId, application.ErrInvalidSchedule:
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case application.ErrInvalidStation:
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case nil:
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/gorilla/mux"
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/adapters/geocoder"
 	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/adapters/repository"
 	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/application"
 	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/domain"
@@ -23,7 +24,7 @@ func TestStationController_GetStations(t *testing.T) {
 	stations := []domain.Station{*newStationFixture(), *newStationFixture()}
 
 	stationRepo := repository.NewStationRepositoryInMemory(stations)
-	stationUC := application.NewStationUseCase(stationRepo)
+	stationUC := application.NewStationUseCase(stationRepo, repository.NewCarRepositoryInMemory([]domain.Car{}), geocoder.NewCepTableGeocoder(nil))
 	stationController := NewStationController(stationUC)
 
 	req := httptest.NewRequest("GET", "/stations", nil)
@@ -46,7 +47,7 @@ func TestStationController_GetStations(t *testing.T) {
 func TestStationController_GetStationById(t *testing.T) {
 	stations := []domain.Station{*newStationFixture(), *newStationFixture()}
 	stationRepo := repository.NewStationRepositoryInMemory(stations)
-	stationUC := application.NewStationUseCase(stationRepo)
+	stationUC := application.NewStationUseCase(stationRepo, repository.NewCarRepositoryInMemory([]domain.Car{}), geocoder.NewCepTableGeocoder(nil))
 	stationController := NewStationController(stationUC)
 
 	testCases := []struct {
@@ -101,7 +102,7 @@ func TestStationController_GetStationById(t *testing.T) {
 func TestStationController_CreateStation(t *testing.T) {
 	stations := []domain.Station{*newStationFixture()}
 	stationRepo := repository.NewStationRepositoryInMemory(stations)
-	stationUC := application.NewStationUseCase(stationRepo)
+	stationUC := application.NewStationUseCase(stationRepo, repository.NewCarRepositoryInMemory([]domain.Car{}), geocoder.NewCepTableGeocoder(nil))
 	stationController := NewStationController(stationUC)
 
 	type params struct {
@@ -186,7 +187,7 @@ func TestStationController_DeleteStation(t *testing.T) {
 	noZeroIdleStation.Idle = 5
 	stations := []domain.Station{*newStationFixture(), noZeroIdleStation}
 	stationRepo := repository.NewStationRepositoryInMemory(stations)
-	stationUC := application.NewStationUseCase(stationRepo)
+	stationUC := application.NewStationUseCase(stationRepo, repository.NewCarRepositoryInMemory([]domain.Car{}), geocoder.NewCepTableGeocoder(nil))
 	stationController := NewStationController(stationUC)
 
 	testCases := []struct {
@@ -252,7 +253,7 @@ func TestStationController_UpdateStationCapacity(t *testing.T) {
 	noZeroIdleStation.Idle = 5
 	stations := []domain.Station{*newStationFixture(), noZeroIdleStation}
 	stationRepo := repository.NewStationRepositoryInMemory(stations)
-	stationUC := application.NewStationUseCase(stationRepo)
+	stationUC := application.NewStationUseCase(stationRepo, repository.NewCarRepositoryInMemory([]domain.Car{}), geocoder.NewCepTableGeocoder(nil))
 	stationController := NewStationController(stationUC)
 
 	testCases := []struct {
@@ -319,3 +320,74 @@ func TestStationController_UpdateStationCapacity(t *testing.T) {
 		})
 	}
 }
+
+func TestStationController_SearchNearbyStations(t *testing.T) {
+	stations := []domain.Station{*newStationFixture(), *newStationFixture()}
+	stations[0].SetLocation(-23.5505, -46.6333)
+	stations[1].SetLocation(-22.9068, -43.1729)
+
+	car, _ := domain.NewCar(2020, 12000, "KST-9016", "abc.123.op-x", stations[0].ID, "0b1b5f2a-3f4e-4b7b-9c41-7d8a6c1f2e3d", "Uno", "FIAT", domain.Combustion, domain.FullLevel)
+
+	stationRepo := repository.NewStationRepositoryInMemory(stations)
+	carRepo := repository.NewCarRepositoryInMemory([]domain.Car{*car})
+	stationUC := application.NewStationUseCase(stationRepo, carRepo, geocoder.NewCepTableGeocoder(nil))
+	stationController := NewStationController(stationUC)
+
+	testCases := []struct {
+		name           string
+		queryArg       string
+		wantStatusCode int
+		wantBody       interface{}
+	}{
+		{
+			name:           "correct req",
+			queryArg:       "?lat=-23.5613&lng=-46.6565&radius=20&model=Uno",
+			wantStatusCode: http.StatusOK,
+			wantBody: []application.NearbyStation{{
+				Station:       stations[0],
+				Distance:      stations[0].DistanceTo(-23.5613, -46.6565),
+				AvailableCars: 1,
+			}},
+		},
+		{
+			name:           "correct req without results",
+			queryArg:       "?lat=-23.5613&lng=-46.6565&model=Palio",
+			wantStatusCode: http.StatusOK,
+			wantBody:       []application.NearbyStation{},
+		},
+		{
+			name:           "incorrect coordinates req",
+			queryArg:       "?lat=south&lng=-46.6565",
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       map[string]string{"error": application.ErrInvalidLocation.Error()},
+		},
+		{
+			name:           "incorrect radius req",
+			queryArg:       "?lat=-23.5613&lng=-46.6565&radius=-1",
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       map[string]string{"error": application.ErrInvalidLocation.Error()},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/stations/nearby"+tc.queryArg, nil)
+			res := httptest.NewRecorder()
+
+			router := mux.NewRouter()
+			router.HandleFunc("/stations/nearby", stationController.SearchNearbyStations).Methods("GET")
+			router.ServeHTTP(res, req)
+
+			if res.Code != tc.wantStatusCode {
+				t.Error("wrong response code", res.Code)
+			}
+
+			json, _ := json.Marshal(tc.wantBody)
+			expectedBody := strings.Trim(string(json), "\n")
+
+			if strings.Trim(res.Body.String(), "\n") != expectedBody {
+				t.Error("wrong response body", strings.Trim(res.Body.String(), "\n"), expectedBody)
+			}
+		})
+	}
+}
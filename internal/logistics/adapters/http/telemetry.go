@@ -0,0 +1,134 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/domain"
+)
+
+const (
+	ndjsonContentType  = "application/x-ndjson"
+	telemetryBatchSize = 500
+	defaultTrackPeriod = 24 * time.Hour
+)
+
+type telemetryController struct {
+	telemetryUC application.TelemetryUseCase
+}
+
+func NewTelemetryController(telemetryUC application.TelemetryUseCase) *telemetryController {
+	return &telemetryController{telemetryUC}
+}
+
+func (c *telemetryController) IngestTelemetry(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+
+	var err error
+	if strings.HasPrefix(r.Header.Get("content-type"), ndjsonContentType) {
+		err = c.ingestStream(r.Body)
+	} else {
+		var readings []domain.TelemetryReading
+		if err := json.NewDecoder(r.Body).Decode(&readings); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, `{"error":"%v"}`, application.ErrInvalidEntity)
+			return
+		}
+		err = c.telemetryUC.IngestTelemetry(readings)
+	}
+
+	switch err {
+	case application.ErrInvalidEntity, application.ErrInvalidTelemetry:
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case application.ErrNotFoundCar:
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case nil:
+		w.WriteHeader(http.StatusAccepted)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	}
+}
+
+func (c *telemetryController) ingestStream(body io.Reader) error {
+	decoder := json.NewDecoder(body)
+	batch := []domain.TelemetryReading{}
+
+	for {
+		var reading domain.TelemetryReading
+		err := decoder.Decode(&reading)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return application.ErrInvalidEntity
+		}
+
+		batch = append(batch, reading)
+		if len(batch) == telemetryBatchSize {
+			if err := c.telemetryUC.IngestTelemetry(batch); err != nil {
+				return err
+			}
+			batch = []domain.TelemetryReading{}
+		}
+	}
+
+	if len(batch) == 0 {
+		return nil
+	}
+
+	return c.telemetryUC.IngestTelemetry(batch)
+}
+
+func (c *telemetryController) GetCarTrack(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	vars := mux.Vars(r)
+	query := r.URL.Query()
+
+	to := time.Now()
+	if param := query.Get("to"); param != "" {
+		t, err := time.Parse(time.RFC3339, param)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, `{"error":"%v"}`, application.ErrInvalidTrack)
+			return
+		}
+		to = t
+	}
+
+	from := to.Add(-defaultTrackPeriod)
+	if param := query.Get("from"); param != "" {
+		t, err := time.Parse(time.RFC3339, param)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, `{"error":"%v"}`, application.ErrInvalidTrack)
+			return
+		}
+		from = t
+	}
+
+	track, err := c.telemetryUC.GetCarTrack(vars["id"], from, to)
+	switch err {
+	case application.ErrInvalidId, application.ErrInvalidTrack:
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case application.ErrNotFoundCar:
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case nil:
+		json, _ := json.Marshal(track)
+		w.WriteHeader(http.StatusOK)
+		w.Write(json)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	}
+}
@@ -0,0 +1,186 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/adapters/repository"
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/domain"
+)
+
+func TestTelemetryController_IngestTelemetry(t *testing.T) {
+	cars := []domain.Car{*newCarFixture()}
+
+	carRepo := repository.NewCarRepositoryInMemory(cars)
+	telemetryRepo := repository.NewTelemetryRepositoryInMemory([]domain.TelemetryReading{})
+	telemetryUC := application.NewTelemetryUseCase(telemetryRepo, carRepo)
+	telemetryController := NewTelemetryController(telemetryUC)
+
+	reading := func(km uint64, at string) string {
+		return fmt.Sprintf(`{"carId":"%s","recordedAt":"%s","latitude":-23.55,"longitude":-46.63,"km":%d,"fuelLevel":80}`, cars[0].ID, at, km)
+	}
+
+	testCases := []struct {
+		name           string
+		contentType    string
+		bodyArg        string
+		wantStatusCode int
+		wantBody       interface{}
+		wantKM         uint64
+	}{
+		{
+			name:           "correct json req",
+			contentType:    "application/json",
+			bodyArg:        "[" + reading(12100, "2022-03-01T08:00:00Z") + "," + reading(12150, "2022-03-01T08:05:00Z") + "]",
+			wantStatusCode: http.StatusAccepted,
+			wantBody:       nil,
+			wantKM:         12150,
+		},
+		{
+			name:           "correct ndjson req",
+			contentType:    "application/x-ndjson",
+			bodyArg:        reading(12200, "2022-03-01T08:10:00Z") + "\n" + reading(12180, "2022-03-01T08:09:00Z") + "\n",
+			wantStatusCode: http.StatusAccepted,
+			wantBody:       nil,
+			wantKM:         12200,
+		},
+		{
+			name:           "out of order req",
+			contentType:    "application/json",
+			bodyArg:        "[" + reading(12000, "2022-03-01T07:00:00Z") + "]",
+			wantStatusCode: http.StatusAccepted,
+			wantBody:       nil,
+			wantKM:         12200,
+		},
+		{
+			name:           "incorrect reading req",
+			contentType:    "application/json",
+			bodyArg:        `[{"carId":"invalid-id","recordedAt":"2022-03-01T08:00:00Z","km":13000}]`,
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       map[string]string{"error": application.ErrInvalidTelemetry.Error()},
+			wantKM:         12200,
+		},
+		{
+			name:           "incorrect ndjson req",
+			contentType:    "application/x-ndjson",
+			bodyArg:        "not json\n",
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       map[string]string{"error": application.ErrInvalidEntity.Error()},
+			wantKM:         12200,
+		},
+		{
+			name:           "not found car req",
+			contentType:    "application/json",
+			bodyArg:        `[{"carId":"35098f2d-6351-4509-87a2-896bab961a25","recordedAt":"2022-03-01T08:00:00Z","km":13000}]`,
+			wantStatusCode: http.StatusNotFound,
+			wantBody:       map[string]string{"error": application.ErrNotFoundCar.Error()},
+			wantKM:         12200,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest("POST", "/telemetry/", strings.NewReader(tc.bodyArg))
+			req.Header.Set("content-type", tc.contentType)
+			res := httptest.NewRecorder()
+
+			router := mux.NewRouter()
+			router.HandleFunc("/telemetry/", telemetryController.IngestTelemetry).Methods("POST")
+			router.ServeHTTP(res, req)
+
+			if res.Code != tc.wantStatusCode {
+				t.Error("wrong response code", res.Code)
+			}
+
+			if tc.wantBody != nil {
+				json, _ := json.Marshal(tc.wantBody)
+				expectedBody := strings.Trim(string(json), "\n")
+
+				if strings.Trim(res.Body.String(), "\n") != expectedBody {
+					t.Error("wrong response body", strings.Trim(res.Body.String(), "\n"), expectedBody)
+				}
+			}
+
+			car, _ := carRepo.FindOne(cars[0].ID)
+			if car.OdometerKM() != tc.wantKM {
+				t.Error("unexpected km", car.OdometerKM())
+			}
+		})
+	}
+}
+
+func TestTelemetryController_GetCarTrack(t *testing.T) {
+	cars := []domain.Car{*newCarFixture()}
+	at := time.Date(2022, time.March, 1, 8, 0, 0, 0, time.UTC)
+	readings := []domain.TelemetryReading{
+		{CarId: cars[0].ID, RecordedAt: at, KM: 12100},
+		{CarId: cars[0].ID, RecordedAt: at.Add(time.Hour), KM: 12150},
+		{CarId: cars[0].ID, RecordedAt: at.Add(48 * time.Hour), KM: 12400},
+	}
+
+	carRepo := repository.NewCarRepositoryInMemory(cars)
+	telemetryRepo := repository.NewTelemetryRepositoryInMemory(readings)
+	telemetryUC := application.NewTelemetryUseCase(telemetryRepo, carRepo)
+	telemetryController := NewTelemetryController(telemetryUC)
+
+	testCases := []struct {
+		name           string
+		pathArg        string
+		wantStatusCode int
+		wantBody       interface{}
+	}{
+		{
+			name:           "correct req",
+			pathArg:        "/cars/" + cars[0].ID + "/track?from=2022-03-01T00:00:00Z&to=2022-03-02T00:00:00Z",
+			wantStatusCode: http.StatusOK,
+			wantBody:       readings[:2],
+		},
+		{
+			name:           "incorrect interval req",
+			pathArg:        "/cars/" + cars[0].ID + "/track?from=2022-03-02T00:00:00Z&to=2022-03-01T00:00:00Z",
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       map[string]string{"error": application.ErrInvalidTrack.Error()},
+		},
+		{
+			name:           "incorrect date req",
+			pathArg:        "/cars/" + cars[0].ID + "/track?from=yesterday",
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       map[string]string{"error": application.ErrInvalidTrack.Error()},
+		},
+		{
+			name:           "not found car req",
+			pathArg:        "/cars/35098f2d-6351-4509-87a2-896bab961a25/track",
+			wantStatusCode: http.StatusNotFound,
+			wantBody:       map[string]string{"error": application.ErrNotFoundCar.Error()},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", tc.pathArg, nil)
+			res := httptest.NewRecorder()
+
+			router := mux.NewRouter()
+			router.HandleFunc("/cars/{id}/track", telemetryController.GetCarTrack).Methods("GET")
+			router.ServeHTTP(res, req)
+
+			if res.Code != tc.wantStatusCode {
+				t.Error("wrong response code", res.Code)
+			}
+
+			json, _ := json.Marshal(tc.wantBody)
+			expectedBody := strings.Trim(string(json), "\n")
+
+			if strings.Trim(res.Body.String(), "\n") != expectedBody {
+				t.Error("wrong response body", strings.Trim(res.Body.String(), "\n"), expectedBody)
+			}
+		})
+	}
+}
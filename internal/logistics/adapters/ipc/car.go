@@ -1,39 +1,110 @@
 package ipc
 
 import (
+	"fmt"
+
 	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/domain"
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/events"
 	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/ipc"
 )
 
 type carIPC struct {
-	carUC application.CarUseCase
+	assignmentUC application.AssignmentUseCase
+	carUC        application.CarUseCase
+	disp         events.Dispatcher
 }
 
-func NewCarIPC(carUC application.CarUseCase) *carIPC {
-	return &carIPC{carUC}
+func NewCarIPC(assignmentUC application.AssignmentUseCase, carUC application.CarUseCase, disp events.Dispatcher) *carIPC {
+	return &carIPC{assignmentUC, carUC, disp}
 }
 
-func (uc carIPC) GetCar(stationId, carModel string) (*ipc.CarData, error) {
-	cars := uc.carUC.SearchCars(application.SearchCarParams{
-		Model:     carModel,
-		StationId: stationId,
-	})
+func (uc carIPC) GetCar(stationId, modelId string, upgrade bool) (*ipc.CarData, error) {
+	car, err := uc.assignmentUC.AssignCar(stationId, modelId, upgrade)
+	if err != nil {
+		return nil, application.ErrNotFoundCar
+	}
 
-	if len(cars) == 0 {
+	return newCarData(*car), nil
+}
+
+func (uc carIPC) GetCars(stationId string, modelIds []string) ([]ipc.CarData, error) {
+	cars, err := uc.assignmentUC.AssignCars(stationId, modelIds)
+	if err != nil {
 		return nil, application.ErrNotFoundCar
 	}
 
-	carData := &ipc.CarData{
-		ID:        cars[0].ID,
-		Age:       cars[0].Age,
-		Plate:     cars[0].Plate,
-		Document:  cars[0].Document,
-		Model:     cars[0].Model,
-		Make:      cars[0].Make,
-		StationId: cars[0].StationId,
-		KM:        cars[0].KM,
-		Status:    uint(cars[0].Status),
+	carsData := []ipc.CarData{}
+	for _, car := range cars {
+		carsData = append(carsData, *newCarData(car))
 	}
 
-	return carData, nil
+	return carsData, nil
+}
+
+func (uc carIPC) ReserveCars(reservationId, stationId string, carIds []string) error {
+	reserved := []string{}
+	for _, id := range carIds {
+		car, err := uc.carUC.GetCarById(id)
+		if err != nil || car.Status != domain.Parked {
+			uc.ReleaseCars(reservationId, stationId, reserved)
+			return application.ErrInvalidReserve
+		}
+
+		evt := domain.SyncCarReserved{EventId: reservationEventId("reserved", reservationId, id), ID: id, StationId: stationId}
+		if err := uc.disp.Dispatch([]events.Event{evt}); err != nil {
+			uc.ReleaseCars(reservationId, stationId, reserved)
+			return application.ErrInvalidReserve
+		}
+
+		reserved = append(reserved, id)
+	}
+
+	return nil
+}
+
+func (uc carIPC) ReleaseCars(reservationId, stationId string, carIds []string) error {
+	for _, id := range carIds {
+		car, err := uc.carUC.GetCarById(id)
+		if err != nil {
+			return application.ErrInvalidCar
+		}
+
+		evt := domain.SyncCarParked{EventId: reservationEventId("released", reservationId, id), ID: id, StationId: stationId, KM: car.KM, FuelLevel: car.FuelLevel}
+		if err := uc.disp.Dispatch([]events.Event{evt}); err != nil {
+			return application.ErrInvalidPark
+		}
+	}
+
+	return nil
+}
+
+func (uc carIPC) GetCarKM(carId string) (uint64, error) {
+	car, err := uc.carUC.GetCarById(carId)
+	if err != nil {
+		return 0, application.ErrNotFoundCar
+	}
+
+	return car.KM, nil
+}
+
+func reservationEventId(movement, reservationId, carId string) string {
+	return fmt.Sprintf("reservation.%s:%s:%s", movement, reservationId, carId)
+}
+
+func newCarData(car domain.Car) *ipc.CarData {
+	return &ipc.CarData{
+		ID:        car.ID,
+		Age:       car.Age,
+		Plate:     car.Plate,
+		Document:  car.Document,
+		ModelId:   car.ModelId,
+		Model:     car.Model,
+		Make:      car.Make,
+		StationId: car.StationId,
+		KM:        car.KM,
+		Status:    uint(car.Status),
+		Energy:    uint(car.Energy),
+		FuelLevel: car.FuelLevel,
+	}
 }
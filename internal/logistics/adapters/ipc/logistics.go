@@ -0,0 +1,10 @@
+package ipc
+
+type logisticsIPC struct {
+	*carIPC
+	*stationIPC
+}
+
+func NewLogisticsIPC(carIPC *carIPC, stationIPC *stationIPC) *logisticsIPC {
+	return &logisticsIPC{carIPC, stationIPC}
+}
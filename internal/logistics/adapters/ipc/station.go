@@ -0,0 +1,32 @@
+package ipc
+
+import (
+	"time"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/application"
+)
+
+type stationIPC struct {
+	stationUC application.StationUseCase
+}
+
+func NewStationIPC(stationUC application.StationUseCase) *stationIPC {
+	return &stationIPC{stationUC}
+}
+
+func (uc stationIPC) IsStationOpen(stationId string, at time.Time, keyDrop bool) (bool, error) {
+	return uc.stationUC.IsStationOpen(stationId, at, keyDrop)
+}
+
+func (uc stationIPC) GetStationLocation(stationId string) (float64, float64, error) {
+	station, err := uc.stationUC.GetStationById(stationId)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if !station.HasLocation() {
+		return 0, 0, application.ErrInvalidLocation
+	}
+
+	return station.Latitude, station.Longitude, nil
+}
@@ -0,0 +1,23 @@
+package pricing
+
+import (
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/ipc"
+)
+
+type upgradeCatalogIPC struct {
+	pricing ipc.PricingIPC
+}
+
+func NewUpgradeCatalogIPC(pricing ipc.PricingIPC) *upgradeCatalogIPC {
+	return &upgradeCatalogIPC{pricing}
+}
+
+func (svc upgradeCatalogIPC) GetUpgradeModels(modelId string) ([]string, error) {
+	models, err := svc.pricing.GetUpgradeModels(modelId)
+	if err != nil {
+		return nil, application.ErrNotFoundModel
+	}
+
+	return models, nil
+}
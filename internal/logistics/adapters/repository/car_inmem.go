@@ -1,6 +1,8 @@
 package repository
 
 import (
+	"sort"
+	"strings"
 	"sync"
 
 	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/application"
@@ -25,15 +27,75 @@ func (repo carRepositoryInMemory) Find(search application.SearchCarParams) []dom
 	defer repo.Unlock()
 
 	cars := []domain.Car{}
-	for k, v := range repo.cars {
-		if v.Plate == search.Plate || v.Document == search.Document || v.Model == search.Model || v.Make == search.Make || v.StationId == search.StationId || v.Age == search.Age || v.KM == search.KM || v.Status == domain.CarStatus(search.Status) {
-			cars = append(cars, repo.cars[k])
+	for _, v := range repo.cars {
+		if search.Matches(v) {
+			cars = append(cars, v)
 		}
 	}
 
+	field, desc := search.SortField()
+	sort.Slice(cars, func(i, j int) bool {
+		if cmp := compareCars(cars[i], cars[j], field); cmp != 0 {
+			return (cmp < 0) != desc
+		}
+		return cars[i].ID < cars[j].ID
+	})
+
+	if search.Offset >= uint(len(cars)) {
+		return []domain.Car{}
+	}
+	cars = cars[search.Offset:]
+	if search.Limit > 0 && search.Limit < uint(len(cars)) {
+		cars = cars[:search.Limit]
+	}
+
 	return cars
 }
 
+func (repo carRepositoryInMemory) Count(search application.SearchCarParams) int {
+	repo.Lock()
+	defer repo.Unlock()
+
+	count := 0
+	for _, v := range repo.cars {
+		if search.Matches(v) {
+			count++
+		}
+	}
+
+	return count
+}
+
+func compareCars(a, b domain.Car, field string) int {
+	compareUint := func(x, y uint64) int {
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		}
+		return 0
+	}
+
+	switch field {
+	case "plate":
+		return strings.Compare(a.Plate, b.Plate)
+	case "age":
+		return compareUint(uint64(a.Age), uint64(b.Age))
+	case "km":
+		return compareUint(a.KM, b.KM)
+	case "fuelLevel":
+		return compareUint(uint64(a.FuelLevel), uint64(b.FuelLevel))
+	case "status":
+		return compareUint(uint64(a.Status), uint64(b.Status))
+	case "make":
+		return strings.Compare(a.Make, b.Make)
+	case "model":
+		return strings.Compare(a.Model, b.Model)
+	}
+	return 0
+}
+
 func (repo carRepositoryInMemory) FindOne(id string) (*domain.Car, error) {
 	repo.Lock()
 	defer repo.Unlock()
@@ -55,6 +117,21 @@ func (repo *carRepositoryInMemory) Save(car domain.Car) error {
 	return nil
 }
 
+func (repo *carRepositoryInMemory) UpdateReportedKM(id string, km uint64) error {
+	repo.Lock()
+	defer repo.Unlock()
+
+	car, exists := repo.cars[id]
+	if !exists {
+		return application.ErrInvalidCar
+	}
+
+	car.ReportOdometer(km)
+	repo.cars[id] = car
+
+	return nil
+}
+
 func (repo *carRepositoryInMemory) Delete(id string) error {
 	repo.Lock()
 	defer repo.Unlock()
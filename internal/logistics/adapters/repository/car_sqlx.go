@@ -3,6 +3,8 @@ package repository
 import (
 	"context"
 	"fmt"
+	"log"
+	"math"
 	"strings"
 
 	"github.com/jmoiron/sqlx"
@@ -14,14 +16,26 @@ import (
 
 const (
 	findCars  = `SELECT * FROM cars`
+	countCars = `SELECT COUNT(*) FROM cars`
 	findCar   = `SELECT * FROM cars WHERE id = $1 LIMIT 1`
 	upsertCar = `
-	INSERT INTO cars VALUES (:id, :age, :plate, :document, :model, :make, :stationId, :km, :status) 
-	ON CONFLICT(id) DO UPDATE SET age = :age, plate = :plate, document = :document, model = :model, make = :make, "stationId" = :stationId, km = :km, status = :status 
+	INSERT INTO cars VALUES (:id, :age, :plate, :document, :model, :make, :stationId, :km, :status, :energy, :fuelLevel, :modelId, :lastReportedKM) 
+	ON CONFLICT(id) DO UPDATE SET age = :age, plate = :plate, document = :document, model = :model, make = :make, "stationId" = :stationId, km = :km, status = :status, energy = :energy, "fuelLevel" = :fuelLevel, "modelId" = :modelId 
 	WHERE cars.id = :id`
-	deleteCar = `DELETE FROM cars WHERE id = $1`
+	updateCarReportedKM = `UPDATE cars SET "lastReportedKM" = $2 WHERE id = $1 AND "lastReportedKM" < $2`
+	deleteCar           = `DELETE FROM cars WHERE id = $1`
 )
 
+var carSortColumns = map[string]string{
+	"plate":     `plate`,
+	"age":       `age`,
+	"km":        `km`,
+	"fuelLevel": `"fuelLevel"`,
+	"status":    `status`,
+	"make":      `make`,
+	"model":     `model`,
+}
+
 type carRepositorySqlx struct {
 	ctx  context.Context
 	DB   *sqlx.DB
@@ -35,61 +49,130 @@ func NewCarRepositorySqlx(ctx context.Context, DB *sqlx.DB, disp events.Dispatch
 func (repo *carRepositorySqlx) Find(search application.SearchCarParams) []domain.Car {
 	cars := []domain.Car{}
 
+	filter, values := carFilter(search)
+	findCarsWithFilter := findCars + filter
+
+	field, desc := search.SortField()
+	if column, ok := carSortColumns[field]; ok {
+		direction := ` ASC`
+		if desc {
+			direction = ` DESC`
+		}
+		findCarsWithFilter = findCarsWithFilter + ` ORDER BY ` + column + direction + `, id ASC`
+	} else {
+		findCarsWithFilter = findCarsWithFilter + ` ORDER BY id ASC`
+	}
+
+	if search.Limit > 0 || search.Offset > 0 {
+		limit := uint64(search.Limit)
+		if limit == 0 {
+			limit = math.MaxInt64
+		}
+		findCarsWithFilter = findCarsWithFilter + fmt.Sprintf(` LIMIT $%v OFFSET $%v`, len(values)+1, len(values)+2)
+		values = append(values, limit, search.Offset)
+	}
+
+	if err := repo.DB.SelectContext(repo.ctx, &cars, findCarsWithFilter, values...); err != nil {
+		return cars
+	}
+
+	return cars
+}
+
+func (repo *carRepositorySqlx) Count(search application.SearchCarParams) int {
+	var count int
+
+	filter, values := carFilter(search)
+
+	if err := repo.DB.GetContext(repo.ctx, &count, countCars+filter, values...); err != nil {
+		return 0
+	}
+
+	return count
+}
+
+func carFilter(search application.SearchCarParams) (string, []interface{}) {
 	var args []string
 	var values []interface{}
-	count := 0
+
+	arg := func(format string, value interface{}) {
+		values = append(values, value)
+		args = append(args, fmt.Sprintf(format, fmt.Sprintf(`$%v`, len(values))))
+	}
+	in := func(column string, list []interface{}) {
+		var placeholders []string
+		for _, v := range list {
+			values = append(values, v)
+			placeholders = append(placeholders, fmt.Sprintf(`$%v`, len(values)))
+		}
+		args = append(args, column+` IN (`+strings.Join(placeholders, `, `)+`)`)
+	}
 
 	if search.Age > 0 {
-		count++
-		args = append(args, fmt.Sprintf(`age = $%v`, count))
-		values = append(values, search.Age)
+		arg(`age = %v`, search.Age)
+	}
+	if search.MinAge > 0 {
+		arg(`age >= %v`, search.MinAge)
+	}
+	if search.MaxAge > 0 {
+		arg(`age <= %v`, search.MaxAge)
 	}
 	if len(search.Plate) > 0 {
-		count++
-		args = append(args, fmt.Sprintf(`plate = $%v`, count))
-		values = append(values, search.Plate)
+		arg(`plate = %v`, search.Plate)
+	}
+	if len(search.PlateLike) > 0 {
+		arg(`LOWER(plate) LIKE %v`, `%`+strings.ToLower(search.PlateLike)+`%`)
 	}
 	if len(search.Document) > 0 {
-		count++
-		args = append(args, fmt.Sprintf(`document = $%v`, count))
-		values = append(values, search.Document)
+		arg(`document = %v`, search.Document)
+	}
+	if len(search.ModelId) > 0 {
+		arg(`"modelId" = %v`, search.ModelId)
 	}
 	if len(search.Model) > 0 {
-		count++
-		args = append(args, fmt.Sprintf(`model = $%v`, count))
-		values = append(values, search.Model)
+		arg(`model = %v`, search.Model)
 	}
 	if len(search.Make) > 0 {
-		count++
-		args = append(args, fmt.Sprintf(`make = $%v`, count))
-		values = append(values, search.Make)
+		arg(`make = %v`, search.Make)
 	}
 	if len(search.StationId) > 0 {
-		count++
-		args = append(args, fmt.Sprintf(`"stationId" = $%v`, count))
-		values = append(values, search.StationId)
+		arg(`"stationId" = %v`, search.StationId)
+	}
+	if len(search.StationIds) > 0 {
+		var stationIds []interface{}
+		for _, id := range search.StationIds {
+			stationIds = append(stationIds, id)
+		}
+		in(`"stationId"`, stationIds)
 	}
 	if search.KM > 0 {
-		count++
-		args = append(args, fmt.Sprintf(`km = $%v`, count))
-		values = append(values, search.KM)
+		arg(`km = %v`, search.KM)
+	}
+	if search.MinKM > 0 {
+		arg(`km >= %v`, search.MinKM)
+	}
+	if search.MaxKM > 0 {
+		arg(`km <= %v`, search.MaxKM)
 	}
 	if search.Status > 0 {
-		count++
-		args = append(args, fmt.Sprintf(`status = $%v`, count))
-		values = append(values, search.Status)
+		arg(`status = %v`, search.Status)
 	}
-
-	findCarsWithFilter := findCars
-	if len(args) > 0 {
-		findCarsWithFilter = findCarsWithFilter + ` WHERE ` + strings.Join(args, ` AND `)
+	if len(search.Statuses) > 0 {
+		var statuses []interface{}
+		for _, status := range search.Statuses {
+			statuses = append(statuses, status)
+		}
+		in(`status`, statuses)
+	}
+	if search.MinFuel > 0 {
+		arg(`"fuelLevel" >= %v`, search.MinFuel)
 	}
 
-	if err := repo.DB.SelectContext(repo.ctx, &cars, findCarsWithFilter, values...); err != nil {
-		return cars
+	if len(args) == 0 {
+		return "", values
 	}
 
-	return cars
+	return ` WHERE ` + strings.Join(args, ` AND `), values
 }
 
 func (repo *carRepositorySqlx) FindOne(id string) (*domain.Car, error) {
@@ -128,6 +211,18 @@ func (repo *carRepositorySqlx) Save(car domain.Car) error {
 		return err
 	}
 
+	// The car is saved whatever its announcement to other modules does.
+	if err := events.DispatchCommitted(repo.disp, car.Events); err != nil {
+		log.Printf("car %s: committed events not dispatched: %v", car.ID, err)
+	}
+
+	return nil
+}
+
+func (repo *carRepositorySqlx) UpdateReportedKM(id string, km uint64) error {
+	if _, err := repo.DB.ExecContext(repo.ctx, updateCarReportedKM, id, km); err != nil {
+		return application.ErrInvalidCar
+	}
 	return nil
 }
 
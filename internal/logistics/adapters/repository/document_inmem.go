@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/domain"
+)
+
+type documentRepositoryInMemory struct {
+	documents map[string]domain.CarDocument
+	*sync.RWMutex
+}
+
+func NewDocumentRepositoryInMemory(documents []domain.CarDocument) *documentRepositoryInMemory {
+	documentsMap := make(map[string]domain.CarDocument)
+	for _, v := range documents {
+		documentsMap[v.ID] = v
+	}
+	return &documentRepositoryInMemory{documentsMap, &sync.RWMutex{}}
+}
+
+func (repo documentRepositoryInMemory) FindAll() []domain.CarDocument {
+	repo.Lock()
+	defer repo.Unlock()
+
+	documents := []domain.CarDocument{}
+	for _, v := range repo.documents {
+		documents = append(documents, v)
+	}
+
+	sortDocuments(documents)
+
+	return documents
+}
+
+func (repo documentRepositoryInMemory) FindByCar(carId string) []domain.CarDocument {
+	repo.Lock()
+	defer repo.Unlock()
+
+	documents := []domain.CarDocument{}
+	for _, v := range repo.documents {
+		if v.CarId == carId {
+			documents = append(documents, v)
+		}
+	}
+
+	sortDocuments(documents)
+
+	return documents
+}
+
+func (repo documentRepositoryInMemory) FindOne(id string) (*domain.CarDocument, error) {
+	repo.Lock()
+	defer repo.Unlock()
+
+	d, exists := repo.documents[id]
+	if !exists {
+		return nil, application.ErrNotFoundDocument
+	}
+
+	return &d, nil
+}
+
+func (repo *documentRepositoryInMemory) Save(document domain.CarDocument) error {
+	repo.Lock()
+	defer repo.Unlock()
+
+	repo.documents[document.ID] = document
+
+	return nil
+}
+
+func sortDocuments(documents []domain.CarDocument) {
+	sort.Slice(documents, func(i, j int) bool {
+		return documents[i].ExpiresAt.Before(documents[j].ExpiresAt)
+	})
+}
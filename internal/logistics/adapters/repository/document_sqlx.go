@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/domain"
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/validation"
+)
+
+const (
+	findDocuments      = `SELECT * FROM cdocuments ORDER BY "expiresAt"`
+	findDocumentsByCar = `SELECT * FROM cdocuments WHERE "carId" = $1 ORDER BY "expiresAt"`
+	findDocument       = `SELECT * FROM cdocuments WHERE id = $1 LIMIT 1`
+	upsertDocument     = `
+	INSERT INTO cdocuments (id, "carId", type, number, "issuedAt", "expiresAt", "flaggedAt") 
+	VALUES (:id, :carId, :type, :number, :issuedAt, :expiresAt, :flaggedAt) 
+	ON CONFLICT(id) DO UPDATE SET number = :number, "issuedAt" = :issuedAt, "expiresAt" = :expiresAt, "flaggedAt" = :flaggedAt 
+	WHERE cdocuments.id = :id`
+
+	findAttachmentsByDocument = `SELECT name, url FROM dattachments WHERE "documentId" = $1`
+	deleteAttachmentsDocument = `DELETE FROM dattachments WHERE "documentId" = $1`
+	insertAttachmentDocument  = `INSERT INTO dattachments ("documentId", name, url) VALUES ($1, $2, $3)`
+)
+
+type documentRepositorySqlx struct {
+	ctx context.Context
+	DB  *sqlx.DB
+}
+
+func NewDocumentRepositorySqlx(ctx context.Context, DB *sqlx.DB) *documentRepositorySqlx {
+	return &documentRepositorySqlx{ctx, DB}
+}
+
+func (repo *documentRepositorySqlx) FindAll() []domain.CarDocument {
+	return repo.find(findDocuments)
+}
+
+func (repo *documentRepositorySqlx) FindByCar(carId string) []domain.CarDocument {
+	return repo.find(findDocumentsByCar, carId)
+}
+
+func (repo *documentRepositorySqlx) find(query string, args ...interface{}) []domain.CarDocument {
+	documents := []domain.CarDocument{}
+
+	if err := repo.DB.SelectContext(repo.ctx, &documents, query, args...); err != nil {
+		return []domain.CarDocument{}
+	}
+
+	for i := range documents {
+		documents[i].Attachments = []domain.Attachment{}
+		if err := repo.DB.SelectContext(repo.ctx, &documents[i].Attachments, findAttachmentsByDocument, documents[i].ID); err != nil {
+			return []domain.CarDocument{}
+		}
+	}
+
+	return documents
+}
+
+func (repo *documentRepositorySqlx) FindOne(id string) (*domain.CarDocument, error) {
+	var document domain.CarDocument
+
+	if err := repo.DB.GetContext(repo.ctx, &document, findDocument, id); err != nil {
+		return nil, application.ErrNotFoundDocument
+	}
+
+	document.Attachments = []domain.Attachment{}
+	if err := repo.DB.SelectContext(repo.ctx, &document.Attachments, findAttachmentsByDocument, document.ID); err != nil {
+		return nil, application.ErrNotFoundDocument
+	}
+
+	return &document, nil
+}
+
+func (repo *documentRepositorySqlx) Save(document domain.CarDocument) error {
+	if err := validation.ValidateEntity(document); err != nil {
+		return application.ErrInvalidDocument
+	}
+
+	tx, err := repo.DB.BeginTxx(repo.ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.NamedExecContext(repo.ctx, upsertDocument, document); err != nil {
+		tx.Rollback()
+		return application.ErrInvalidDocument
+	}
+
+	if _, err := tx.ExecContext(repo.ctx, deleteAttachmentsDocument, document.ID); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	for _, a := range document.Attachments {
+		if _, err := tx.ExecContext(repo.ctx, insertAttachmentDocument, document.ID, a.Name, a.URL); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/domain"
+)
+
+func ClearDocumentDB(t *testing.T, db *sqlx.DB) {
+	t.Helper()
+	const deleteAllAttachments = "DELETE FROM dattachments"
+	const deleteAllDocuments = "DELETE FROM cdocuments"
+
+	if _, err := db.Exec(deleteAllAttachments); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec(deleteAllDocuments); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDocumentRepositorySqlx_Save(t *testing.T) {
+	db := GetCarDBConn(t)
+	defer db.Close()
+
+	ClearDocumentDB(t, db)
+	defer ClearDocumentDB(t, db)
+
+	repo := NewDocumentRepositorySqlx(context.Background(), db)
+
+	issuedAt := time.Date(2022, time.March, 1, 0, 0, 0, 0, time.UTC)
+	document, _ := domain.NewCarDocument(
+		"e4ce866a-f5b7-4774-8f9d-5eb74c3900cc", "INS-2022-001", domain.InsurancePolicy,
+		issuedAt, issuedAt.AddDate(1, 0, 0),
+		[]domain.Attachment{{Name: "policy.pdf", URL: "https://files.example.com/policy.pdf"}})
+
+	if err := repo.Save(*document); err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	document.Flag(issuedAt.AddDate(0, 11, 15), 30)
+	if err := repo.Save(*document); err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	found, err := repo.FindOne(document.ID)
+	if err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	if found.FlaggedAt == nil || len(found.Attachments) != 1 || found.Attachments[0].Name != "policy.pdf" {
+		t.Error("unexpected document", found)
+	}
+
+	if documents := repo.FindByCar(document.CarId); len(documents) != 1 {
+		t.Error("unexpected documents", documents)
+	}
+
+	if _, err := repo.FindOne("1b2c3d4e-5f6a-4b7c-8d9e-0f1a2b3c4d5e"); !errors.Is(err, application.ErrNotFoundDocument) {
+		t.Error("unexpected error", err)
+	}
+
+	document.ID = ""
+	if err := repo.Save(*document); !errors.Is(err, application.ErrInvalidDocument) {
+		t.Error("unexpected error", err)
+	}
+}
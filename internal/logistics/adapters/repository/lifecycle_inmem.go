@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"sync"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/domain"
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/validation"
+)
+
+type lifecycleRepositoryInMemory struct {
+	lifecycles map[string]domain.CarLifecycle
+	carRepo    application.CarWriteRepository
+	*sync.RWMutex
+}
+
+func NewLifecycleRepositoryInMemory(lifecycles []domain.CarLifecycle, carRepo application.CarWriteRepository) *lifecycleRepositoryInMemory {
+	lifecyclesMap := make(map[string]domain.CarLifecycle)
+	for _, v := range lifecycles {
+		lifecyclesMap[v.CarId] = v
+	}
+	return &lifecycleRepositoryInMemory{lifecyclesMap, carRepo, &sync.RWMutex{}}
+}
+
+func (repo lifecycleRepositoryInMemory) FindOne(carId string) (*domain.CarLifecycle, error) {
+	repo.Lock()
+	defer repo.Unlock()
+
+	lifecycle, exists := repo.lifecycles[carId]
+	if !exists {
+		return nil, application.ErrNotFoundCar
+	}
+
+	return &lifecycle, nil
+}
+
+func (repo *lifecycleRepositoryInMemory) Save(lifecycle domain.CarLifecycle) error {
+	repo.Lock()
+	defer repo.Unlock()
+
+	if err := validation.ValidateEntity(lifecycle); err != nil {
+		return application.ErrInvalidEntity
+	}
+
+	repo.lifecycles[lifecycle.CarId] = lifecycle
+
+	return nil
+}
+
+func (repo *lifecycleRepositoryInMemory) SaveWithCar(lifecycle domain.CarLifecycle, car domain.Car) error {
+	repo.Lock()
+	defer repo.Unlock()
+
+	if err := validation.ValidateEntity(lifecycle); err != nil {
+		return application.ErrInvalidEntity
+	}
+
+	if err := repo.carRepo.Save(car); err != nil {
+		return err
+	}
+
+	repo.lifecycles[lifecycle.CarId] = lifecycle
+
+	return nil
+}
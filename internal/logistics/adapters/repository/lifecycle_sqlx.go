@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/domain"
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/events"
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/validation"
+)
+
+const (
+	findLifecycle   = `SELECT * FROM clifecycles WHERE "carId" = $1 LIMIT 1`
+	upsertLifecycle = `
+	INSERT INTO clifecycles ("carId", "purchasedAt", "purchaseKM", "purchasePrice", supplier, "usefulLife", "residualValue", "retiredAt", "listedAt", "askingPrice", "soldAt", buyer, "salePrice") 
+	VALUES (:carId, :purchasedAt, :purchaseKM, :purchasePrice, :supplier, :usefulLife, :residualValue, :retiredAt, :listedAt, :askingPrice, :soldAt, :buyer, :salePrice) 
+	ON CONFLICT("carId") DO UPDATE SET "purchasedAt" = :purchasedAt, "purchaseKM" = :purchaseKM, "purchasePrice" = :purchasePrice, supplier = :supplier, "usefulLife" = :usefulLife, "residualValue" = :residualValue, 
+	"retiredAt" = :retiredAt, "listedAt" = :listedAt, "askingPrice" = :askingPrice, "soldAt" = :soldAt, buyer = :buyer, "salePrice" = :salePrice 
+	WHERE clifecycles."carId" = :carId`
+)
+
+type lifecycleRepositorySqlx struct {
+	ctx  context.Context
+	DB   *sqlx.DB
+	disp events.Dispatcher
+}
+
+func NewLifecycleRepositorySqlx(ctx context.Context, DB *sqlx.DB, disp events.Dispatcher) *lifecycleRepositorySqlx {
+	return &lifecycleRepositorySqlx{ctx, DB, disp}
+}
+
+func (repo *lifecycleRepositorySqlx) FindOne(carId string) (*domain.CarLifecycle, error) {
+	var lifecycle domain.CarLifecycle
+
+	if err := repo.DB.GetContext(repo.ctx, &lifecycle, findLifecycle, carId); err != nil {
+		return nil, application.ErrNotFoundCar
+	}
+
+	return &lifecycle, nil
+}
+
+func (repo *lifecycleRepositorySqlx) Save(lifecycle domain.CarLifecycle) error {
+	if err := validation.ValidateEntity(lifecycle); err != nil {
+		return application.ErrInvalidEntity
+	}
+
+	if _, err := repo.DB.NamedExecContext(repo.ctx, upsertLifecycle, lifecycle); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (repo *lifecycleRepositorySqlx) SaveWithCar(lifecycle domain.CarLifecycle, car domain.Car) error {
+	if err := validation.ValidateEntity(lifecycle); err != nil {
+		return application.ErrInvalidEntity
+	}
+
+	if err := validation.ValidateEntity(car); err != nil {
+		return application.ErrInvalidCar
+	}
+
+	tx, err := repo.DB.BeginTxx(repo.ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.NamedExecContext(repo.ctx, upsertCar, car); err != nil {
+		tx.Rollback()
+		return application.ErrInvalidCar
+	}
+
+	if len(car.Events) > 0 {
+		if err := repo.disp.Dispatch(car.Events); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	if _, err := tx.NamedExecContext(repo.ctx, upsertLifecycle, lifecycle); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,116 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/domain"
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/events"
+)
+
+func ClearLifecycleDB(t *testing.T, db *sqlx.DB) {
+	t.Helper()
+	const deleteAllLifecycles = "DELETE FROM clifecycles"
+
+	if _, err := db.Exec(deleteAllLifecycles); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLifecycleRepositorySqlx_Save(t *testing.T) {
+	db := GetCarDBConn(t)
+	defer db.Close()
+
+	ClearLifecycleDB(t, db)
+	defer ClearLifecycleDB(t, db)
+
+	repo := NewLifecycleRepositorySqlx(context.Background(), db, &dispatcherMock{calls: make(map[string]uint)})
+
+	purchasedAt := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+	lifecycle := domain.NewCarLifecycle("e4ce866a-f5b7-4774-8f9d-5eb74c3900cc")
+	lifecycle.Acquire(purchasedAt, 0, 60000, "Fiat Dealer", 4, 20000)
+
+	if err := repo.Save(*lifecycle); err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	lifecycle.Retire(purchasedAt.AddDate(4, 0, 0))
+	if err := repo.Save(*lifecycle); err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	found, err := repo.FindOne(lifecycle.CarId)
+	if err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	if !found.Acquired() || found.RetiredAt == nil || found.SoldAt != nil || found.Supplier != "Fiat Dealer" || found.PurchasePrice != 60000 {
+		t.Error("unexpected lifecycle", found)
+	}
+
+	if _, err := repo.FindOne("1b2c3d4e-5f6a-4b7c-8d9e-0f1a2b3c4d5e"); !errors.Is(err, application.ErrNotFoundCar) {
+		t.Error("unexpected error", err)
+	}
+
+	lifecycle.CarId = ""
+	if err := repo.Save(*lifecycle); !errors.Is(err, application.ErrInvalidEntity) {
+		t.Error("unexpected error", err)
+	}
+}
+
+func TestLifecycleRepositorySqlx_SaveWithCar(t *testing.T) {
+	db := GetCarDBConn(t)
+	defer db.Close()
+
+	defer ClearCarDB(t, db)
+	defer ClearLifecycleDB(t, db)
+
+	repo := NewLifecycleRepositorySqlx(context.Background(), db, &dispatcherMock{calls: make(map[string]uint)})
+	carRepo := NewCarRepositorySqlx(context.Background(), db, events.NewEventDispatcher())
+
+	testCases := []struct {
+		name         string
+		carIdArg     string
+		wantError    error
+		wantCarSaved bool
+	}{
+		{
+			name:         "correct input",
+			carIdArg:     newCarFixture().ID,
+			wantError:    nil,
+			wantCarSaved: true,
+		},
+		{
+			name:         "incorrect lifecycle input",
+			carIdArg:     "",
+			wantError:    application.ErrInvalidEntity,
+			wantCarSaved: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ClearCarDB(t, db)
+
+			car := newCarFixture()
+			car.Status = domain.Retired
+			lifecycle := domain.NewCarLifecycle(tc.carIdArg)
+			lifecycle.Retire(time.Now())
+
+			err := repo.SaveWithCar(*lifecycle, *car)
+
+			if !errors.Is(err, tc.wantError) {
+				t.Error(err)
+			}
+
+			_, err = carRepo.FindOne(car.ID)
+			if (err == nil) != tc.wantCarSaved {
+				t.Error("unexpected car result", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/domain"
+)
+
+type occupancyRepositoryInMemory struct {
+	entries map[string]domain.OccupancyEntry
+	*sync.RWMutex
+}
+
+func NewOccupancyRepositoryInMemory(entries []domain.OccupancyEntry) *occupancyRepositoryInMemory {
+	entriesMap := make(map[string]domain.OccupancyEntry)
+	for _, v := range entries {
+		entriesMap[v.EventId] = v
+	}
+	return &occupancyRepositoryInMemory{entriesMap, &sync.RWMutex{}}
+}
+
+func (repo occupancyRepositoryInMemory) FindOne(eventId string) (*domain.OccupancyEntry, error) {
+	repo.Lock()
+	defer repo.Unlock()
+
+	e, exists := repo.entries[eventId]
+	if !exists {
+		return nil, application.ErrNotFoundOccupancyEntry
+	}
+
+	return &e, nil
+}
+
+func (repo occupancyRepositoryInMemory) FindByStation(stationId string) []domain.OccupancyEntry {
+	repo.Lock()
+	defer repo.Unlock()
+
+	entries := []domain.OccupancyEntry{}
+	for _, e := range repo.entries {
+		if e.StationId == stationId {
+			entries = append(entries, e)
+		}
+	}
+
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Date.Before(entries[j].Date) })
+
+	return entries
+}
+
+func (repo *occupancyRepositoryInMemory) Save(entry domain.OccupancyEntry) error {
+	repo.Lock()
+	defer repo.Unlock()
+
+	if _, exists := repo.entries[entry.EventId]; exists {
+		return nil
+	}
+
+	repo.entries[entry.EventId] = entry
+
+	return nil
+}
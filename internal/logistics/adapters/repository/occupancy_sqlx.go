@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/domain"
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/validation"
+)
+
+const (
+	findOccupancyEntry            = `SELECT * FROM occupancy WHERE "eventId" = $1 LIMIT 1`
+	findOccupancyEntriesByStation = `SELECT * FROM occupancy WHERE "stationId" = $1 ORDER BY date`
+	insertOccupancyEntry          = `
+	INSERT INTO occupancy VALUES (:eventId, :stationId, :carId, :movement, :delta, :date) 
+	ON CONFLICT("eventId") DO NOTHING`
+)
+
+type occupancyRepositorySqlx struct {
+	ctx context.Context
+	DB  *sqlx.DB
+}
+
+func NewOccupancyRepositorySqlx(ctx context.Context, DB *sqlx.DB) *occupancyRepositorySqlx {
+	return &occupancyRepositorySqlx{ctx, DB}
+}
+
+func (repo *occupancyRepositorySqlx) FindOne(eventId string) (*domain.OccupancyEntry, error) {
+	var entry domain.OccupancyEntry
+
+	if err := repo.DB.GetContext(repo.ctx, &entry, findOccupancyEntry, eventId); err != nil {
+		return nil, application.ErrNotFoundOccupancyEntry
+	}
+
+	return &entry, nil
+}
+
+func (repo *occupancyRepositorySqlx) FindByStation(stationId string) []domain.OccupancyEntry {
+	entries := []domain.OccupancyEntry{}
+
+	if err := repo.DB.SelectContext(repo.ctx, &entries, findOccupancyEntriesByStation, stationId); err != nil {
+		return entries
+	}
+
+	return entries
+}
+
+// Event ids are the idempotency keys of the ledger.
+func (repo *occupancyRepositorySqlx) Save(entry domain.OccupancyEntry) error {
+	if err := validation.ValidateEntity(entry); err != nil {
+		return application.ErrInvalidOccupancy
+	}
+	if _, err := repo.DB.NamedExecContext(repo.ctx, insertOccupancyEntry, entry); err != nil {
+		return application.ErrInvalidOccupancy
+	}
+
+	return nil
+}
@@ -0,0 +1,107 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/domain"
+)
+
+func newOccupancyEntryFixture(eventId string, movement domain.OccupancyMovement) *domain.OccupancyEntry {
+	e, _ := domain.NewOccupancyEntry(eventId, "e4ce866a-f5b7-4774-8f9d-5eb74c3900cc", "83369771-f9a4-48b7-b87b-463f19f7b187", movement, time.Date(2022, time.March, 1, 8, 0, 0, 0, time.UTC))
+	return e
+}
+
+func ClearOccupancyDB(t *testing.T, db *sqlx.DB) {
+	t.Helper()
+	const deleteAllEntries = "DELETE FROM occupancy"
+
+	if _, err := db.Exec(deleteAllEntries); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestOccupancyRepositorySqlx_Save(t *testing.T) {
+	db := GetCarDBConn(t)
+	defer db.Close()
+
+	defer ClearOccupancyDB(t, db)
+
+	repo := NewOccupancyRepositorySqlx(context.Background(), db)
+
+	invalid := newOccupancyEntryFixture("car.parked:1", domain.Arrived)
+	invalid.StationId = "invalid-id"
+
+	testCases := []struct {
+		name      string
+		entryArg  domain.OccupancyEntry
+		wantError error
+		wantTotal int
+	}{
+		{
+			name:      "correct input",
+			entryArg:  *newOccupancyEntryFixture("car.parked:1", domain.Arrived),
+			wantError: nil,
+			wantTotal: 1,
+		},
+		{
+			name:      "duplicated event input",
+			entryArg:  *newOccupancyEntryFixture("car.parked:1", domain.Arrived),
+			wantError: nil,
+			wantTotal: 1,
+		},
+		{
+			name:      "correct left input",
+			entryArg:  *newOccupancyEntryFixture("car.in-transfer:1", domain.Left),
+			wantError: nil,
+			wantTotal: 0,
+		},
+		{
+			name:      "incorrect entry input",
+			entryArg:  *invalid,
+			wantError: application.ErrInvalidOccupancy,
+			wantTotal: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := repo.Save(tc.entryArg)
+
+			if !errors.Is(err, tc.wantError) {
+				t.Error(err)
+			}
+
+			entries := repo.FindByStation("e4ce866a-f5b7-4774-8f9d-5eb74c3900cc")
+			if total := domain.Occupancy(entries); total != tc.wantTotal {
+				t.Error("unexpected occupancy", total)
+			}
+		})
+	}
+}
+
+func TestOccupancyRepositorySqlx_FindOne(t *testing.T) {
+	db := GetCarDBConn(t)
+	defer db.Close()
+
+	defer ClearOccupancyDB(t, db)
+
+	repo := NewOccupancyRepositorySqlx(context.Background(), db)
+
+	entry := newOccupancyEntryFixture("car.added:1", domain.Arrived)
+	if err := repo.Save(*entry); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := repo.FindOne(entry.EventId); err != nil {
+		t.Error("unexpected error", err)
+	}
+
+	if _, err := repo.FindOne("car.added:2"); !errors.Is(err, application.ErrNotFoundOccupancyEntry) {
+		t.Error("unexpected error", err)
+	}
+}
@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"sync"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/domain"
+)
+
+type maintenancePlanRepositoryInMemory struct {
+	plans map[string]domain.MaintenancePlan
+	*sync.RWMutex
+}
+
+func NewMaintenancePlanRepositoryInMemory(plans []domain.MaintenancePlan) *maintenancePlanRepositoryInMemory {
+	plansMap := make(map[string]domain.MaintenancePlan)
+	for _, v := range plans {
+		plansMap[v.ID] = v
+	}
+	return &maintenancePlanRepositoryInMemory{plansMap, &sync.RWMutex{}}
+}
+
+func (repo maintenancePlanRepositoryInMemory) FindAll() []domain.MaintenancePlan {
+	repo.Lock()
+	defer repo.Unlock()
+
+	plans := []domain.MaintenancePlan{}
+	for k := range repo.plans {
+		plans = append(plans, repo.plans[k])
+	}
+
+	return plans
+}
+
+func (repo maintenancePlanRepositoryInMemory) FindOne(id string) (*domain.MaintenancePlan, error) {
+	repo.Lock()
+	defer repo.Unlock()
+
+	p, exists := repo.plans[id]
+	if !exists {
+		return nil, application.ErrNotFoundMaintenancePlan
+	}
+
+	return &p, nil
+}
+
+func (repo maintenancePlanRepositoryInMemory) FindByModel(modelId string) (*domain.MaintenancePlan, error) {
+	repo.Lock()
+	defer repo.Unlock()
+
+	for _, p := range repo.plans {
+		if p.ModelId == modelId {
+			return &p, nil
+		}
+	}
+
+	return nil, application.ErrNotFoundMaintenancePlan
+}
+
+func (repo *maintenancePlanRepositoryInMemory) Save(plan domain.MaintenancePlan) error {
+	repo.Lock()
+	defer repo.Unlock()
+
+	repo.plans[plan.ID] = plan
+
+	return nil
+}
+
+func (repo *maintenancePlanRepositoryInMemory) Delete(id string) error {
+	repo.Lock()
+	defer repo.Unlock()
+
+	if _, exists := repo.plans[id]; !exists {
+		return application.ErrNotFoundMaintenancePlan
+	}
+
+	delete(repo.plans, id)
+
+	return nil
+}
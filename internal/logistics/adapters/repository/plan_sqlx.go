@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/domain"
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/validation"
+)
+
+const (
+	findMaintenancePlans       = `SELECT * FROM mplans`
+	findMaintenancePlan        = `SELECT * FROM mplans WHERE id = $1 LIMIT 1`
+	findMaintenancePlanByModel = `SELECT * FROM mplans WHERE "modelId" = $1 LIMIT 1`
+	upsertMaintenancePlan      = `
+	INSERT INTO mplans VALUES (:id, :modelId, :intervalKM, :intervalMonths, :createdAt) 
+	ON CONFLICT(id) DO UPDATE SET "modelId" = :modelId, "intervalKM" = :intervalKM, "intervalMonths" = :intervalMonths 
+	WHERE mplans.id = :id`
+	deleteMaintenancePlan = `DELETE FROM mplans WHERE id = $1`
+)
+
+type maintenancePlanRepositorySqlx struct {
+	ctx context.Context
+	DB  *sqlx.DB
+}
+
+func NewMaintenancePlanRepositorySqlx(ctx context.Context, DB *sqlx.DB) *maintenancePlanRepositorySqlx {
+	return &maintenancePlanRepositorySqlx{ctx, DB}
+}
+
+func (repo *maintenancePlanRepositorySqlx) FindAll() []domain.MaintenancePlan {
+	plans := []domain.MaintenancePlan{}
+
+	if err := repo.DB.SelectContext(repo.ctx, &plans, findMaintenancePlans); err != nil {
+		return plans
+	}
+
+	return plans
+}
+
+func (repo *maintenancePlanRepositorySqlx) FindOne(id string) (*domain.MaintenancePlan, error) {
+	var plan domain.MaintenancePlan
+
+	if err := repo.DB.GetContext(repo.ctx, &plan, findMaintenancePlan, id); err != nil {
+		return nil, application.ErrNotFoundMaintenancePlan
+	}
+
+	return &plan, nil
+}
+
+func (repo *maintenancePlanRepositorySqlx) FindByModel(modelId string) (*domain.MaintenancePlan, error) {
+	var plan domain.MaintenancePlan
+
+	if err := repo.DB.GetContext(repo.ctx, &plan, findMaintenancePlanByModel, modelId); err != nil {
+		return nil, application.ErrNotFoundMaintenancePlan
+	}
+
+	return &plan, nil
+}
+
+func (repo *maintenancePlanRepositorySqlx) Save(plan domain.MaintenancePlan) error {
+	if err := validation.ValidateEntity(plan); err != nil {
+		return application.ErrInvalidMaintenancePlan
+	}
+	if _, err := repo.DB.NamedExecContext(repo.ctx, upsertMaintenancePlan, plan); err != nil {
+		return application.ErrInvalidMaintenancePlan
+	}
+
+	return nil
+}
+
+func (repo *maintenancePlanRepositorySqlx) Delete(id string) error {
+	r, err := repo.DB.ExecContext(repo.ctx, deleteMaintenancePlan, id)
+	if err != nil {
+		return application.ErrNotFoundMaintenancePlan
+	}
+	n, err := r.RowsAffected()
+	if err != nil || n == 0 {
+		return application.ErrNotFoundMaintenancePlan
+	}
+	return nil
+}
@@ -12,8 +12,15 @@ import (
 const (
 	findStations  = "SELECT * FROM stations"
 	findStation   = "SELECT * FROM stations WHERE id = $1 LIMIT 1"
-	upsertStation = "INSERT INTO stations VALUES (:id, :name, :address, :complement, :state, :city, :cep, :capacity, :idle) ON CONFLICT(id) DO UPDATE SET name = :name, address = :address, complement = :complement, state = :state, city = :city, cep = :cep, capacity = :capacity, idle = :idle WHERE stations.id = :id"
+	upsertStation = "INSERT INTO stations VALUES (:id, :name, :address, :complement, :state, :city, :cep, :capacity, :idle, :latitude, :longitude, :keyDrop, :timezone) ON CONFLICT(id) DO UPDATE SET name = :name, address = :address, complement = :complement, state = :state, city = :city, cep = :cep, capacity = :capacity, idle = :idle, latitude = :latitude, longitude = :longitude, \"keyDrop\" = :keyDrop, timezone = :timezone WHERE stations.id = :id"
 	deleteStation = "DELETE FROM stations WHERE id = $1"
+
+	findScheduleByStation = `SELECT weekday, opens, closes FROM sschedules WHERE "stationId" = $1 ORDER BY weekday, opens`
+	deleteScheduleStation = `DELETE FROM sschedules WHERE "stationId" = $1`
+	insertScheduleStation = `INSERT INTO sschedules ("stationId", weekday, opens, closes) VALUES ($1, $2, $3, $4)`
+	findHolidaysByStation = `SELECT date, name FROM sholidays WHERE "stationId" = $1 ORDER BY date`
+	deleteHolidaysStation = `DELETE FROM sholidays WHERE "stationId" = $1`
+	insertHolidayStation  = `INSERT INTO sholidays ("stationId", date, name) VALUES ($1, $2, $3)`
 )
 
 type stationRepositorySqlx struct {
@@ -32,6 +39,12 @@ func (repo *stationRepositorySqlx) FindAll() []domain.Station {
 		return stations
 	}
 
+	for i := range stations {
+		if err := repo.findSchedule(&stations[i]); err != nil {
+			return []domain.Station{}
+		}
+	}
+
 	return stations
 }
 
@@ -42,21 +55,82 @@ func (repo *stationRepositorySqlx) FindOne(id string) (*domain.Station, error) {
 		return nil, application.ErrNotFoundStation
 	}
 
+	if err := repo.findSchedule(&station); err != nil {
+		return nil, application.ErrNotFoundStation
+	}
+
 	return &station, nil
 }
 
+func (repo *stationRepositorySqlx) findSchedule(station *domain.Station) error {
+	station.Schedule = []domain.OpeningHours{}
+	if err := repo.DB.SelectContext(repo.ctx, &station.Schedule, findScheduleByStation, station.ID); err != nil {
+		return err
+	}
+
+	station.Holidays = []domain.Holiday{}
+	if err := repo.DB.SelectContext(repo.ctx, &station.Holidays, findHolidaysByStation, station.ID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (repo *stationRepositorySqlx) Save(station domain.Station) error {
 	if err := validation.ValidateEntity(station); err != nil {
 		return application.ErrInvalidStation
 	}
-	if _, err := repo.DB.NamedExecContext(repo.ctx, upsertStation, station); err != nil {
+
+	tx, err := repo.DB.BeginTxx(repo.ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.NamedExecContext(repo.ctx, upsertStation, station); err != nil {
+		tx.Rollback()
 		return application.ErrInvalidStation
 	}
 
+	if _, err := tx.ExecContext(repo.ctx, deleteScheduleStation, station.ID); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	for _, h := range station.Schedule {
+		if _, err := tx.ExecContext(repo.ctx, insertScheduleStation, station.ID, h.Weekday, h.Opens, h.Closes); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	if _, err := tx.ExecContext(repo.ctx, deleteHolidaysStation, station.ID); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	for _, h := range station.Holidays {
+		if _, err := tx.ExecContext(repo.ctx, insertHolidayStation, station.ID, h.Date, h.Name); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		tx.Rollback()
+		return err
+	}
+
 	return nil
 }
 
 func (repo *stationRepositorySqlx) Delete(id string) error {
+	if _, err := repo.DB.ExecContext(repo.ctx, deleteScheduleStation, id); err != nil {
+		return application.ErrInvalidStation
+	}
+	if _, err := repo.DB.ExecContext(repo.ctx, deleteHolidaysStation, id); err != nil {
+		return application.ErrInvalidStation
+	}
+
 	r, err := repo.DB.ExecContext(repo.ctx, deleteStation, id)
 	if err != nil {
 		return application.ErrInvalidStation
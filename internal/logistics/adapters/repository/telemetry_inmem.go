@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/domain"
+)
+
+type telemetryRepositoryInMemory struct {
+	readings map[string][]domain.TelemetryReading
+	*sync.RWMutex
+}
+
+func NewTelemetryRepositoryInMemory(readings []domain.TelemetryReading) *telemetryRepositoryInMemory {
+	readingsMap := make(map[string][]domain.TelemetryReading)
+	for _, v := range readings {
+		readingsMap[v.CarId] = append(readingsMap[v.CarId], v)
+	}
+	return &telemetryRepositoryInMemory{readingsMap, &sync.RWMutex{}}
+}
+
+func (repo telemetryRepositoryInMemory) FindByCar(carId string, from, to time.Time) []domain.TelemetryReading {
+	repo.Lock()
+	defer repo.Unlock()
+
+	readings := []domain.TelemetryReading{}
+	for _, r := range repo.readings[carId] {
+		if !r.RecordedAt.Before(from) && !r.RecordedAt.After(to) {
+			readings = append(readings, r)
+		}
+	}
+
+	sort.Slice(readings, func(i, j int) bool { return readings[i].RecordedAt.Before(readings[j].RecordedAt) })
+
+	return readings
+}
+
+func (repo *telemetryRepositoryInMemory) Save(readings []domain.TelemetryReading) error {
+	repo.Lock()
+	defer repo.Unlock()
+
+	for _, r := range readings {
+		duplicated := false
+		for _, v := range repo.readings[r.CarId] {
+			if v.RecordedAt.Equal(r.RecordedAt) {
+				duplicated = true
+				break
+			}
+		}
+		if !duplicated {
+			repo.readings[r.CarId] = append(repo.readings[r.CarId], r)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/domain"
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/events"
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/validation"
+)
+
+const (
+	findTelemetryByCar = `
+	SELECT * FROM telemetry WHERE "carId" = $1 AND "recordedAt" >= $2 AND "recordedAt" <= $3 
+	ORDER BY "recordedAt"`
+	insertTelemetry = `
+	INSERT INTO telemetry VALUES (:carId, :recordedAt, :latitude, :longitude, :km, :fuelLevel) 
+	ON CONFLICT("carId", "recordedAt") DO NOTHING`
+)
+
+type telemetryRepositorySqlx struct {
+	ctx  context.Context
+	DB   *sqlx.DB
+	disp events.Dispatcher
+}
+
+func NewTelemetryRepositorySqlx(ctx context.Context, DB *sqlx.DB, disp events.Dispatcher) *telemetryRepositorySqlx {
+	return &telemetryRepositorySqlx{ctx, DB, disp}
+}
+
+func (repo *telemetryRepositorySqlx) FindByCar(carId string, from, to time.Time) []domain.TelemetryReading {
+	readings := []domain.TelemetryReading{}
+
+	if err := repo.DB.SelectContext(repo.ctx, &readings, findTelemetryByCar, carId, from, to); err != nil {
+		return readings
+	}
+
+	return readings
+}
+
+func (repo *telemetryRepositorySqlx) Save(readings []domain.TelemetryReading) error {
+	for _, r := range readings {
+		if err := validation.ValidateEntity(r); err != nil {
+			return application.ErrInvalidTelemetry
+		}
+	}
+
+	tx, err := repo.DB.BeginTxx(repo.ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	recorded := []events.Event{}
+	for _, r := range readings {
+		res, err := tx.NamedExecContext(repo.ctx, insertTelemetry, r)
+		if err != nil {
+			tx.Rollback()
+			return application.ErrInvalidTelemetry
+		}
+		if n, _ := res.RowsAffected(); n > 0 {
+			recorded = append(recorded, domain.TelemetryRecorded{
+				CarId:      r.CarId,
+				RecordedAt: r.RecordedAt,
+				Latitude:   r.Latitude,
+				Longitude:  r.Longitude,
+				KM:         r.KM,
+				FuelLevel:  r.FuelLevel,
+			})
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if len(recorded) > 0 {
+		return repo.disp.Dispatch(recorded)
+	}
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/domain"
+)
+
+func newTelemetryFixture(at time.Time, km uint64) domain.TelemetryReading {
+	r, _ := domain.NewTelemetryReading("83369771-f9a4-48b7-b87b-463f19f7b187", at, -23.5505, -46.6333, km, 80)
+	return *r
+}
+
+func ClearTelemetryDB(t *testing.T, db *sqlx.DB) {
+	t.Helper()
+	const deleteAllReadings = "DELETE FROM telemetry"
+
+	if _, err := db.Exec(deleteAllReadings); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTelemetryRepositorySqlx_Save(t *testing.T) {
+	db := GetCarDBConn(t)
+	defer db.Close()
+
+	defer ClearTelemetryDB(t, db)
+
+	dispatcher := &dispatcherMock{calls: make(map[string]uint)}
+	repo := NewTelemetryRepositorySqlx(context.Background(), db, dispatcher)
+
+	at := time.Date(2022, time.March, 1, 8, 0, 0, 0, time.UTC)
+	invalid := newTelemetryFixture(at, 12000)
+	invalid.CarId = "invalid-id"
+
+	testCases := []struct {
+		name         string
+		readingsArg  []domain.TelemetryReading
+		wantError    error
+		wantReadings int
+		wantDispatch uint
+	}{
+		{
+			name:         "correct input",
+			readingsArg:  []domain.TelemetryReading{newTelemetryFixture(at, 12000), newTelemetryFixture(at.Add(time.Minute), 12001)},
+			wantError:    nil,
+			wantReadings: 2,
+			wantDispatch: 1,
+		},
+		{
+			name:         "duplicated input",
+			readingsArg:  []domain.TelemetryReading{newTelemetryFixture(at.Add(time.Minute), 12001), newTelemetryFixture(at.Add(2*time.Minute), 12002)},
+			wantError:    nil,
+			wantReadings: 3,
+			wantDispatch: 1,
+		},
+		{
+			name:         "incorrect reading input",
+			readingsArg:  []domain.TelemetryReading{newTelemetryFixture(at.Add(3*time.Minute), 12003), invalid},
+			wantError:    application.ErrInvalidTelemetry,
+			wantReadings: 3,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			dispatcher.calls = make(map[string]uint)
+			err := repo.Save(tc.readingsArg)
+
+			if !errors.Is(err, tc.wantError) {
+				t.Error(err)
+			}
+
+			if dispatcher.calls["Dispatch"] != tc.wantDispatch {
+				t.Error("unexpected dispatch calls", dispatcher.calls["Dispatch"])
+			}
+
+			readings := repo.FindByCar("83369771-f9a4-48b7-b87b-463f19f7b187", at, at.Add(time.Hour))
+			if len(readings) != tc.wantReadings {
+				t.Error("unexpected readings", len(readings))
+			}
+		})
+	}
+}
+
+func TestTelemetryRepositorySqlx_FindByCar(t *testing.T) {
+	db := GetCarDBConn(t)
+	defer db.Close()
+
+	defer ClearTelemetryDB(t, db)
+
+	repo := NewTelemetryRepositorySqlx(context.Background(), db, &dispatcherMock{calls: make(map[string]uint)})
+
+	at := time.Date(2022, time.March, 1, 8, 0, 0, 0, time.UTC)
+	readings := []domain.TelemetryReading{
+		newTelemetryFixture(at.Add(time.Hour), 12050),
+		newTelemetryFixture(at, 12000),
+		newTelemetryFixture(at.Add(48*time.Hour), 12500),
+	}
+	if err := repo.Save(readings); err != nil {
+		t.Fatal(err)
+	}
+
+	track := repo.FindByCar("83369771-f9a4-48b7-b87b-463f19f7b187", at, at.Add(24*time.Hour))
+	if len(track) != 2 || track[0].KM != 12000 || track[1].KM != 12050 {
+		t.Error("unexpected track", track)
+	}
+}
@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/domain"
+)
+
+type workOrderRepositoryInMemory struct {
+	workOrders map[string]domain.WorkOrder
+	carRepo    application.CarWriteRepository
+	*sync.RWMutex
+}
+
+func NewWorkOrderRepositoryInMemory(workOrders []domain.WorkOrder, carRepo application.CarWriteRepository) *workOrderRepositoryInMemory {
+	workOrdersMap := make(map[string]domain.WorkOrder)
+	for _, v := range workOrders {
+		workOrdersMap[v.ID] = v
+	}
+	return &workOrderRepositoryInMemory{workOrdersMap, carRepo, &sync.RWMutex{}}
+}
+
+func (repo workOrderRepositoryInMemory) FindByCar(carId string) []domain.WorkOrder {
+	repo.Lock()
+	defer repo.Unlock()
+
+	workOrders := []domain.WorkOrder{}
+	for _, v := range repo.workOrders {
+		if v.CarId == carId {
+			workOrders = append(workOrders, v)
+		}
+	}
+
+	sort.Slice(workOrders, func(i, j int) bool {
+		return workOrders[i].DateStart.Before(workOrders[j].DateStart)
+	})
+
+	return workOrders
+}
+
+func (repo workOrderRepositoryInMemory) FindOne(id string) (*domain.WorkOrder, error) {
+	repo.Lock()
+	defer repo.Unlock()
+
+	w, exists := repo.workOrders[id]
+	if !exists {
+		return nil, application.ErrNotFoundWorkOrder
+	}
+
+	return &w, nil
+}
+
+func (repo *workOrderRepositoryInMemory) Save(workOrder domain.WorkOrder) error {
+	repo.Lock()
+	defer repo.Unlock()
+
+	repo.workOrders[workOrder.ID] = workOrder
+
+	return nil
+}
+
+func (repo *workOrderRepositoryInMemory) SaveWithCar(workOrder domain.WorkOrder, car domain.Car) error {
+	repo.Lock()
+	defer repo.Unlock()
+
+	if err := repo.carRepo.Save(car); err != nil {
+		return err
+	}
+
+	repo.workOrders[workOrder.ID] = workOrder
+
+	return nil
+}
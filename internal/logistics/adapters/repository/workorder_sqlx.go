@@ -0,0 +1,157 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/domain"
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/events"
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/validation"
+)
+
+const (
+	findWorkOrdersByCar = `
+	SELECT id, "carId", "stationId", reason, type, workshop, cost, km, "dateStart", "dateEnd", status FROM workorders 
+	WHERE "carId" = $1 ORDER BY "dateStart"`
+	findWorkOrder = `
+	SELECT id, "carId", "stationId", reason, type, workshop, cost, km, "dateStart", "dateEnd", status FROM workorders 
+	WHERE id = $1 LIMIT 1`
+	upsertWorkOrder = `
+	INSERT INTO workorders (id, "carId", "stationId", reason, type, workshop, cost, km, "dateStart", "dateEnd", status) 
+	VALUES (:id, :carId, :stationId, :reason, :type, :workshop, :cost, :km, :dateStart, :dateEnd, :status) 
+	ON CONFLICT(id) DO UPDATE SET reason = :reason, type = :type, workshop = :workshop, cost = :cost, km = :km, "dateStart" = :dateStart, "dateEnd" = :dateEnd, status = :status 
+	WHERE workorders.id = :id`
+
+	findPartsByWorkOrder = `SELECT name, quantity, price FROM wparts WHERE "workOrderId" = $1`
+	deletePartsWorkOrder = `DELETE FROM wparts WHERE "workOrderId" = $1`
+	insertPartWorkOrder  = `INSERT INTO wparts ("workOrderId", name, quantity, price) VALUES ($1, $2, $3, $4)`
+)
+
+type workOrderRepositorySqlx struct {
+	ctx  context.Context
+	DB   *sqlx.DB
+	disp events.Dispatcher
+}
+
+func NewWorkOrderRepositorySqlx(ctx context.Context, DB *sqlx.DB, disp events.Dispatcher) *workOrderRepositorySqlx {
+	return &workOrderRepositorySqlx{ctx, DB, disp}
+}
+
+func (repo *workOrderRepositorySqlx) FindByCar(carId string) []domain.WorkOrder {
+	workOrders := []domain.WorkOrder{}
+
+	if err := repo.DB.SelectContext(repo.ctx, &workOrders, findWorkOrdersByCar, carId); err != nil {
+		return []domain.WorkOrder{}
+	}
+
+	for i := range workOrders {
+		workOrders[i].Parts = []domain.Part{}
+		if err := repo.DB.SelectContext(repo.ctx, &workOrders[i].Parts, findPartsByWorkOrder, workOrders[i].ID); err != nil {
+			return []domain.WorkOrder{}
+		}
+	}
+
+	return workOrders
+}
+
+func (repo *workOrderRepositorySqlx) FindOne(id string) (*domain.WorkOrder, error) {
+	var workOrder domain.WorkOrder
+
+	if err := repo.DB.GetContext(repo.ctx, &workOrder, findWorkOrder, id); err != nil {
+		return nil, application.ErrNotFoundWorkOrder
+	}
+
+	workOrder.Parts = []domain.Part{}
+	if err := repo.DB.SelectContext(repo.ctx, &workOrder.Parts, findPartsByWorkOrder, workOrder.ID); err != nil {
+		return nil, application.ErrNotFoundWorkOrder
+	}
+
+	return &workOrder, nil
+}
+
+func (repo *workOrderRepositorySqlx) Save(workOrder domain.WorkOrder) error {
+	if err := validation.ValidateEntity(workOrder); err != nil {
+		return application.ErrInvalidWorkOrder
+	}
+
+	tx, err := repo.DB.BeginTxx(repo.ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := repo.save(tx, workOrder); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return nil
+}
+
+func (repo *workOrderRepositorySqlx) SaveWithCar(workOrder domain.WorkOrder, car domain.Car) error {
+	if err := validation.ValidateEntity(workOrder); err != nil {
+		return application.ErrInvalidWorkOrder
+	}
+
+	if err := validation.ValidateEntity(car); err != nil {
+		return application.ErrInvalidCar
+	}
+
+	tx, err := repo.DB.BeginTxx(repo.ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.NamedExecContext(repo.ctx, upsertCar, car); err != nil {
+		tx.Rollback()
+		return application.ErrInvalidCar
+	}
+
+	if len(car.Events) > 0 {
+		if err := repo.disp.Dispatch(car.Events); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	if err := repo.save(tx, workOrder); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return nil
+}
+
+func (repo *workOrderRepositorySqlx) save(tx *sqlx.Tx, workOrder domain.WorkOrder) error {
+	if _, err := tx.NamedExecContext(repo.ctx, upsertWorkOrder, workOrder); err != nil {
+		return application.ErrInvalidWorkOrder
+	}
+
+	if _, err := tx.ExecContext(repo.ctx, deletePartsWorkOrder, workOrder.ID); err != nil {
+		return err
+	}
+
+	for _, p := range workOrder.Parts {
+		if _, err := tx.ExecContext(repo.ctx, insertPartWorkOrder, workOrder.ID, p.Name, p.Quantity, p.Price); err != nil {
+			return err
+		}
+	}
+
+	if len(workOrder.Events) > 0 {
+		if err := repo.disp.Dispatch(workOrder.Events); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,202 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/domain"
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/events"
+)
+
+func newWorkOrderFixture() *domain.WorkOrder {
+	w, _ := domain.NewWorkOrder("83369771-f9a4-48b7-b87b-463f19f7b187", "e4ce866a-f5b7-4774-8f9d-5eb74c3900cc", "oil change", "Auto Center", domain.Scheduled, 12000, time.Date(2022, time.March, 1, 8, 0, 0, 0, time.UTC))
+	return w
+}
+
+func ClearWorkOrderDB(t *testing.T, db *sqlx.DB) {
+	t.Helper()
+	const deleteAllParts = "DELETE FROM wparts"
+	const deleteAllWorkOrders = "DELETE FROM workorders"
+
+	if _, err := db.Exec(deleteAllParts); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec(deleteAllWorkOrders); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestWorkOrderRepositorySqlx_Save(t *testing.T) {
+	db := GetCarDBConn(t)
+	defer db.Close()
+
+	defer ClearWorkOrderDB(t, db)
+
+	dispatcher := &dispatcherMock{calls: make(map[string]uint)}
+	repo := NewWorkOrderRepositorySqlx(context.Background(), db, dispatcher)
+
+	completed := newWorkOrderFixture()
+	completed.Complete(completed.DateStart.Add(24*time.Hour), 150, []domain.Part{{Name: "oil filter", Quantity: 1, Price: 50}}, 12010)
+
+	invalid := newWorkOrderFixture()
+	invalid.ID = ""
+
+	testCases := []struct {
+		name          string
+		workOrderArg  domain.WorkOrder
+		wantError     error
+		wantParts     int
+		wantDispCalls uint
+	}{
+		{
+			name:          "correct input",
+			workOrderArg:  *newWorkOrderFixture(),
+			wantError:     nil,
+			wantParts:     0,
+			wantDispCalls: 0,
+		},
+		{
+			name:          "correct completed input",
+			workOrderArg:  *completed,
+			wantError:     nil,
+			wantParts:     1,
+			wantDispCalls: 1,
+		},
+		{
+			name:          "incorrect work order input",
+			workOrderArg:  *invalid,
+			wantError:     application.ErrInvalidWorkOrder,
+			wantDispCalls: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			dispatcher.calls["Dispatch"] = 0
+
+			err := repo.Save(tc.workOrderArg)
+
+			if !errors.Is(err, tc.wantError) {
+				t.Error(err)
+			}
+
+			if dispatcher.calls["Dispatch"] != tc.wantDispCalls {
+				t.Error("invalid dispatcher call", dispatcher.calls["Dispatch"])
+			}
+
+			if err != nil {
+				return
+			}
+
+			w, err := repo.FindOne(tc.workOrderArg.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(w.Parts) != tc.wantParts || w.Status != tc.workOrderArg.Status {
+				t.Error("unexpected result", w)
+			}
+		})
+	}
+}
+
+func TestWorkOrderRepositorySqlx_SaveWithCar(t *testing.T) {
+	db := GetCarDBConn(t)
+	defer db.Close()
+
+	defer ClearCarDB(t, db)
+	defer ClearWorkOrderDB(t, db)
+
+	repo := NewWorkOrderRepositorySqlx(context.Background(), db, &dispatcherMock{calls: make(map[string]uint)})
+	carRepo := NewCarRepositorySqlx(context.Background(), db, events.NewEventDispatcher())
+
+	invalid := newWorkOrderFixture()
+	invalid.Reason = ""
+
+	testCases := []struct {
+		name         string
+		workOrderArg domain.WorkOrder
+		wantError    error
+		wantCarSaved bool
+	}{
+		{
+			name:         "correct input",
+			workOrderArg: *newWorkOrderFixture(),
+			wantError:    nil,
+			wantCarSaved: true,
+		},
+		{
+			name:         "incorrect work order input",
+			workOrderArg: *invalid,
+			wantError:    application.ErrInvalidWorkOrder,
+			wantCarSaved: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ClearCarDB(t, db)
+
+			car := newCarFixture()
+			car.ToMaintenance(car.StationId, car.KM)
+
+			err := repo.SaveWithCar(tc.workOrderArg, *car)
+
+			if !errors.Is(err, tc.wantError) {
+				t.Error(err)
+			}
+
+			_, err = carRepo.FindOne(car.ID)
+			if (err == nil) != tc.wantCarSaved {
+				t.Error("unexpected car result", err)
+			}
+		})
+	}
+}
+
+func TestWorkOrderRepositorySqlx_FindByCar(t *testing.T) {
+	db := GetCarDBConn(t)
+	defer db.Close()
+
+	defer ClearWorkOrderDB(t, db)
+
+	repo := NewWorkOrderRepositorySqlx(context.Background(), db, events.NewEventDispatcher())
+
+	workOrders := []domain.WorkOrder{*newWorkOrderFixture(), *newWorkOrderFixture()}
+	for _, w := range workOrders {
+		if err := repo.Save(w); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		carIdArg string
+		wantLen  int
+	}{
+		{
+			name:     "correct input",
+			carIdArg: workOrders[0].CarId,
+			wantLen:  2,
+		},
+		{
+			name:     "car without history",
+			carIdArg: "5ce5a1a1-f324-4c8b-8c92-d7e820cbb238",
+			wantLen:  0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := repo.FindByCar(tc.carIdArg)
+
+			if len(w) != tc.wantLen {
+				t.Error("unexpected result", w)
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package application
+
+import (
+	"time"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/domain"
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/validation"
+)
+
+type AssignmentUseCase interface {
+	GetAssignmentCandidates(stationId, modelId string) ([]domain.AssignmentCandidate, error)
+	AssignCar(stationId, modelId string, upgrade bool) (*domain.Car, error)
+	AssignCars(stationId string, modelIds []string) ([]domain.Car, error)
+}
+
+type assignmentUseCase struct {
+	carRepo       CarReadRepository
+	occupancyRepo OccupancyReadRepository
+	maintenanceUC MaintenanceUseCase
+	documentUC    DocumentUseCase
+	strategy      AssignmentStrategy
+	upgrades      UpgradeCatalog
+}
+
+func NewAssignmentUseCase(
+	carRepo CarReadRepository,
+	occupancyRepo OccupancyReadRepository,
+	maintenanceUC MaintenanceUseCase,
+	documentUC DocumentUseCase,
+	strategy AssignmentStrategy,
+	upgrades UpgradeCatalog,
+) *assignmentUseCase {
+	return &assignmentUseCase{
+		carRepo:       carRepo,
+		occupancyRepo: occupancyRepo,
+		maintenanceUC: maintenanceUC,
+		documentUC:    documentUC,
+		strategy:      strategy,
+		upgrades:      upgrades,
+	}
+}
+
+func (uc assignmentUseCase) GetAssignmentCandidates(stationId, modelId string) ([]domain.AssignmentCandidate, error) {
+	if err := validation.ValidId(stationId); err != nil {
+		return nil, ErrInvalidId
+	}
+
+	if err := validation.ValidId(modelId); err != nil {
+		return nil, ErrInvalidModel
+	}
+
+	return uc.strategy.Rank(uc.eligibleCars(stationId, modelId), time.Now()), nil
+}
+
+func (uc assignmentUseCase) AssignCar(stationId, modelId string, upgrade bool) (*domain.Car, error) {
+	return uc.assign(stationId, modelId, upgrade, map[string]bool{})
+}
+
+func (uc assignmentUseCase) AssignCars(stationId string, modelIds []string) ([]domain.Car, error) {
+	if len(modelIds) == 0 {
+		return nil, ErrInvalidModel
+	}
+
+	cars := []domain.Car{}
+	taken := map[string]bool{}
+	for _, modelId := range modelIds {
+		car, err := uc.assign(stationId, modelId, false, taken)
+		if err != nil {
+			return nil, err
+		}
+
+		taken[car.ID] = true
+		cars = append(cars, *car)
+	}
+
+	return cars, nil
+}
+
+func (uc assignmentUseCase) assign(stationId, modelId string, upgrade bool, taken map[string]bool) (*domain.Car, error) {
+	candidates, err := uc.GetAssignmentCandidates(stationId, modelId)
+	if err != nil {
+		return nil, err
+	}
+	candidates = untaken(candidates, taken)
+
+	if len(candidates) == 0 && upgrade && uc.upgrades != nil {
+		models, err := uc.upgrades.GetUpgradeModels(modelId)
+		if err != nil {
+			return nil, ErrNotFoundCar
+		}
+
+		for _, m := range models {
+			if candidates = untaken(uc.strategy.Rank(uc.eligibleCars(stationId, m), time.Now()), taken); len(candidates) > 0 {
+				break
+			}
+		}
+	}
+
+	if len(candidates) == 0 {
+		return nil, ErrNotFoundCar
+	}
+
+	return &candidates[0].Car, nil
+}
+
+func untaken(candidates []domain.AssignmentCandidate, taken map[string]bool) []domain.AssignmentCandidate {
+	left := []domain.AssignmentCandidate{}
+	for _, c := range candidates {
+		if !taken[c.Car.ID] {
+			left = append(left, c)
+		}
+	}
+	return left
+}
+
+func (uc assignmentUseCase) eligibleCars(stationId, modelId string) []domain.AssignmentCandidate {
+	idleSince := map[string]time.Time{}
+	for _, e := range uc.occupancyRepo.FindByStation(stationId) {
+		if e.Movement == domain.Arrived && e.CarId != "" && e.Date.After(idleSince[e.CarId]) {
+			idleSince[e.CarId] = e.Date
+		}
+	}
+
+	candidates := []domain.AssignmentCandidate{}
+	for _, car := range uc.carRepo.Find(SearchCarParams{StationId: stationId, ModelId: modelId, Status: uint(domain.Parked)}) {
+		if overdue, err := uc.maintenanceUC.IsMaintenanceOverdue(car.ID); err != nil || overdue {
+			continue
+		}
+
+		if expired, err := uc.documentUC.HasExpiredMandatoryDocument(car.ID); err != nil || expired {
+			continue
+		}
+
+		candidates = append(candidates, domain.AssignmentCandidate{Car: car, IdleSince: idleSince[car.ID]})
+	}
+
+	return candidates
+}
@@ -0,0 +1,230 @@
+package application
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/domain"
+)
+
+type carSearchMock struct {
+	CarReadRepository
+	cars []domain.Car
+}
+
+func (m *carSearchMock) Find(search SearchCarParams) []domain.Car {
+	cars := []domain.Car{}
+	for _, c := range m.cars {
+		if search.Matches(c) {
+			cars = append(cars, c)
+		}
+	}
+	return cars
+}
+
+type maintenanceUseCaseMock struct {
+	MaintenanceUseCase
+	overdue map[string]bool
+}
+
+func (m *maintenanceUseCaseMock) IsMaintenanceOverdue(carId string) (bool, error) {
+	return m.overdue[carId], nil
+}
+
+type documentUseCaseMock struct {
+	DocumentUseCase
+	expired map[string]bool
+}
+
+func (m *documentUseCaseMock) HasExpiredMandatoryDocument(carId string) (bool, error) {
+	return m.expired[carId], nil
+}
+
+type upgradeCatalogMock struct {
+	expectedModels []string
+	calls          map[string]uint
+}
+
+func (m *upgradeCatalogMock) GetUpgradeModels(modelId string) ([]string, error) {
+	m.calls["GetUpgradeModels"] = m.calls["GetUpgradeModels"] + 1
+	return m.expectedModels, nil
+}
+
+func TestAssignmentUseCase_AssignCar(t *testing.T) {
+	const upgradeModelId = "6f0c1d2e-8a4b-4c3d-9e5f-1a2b3c4d5e6f"
+
+	newCar := func(id, plate string, km uint64, status domain.CarStatus) domain.Car {
+		car := newCarFixture()
+		car.ID = id
+		car.Plate = plate
+		car.KM = km
+		car.Status = status
+		return *car
+	}
+
+	worn := newCar("0f6a3b1c-2d4e-4f5a-8b6c-7d8e9f0a1b2c", "AAA-0001", 60000, domain.Parked)
+	idle := newCar("1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d", "AAA-0002", 30000, domain.Parked)
+	overdue := newCar("2b3c4d5e-6f7a-4b8c-9d0e-1f2a3b4c5d6e", "AAA-0003", 1000, domain.Parked)
+	expired := newCar("3c4d5e6f-7a8b-4c9d-8e1f-2a3b4c5d6e7f", "AAA-0004", 2000, domain.Parked)
+	reserved := newCar("4d5e6f7a-8b9c-4d0e-9f2a-3b4c5d6e7f8a", "AAA-0005", 3000, domain.Reserved)
+	upgrade := newCar("5e6f7a8b-9c0d-4e1f-8a3b-4c5d6e7f8a9b", "BBB-0001", 5000, domain.Parked)
+	upgrade.ModelId = upgradeModelId
+
+	stationId := worn.StationId
+	entries := []domain.OccupancyEntry{
+		{StationId: stationId, CarId: worn.ID, Movement: domain.Arrived, Date: time.Now().Add(-48 * time.Hour)},
+		{StationId: stationId, CarId: idle.ID, Movement: domain.Arrived, Date: time.Now().Add(-2 * time.Hour)},
+	}
+
+	type args struct {
+		stationId string
+		upgrade   bool
+	}
+
+	type want struct {
+		carId       string
+		err         error
+		upgradeCall uint
+	}
+
+	testCases := []struct {
+		name     string
+		cars     []domain.Car
+		criteria []domain.AssignmentCriterion
+		args     args
+		want     want
+	}{
+		{
+			name:     "lowest km eligible car",
+			cars:     []domain.Car{worn, idle, overdue, expired, reserved},
+			criteria: []domain.AssignmentCriterion{domain.LowestKM},
+			args:     args{stationId: stationId},
+			want:     want{carId: idle.ID},
+		},
+		{
+			name:     "longest idle eligible car",
+			cars:     []domain.Car{worn, idle, overdue, expired, reserved},
+			criteria: []domain.AssignmentCriterion{domain.LongestIdle},
+			args:     args{stationId: stationId},
+			want:     want{carId: worn.ID},
+		},
+		{
+			name:     "upgraded car",
+			cars:     []domain.Car{overdue, reserved, upgrade},
+			criteria: []domain.AssignmentCriterion{domain.LowestKM},
+			args:     args{stationId: stationId, upgrade: true},
+			want:     want{carId: upgrade.ID, upgradeCall: 1},
+		},
+		{
+			name:     "no car without upgrade",
+			cars:     []domain.Car{overdue, reserved, upgrade},
+			criteria: []domain.AssignmentCriterion{domain.LowestKM},
+			args:     args{stationId: stationId},
+			want:     want{err: ErrNotFoundCar},
+		},
+		{
+			name:     "incorrect station id",
+			cars:     []domain.Car{worn},
+			criteria: []domain.AssignmentCriterion{domain.LowestKM},
+			args:     args{stationId: "invalid-id"},
+			want:     want{err: ErrInvalidId},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			upgrades := &upgradeCatalogMock{expectedModels: []string{upgradeModelId}, calls: make(map[string]uint)}
+			assignmentUC := NewAssignmentUseCase(
+				&carSearchMock{cars: tc.cars},
+				&occupancyRepositoryMock{expectedFindByStation: entries, calls: make(map[string]uint)},
+				&maintenanceUseCaseMock{overdue: map[string]bool{overdue.ID: true}},
+				&documentUseCaseMock{expired: map[string]bool{expired.ID: true}},
+				domain.NewCriteriaAssignment(tc.criteria...),
+				upgrades,
+			)
+			car, err := assignmentUC.AssignCar(tc.args.stationId, modelIdFixture, tc.args.upgrade)
+
+			if !errors.Is(err, tc.want.err) {
+				t.Error("unexpected error", err)
+			}
+
+			if tc.want.err == nil && (car == nil || car.ID != tc.want.carId) {
+				t.Error("unexpected car", car, tc.want.carId)
+			}
+
+			if upgrades.calls["GetUpgradeModels"] != tc.want.upgradeCall {
+				t.Error("invalid upgrade call", upgrades.calls["GetUpgradeModels"])
+			}
+		})
+	}
+}
+
+func TestAssignmentUseCase_AssignCars(t *testing.T) {
+	const otherModelId = "6f0c1d2e-8a4b-4c3d-9e5f-1a2b3c4d5e6f"
+
+	first := *newCarFixture()
+	second := *newCarFixture()
+	second.ID = "1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d"
+	second.Plate = "AAA-0002"
+	second.KM = 30000
+	other := *newCarFixture()
+	other.ID = "5e6f7a8b-9c0d-4e1f-8a3b-4c5d6e7f8a9b"
+	other.Plate = "BBB-0001"
+	other.ModelId = otherModelId
+
+	testCases := []struct {
+		name       string
+		modelIds   []string
+		wantCarIds []string
+		wantErr    error
+	}{
+		{
+			name:       "distinct cars of the same model",
+			modelIds:   []string{modelIdFixture, modelIdFixture},
+			wantCarIds: []string{first.ID, second.ID},
+		},
+		{
+			name:       "cars of different models",
+			modelIds:   []string{otherModelId, modelIdFixture},
+			wantCarIds: []string{other.ID, first.ID},
+		},
+		{
+			name:     "none when a model runs out",
+			modelIds: []string{otherModelId, otherModelId},
+			wantErr:  ErrNotFoundCar,
+		},
+		{
+			name:     "incorrect empty models",
+			modelIds: []string{},
+			wantErr:  ErrInvalidModel,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assignmentUC := NewAssignmentUseCase(
+				&carSearchMock{cars: []domain.Car{first, second, other}},
+				&occupancyRepositoryMock{calls: make(map[string]uint)},
+				&maintenanceUseCaseMock{},
+				&documentUseCaseMock{},
+				domain.NewCriteriaAssignment(domain.LowestKM),
+				nil,
+			)
+			cars, err := assignmentUC.AssignCars(first.StationId, tc.modelIds)
+
+			if !errors.Is(err, tc.wantErr) {
+				t.Error("unexpected error", err)
+			}
+
+			if len(cars) != len(tc.wantCarIds) {
+				t.Fatal("unexpected cars", cars)
+			}
+			for i, c := range cars {
+				if c.ID != tc.wantCarIds[i] {
+					t.Error("unexpected car", c.ID, tc.wantCarIds[i])
+				}
+			}
+		})
+	}
+}
@@ -1,6 +1,9 @@
 package application
 
 import (
+	"errors"
+	"strings"
+
 	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/domain"
 	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/events"
 	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/validation"
@@ -8,46 +11,165 @@ import (
 
 type CarUseCase interface {
 	SearchCars(search SearchCarParams) []domain.Car
+	CountCars(search SearchCarParams) int
 	GetCarById(id string) (*domain.Car, error)
-	AddCar(age uint16, km uint64, plate, document, stationId, model, make string) error
-	DeleteCar(id string) error
+	AddCar(age uint16, km uint64, plate, document, stationId, modelId string, fuelLevel uint8) error
 	MoveCarToMaintenance(id, stationId string, km uint64) error
-	ParkCar(id, stationId string, km uint64) error
+	ParkCar(id, stationId string, km uint64, fuelLevel *uint8) error
 	TransferCar(id, stationId string) error
-	SyncParkCar(id, stationId string, km uint64) error
+	ReleaseCarFromMaintenance(id, stationId string, km uint64) error
+	SyncParkCar(id, stationId string, km uint64, fuelLevel uint8) error
 	SyncCarToTransit(id string) error
 	SyncReserveCar(id string) error
+	ImportCars(rows []CarImportRow, dryRun bool) CarImportReport
 }
 
 type carUseCase struct {
 	carRepo     CarRepository
 	stationRepo StationReadRepository
+	catalog     ModelCatalog
 }
 
-func NewCarUseCase(carRepo CarRepository, stationRepo StationReadRepository) *carUseCase {
+func NewCarUseCase(carRepo CarRepository, stationRepo StationReadRepository, catalog ModelCatalog) *carUseCase {
 	return &carUseCase{
 		carRepo:     carRepo,
 		stationRepo: stationRepo,
+		catalog:     catalog,
 	}
 }
 
 type SearchCarParams struct {
-	Plate     string `json:"plate" db:"plate"`
-	Document  string `json:"document" db:"document"`
-	Model     string `json:"model" db:"model"`
-	Make      string `json:"make" db:"make"`
-	StationId string `json:"stationId" db:"stationId"`
-	Age       uint16 `json:"age" db:"age"`
-	KM        uint64 `json:"km" db:"km"`
-	Status    uint   `json:"status" db:"status"`
-	// Limit     uint
-	// Offset    uint
+	Plate      string   `json:"plate" db:"plate"`
+	Document   string   `json:"document" db:"document"`
+	ModelId    string   `json:"modelId" db:"modelId"`
+	Model      string   `json:"model" db:"model"`
+	Make       string   `json:"make" db:"make"`
+	StationId  string   `json:"stationId" db:"stationId"`
+	Age        uint16   `json:"age" db:"age"`
+	KM         uint64   `json:"km" db:"km"`
+	Status     uint     `json:"status" db:"status"`
+	MinFuel    uint8    `json:"minFuel" db:"fuelLevel"`
+	PlateLike  string   `json:"plateLike"`
+	StationIds []string `json:"stationIds"`
+	Statuses   []uint   `json:"statuses"`
+	MinAge     uint16   `json:"minAge"`
+	MaxAge     uint16   `json:"maxAge"`
+	MinKM      uint64   `json:"minKm"`
+	MaxKM      uint64   `json:"maxKm"`
+	Sort       string   `json:"sort"`
+	Limit      uint     `json:"limit"`
+	Offset     uint     `json:"offset"`
+}
+
+var CarSortFields = []string{"plate", "age", "km", "fuelLevel", "status", "make", "model"}
+
+func (s SearchCarParams) SortField() (string, bool) {
+	if strings.HasPrefix(s.Sort, "-") {
+		return s.Sort[1:], true
+	}
+	return s.Sort, false
+}
+
+func (s SearchCarParams) Valid() bool {
+	if s.MaxAge > 0 && s.MinAge > s.MaxAge {
+		return false
+	}
+	if s.MaxKM > 0 && s.MinKM > s.MaxKM {
+		return false
+	}
+
+	field, _ := s.SortField()
+	if field == "" {
+		return true
+	}
+	for _, f := range CarSortFields {
+		if f == field {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s SearchCarParams) Matches(car domain.Car) bool {
+	if s.Plate != "" && car.Plate != s.Plate {
+		return false
+	}
+	if s.PlateLike != "" && !strings.Contains(strings.ToLower(car.Plate), strings.ToLower(s.PlateLike)) {
+		return false
+	}
+	if s.Document != "" && car.Document != s.Document {
+		return false
+	}
+	if s.ModelId != "" && car.ModelId != s.ModelId {
+		return false
+	}
+	if s.Model != "" && car.Model != s.Model {
+		return false
+	}
+	if s.Make != "" && car.Make != s.Make {
+		return false
+	}
+	if s.StationId != "" && car.StationId != s.StationId {
+		return false
+	}
+	if len(s.StationIds) > 0 && !containsString(s.StationIds, car.StationId) {
+		return false
+	}
+	if s.Age > 0 && car.Age != s.Age {
+		return false
+	}
+	if s.MinAge > 0 && car.Age < s.MinAge {
+		return false
+	}
+	if s.MaxAge > 0 && car.Age > s.MaxAge {
+		return false
+	}
+	if s.KM > 0 && car.KM != s.KM {
+		return false
+	}
+	if s.MinKM > 0 && car.KM < s.MinKM {
+		return false
+	}
+	if s.MaxKM > 0 && car.KM > s.MaxKM {
+		return false
+	}
+	if s.Status > 0 && car.Status != domain.CarStatus(s.Status) {
+		return false
+	}
+	if len(s.Statuses) > 0 && !containsStatus(s.Statuses, car.Status) {
+		return false
+	}
+
+	return car.FuelLevel >= s.MinFuel
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func containsStatus(statuses []uint, status domain.CarStatus) bool {
+	for _, s := range statuses {
+		if domain.CarStatus(s) == status {
+			return true
+		}
+	}
+	return false
 }
 
 func (uc carUseCase) SearchCars(params SearchCarParams) []domain.Car {
 	return uc.carRepo.Find(params)
 }
 
+func (uc carUseCase) CountCars(params SearchCarParams) int {
+	return uc.carRepo.Count(params)
+}
+
 func (uc carUseCase) GetCarById(id string) (*domain.Car, error) {
 	if err := validation.ValidId(id); err != nil {
 		return nil, ErrInvalidId
@@ -62,7 +184,7 @@ func (uc carUseCase) GetCarById(id string) (*domain.Car, error) {
 	return car, nil
 }
 
-func (uc carUseCase) AddCar(age uint16, km uint64, plate, document, stationId, model, make string) error {
+func (uc carUseCase) AddCar(age uint16, km uint64, plate, document, stationId, modelId string, fuelLevel uint8) error {
 	s, err := uc.stationRepo.FindOne(stationId)
 	if err != nil {
 		return ErrInvalidEntity
@@ -72,7 +194,19 @@ func (uc carUseCase) AddCar(age uint16, km uint64, plate, document, stationId, m
 		return ErrStationMaxCapacity
 	}
 
-	newCar, err := domain.NewCar(age, km, plate, document, stationId, model, make)
+	if err := validation.ValidId(modelId); err != nil {
+		return ErrInvalidModel
+	}
+
+	make, model, energy, err := uc.catalog.GetModel(modelId)
+	if errors.Is(err, ErrNotFoundModel) {
+		return ErrNotFoundModel
+	}
+	if err != nil {
+		return err
+	}
+
+	newCar, err := domain.NewCar(age, km, plate, document, stationId, modelId, model, make, energy, fuelLevel)
 	if err != nil {
 		return ErrInvalidEntity
 	}
@@ -84,7 +218,7 @@ func (uc carUseCase) AddCar(age uint16, km uint64, plate, document, stationId, m
 	return nil
 }
 
-func (uc carUseCase) DeleteCar(id string) error {
+func (uc carUseCase) MoveCarToMaintenance(id, stationId string, km uint64) error {
 	if err := validation.ValidId(id); err != nil {
 		return ErrInvalidId
 	}
@@ -94,39 +228,57 @@ func (uc carUseCase) DeleteCar(id string) error {
 		return ErrInvalidCar
 	}
 
-	if car.Status != domain.Maintenance {
-		return ErrCarNotInMaintenance
+	if err := car.ToMaintenance(stationId, km); err != nil {
+		return ErrInvalidMaintenance
 	}
 
-	if err := uc.carRepo.Delete(id); err != nil {
+	if err := uc.carRepo.Save(*car); err != nil {
 		return ErrInvalidCar
 	}
 
 	return nil
 }
 
-func (uc carUseCase) MoveCarToMaintenance(id, stationId string, km uint64) error {
+func (uc carUseCase) ParkCar(id, stationId string, km uint64, fuelLevel *uint8) error {
 	if err := validation.ValidId(id); err != nil {
 		return ErrInvalidId
 	}
 
+	s, err := uc.stationRepo.FindOne(stationId)
+	if err != nil {
+		return ErrInvalidEntity
+	}
+
+	if (s.Capacity - s.Idle) == 0 {
+		return ErrStationMaxCapacity
+	}
+
 	car, err := uc.carRepo.FindOne(id)
 	if err != nil {
 		return ErrInvalidCar
 	}
 
-	if err := car.ToMaintenance(stationId, km); err != nil {
-		return ErrInvalidMaintenance
+	if km == 0 {
+		km = car.OdometerKM()
+	}
+
+	fuel := car.FuelLevel
+	if fuelLevel != nil {
+		fuel = *fuelLevel
+	}
+
+	if err := car.Park(stationId, km, fuel); err != nil {
+		return ErrInvalidPark
 	}
 
 	if err := uc.carRepo.Save(*car); err != nil {
-		return ErrInvalidCar
+		return err
 	}
 
 	return nil
 }
 
-func (uc carUseCase) ParkCar(id, stationId string, km uint64) error {
+func (uc carUseCase) TransferCar(id, stationId string) error {
 	if err := validation.ValidId(id); err != nil {
 		return ErrInvalidId
 	}
@@ -145,19 +297,18 @@ func (uc carUseCase) ParkCar(id, stationId string, km uint64) error {
 		return ErrInvalidCar
 	}
 
-	if err := car.Park(stationId, km); err != nil {
-		return ErrInvalidPark
+	if err := car.Transfer(stationId); err != nil {
+		return ErrInvalidTransfer
 	}
 
 	if err := uc.carRepo.Save(*car); err != nil {
-		return err
-		// return ErrInvalidCar
+		return ErrInvalidCar
 	}
 
 	return nil
 }
 
-func (uc carUseCase) TransferCar(id, stationId string) error {
+func (uc carUseCase) ReleaseCarFromMaintenance(id, stationId string, km uint64) error {
 	if err := validation.ValidId(id); err != nil {
 		return ErrInvalidId
 	}
@@ -176,8 +327,12 @@ func (uc carUseCase) TransferCar(id, stationId string) error {
 		return ErrInvalidCar
 	}
 
-	if err := car.Transfer(stationId); err != nil {
-		return ErrInvalidTransfer
+	if car.Status != domain.Maintenance {
+		return ErrCarNotInMaintenance
+	}
+
+	if err := car.Park(stationId, km, car.FuelLevel); err != nil {
+		return ErrInvalidPark
 	}
 
 	if err := uc.carRepo.Save(*car); err != nil {
@@ -187,7 +342,7 @@ func (uc carUseCase) TransferCar(id, stationId string) error {
 	return nil
 }
 
-func (uc carUseCase) SyncParkCar(id, stationId string, km uint64) error {
+func (uc carUseCase) SyncParkCar(id, stationId string, km uint64, fuelLevel uint8) error {
 	if err := validation.ValidId(id); err != nil {
 		return ErrInvalidId
 	}
@@ -206,7 +361,7 @@ func (uc carUseCase) SyncParkCar(id, stationId string, km uint64) error {
 		return ErrInvalidCar
 	}
 
-	if err := car.Park(stationId, km); err != nil {
+	if err := car.Park(stationId, km, fuelLevel); err != nil {
 		return ErrInvalidPark
 	}
 
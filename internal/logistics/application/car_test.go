@@ -14,6 +14,7 @@ func newCarFixture() *domain.Car {
 		Age:       2020,
 		Plate:     "KST-9016",
 		Document:  "abc.123.op-x",
+		ModelId:   modelIdFixture,
 		Model:     "Uno",
 		Make:      "FIAT",
 		StationId: "83369771-f9a4-48b7-b87b-463f19f7b187",
@@ -22,6 +23,19 @@ func newCarFixture() *domain.Car {
 	}
 }
 
+const modelIdFixture = "0b1b5f2a-3f4e-4b7b-9c41-7d8a6c1f2e3d"
+
+type modelCatalogMock struct {
+	expectedErr error
+}
+
+func (m *modelCatalogMock) GetModel(modelId string) (string, string, domain.EnergyType, error) {
+	if m.expectedErr != nil {
+		return "", "", 0, m.expectedErr
+	}
+	return "FIAT", "Uno", domain.Combustion, nil
+}
+
 type carRepositoryMock struct {
 	expectedFindAllCars []domain.Car
 	expectedFindOneCar  *domain.Car
@@ -36,6 +50,11 @@ func (m *carRepositoryMock) Find(search SearchCarParams) []domain.Car {
 	return m.expectedFindAllCars
 }
 
+func (m *carRepositoryMock) Count(search SearchCarParams) int {
+	m.calls["Count"] = m.calls["Count"] + 1
+	return len(m.expectedFindAllCars)
+}
+
 func (m *carRepositoryMock) FindOne(id string) (*domain.Car, error) {
 	m.calls["FindOne"] = m.calls["FindOne"] + 1
 	return m.expectedFindOneCar, m.expectedFindOneErr
@@ -46,6 +65,11 @@ func (m *carRepositoryMock) Save(car domain.Car) error {
 	return m.expectedSaveErr
 }
 
+func (m *carRepositoryMock) UpdateReportedKM(id string, km uint64) error {
+	m.calls["UpdateReportedKM"] = m.calls["UpdateReportedKM"] + 1
+	return m.expectedSaveErr
+}
+
 func (m *carRepositoryMock) Delete(id string) error {
 	m.calls["Delete"] = m.calls["Delete"] + 1
 	return m.expectedDeleteErr
@@ -127,7 +151,7 @@ func TestCarUseCase_GetCarById(t *testing.T) {
 				calls:              make(map[string]uint),
 			}
 			stationRepo := &stationRepositoryMock{}
-			carUC := NewCarUseCase(carRepo, stationRepo)
+			carUC := NewCarUseCase(carRepo, stationRepo, nil)
 			cars, err := carUC.GetCarById(tc.args.id)
 
 			if carRepo.calls["FindOne"] != tc.want.calls {
@@ -155,6 +179,7 @@ func TestCarUseCase_AddCar(t *testing.T) {
 		repoStation    *domain.Station
 		repoStationErr error
 		repoCarErr     error
+		catalogErr     error
 	}
 
 	type args struct {
@@ -163,8 +188,7 @@ func TestCarUseCase_AddCar(t *testing.T) {
 		plate     string
 		document  string
 		stationId string
-		model     string
-		make      string
+		modelId   string
 	}
 
 	type want struct {
@@ -192,8 +216,7 @@ func TestCarUseCase_AddCar(t *testing.T) {
 				plate:     "KST-9016",
 				document:  "abc.123.op-x",
 				stationId: newStation.ID,
-				model:     "Uno",
-				make:      "FIAT",
+				modelId:   modelIdFixture,
 			},
 			want: want{
 				err:          nil,
@@ -214,8 +237,7 @@ func TestCarUseCase_AddCar(t *testing.T) {
 				plate:     "KST-9016",
 				document:  "abc.123.op-x",
 				stationId: "invalid-id",
-				model:     "Uno",
-				make:      "FIAT",
+				modelId:   modelIdFixture,
 			},
 			want: want{
 				err:          ErrInvalidEntity,
@@ -236,8 +258,7 @@ func TestCarUseCase_AddCar(t *testing.T) {
 				plate:     "",
 				document:  "abc.123.op-x",
 				stationId: newStation.ID,
-				model:     "Uno",
-				make:      "FIAT",
+				modelId:   modelIdFixture,
 			},
 			want: want{
 				err:          ErrInvalidEntity,
@@ -246,9 +267,9 @@ func TestCarUseCase_AddCar(t *testing.T) {
 			},
 		},
 		{
-			name: "incorrect plate input",
+			name: "incorrect model id input",
 			setup: setup{
-				repoStation:    maxCapStation,
+				repoStation:    newStation,
 				repoStationErr: nil,
 				repoCarErr:     nil,
 			},
@@ -257,136 +278,56 @@ func TestCarUseCase_AddCar(t *testing.T) {
 				km:        12000,
 				plate:     "KST-9016",
 				document:  "abc.123.op-x",
-				stationId: maxCapStation.ID,
-				model:     "Uno",
-				make:      "FIAT",
+				stationId: newStation.ID,
+				modelId:   "Uno",
 			},
 			want: want{
-				err:          ErrStationMaxCapacity,
+				err:          ErrInvalidModel,
 				carCalls:     0,
 				stationCalls: 1,
 			},
 		},
-	}
-
-	for _, tc := range testCases {
-		t.Run(tc.name, func(t *testing.T) {
-			carRepo := &carRepositoryMock{
-				expectedSaveErr: tc.setup.repoCarErr,
-				calls:           make(map[string]uint),
-			}
-			stationRepo := &stationRepositoryMock{
-				expectedFindOneStation: tc.setup.repoStation,
-				expectedFindOneErr:     tc.setup.repoStationErr,
-				calls:                  make(map[string]uint),
-			}
-			carUC := NewCarUseCase(carRepo, stationRepo)
-			err := carUC.AddCar(tc.args.age, tc.args.km, tc.args.plate, tc.args.document, tc.args.stationId, tc.args.model, tc.args.make)
-
-			if stationRepo.calls["FindOne"] != tc.want.stationCalls {
-				t.Error("invalid repo call", stationRepo.calls["FindOne"])
-			}
-			if carRepo.calls["Save"] != tc.want.carCalls {
-				t.Error("invalid repo call", carRepo.calls["Save"])
-			}
-
-			if !errors.Is(err, tc.want.err) {
-				t.Error("unexpected error", err)
-			}
-		})
-	}
-}
-
-func TestCarUseCase_DeleteCar(t *testing.T) {
-	newCar := newCarFixture()
-	newCar.Status = domain.Maintenance
-
-	activeCar := newCarFixture()
-
-	type setup struct {
-		repoFindCar *domain.Car
-		repoFindErr error
-		repoDelErr  error
-	}
-
-	type args struct {
-		id string
-	}
-
-	type want struct {
-		err         error
-		findCalls   uint
-		deleteCalls uint
-	}
-
-	testCases := []struct {
-		name  string
-		setup setup
-		args  args
-		want  want
-	}{
 		{
-			name: "correct input",
+			name: "model not in catalog input",
 			setup: setup{
-				repoFindCar: newCar,
-				repoFindErr: nil,
-				repoDelErr:  nil,
-			},
-			args: args{
-				id: newCar.ID,
-			},
-			want: want{
-				err:         nil,
-				findCalls:   1,
-				deleteCalls: 1,
-			},
-		},
-		{
-			name: "incorrect id input",
-			setup: setup{
-				repoFindCar: nil,
-				repoFindErr: nil,
-				repoDelErr:  nil,
-			},
-			args: args{
-				id: "invalid-id",
-			},
-			want: want{
-				err:         ErrInvalidId,
-				findCalls:   0,
-				deleteCalls: 0,
-			},
-		},
-		{
-			name: "not found car",
-			setup: setup{
-				repoFindCar: nil,
-				repoFindErr: ErrInvalidCar,
-				repoDelErr:  nil,
+				repoStation:    newStation,
+				repoStationErr: nil,
+				repoCarErr:     nil,
+				catalogErr:     ErrNotFoundModel,
 			},
 			args: args{
-				id: "35098f2d-6351-4509-87a2-896bab961a25",
+				age:       2020,
+				km:        12000,
+				plate:     "KST-9016",
+				document:  "abc.123.op-x",
+				stationId: newStation.ID,
+				modelId:   modelIdFixture,
 			},
 			want: want{
-				err:         ErrInvalidCar,
-				findCalls:   1,
-				deleteCalls: 0,
+				err:          ErrNotFoundModel,
+				carCalls:     0,
+				stationCalls: 1,
 			},
 		},
 		{
-			name: "incorrect car status",
+			name: "incorrect plate input",
 			setup: setup{
-				repoFindCar: activeCar,
-				repoFindErr: nil,
-				repoDelErr:  nil,
+				repoStation:    maxCapStation,
+				repoStationErr: nil,
+				repoCarErr:     nil,
 			},
 			args: args{
-				id: activeCar.ID,
+				age:       2020,
+				km:        12000,
+				plate:     "KST-9016",
+				document:  "abc.123.op-x",
+				stationId: maxCapStation.ID,
+				modelId:   modelIdFixture,
 			},
 			want: want{
-				err:         ErrCarNotInMaintenance,
-				findCalls:   1,
-				deleteCalls: 0,
+				err:          ErrStationMaxCapacity,
+				carCalls:     0,
+				stationCalls: 1,
 			},
 		},
 	}
@@ -394,25 +335,27 @@ func TestCarUseCase_DeleteCar(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			carRepo := &carRepositoryMock{
-				expectedFindOneCar: tc.setup.repoFindCar,
-				expectedFindOneErr: tc.setup.repoFindErr,
-				expectedDeleteErr:  tc.setup.repoDelErr,
-				calls:              make(map[string]uint),
+				expectedSaveErr: tc.setup.repoCarErr,
+				calls:           make(map[string]uint),
 			}
-			stationRepo := &stationRepositoryMock{}
-			carUC := NewCarUseCase(carRepo, stationRepo)
-			err := carUC.DeleteCar(tc.args.id)
-
-			if carRepo.calls["FindOne"] != tc.want.findCalls {
-				t.Error("invalid repo call", carRepo.calls["FindOne"])
+			stationRepo := &stationRepositoryMock{
+				expectedFindOneStation: tc.setup.repoStation,
+				expectedFindOneErr:     tc.setup.repoStationErr,
+				calls:                  make(map[string]uint),
 			}
+			catalog := &modelCatalogMock{expectedErr: tc.setup.catalogErr}
+			carUC := NewCarUseCase(carRepo, stationRepo, catalog)
+			err := carUC.AddCar(tc.args.age, tc.args.km, tc.args.plate, tc.args.document, tc.args.stationId, tc.args.modelId, domain.FullLevel)
 
-			if carRepo.calls["Delete"] != tc.want.deleteCalls {
-				t.Error("invalid repo call", carRepo.calls["Delete"])
+			if stationRepo.calls["FindOne"] != tc.want.stationCalls {
+				t.Error("invalid repo call", stationRepo.calls["FindOne"])
+			}
+			if carRepo.calls["Save"] != tc.want.carCalls {
+				t.Error("invalid repo call", carRepo.calls["Save"])
 			}
 
 			if !errors.Is(err, tc.want.err) {
-				t.Error("unexpected error", err, tc.want.err)
+				t.Error("unexpected error", err)
 			}
 		})
 	}
@@ -531,7 +474,7 @@ func TestCarUseCase_MoveCarToMaintenance(t *testing.T) {
 				calls:              make(map[string]uint),
 			}
 			stationRepo := &stationRepositoryMock{}
-			carUC := NewCarUseCase(carRepo, stationRepo)
+			carUC := NewCarUseCase(carRepo, stationRepo, nil)
 			err := carUC.MoveCarToMaintenance(tc.args.id, tc.args.stationId, tc.args.km)
 
 			if carRepo.calls["FindOne"] != tc.want.findCalls {
@@ -558,6 +501,9 @@ func TestCarUseCase_ParkCar(t *testing.T) {
 	maxCapStation := newStationFixture()
 	maxCapStation.Idle = maxCapStation.Capacity
 
+	trackedCar := newCarFixture()
+	trackedCar.Status = domain.Transfer
+
 	type setup struct {
 		repoFindStation    *domain.Station
 		repoFindErrStation error
@@ -606,6 +552,24 @@ func TestCarUseCase_ParkCar(t *testing.T) {
 				saveCarCalls:     1,
 			},
 		},
+		{
+			name: "km from telemetry input",
+			setup: setup{
+				repoFindStation: newStation,
+				repoFindCar:     trackedCar,
+			},
+			args: args{
+				id:        trackedCar.ID,
+				stationId: trackedCar.StationId,
+				km:        0,
+			},
+			want: want{
+				err:              nil,
+				findStationCalls: 1,
+				findCarCalls:     1,
+				saveCarCalls:     1,
+			},
+		},
 		{
 			name: "incorrect id input",
 			setup: setup{
@@ -726,8 +690,9 @@ func TestCarUseCase_ParkCar(t *testing.T) {
 				expectedFindOneErr:     tc.setup.repoFindErrStation,
 				calls:                  make(map[string]uint),
 			}
-			carUC := NewCarUseCase(carRepo, stationRepo)
-			err := carUC.ParkCar(tc.args.id, tc.args.stationId, tc.args.km)
+			carUC := NewCarUseCase(carRepo, stationRepo, nil)
+			fuel := domain.FullLevel
+			err := carUC.ParkCar(tc.args.id, tc.args.stationId, tc.args.km, &fuel)
 
 			if stationRepo.calls["FindOne"] != tc.want.findStationCalls {
 				t.Error("invalid repo call", carRepo.calls["FindOne"])
@@ -917,7 +882,7 @@ func TestCarUseCase_TransferCar(t *testing.T) {
 				expectedFindOneErr:     tc.setup.repoFindErrStation,
 				calls:                  make(map[string]uint),
 			}
-			carUC := NewCarUseCase(carRepo, stationRepo)
+			carUC := NewCarUseCase(carRepo, stationRepo, nil)
 			err := carUC.TransferCar(tc.args.id, tc.args.stationId)
 
 			if stationRepo.calls["FindOne"] != tc.want.findStationCalls {
@@ -0,0 +1,152 @@
+package application
+
+import (
+	"errors"
+	"time"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/domain"
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/validation"
+)
+
+type DocumentUseCase interface {
+	GetCarDocuments(carId string) ([]domain.CarDocument, error)
+	AddCarDocument(carId, number string, documentType domain.DocumentType, issuedAt, expiresAt time.Time, attachments []domain.Attachment) error
+	RenewCarDocument(id, number string, issuedAt, expiresAt time.Time, attachments []domain.Attachment) error
+	GetExpiringDocuments(within uint) []domain.DocumentExpiry
+	FlagExpiringDocuments(within uint) ([]domain.DocumentExpiry, error)
+	HasExpiredMandatoryDocument(carId string) (bool, error)
+}
+
+type documentUseCase struct {
+	documentRepo DocumentRepository
+	carRepo      CarReadRepository
+}
+
+func NewDocumentUseCase(documentRepo DocumentRepository, carRepo CarReadRepository) *documentUseCase {
+	return &documentUseCase{
+		documentRepo: documentRepo,
+		carRepo:      carRepo,
+	}
+}
+
+func (uc documentUseCase) GetCarDocuments(carId string) ([]domain.CarDocument, error) {
+	if err := validation.ValidId(carId); err != nil {
+		return nil, ErrInvalidId
+	}
+
+	if _, err := uc.carRepo.FindOne(carId); err != nil {
+		return nil, ErrNotFoundCar
+	}
+
+	return uc.documentRepo.FindByCar(carId), nil
+}
+
+func (uc documentUseCase) AddCarDocument(carId, number string, documentType domain.DocumentType, issuedAt, expiresAt time.Time, attachments []domain.Attachment) error {
+	if err := validation.ValidId(carId); err != nil {
+		return ErrInvalidId
+	}
+
+	if _, err := uc.carRepo.FindOne(carId); err != nil {
+		return ErrNotFoundCar
+	}
+
+	document, err := domain.NewCarDocument(carId, number, documentType, issuedAt, expiresAt, attachments)
+	if errors.Is(err, domain.ErrInvalidDocument) {
+		return ErrInvalidDocument
+	}
+	if err != nil {
+		return ErrInvalidEntity
+	}
+
+	if err := uc.documentRepo.Save(*document); err != nil {
+		return ErrInvalidDocument
+	}
+
+	return nil
+}
+
+func (uc documentUseCase) RenewCarDocument(id, number string, issuedAt, expiresAt time.Time, attachments []domain.Attachment) error {
+	if err := validation.ValidId(id); err != nil {
+		return ErrInvalidId
+	}
+
+	document, err := uc.documentRepo.FindOne(id)
+	if err != nil {
+		return ErrNotFoundDocument
+	}
+
+	if err := document.Renew(number, issuedAt, expiresAt, attachments); err != nil {
+		return ErrInvalidDocument
+	}
+
+	if err := uc.documentRepo.Save(*document); err != nil {
+		return ErrInvalidDocument
+	}
+
+	return nil
+}
+
+func (uc documentUseCase) GetExpiringDocuments(within uint) []domain.DocumentExpiry {
+	now := time.Now()
+	expiries := []domain.DocumentExpiry{}
+
+	for _, document := range domain.CurrentDocuments(uc.documentRepo.FindAll()) {
+		if !document.ExpiresWithin(now, within) {
+			continue
+		}
+
+		car, err := uc.carRepo.FindOne(document.CarId)
+		if err != nil {
+			continue
+		}
+
+		expiries = append(expiries, document.Expiry(*car, now))
+	}
+
+	return expiries
+}
+
+func (uc documentUseCase) FlagExpiringDocuments(within uint) ([]domain.DocumentExpiry, error) {
+	now := time.Now()
+	flagged := []domain.DocumentExpiry{}
+
+	for _, document := range domain.CurrentDocuments(uc.documentRepo.FindAll()) {
+		if !document.Flag(now, within) {
+			continue
+		}
+
+		car, err := uc.carRepo.FindOne(document.CarId)
+		if err != nil {
+			continue
+		}
+
+		if err := uc.documentRepo.Save(document); err != nil {
+			return flagged, ErrInvalidDocument
+		}
+
+		flagged = append(flagged, document.Expiry(*car, now))
+	}
+
+	return flagged, nil
+}
+
+func (uc documentUseCase) HasExpiredMandatoryDocument(carId string) (bool, error) {
+	if err := validation.ValidId(carId); err != nil {
+		return false, ErrInvalidId
+	}
+
+	current := make(map[domain.DocumentType]domain.CarDocument)
+	for _, document := range domain.CurrentDocuments(uc.documentRepo.FindByCar(carId)) {
+		current[document.Type] = document
+	}
+
+	now := time.Now()
+	for _, documentType := range domain.MandatoryDocuments {
+		document, ok := current[documentType]
+		if !ok || document.Expired(now) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
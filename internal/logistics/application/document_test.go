@@ -0,0 +1,228 @@
+package application
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/domain"
+)
+
+type documentRepositoryMock struct {
+	expectedFindDocuments []domain.CarDocument
+	expectedFindOne       *domain.CarDocument
+	expectedFindOneErr    error
+	expectedSaveErr       error
+	calls                 map[string]uint
+}
+
+func (m *documentRepositoryMock) FindAll() []domain.CarDocument {
+	m.calls["FindAll"] = m.calls["FindAll"] + 1
+	return m.expectedFindDocuments
+}
+
+func (m *documentRepositoryMock) FindByCar(carId string) []domain.CarDocument {
+	m.calls["FindByCar"] = m.calls["FindByCar"] + 1
+	return m.expectedFindDocuments
+}
+
+func (m *documentRepositoryMock) FindOne(id string) (*domain.CarDocument, error) {
+	m.calls["FindOne"] = m.calls["FindOne"] + 1
+	return m.expectedFindOne, m.expectedFindOneErr
+}
+
+func (m *documentRepositoryMock) Save(document domain.CarDocument) error {
+	m.calls["Save"] = m.calls["Save"] + 1
+	return m.expectedSaveErr
+}
+
+func newCarDocumentFixture(documentType domain.DocumentType, expiresAt time.Time) domain.CarDocument {
+	return domain.CarDocument{
+		ID:          "0d5f6c9a-3b2e-4c7d-8e1f-2a3b4c5d6e7f",
+		CarId:       newCarFixture().ID,
+		Type:        documentType,
+		Number:      "DOC-1",
+		IssuedAt:    expiresAt.AddDate(-1, 0, 0),
+		ExpiresAt:   expiresAt,
+		Attachments: []domain.Attachment{},
+	}
+}
+
+func TestDocumentUseCase_AddCarDocument(t *testing.T) {
+	issuedAt := time.Now()
+
+	type setup struct {
+		repoFindCar    *domain.Car
+		repoFindCarErr error
+	}
+
+	type args struct {
+		carId     string
+		docType   domain.DocumentType
+		expiresAt time.Time
+	}
+
+	type want struct {
+		err       error
+		saveCalls uint
+	}
+
+	testCases := []struct {
+		name  string
+		setup setup
+		args  args
+		want  want
+	}{
+		{
+			name:  "correct input",
+			setup: setup{repoFindCar: newCarFixture()},
+			args:  args{carId: newCarFixture().ID, docType: domain.Registration, expiresAt: issuedAt.AddDate(1, 0, 0)},
+			want:  want{err: nil, saveCalls: 1},
+		},
+		{
+			name:  "incorrect id input",
+			setup: setup{},
+			args:  args{carId: "invalid-id", docType: domain.Registration, expiresAt: issuedAt.AddDate(1, 0, 0)},
+			want:  want{err: ErrInvalidId, saveCalls: 0},
+		},
+		{
+			name:  "not found car",
+			setup: setup{repoFindCarErr: ErrNotFoundCar},
+			args:  args{carId: newCarFixture().ID, docType: domain.Registration, expiresAt: issuedAt.AddDate(1, 0, 0)},
+			want:  want{err: ErrNotFoundCar, saveCalls: 0},
+		},
+		{
+			name:  "incorrect expiry input",
+			setup: setup{repoFindCar: newCarFixture()},
+			args:  args{carId: newCarFixture().ID, docType: domain.Registration, expiresAt: issuedAt.AddDate(-1, 0, 0)},
+			want:  want{err: ErrInvalidDocument, saveCalls: 0},
+		},
+		{
+			name:  "incorrect type input",
+			setup: setup{repoFindCar: newCarFixture()},
+			args:  args{carId: newCarFixture().ID, docType: domain.DocumentType(0), expiresAt: issuedAt.AddDate(1, 0, 0)},
+			want:  want{err: ErrInvalidEntity, saveCalls: 0},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			documentRepo := &documentRepositoryMock{calls: make(map[string]uint)}
+			carRepo := &carRepositoryMock{
+				expectedFindOneCar: tc.setup.repoFindCar,
+				expectedFindOneErr: tc.setup.repoFindCarErr,
+				calls:              make(map[string]uint),
+			}
+			documentUC := NewDocumentUseCase(documentRepo, carRepo)
+
+			err := documentUC.AddCarDocument(tc.args.carId, "DOC-1", tc.args.docType, issuedAt, tc.args.expiresAt, nil)
+
+			if !errors.Is(err, tc.want.err) {
+				t.Error("unexpected error", err)
+			}
+
+			if documentRepo.calls["Save"] != tc.want.saveCalls {
+				t.Error("invalid repo call", documentRepo.calls["Save"])
+			}
+		})
+	}
+}
+
+func TestDocumentUseCase_FlagExpiringDocuments(t *testing.T) {
+	now := time.Now()
+	flagged := newCarDocumentFixture(domain.Registration, now.AddDate(0, 0, 5))
+	flagged.FlaggedAt = &now
+
+	documentRepo := &documentRepositoryMock{
+		expectedFindDocuments: []domain.CarDocument{
+			newCarDocumentFixture(domain.InsurancePolicy, now.AddDate(0, 0, 10)),
+			newCarDocumentFixture(domain.InspectionCertificate, now.AddDate(0, 3, 0)),
+			flagged,
+		},
+		calls: make(map[string]uint),
+	}
+	carRepo := &carRepositoryMock{expectedFindOneCar: newCarFixture(), calls: make(map[string]uint)}
+	documentUC := NewDocumentUseCase(documentRepo, carRepo)
+
+	expiries, err := documentUC.FlagExpiringDocuments(30)
+	if err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	if len(expiries) != 1 || expiries[0].Type != domain.InsurancePolicy || expiries[0].Plate != newCarFixture().Plate {
+		t.Error("unexpected flagged documents", expiries)
+	}
+
+	if documentRepo.calls["Save"] != 1 {
+		t.Error("invalid repo call", documentRepo.calls["Save"])
+	}
+
+	if expiries := documentUC.GetExpiringDocuments(30); len(expiries) != 2 {
+		t.Error("unexpected expiring documents", expiries)
+	}
+}
+
+func TestDocumentUseCase_HasExpiredMandatoryDocument(t *testing.T) {
+	now := time.Now()
+	renewed := newCarDocumentFixture(domain.InsurancePolicy, now.AddDate(1, 0, 0))
+	renewed.ID = "9a8b7c6d-5e4f-4a3b-9c1d-0e9f8a7b6c5d"
+
+	registration := newCarDocumentFixture(domain.Registration, now.AddDate(0, 1, 0))
+	inspection := newCarDocumentFixture(domain.InspectionCertificate, now.AddDate(0, 1, 0))
+
+	testCases := []struct {
+		name      string
+		documents []domain.CarDocument
+		want      bool
+	}{
+		{
+			name:      "valid documents",
+			documents: []domain.CarDocument{registration, newCarDocumentFixture(domain.InsurancePolicy, now.AddDate(0, 1, 0)), inspection},
+			want:      false,
+		},
+		{
+			name:      "expired mandatory document",
+			documents: []domain.CarDocument{registration, newCarDocumentFixture(domain.InsurancePolicy, now.AddDate(0, 0, -1)), inspection},
+			want:      true,
+		},
+		{
+			name:      "missing mandatory document",
+			documents: []domain.CarDocument{registration, inspection},
+			want:      true,
+		},
+		{
+			name:      "no documents",
+			documents: []domain.CarDocument{},
+			want:      true,
+		},
+		{
+			name: "expired optional document",
+			documents: []domain.CarDocument{
+				registration, newCarDocumentFixture(domain.InsurancePolicy, now.AddDate(0, 1, 0)), inspection,
+				newCarDocumentFixture(domain.EmissionsCertificate, now.AddDate(0, 0, -1)),
+			},
+			want: false,
+		},
+		{
+			name:      "renewed mandatory document",
+			documents: []domain.CarDocument{registration, newCarDocumentFixture(domain.InsurancePolicy, now.AddDate(0, 0, -1)), renewed, inspection},
+			want:      false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			documentRepo := &documentRepositoryMock{expectedFindDocuments: tc.documents, calls: make(map[string]uint)}
+			documentUC := NewDocumentUseCase(documentRepo, &carRepositoryMock{calls: make(map[string]uint)})
+
+			expired, err := documentUC.HasExpiredMandatoryDocument(newCarFixture().ID)
+			if err != nil {
+				t.Fatal("unexpected error", err)
+			}
+
+			if expired != tc.want {
+				t.Error("unexpected expired", expired)
+			}
+		})
+	}
+}
@@ -11,13 +11,17 @@ var (
 	ErrInvalidId      = errors.New("invalid id")
 	ErrStationHasCars = errors.New("station has already cars")
 
-	ErrInvalidEntity   = fmt.Errorf("%w", domain.ErrInvalidEntity)
-	ErrInvalidCapacity = fmt.Errorf("%w", domain.ErrInvalidCapacity)
-	ErrInvalidCurrCars = fmt.Errorf("%w", domain.ErrInvalidCurrCars)
-	ErrInvalidIdle     = fmt.Errorf("%w", domain.ErrInvalidIdle)
+	ErrInvalidEntity    = fmt.Errorf("%w", domain.ErrInvalidEntity)
+	ErrInvalidCapacity  = fmt.Errorf("%w", domain.ErrInvalidCapacity)
+	ErrInvalidCurrCars  = fmt.Errorf("%w", domain.ErrInvalidCurrCars)
+	ErrInvalidIdle      = fmt.Errorf("%w", domain.ErrInvalidIdle)
+	ErrInvalidLocation  = fmt.Errorf("%w", domain.ErrInvalidLocation)
+	ErrInvalidSchedule  = fmt.Errorf("%w", domain.ErrInvalidSchedule)
+	ErrInvalidOccupancy = fmt.Errorf("%w", domain.ErrInvalidOccupancy)
 
 	ErrNotFoundStation = errors.New("station not found")
 	ErrInvalidStation  = errors.New("invalid station")
+	ErrNotFoundCep     = errors.New("cep not found")
 
 	ErrStationMaxCapacity  = errors.New("station with max capacity")
 	ErrCarNotInMaintenance = errors.New("car is not in maintenance")
@@ -26,10 +30,34 @@ var (
 	ErrInvalidModel        = errors.New("invalid model")
 	ErrNotFoundModel       = errors.New("model not found")
 	ErrModelHasCars        = errors.New("model has already cars")
+	ErrDuplicatedPlate     = errors.New("duplicated car plate")
 
 	ErrInvalidMaintenance = fmt.Errorf("%w", domain.ErrInvalidMaintenance)
 	ErrInvalidTransit     = fmt.Errorf("%w", domain.ErrInvalidTransit)
 	ErrInvalidReserve     = fmt.Errorf("%w", domain.ErrInvalidReserve)
 	ErrInvalidPark        = fmt.Errorf("%w", domain.ErrInvalidPark)
 	ErrInvalidTransfer    = fmt.Errorf("%w", domain.ErrInvalidTransfer)
+	ErrInvalidWorkOrder   = fmt.Errorf("%w", domain.ErrInvalidWorkOrder)
+
+	ErrNotFoundWorkOrder = errors.New("not found work order")
+	ErrCarInMaintenance  = errors.New("car is already in maintenance")
+
+	ErrInvalidMaintenancePlan  = fmt.Errorf("%w", domain.ErrInvalidMaintenancePlan)
+	ErrNotFoundMaintenancePlan = errors.New("not found maintenance plan")
+	ErrMaintenancePlanExists   = errors.New("maintenance plan already exists for model")
+
+	ErrInvalidRebalancePlan = errors.New("invalid rebalance plan")
+
+	ErrNotFoundOccupancyEntry = errors.New("not found occupancy entry")
+
+	ErrInvalidTelemetry = fmt.Errorf("%w", domain.ErrInvalidTelemetry)
+	ErrInvalidTrack     = errors.New("invalid track interval")
+
+	ErrInvalidDocument  = fmt.Errorf("%w", domain.ErrInvalidDocument)
+	ErrNotFoundDocument = errors.New("not found car document")
+
+	ErrInvalidAcquisition  = fmt.Errorf("%w", domain.ErrInvalidAcquisition)
+	ErrInvalidRetirement   = fmt.Errorf("%w", domain.ErrInvalidRetirement)
+	ErrInvalidSale         = fmt.Errorf("%w", domain.ErrInvalidSale)
+	ErrNotFoundAcquisition = errors.New("not found car acquisition")
 )
@@ -0,0 +1,152 @@
+package application
+
+import (
+	"errors"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/domain"
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/validation"
+)
+
+type CarImportRow struct {
+	Line      int    `json:"line"`
+	Age       uint16 `json:"age"`
+	KM        uint64 `json:"km"`
+	Plate     string `json:"plate"`
+	Document  string `json:"document"`
+	StationId string `json:"stationId"`
+	ModelId   string `json:"modelId"`
+	FuelLevel uint8  `json:"fuelLevel"`
+	Err       error  `json:"-"`
+}
+
+type CarImportResult struct {
+	Line  int    `json:"line"`
+	Plate string `json:"plate"`
+	CarId string `json:"carId,omitempty"`
+	Error string `json:"error,omitempty"`
+}
+
+type CarImportReport struct {
+	DryRun   bool              `json:"dryRun"`
+	Total    int               `json:"total"`
+	Imported int               `json:"imported"`
+	Failed   int               `json:"failed"`
+	Rows     []CarImportResult `json:"rows"`
+}
+
+func (uc carUseCase) ImportCars(rows []CarImportRow, dryRun bool) CarImportReport {
+	report := CarImportReport{DryRun: dryRun, Total: len(rows), Rows: []CarImportResult{}}
+
+	free := make(map[string]uint)
+	plates := make(map[string]bool)
+	models := make(map[string]*catalogModel)
+
+	for _, row := range rows {
+		car, err := uc.importCar(row, free, plates, models)
+
+		result := CarImportResult{Line: row.Line, Plate: row.Plate}
+		if err == nil && !dryRun {
+			if err = uc.carRepo.Save(*car); err != nil {
+				err = ErrInvalidCar
+			}
+		}
+
+		if err != nil {
+			result.Error = err.Error()
+			report.Failed++
+		} else {
+			result.CarId = car.ID
+			report.Imported++
+		}
+
+		report.Rows = append(report.Rows, result)
+	}
+
+	return report
+}
+
+func (uc carUseCase) importCar(row CarImportRow, free map[string]uint, plates map[string]bool, models map[string]*catalogModel) (*domain.Car, error) {
+	if row.Err != nil {
+		return nil, row.Err
+	}
+
+	if _, exists := free[row.StationId]; !exists {
+		s, err := uc.stationRepo.FindOne(row.StationId)
+		if err != nil {
+			return nil, ErrInvalidStation
+		}
+		free[row.StationId] = 0
+		if s.Capacity > s.Idle {
+			free[row.StationId] = s.Capacity - s.Idle
+		}
+	}
+
+	if free[row.StationId] == 0 {
+		return nil, ErrStationMaxCapacity
+	}
+
+	if plates[row.Plate] || uc.plateExists(row.Plate) {
+		return nil, ErrDuplicatedPlate
+	}
+
+	model, err := uc.importModel(row.ModelId, models)
+	if err != nil {
+		return nil, err
+	}
+
+	car, err := domain.NewCar(row.Age, row.KM, row.Plate, row.Document, row.StationId, row.ModelId, model.Model, model.Make, model.Energy, row.FuelLevel)
+	if err != nil {
+		return nil, ErrInvalidEntity
+	}
+
+	free[row.StationId]--
+	plates[row.Plate] = true
+
+	return car, nil
+}
+
+type catalogModel struct {
+	Make   string
+	Model  string
+	Energy domain.EnergyType
+}
+
+func (uc carUseCase) importModel(modelId string, models map[string]*catalogModel) (*catalogModel, error) {
+	if model, exists := models[modelId]; exists {
+		if model == nil {
+			return nil, ErrNotFoundModel
+		}
+		return model, nil
+	}
+
+	if err := validation.ValidId(modelId); err != nil {
+		return nil, ErrInvalidModel
+	}
+
+	make, name, energy, err := uc.catalog.GetModel(modelId)
+	if errors.Is(err, ErrNotFoundModel) {
+		models[modelId] = nil
+		return nil, ErrNotFoundModel
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	models[modelId] = &catalogModel{Make: make, Model: name, Energy: energy}
+
+	return models[modelId], nil
+}
+
+func (uc carUseCase) plateExists(plate string) bool {
+	if plate == "" {
+		return false
+	}
+
+	for _, c := range uc.carRepo.Find(SearchCarParams{Plate: plate}) {
+		if c.Plate == plate {
+			return true
+		}
+	}
+
+	return false
+}
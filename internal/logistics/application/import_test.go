@@ -0,0 +1,90 @@
+package application
+
+import (
+	"testing"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/domain"
+)
+
+func TestCarUseCase_ImportCars(t *testing.T) {
+	station := newStationFixture()
+	station.Capacity = 3
+	station.Idle = 2
+
+	row := func(line int, plate string) CarImportRow {
+		return CarImportRow{
+			Line:      line,
+			Age:       2020,
+			KM:        12000,
+			Plate:     plate,
+			Document:  "abc.123.op-x",
+			StationId: station.ID,
+			ModelId:   modelIdFixture,
+			FuelLevel: domain.FullLevel,
+		}
+	}
+
+	invalidModel := row(2, "KST-9016")
+	invalidModel.ModelId = "Uno"
+
+	testCases := []struct {
+		name          string
+		dryRun        bool
+		rows          []CarImportRow
+		wantImported  int
+		wantFailed    int
+		wantSaveCalls uint
+	}{
+		{
+			name:          "correct input",
+			rows:          []CarImportRow{row(2, "KST-9016")},
+			wantImported:  1,
+			wantFailed:    0,
+			wantSaveCalls: 1,
+		},
+		{
+			name:          "station over capacity input",
+			rows:          []CarImportRow{row(2, "KST-9016"), row(3, "KST-9017")},
+			wantImported:  1,
+			wantFailed:    1,
+			wantSaveCalls: 1,
+		},
+		{
+			name:          "incorrect model input",
+			rows:          []CarImportRow{invalidModel, row(3, "KST-9017")},
+			wantImported:  1,
+			wantFailed:    1,
+			wantSaveCalls: 1,
+		},
+		{
+			name:          "dry run input",
+			dryRun:        true,
+			rows:          []CarImportRow{row(2, "KST-9016"), row(3, "")},
+			wantImported:  1,
+			wantFailed:    1,
+			wantSaveCalls: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			carRepo := &carRepositoryMock{calls: make(map[string]uint)}
+			stationRepo := &stationRepositoryMock{expectedFindOneStation: station, calls: make(map[string]uint)}
+			carUC := NewCarUseCase(carRepo, stationRepo, &modelCatalogMock{})
+
+			report := carUC.ImportCars(tc.rows, tc.dryRun)
+
+			if report.Imported != tc.wantImported || report.Failed != tc.wantFailed || len(report.Rows) != len(tc.rows) {
+				t.Error("unexpected report", report)
+			}
+
+			if carRepo.calls["Save"] != tc.wantSaveCalls {
+				t.Error("invalid repo call", carRepo.calls["Save"])
+			}
+
+			if stationRepo.calls["FindOne"] != 1 {
+				t.Error("invalid repo call", stationRepo.calls["FindOne"])
+			}
+		})
+	}
+}
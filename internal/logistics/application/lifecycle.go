@@ -0,0 +1,170 @@
+package application
+
+import (
+	"time"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/domain"
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/validation"
+)
+
+type LifecycleUseCase interface {
+	GetCarLifecycle(carId string) (*domain.CarLifecycle, error)
+	RegisterAcquisition(carId string, purchasedAt time.Time, km uint64, price float64, supplier string, usefulLife uint8, residualValue float64) error
+	GetCarDepreciation(carId string, at time.Time) (*domain.Depreciation, error)
+	RetireCar(carId string) error
+	PutCarForSale(carId string, askingPrice float64) error
+	SellCar(carId, buyer string, price float64) error
+	GetFleetAgeReport() []domain.FleetAge
+}
+
+type lifecycleUseCase struct {
+	lifecycleRepo LifecycleRepository
+	carRepo       CarRepository
+	stationRepo   StationReadRepository
+}
+
+func NewLifecycleUseCase(lifecycleRepo LifecycleRepository, carRepo CarRepository, stationRepo StationReadRepository) *lifecycleUseCase {
+	return &lifecycleUseCase{
+		lifecycleRepo: lifecycleRepo,
+		carRepo:       carRepo,
+		stationRepo:   stationRepo,
+	}
+}
+
+func (uc lifecycleUseCase) GetCarLifecycle(carId string) (*domain.CarLifecycle, error) {
+	if err := validation.ValidId(carId); err != nil {
+		return nil, ErrInvalidId
+	}
+
+	if _, err := uc.carRepo.FindOne(carId); err != nil {
+		return nil, ErrNotFoundCar
+	}
+
+	return uc.lifecycle(carId), nil
+}
+
+func (uc lifecycleUseCase) lifecycle(carId string) *domain.CarLifecycle {
+	lifecycle, err := uc.lifecycleRepo.FindOne(carId)
+	if err != nil {
+		return domain.NewCarLifecycle(carId)
+	}
+
+	return lifecycle
+}
+
+func (uc lifecycleUseCase) RegisterAcquisition(carId string, purchasedAt time.Time, km uint64, price float64, supplier string, usefulLife uint8, residualValue float64) error {
+	lifecycle, err := uc.GetCarLifecycle(carId)
+	if err != nil {
+		return err
+	}
+
+	if err := lifecycle.Acquire(purchasedAt, km, price, supplier, usefulLife, residualValue); err != nil {
+		return ErrInvalidAcquisition
+	}
+
+	if err := uc.lifecycleRepo.Save(*lifecycle); err != nil {
+		return ErrInvalidAcquisition
+	}
+
+	return nil
+}
+
+func (uc lifecycleUseCase) GetCarDepreciation(carId string, at time.Time) (*domain.Depreciation, error) {
+	lifecycle, err := uc.GetCarLifecycle(carId)
+	if err != nil {
+		return nil, err
+	}
+
+	depreciation, err := lifecycle.Depreciation(at)
+	if err != nil {
+		return nil, ErrNotFoundAcquisition
+	}
+
+	return depreciation, nil
+}
+
+func (uc lifecycleUseCase) RetireCar(carId string) error {
+	if err := validation.ValidId(carId); err != nil {
+		return ErrInvalidId
+	}
+
+	car, err := uc.carRepo.FindOne(carId)
+	if err != nil {
+		return ErrInvalidCar
+	}
+
+	if err := car.Retire(); err != nil {
+		return ErrCarNotInMaintenance
+	}
+
+	lifecycle := uc.lifecycle(carId)
+	if err := lifecycle.Retire(time.Now()); err != nil {
+		return ErrInvalidRetirement
+	}
+
+	return uc.save(*car, *lifecycle)
+}
+
+func (uc lifecycleUseCase) PutCarForSale(carId string, askingPrice float64) error {
+	if err := validation.ValidId(carId); err != nil {
+		return ErrInvalidId
+	}
+
+	car, err := uc.carRepo.FindOne(carId)
+	if err != nil {
+		return ErrInvalidCar
+	}
+
+	if err := car.PutForSale(); err != nil {
+		return ErrInvalidSale
+	}
+
+	lifecycle := uc.lifecycle(carId)
+	if err := lifecycle.ListForSale(time.Now(), askingPrice); err != nil {
+		return ErrInvalidSale
+	}
+
+	return uc.save(*car, *lifecycle)
+}
+
+func (uc lifecycleUseCase) SellCar(carId, buyer string, price float64) error {
+	if err := validation.ValidId(carId); err != nil {
+		return ErrInvalidId
+	}
+
+	car, err := uc.carRepo.FindOne(carId)
+	if err != nil {
+		return ErrInvalidCar
+	}
+
+	if err := car.Sell(); err != nil {
+		return ErrInvalidSale
+	}
+
+	lifecycle := uc.lifecycle(carId)
+	if err := lifecycle.Sell(time.Now(), buyer, price); err != nil {
+		return ErrInvalidSale
+	}
+
+	return uc.save(*car, *lifecycle)
+}
+
+func (uc lifecycleUseCase) save(car domain.Car, lifecycle domain.CarLifecycle) error {
+	if err := uc.lifecycleRepo.SaveWithCar(lifecycle, car); err != nil {
+		return ErrInvalidCar
+	}
+
+	return nil
+}
+
+func (uc lifecycleUseCase) GetFleetAgeReport() []domain.FleetAge {
+	now := time.Now()
+	report := []domain.FleetAge{}
+
+	for _, station := range uc.stationRepo.FindAll() {
+		cars := uc.carRepo.Find(SearchCarParams{StationId: station.ID})
+		report = append(report, domain.FleetAgeOf(station.ID, cars, now))
+	}
+
+	return report
+}
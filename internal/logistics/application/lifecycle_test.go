@@ -0,0 +1,207 @@
+package application
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/domain"
+)
+
+type lifecycleRepositoryMock struct {
+	expectedFindOne    *domain.CarLifecycle
+	expectedFindOneErr error
+	expectedSaveErr    error
+	saved              *domain.CarLifecycle
+	calls              map[string]uint
+}
+
+func (m *lifecycleRepositoryMock) FindOne(carId string) (*domain.CarLifecycle, error) {
+	m.calls["FindOne"] = m.calls["FindOne"] + 1
+	return m.expectedFindOne, m.expectedFindOneErr
+}
+
+func (m *lifecycleRepositoryMock) Save(lifecycle domain.CarLifecycle) error {
+	m.calls["Save"] = m.calls["Save"] + 1
+	m.saved = &lifecycle
+	return m.expectedSaveErr
+}
+
+func (m *lifecycleRepositoryMock) SaveWithCar(lifecycle domain.CarLifecycle, car domain.Car) error {
+	m.calls["SaveWithCar"] = m.calls["SaveWithCar"] + 1
+	m.saved = &lifecycle
+	return m.expectedSaveErr
+}
+
+func TestLifecycleUseCase_RetireCar(t *testing.T) {
+	newCar := newCarFixture()
+	newCar.Status = domain.Maintenance
+
+	activeCar := newCarFixture()
+
+	type setup struct {
+		repoFindCar *domain.Car
+		repoFindErr error
+	}
+
+	type want struct {
+		err       error
+		findCalls uint
+		saveCalls uint
+	}
+
+	testCases := []struct {
+		name  string
+		setup setup
+		idArg string
+		want  want
+	}{
+		{
+			name:  "correct input",
+			setup: setup{repoFindCar: newCar},
+			idArg: newCar.ID,
+			want:  want{err: nil, findCalls: 1, saveCalls: 1},
+		},
+		{
+			name:  "incorrect id input",
+			setup: setup{},
+			idArg: "invalid-id",
+			want:  want{err: ErrInvalidId, findCalls: 0, saveCalls: 0},
+		},
+		{
+			name:  "not found car",
+			setup: setup{repoFindErr: ErrInvalidCar},
+			idArg: "35098f2d-6351-4509-87a2-896bab961a25",
+			want:  want{err: ErrInvalidCar, findCalls: 1, saveCalls: 0},
+		},
+		{
+			name:  "incorrect car status",
+			setup: setup{repoFindCar: activeCar},
+			idArg: activeCar.ID,
+			want:  want{err: ErrCarNotInMaintenance, findCalls: 1, saveCalls: 0},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			carRepo := &carRepositoryMock{
+				expectedFindOneCar: tc.setup.repoFindCar,
+				expectedFindOneErr: tc.setup.repoFindErr,
+				calls:              make(map[string]uint),
+			}
+			lifecycleRepo := &lifecycleRepositoryMock{
+				expectedFindOneErr: ErrNotFoundCar,
+				calls:              make(map[string]uint),
+			}
+			lifecycleUC := NewLifecycleUseCase(lifecycleRepo, carRepo, &stationRepositoryMock{})
+			err := lifecycleUC.RetireCar(tc.idArg)
+
+			if carRepo.calls["FindOne"] != tc.want.findCalls {
+				t.Error("invalid repo call", carRepo.calls["FindOne"])
+			}
+
+			if carRepo.calls["Save"] != 0 || lifecycleRepo.calls["SaveWithCar"] != tc.want.saveCalls {
+				t.Error("invalid repo call", carRepo.calls["Save"], lifecycleRepo.calls["SaveWithCar"])
+			}
+
+			if carRepo.calls["Delete"] != 0 {
+				t.Error("invalid repo call", carRepo.calls["Delete"])
+			}
+
+			if !errors.Is(err, tc.want.err) {
+				t.Error("unexpected error", err, tc.want.err)
+			}
+
+			if err == nil && lifecycleRepo.saved.RetiredAt == nil {
+				t.Error("unexpected lifecycle", lifecycleRepo.saved)
+			}
+		})
+	}
+}
+
+func TestLifecycleUseCase_SellCar(t *testing.T) {
+	forSaleCar := newCarFixture()
+	forSaleCar.Status = domain.ForSale
+
+	retiredAt := time.Now().AddDate(0, -1, 0)
+	listed := domain.NewCarLifecycle(forSaleCar.ID)
+	listed.Retire(retiredAt)
+	listed.ListForSale(retiredAt, 35000)
+
+	testCases := []struct {
+		name          string
+		repoCar       *domain.Car
+		repoLifecycle *domain.CarLifecycle
+		buyerArg      string
+		priceArg      float64
+		wantErr       error
+	}{
+		{
+			name:          "correct input",
+			repoCar:       forSaleCar,
+			repoLifecycle: listed,
+			buyerArg:      "Buyer Ltda",
+			priceArg:      33000,
+			wantErr:       nil,
+		},
+		{
+			name:          "incorrect buyer input",
+			repoCar:       forSaleCar,
+			repoLifecycle: listed,
+			buyerArg:      "",
+			priceArg:      33000,
+			wantErr:       ErrInvalidSale,
+		},
+		{
+			name:          "incorrect car status input",
+			repoCar:       newCarFixture(),
+			repoLifecycle: listed,
+			buyerArg:      "Buyer Ltda",
+			priceArg:      33000,
+			wantErr:       ErrInvalidSale,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			car := *tc.repoCar
+			lifecycle := *tc.repoLifecycle
+			carRepo := &carRepositoryMock{expectedFindOneCar: &car, calls: make(map[string]uint)}
+			lifecycleRepo := &lifecycleRepositoryMock{expectedFindOne: &lifecycle, calls: make(map[string]uint)}
+
+			lifecycleUC := NewLifecycleUseCase(lifecycleRepo, carRepo, &stationRepositoryMock{})
+			err := lifecycleUC.SellCar(car.ID, tc.buyerArg, tc.priceArg)
+
+			if !errors.Is(err, tc.wantErr) {
+				t.Error("unexpected error", err)
+			}
+
+			if err == nil && (lifecycleRepo.saved.SoldAt == nil || lifecycleRepo.saved.SalePrice != tc.priceArg || car.Status != domain.Sold) {
+				t.Error("unexpected sale", car, lifecycleRepo.saved)
+			}
+		})
+	}
+}
+
+func TestLifecycleUseCase_GetFleetAgeReport(t *testing.T) {
+	station := newStationFixture()
+
+	soldCar := *newCarFixture()
+	soldCar.Status = domain.Sold
+
+	carRepo := &carRepositoryMock{
+		expectedFindAllCars: []domain.Car{*newCarFixture(), soldCar},
+		calls:               make(map[string]uint),
+	}
+	stationRepo := &stationRepositoryMock{
+		expectedFindAllStations: []domain.Station{*station},
+		calls:                   make(map[string]uint),
+	}
+
+	lifecycleUC := NewLifecycleUseCase(&lifecycleRepositoryMock{}, carRepo, stationRepo)
+	report := lifecycleUC.GetFleetAgeReport()
+
+	if len(report) != 1 || report[0].StationId != station.ID || report[0].Cars != 1 || report[0].OldestYear != 2020 {
+		t.Error("unexpected report", report)
+	}
+}
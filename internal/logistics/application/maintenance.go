@@ -0,0 +1,219 @@
+package application
+
+import (
+	"errors"
+	"time"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/domain"
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/validation"
+)
+
+type MaintenanceUseCase interface {
+	GetMaintenanceHistory(carId string) ([]domain.WorkOrder, error)
+	GetWorkOrderById(id string) (*domain.WorkOrder, error)
+	OpenWorkOrder(carId, stationId, reason, workshop string, maintenanceType domain.MaintenanceType, km uint64, dateStart time.Time) error
+	CompleteWorkOrder(id string, dateEnd time.Time, cost float32, parts []domain.Part, km uint64) error
+	GetMaintenancePlans() []domain.MaintenancePlan
+	AddMaintenancePlan(modelId string, intervalKM uint64, intervalMonths uint) error
+	DeleteMaintenancePlan(id string) error
+	GetDueMaintenance(within uint) []domain.MaintenanceDue
+	IsMaintenanceOverdue(carId string) (bool, error)
+}
+
+type maintenanceUseCase struct {
+	workOrderRepo WorkOrderRepository
+	planRepo      MaintenancePlanRepository
+	carRepo       CarRepository
+	lifecycleRepo LifecycleReadRepository
+}
+
+func NewMaintenanceUseCase(workOrderRepo WorkOrderRepository, planRepo MaintenancePlanRepository, carRepo CarRepository, lifecycleRepo LifecycleReadRepository) *maintenanceUseCase {
+	return &maintenanceUseCase{
+		workOrderRepo: workOrderRepo,
+		planRepo:      planRepo,
+		carRepo:       carRepo,
+		lifecycleRepo: lifecycleRepo,
+	}
+}
+
+func (uc maintenanceUseCase) GetMaintenanceHistory(carId string) ([]domain.WorkOrder, error) {
+	if err := validation.ValidId(carId); err != nil {
+		return nil, ErrInvalidId
+	}
+
+	if _, err := uc.carRepo.FindOne(carId); err != nil {
+		return nil, ErrNotFoundCar
+	}
+
+	return uc.workOrderRepo.FindByCar(carId), nil
+}
+
+func (uc maintenanceUseCase) GetWorkOrderById(id string) (*domain.WorkOrder, error) {
+	if err := validation.ValidId(id); err != nil {
+		return nil, ErrInvalidId
+	}
+
+	workOrder, err := uc.workOrderRepo.FindOne(id)
+	if err != nil {
+		return nil, ErrNotFoundWorkOrder
+	}
+
+	return workOrder, nil
+}
+
+func (uc maintenanceUseCase) OpenWorkOrder(carId, stationId, reason, workshop string, maintenanceType domain.MaintenanceType, km uint64, dateStart time.Time) error {
+	if err := validation.ValidId(carId); err != nil {
+		return ErrInvalidId
+	}
+
+	car, err := uc.carRepo.FindOne(carId)
+	if err != nil {
+		return ErrInvalidCar
+	}
+
+	if car.Status == domain.Maintenance {
+		return ErrCarInMaintenance
+	}
+
+	workOrder, err := domain.NewWorkOrder(carId, stationId, reason, workshop, maintenanceType, km, dateStart)
+	if err != nil {
+		return ErrInvalidEntity
+	}
+
+	if err := car.ToMaintenance(stationId, km); err != nil {
+		return ErrInvalidMaintenance
+	}
+
+	if err := uc.workOrderRepo.SaveWithCar(*workOrder, *car); err != nil {
+		return ErrInvalidWorkOrder
+	}
+
+	return nil
+}
+
+func (uc maintenanceUseCase) CompleteWorkOrder(id string, dateEnd time.Time, cost float32, parts []domain.Part, km uint64) error {
+	if err := validation.ValidId(id); err != nil {
+		return ErrInvalidId
+	}
+
+	workOrder, err := uc.workOrderRepo.FindOne(id)
+	if err != nil {
+		return ErrNotFoundWorkOrder
+	}
+
+	if err := workOrder.Complete(dateEnd, cost, parts, km); err != nil {
+		return ErrInvalidWorkOrder
+	}
+
+	if err := uc.workOrderRepo.Save(*workOrder); err != nil {
+		return ErrInvalidWorkOrder
+	}
+
+	return nil
+}
+
+func (uc maintenanceUseCase) GetMaintenancePlans() []domain.MaintenancePlan {
+	return uc.planRepo.FindAll()
+}
+
+func (uc maintenanceUseCase) AddMaintenancePlan(modelId string, intervalKM uint64, intervalMonths uint) error {
+	if err := validation.ValidId(modelId); err != nil {
+		return ErrInvalidModel
+	}
+
+	if _, err := uc.planRepo.FindByModel(modelId); err == nil {
+		return ErrMaintenancePlanExists
+	}
+
+	plan, err := domain.NewMaintenancePlan(modelId, intervalKM, intervalMonths)
+	if errors.Is(err, domain.ErrInvalidMaintenancePlan) {
+		return ErrInvalidMaintenancePlan
+	}
+	if err != nil {
+		return ErrInvalidEntity
+	}
+
+	if err := uc.planRepo.Save(*plan); err != nil {
+		return ErrInvalidMaintenancePlan
+	}
+
+	return nil
+}
+
+func (uc maintenanceUseCase) DeleteMaintenancePlan(id string) error {
+	if err := validation.ValidId(id); err != nil {
+		return ErrInvalidId
+	}
+
+	if err := uc.planRepo.Delete(id); err != nil {
+		return ErrNotFoundMaintenancePlan
+	}
+
+	return nil
+}
+
+func (uc maintenanceUseCase) GetDueMaintenance(within uint) []domain.MaintenanceDue {
+	now := time.Now()
+	dues := []domain.MaintenanceDue{}
+
+	for _, plan := range uc.planRepo.FindAll() {
+		cars := uc.carRepo.Find(SearchCarParams{ModelId: plan.ModelId})
+		for _, car := range cars {
+			if !car.InFleet() || car.Status == domain.Maintenance || !plan.Applies(car) {
+				continue
+			}
+
+			due := plan.Due(car, uc.lastScheduledService(car.ID), uc.acquisition(car.ID), now)
+			if due.Within(now, within) {
+				dues = append(dues, due)
+			}
+		}
+	}
+
+	return dues
+}
+
+func (uc maintenanceUseCase) IsMaintenanceOverdue(carId string) (bool, error) {
+	if err := validation.ValidId(carId); err != nil {
+		return false, ErrInvalidId
+	}
+
+	car, err := uc.carRepo.FindOne(carId)
+	if err != nil {
+		return false, ErrNotFoundCar
+	}
+
+	plan, err := uc.planRepo.FindByModel(car.ModelId)
+	if err != nil {
+		return false, nil
+	}
+
+	due := plan.Due(*car, uc.lastScheduledService(car.ID), uc.acquisition(car.ID), time.Now())
+
+	return due.Overdue, nil
+}
+
+func (uc maintenanceUseCase) lastScheduledService(carId string) *domain.WorkOrder {
+	var last *domain.WorkOrder
+
+	workOrders := uc.workOrderRepo.FindByCar(carId)
+	for i, w := range workOrders {
+		if w.Type != domain.Scheduled || w.Status != domain.WorkOrderDone || w.DateEnd == nil {
+			continue
+		}
+		if last == nil || w.DateEnd.After(*last.DateEnd) {
+			last = &workOrders[i]
+		}
+	}
+
+	return last
+}
+
+func (uc maintenanceUseCase) acquisition(carId string) *domain.CarLifecycle {
+	lifecycle, err := uc.lifecycleRepo.FindOne(carId)
+	if err != nil {
+		return nil
+	}
+
+	return lifecycle
+}
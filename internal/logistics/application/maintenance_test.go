@@ -0,0 +1,554 @@
+package application
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/domain"
+)
+
+func newWorkOrderFixture() *domain.WorkOrder {
+	return &domain.WorkOrder{
+		ID:        "5ce5a1a1-f324-4c8b-8c92-d7e820cbb238",
+		CarId:     "e4ce866a-f5b7-4774-8f9d-5eb74c3900cc",
+		StationId: "83369771-f9a4-48b7-b87b-463f19f7b187",
+		Reason:    "oil change",
+		Type:      domain.Scheduled,
+		Workshop:  "Auto Center",
+		Parts:     []domain.Part{},
+		KM:        12000,
+		DateStart: time.Date(2022, time.March, 1, 8, 0, 0, 0, time.UTC),
+		Status:    domain.WorkOrderInProgress,
+	}
+}
+
+type workOrderRepositoryMock struct {
+	expectedFindByCar        []domain.WorkOrder
+	expectedFindOneWorkOrder *domain.WorkOrder
+	expectedFindOneErr       error
+	expectedSaveErr          error
+	calls                    map[string]uint
+}
+
+func (m *workOrderRepositoryMock) FindByCar(carId string) []domain.WorkOrder {
+	m.calls["FindByCar"] = m.calls["FindByCar"] + 1
+	return m.expectedFindByCar
+}
+
+func (m *workOrderRepositoryMock) FindOne(id string) (*domain.WorkOrder, error) {
+	m.calls["FindOne"] = m.calls["FindOne"] + 1
+	return m.expectedFindOneWorkOrder, m.expectedFindOneErr
+}
+
+func (m *workOrderRepositoryMock) Save(workOrder domain.WorkOrder) error {
+	m.calls["Save"] = m.calls["Save"] + 1
+	return m.expectedSaveErr
+}
+
+func (m *workOrderRepositoryMock) SaveWithCar(workOrder domain.WorkOrder, car domain.Car) error {
+	m.calls["SaveWithCar"] = m.calls["SaveWithCar"] + 1
+	return m.expectedSaveErr
+}
+
+func TestMaintenanceUseCase_GetMaintenanceHistory(t *testing.T) {
+	newCar := newCarFixture()
+
+	type setup struct {
+		repoCar    *domain.Car
+		repoCarErr error
+	}
+
+	type want struct {
+		err            error
+		findByCarCalls uint
+	}
+
+	testCases := []struct {
+		name  string
+		setup setup
+		carId string
+		want  want
+	}{
+		{
+			name:  "correct input",
+			setup: setup{repoCar: newCar},
+			carId: newCar.ID,
+			want:  want{err: nil, findByCarCalls: 1},
+		},
+		{
+			name:  "incorrect id input",
+			carId: "invalid-id",
+			want:  want{err: ErrInvalidId, findByCarCalls: 0},
+		},
+		{
+			name:  "not found car",
+			setup: setup{repoCarErr: ErrNotFoundCar},
+			carId: "35098f2d-6351-4509-87a2-896bab961a25",
+			want:  want{err: ErrNotFoundCar, findByCarCalls: 0},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			carRepo := &carRepositoryMock{
+				expectedFindOneCar: tc.setup.repoCar,
+				expectedFindOneErr: tc.setup.repoCarErr,
+				calls:              make(map[string]uint),
+			}
+			workOrderRepo := &workOrderRepositoryMock{
+				expectedFindByCar: []domain.WorkOrder{*newWorkOrderFixture()},
+				calls:             make(map[string]uint),
+			}
+			maintenanceUC := NewMaintenanceUseCase(workOrderRepo, &maintenancePlanRepositoryMock{calls: make(map[string]uint)}, carRepo, &lifecycleRepositoryMock{calls: make(map[string]uint)})
+			_, err := maintenanceUC.GetMaintenanceHistory(tc.carId)
+
+			if workOrderRepo.calls["FindByCar"] != tc.want.findByCarCalls {
+				t.Error("invalid repo call", workOrderRepo.calls["FindByCar"])
+			}
+
+			if !errors.Is(err, tc.want.err) {
+				t.Error("unexpected error", err, tc.want.err)
+			}
+		})
+	}
+}
+
+func TestMaintenanceUseCase_OpenWorkOrder(t *testing.T) {
+	newCar := newCarFixture()
+
+	underFixCar := newCarFixture()
+	underFixCar.Status = domain.Maintenance
+
+	type setup struct {
+		repoCar    *domain.Car
+		repoCarErr error
+	}
+
+	type args struct {
+		carId           string
+		reason          string
+		maintenanceType domain.MaintenanceType
+	}
+
+	type want struct {
+		err                error
+		carSaveCalls       uint
+		workOrderSaveCalls uint
+	}
+
+	testCases := []struct {
+		name  string
+		setup setup
+		args  args
+		want  want
+	}{
+		{
+			name:  "correct input",
+			setup: setup{repoCar: newCar},
+			args:  args{carId: newCar.ID, reason: "flat tire", maintenanceType: domain.Repair},
+			want:  want{err: nil, carSaveCalls: 0, workOrderSaveCalls: 1},
+		},
+		{
+			name: "incorrect id input",
+			args: args{carId: "invalid-id", reason: "flat tire", maintenanceType: domain.Repair},
+			want: want{err: ErrInvalidId, carSaveCalls: 0, workOrderSaveCalls: 0},
+		},
+		{
+			name:  "not found car",
+			setup: setup{repoCarErr: ErrNotFoundCar},
+			args:  args{carId: newCar.ID, reason: "flat tire", maintenanceType: domain.Repair},
+			want:  want{err: ErrInvalidCar, carSaveCalls: 0, workOrderSaveCalls: 0},
+		},
+		{
+			name:  "car already in maintenance",
+			setup: setup{repoCar: underFixCar},
+			args:  args{carId: underFixCar.ID, reason: "flat tire", maintenanceType: domain.Repair},
+			want:  want{err: ErrCarInMaintenance, carSaveCalls: 0, workOrderSaveCalls: 0},
+		},
+		{
+			name:  "incorrect work order input",
+			setup: setup{repoCar: newCar},
+			args:  args{carId: newCar.ID, maintenanceType: domain.Repair},
+			want:  want{err: ErrInvalidEntity, carSaveCalls: 0, workOrderSaveCalls: 0},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var car *domain.Car
+			if tc.setup.repoCar != nil {
+				c := *tc.setup.repoCar
+				car = &c
+			}
+			carRepo := &carRepositoryMock{
+				expectedFindOneCar: car,
+				expectedFindOneErr: tc.setup.repoCarErr,
+				calls:              make(map[string]uint),
+			}
+			workOrderRepo := &workOrderRepositoryMock{calls: make(map[string]uint)}
+			maintenanceUC := NewMaintenanceUseCase(workOrderRepo, &maintenancePlanRepositoryMock{calls: make(map[string]uint)}, carRepo, &lifecycleRepositoryMock{calls: make(map[string]uint)})
+			err := maintenanceUC.OpenWorkOrder(tc.args.carId, newCar.StationId, tc.args.reason, "Auto Center", tc.args.maintenanceType, newCar.KM, time.Now())
+
+			if carRepo.calls["Save"] != tc.want.carSaveCalls {
+				t.Error("invalid repo call", carRepo.calls["Save"])
+			}
+
+			if workOrderRepo.calls["SaveWithCar"] != tc.want.workOrderSaveCalls {
+				t.Error("invalid repo call", workOrderRepo.calls["SaveWithCar"])
+			}
+
+			if !errors.Is(err, tc.want.err) {
+				t.Error("unexpected error", err, tc.want.err)
+			}
+		})
+	}
+}
+
+func TestMaintenanceUseCase_CompleteWorkOrder(t *testing.T) {
+	workOrder := newWorkOrderFixture()
+
+	doneWorkOrder := newWorkOrderFixture()
+	doneWorkOrder.Status = domain.WorkOrderDone
+
+	type setup struct {
+		repoWorkOrder *domain.WorkOrder
+		repoFindErr   error
+	}
+
+	type args struct {
+		id string
+		km uint64
+	}
+
+	type want struct {
+		err       error
+		saveCalls uint
+	}
+
+	testCases := []struct {
+		name  string
+		setup setup
+		args  args
+		want  want
+	}{
+		{
+			name:  "correct input",
+			setup: setup{repoWorkOrder: workOrder},
+			args:  args{id: workOrder.ID, km: workOrder.KM + 10},
+			want:  want{err: nil, saveCalls: 1},
+		},
+		{
+			name: "incorrect id input",
+			args: args{id: "invalid-id", km: workOrder.KM},
+			want: want{err: ErrInvalidId, saveCalls: 0},
+		},
+		{
+			name:  "not found work order",
+			setup: setup{repoFindErr: ErrNotFoundWorkOrder},
+			args:  args{id: workOrder.ID, km: workOrder.KM},
+			want:  want{err: ErrNotFoundWorkOrder, saveCalls: 0},
+		},
+		{
+			name:  "incorrect status",
+			setup: setup{repoWorkOrder: doneWorkOrder},
+			args:  args{id: doneWorkOrder.ID, km: doneWorkOrder.KM},
+			want:  want{err: ErrInvalidWorkOrder, saveCalls: 0},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			workOrderRepo := &workOrderRepositoryMock{
+				expectedFindOneWorkOrder: tc.setup.repoWorkOrder,
+				expectedFindOneErr:       tc.setup.repoFindErr,
+				calls:                    make(map[string]uint),
+			}
+			carRepo := &carRepositoryMock{calls: make(map[string]uint)}
+			maintenanceUC := NewMaintenanceUseCase(workOrderRepo, &maintenancePlanRepositoryMock{calls: make(map[string]uint)}, carRepo, &lifecycleRepositoryMock{calls: make(map[string]uint)})
+			err := maintenanceUC.CompleteWorkOrder(tc.args.id, workOrder.DateStart.Add(24*time.Hour), 120, []domain.Part{}, tc.args.km)
+
+			if workOrderRepo.calls["Save"] != tc.want.saveCalls {
+				t.Error("invalid repo call", workOrderRepo.calls["Save"])
+			}
+
+			if !errors.Is(err, tc.want.err) {
+				t.Error("unexpected error", err, tc.want.err)
+			}
+		})
+	}
+}
+
+type maintenancePlanRepositoryMock struct {
+	expectedFindAll      []domain.MaintenancePlan
+	expectedFindByModel  *domain.MaintenancePlan
+	expectedFindModelErr error
+	expectedSaveErr      error
+	expectedDeleteErr    error
+	calls                map[string]uint
+}
+
+func (m *maintenancePlanRepositoryMock) FindAll() []domain.MaintenancePlan {
+	m.calls["FindAll"] = m.calls["FindAll"] + 1
+	return m.expectedFindAll
+}
+
+func (m *maintenancePlanRepositoryMock) FindOne(id string) (*domain.MaintenancePlan, error) {
+	m.calls["FindOne"] = m.calls["FindOne"] + 1
+	return m.expectedFindByModel, m.expectedFindModelErr
+}
+
+func (m *maintenancePlanRepositoryMock) FindByModel(modelId string) (*domain.MaintenancePlan, error) {
+	m.calls["FindByModel"] = m.calls["FindByModel"] + 1
+	return m.expectedFindByModel, m.expectedFindModelErr
+}
+
+func (m *maintenancePlanRepositoryMock) Save(plan domain.MaintenancePlan) error {
+	m.calls["Save"] = m.calls["Save"] + 1
+	return m.expectedSaveErr
+}
+
+func (m *maintenancePlanRepositoryMock) Delete(id string) error {
+	m.calls["Delete"] = m.calls["Delete"] + 1
+	return m.expectedDeleteErr
+}
+
+func TestMaintenanceUseCase_AddMaintenancePlan(t *testing.T) {
+	plan := &domain.MaintenancePlan{
+		ID:         "0b6c0ab4-2d1c-4d1b-9b0a-8e2f7c6b1e11",
+		ModelId:    modelIdFixture,
+		IntervalKM: 10000,
+	}
+
+	type setup struct {
+		repoPlan *domain.MaintenancePlan
+		repoErr  error
+	}
+
+	type args struct {
+		modelId        string
+		intervalKM     uint64
+		intervalMonths uint
+	}
+
+	type want struct {
+		err       error
+		saveCalls uint
+	}
+
+	testCases := []struct {
+		name  string
+		setup setup
+		args  args
+		want  want
+	}{
+		{
+			name:  "correct input",
+			setup: setup{repoErr: ErrNotFoundMaintenancePlan},
+			args:  args{modelId: modelIdFixture, intervalKM: 10000, intervalMonths: 12},
+			want:  want{err: nil, saveCalls: 1},
+		},
+		{
+			name:  "plan already exists",
+			setup: setup{repoPlan: plan},
+			args:  args{modelId: modelIdFixture, intervalKM: 10000, intervalMonths: 12},
+			want:  want{err: ErrMaintenancePlanExists, saveCalls: 0},
+		},
+		{
+			name:  "incorrect interval input",
+			setup: setup{repoErr: ErrNotFoundMaintenancePlan},
+			args:  args{modelId: modelIdFixture},
+			want:  want{err: ErrInvalidMaintenancePlan, saveCalls: 0},
+		},
+		{
+			name:  "incorrect model input",
+			setup: setup{repoErr: ErrNotFoundMaintenancePlan},
+			args:  args{modelId: "Uno", intervalKM: 10000},
+			want:  want{err: ErrInvalidModel, saveCalls: 0},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			planRepo := &maintenancePlanRepositoryMock{
+				expectedFindByModel:  tc.setup.repoPlan,
+				expectedFindModelErr: tc.setup.repoErr,
+				calls:                make(map[string]uint),
+			}
+			maintenanceUC := NewMaintenanceUseCase(&workOrderRepositoryMock{calls: make(map[string]uint)}, planRepo, &carRepositoryMock{calls: make(map[string]uint)}, &lifecycleRepositoryMock{calls: make(map[string]uint)})
+			err := maintenanceUC.AddMaintenancePlan(tc.args.modelId, tc.args.intervalKM, tc.args.intervalMonths)
+
+			if planRepo.calls["Save"] != tc.want.saveCalls {
+				t.Error("invalid repo call", planRepo.calls["Save"])
+			}
+
+			if !errors.Is(err, tc.want.err) {
+				t.Error("unexpected error", err, tc.want.err)
+			}
+		})
+	}
+}
+
+func TestMaintenanceUseCase_IsMaintenanceOverdue(t *testing.T) {
+	newCar := newCarFixture()
+	newCar.Age = uint16(time.Now().Year())
+
+	plan := &domain.MaintenancePlan{
+		ID:         "0b6c0ab4-2d1c-4d1b-9b0a-8e2f7c6b1e11",
+		ModelId:    newCar.ModelId,
+		IntervalKM: 10000,
+	}
+
+	serviced := newWorkOrderFixture()
+	serviced.Complete(serviced.DateStart.Add(24*time.Hour), 100, []domain.Part{}, 12000)
+
+	acquired := domain.NewCarLifecycle(newCar.ID)
+	acquired.Acquire(time.Now().AddDate(-1, 0, 0), 0, 60000, "Fiat Dealer", 4, 20000)
+
+	type setup struct {
+		repoPlan    *domain.MaintenancePlan
+		repoPlanErr error
+		workOrders  []domain.WorkOrder
+		acquisition *domain.CarLifecycle
+	}
+
+	type want struct {
+		overdue bool
+		err     error
+	}
+
+	testCases := []struct {
+		name  string
+		setup setup
+		carId string
+		want  want
+	}{
+		{
+			name:  "overdue by km",
+			setup: setup{repoPlan: plan, acquisition: acquired},
+			carId: newCar.ID,
+			want:  want{overdue: true, err: nil},
+		},
+		{
+			name:  "never serviced nor acquired",
+			setup: setup{repoPlan: plan},
+			carId: newCar.ID,
+			want:  want{overdue: false, err: nil},
+		},
+		{
+			name:  "serviced recently",
+			setup: setup{repoPlan: plan, workOrders: []domain.WorkOrder{*serviced}},
+			carId: newCar.ID,
+			want:  want{overdue: false, err: nil},
+		},
+		{
+			name:  "model without plan",
+			setup: setup{repoPlanErr: ErrNotFoundMaintenancePlan},
+			carId: newCar.ID,
+			want:  want{overdue: false, err: nil},
+		},
+		{
+			name:  "incorrect id input",
+			carId: "invalid-id",
+			want:  want{overdue: false, err: ErrInvalidId},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			planRepo := &maintenancePlanRepositoryMock{
+				expectedFindByModel:  tc.setup.repoPlan,
+				expectedFindModelErr: tc.setup.repoPlanErr,
+				calls:                make(map[string]uint),
+			}
+			workOrderRepo := &workOrderRepositoryMock{
+				expectedFindByCar: tc.setup.workOrders,
+				calls:             make(map[string]uint),
+			}
+			carRepo := &carRepositoryMock{
+				expectedFindOneCar: newCar,
+				calls:              make(map[string]uint),
+			}
+			lifecycleRepo := &lifecycleRepositoryMock{expectedFindOne: tc.setup.acquisition, calls: make(map[string]uint)}
+			maintenanceUC := NewMaintenanceUseCase(workOrderRepo, planRepo, carRepo, lifecycleRepo)
+			overdue, err := maintenanceUC.IsMaintenanceOverdue(tc.carId)
+
+			if overdue != tc.want.overdue {
+				t.Error("unexpected result", overdue)
+			}
+
+			if !errors.Is(err, tc.want.err) {
+				t.Error("unexpected error", err, tc.want.err)
+			}
+		})
+	}
+}
+
+func TestMaintenanceUseCase_GetDueMaintenance(t *testing.T) {
+	newCar := newCarFixture()
+	newCar.Age = uint16(time.Now().Year())
+	newCar.KM = 9500
+
+	otherCar := newCarFixture()
+	otherCar.ID = "35098f2d-6351-4509-87a2-896bab961a25"
+	otherCar.ModelId = "6f0c1d2e-8a4b-4c3d-9e5f-1a2b3c4d5e6f"
+
+	soldCar := *newCar
+	soldCar.Status = domain.Sold
+
+	servicedCar := *newCar
+	servicedCar.Status = domain.Maintenance
+
+	plan := domain.MaintenancePlan{
+		ID:             "0b6c0ab4-2d1c-4d1b-9b0a-8e2f7c6b1e11",
+		ModelId:        newCar.ModelId,
+		IntervalKM:     10000,
+		IntervalMonths: 12,
+		CreatedAt:      time.Now(),
+	}
+
+	testCases := []struct {
+		name    string
+		cars    []domain.Car
+		within  uint
+		wantLen int
+	}{
+		{
+			name:    "not due yet",
+			cars:    []domain.Car{*newCar, *otherCar},
+			within:  0,
+			wantLen: 0,
+		},
+		{
+			name:    "due within window",
+			cars:    []domain.Car{*newCar, *otherCar},
+			within:  400,
+			wantLen: 1,
+		},
+		{
+			name:    "sold or in maintenance",
+			cars:    []domain.Car{soldCar, servicedCar},
+			within:  400,
+			wantLen: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			planRepo := &maintenancePlanRepositoryMock{
+				expectedFindAll: []domain.MaintenancePlan{plan},
+				calls:           make(map[string]uint),
+			}
+			carRepo := &carRepositoryMock{
+				expectedFindAllCars: tc.cars,
+				calls:               make(map[string]uint),
+			}
+			maintenanceUC := NewMaintenanceUseCase(&workOrderRepositoryMock{calls: make(map[string]uint)}, planRepo, carRepo, &lifecycleRepositoryMock{calls: make(map[string]uint)})
+			dues := maintenanceUC.GetDueMaintenance(tc.within)
+
+			if len(dues) != tc.wantLen {
+				t.Error("unexpected result", dues)
+			}
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package application
+
+import (
+	"time"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/domain"
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/validation"
+)
+
+type OccupancyUseCase interface {
+	RecordOccupancy(eventId, stationId, carId string, movement domain.OccupancyMovement) error
+	GetOccupancyDrift() []domain.OccupancyDrift
+	ReconcileOccupancy() ([]domain.OccupancyDrift, error)
+}
+
+type occupancyUseCase struct {
+	occupancyRepo OccupancyRepository
+	stationRepo   StationRepository
+	carRepo       CarReadRepository
+}
+
+func NewOccupancyUseCase(occupancyRepo OccupancyRepository, stationRepo StationRepository, carRepo CarReadRepository) *occupancyUseCase {
+	return &occupancyUseCase{
+		occupancyRepo: occupancyRepo,
+		stationRepo:   stationRepo,
+		carRepo:       carRepo,
+	}
+}
+
+func (uc occupancyUseCase) RecordOccupancy(eventId, stationId, carId string, movement domain.OccupancyMovement) error {
+	if err := validation.ValidId(stationId); err != nil {
+		return ErrInvalidId
+	}
+
+	station, err := uc.stationRepo.FindOne(stationId)
+	if err != nil {
+		return ErrInvalidStation
+	}
+
+	if _, err := uc.occupancyRepo.FindOne(eventId); err == nil {
+		return nil
+	}
+
+	entry, err := domain.NewOccupancyEntry(eventId, stationId, carId, movement, time.Now())
+	if err != nil {
+		return ErrInvalidEntity
+	}
+
+	if err := uc.occupancyRepo.Save(*entry); err != nil {
+		return ErrInvalidOccupancy
+	}
+
+	return uc.refreshIdle(station, domain.Occupancy(uc.occupancyRepo.FindByStation(stationId)))
+}
+
+func (uc occupancyUseCase) GetOccupancyDrift() []domain.OccupancyDrift {
+	drifts := []domain.OccupancyDrift{}
+
+	parked := uc.parkedCars()
+	for _, s := range uc.stationRepo.FindAll() {
+		ledger := domain.Occupancy(uc.occupancyRepo.FindByStation(s.ID))
+		if drift := domain.NewOccupancyDrift(s.ID, ledger, parked[s.ID]); drift.Drift != 0 {
+			drifts = append(drifts, drift)
+		}
+	}
+
+	return drifts
+}
+
+func (uc occupancyUseCase) ReconcileOccupancy() ([]domain.OccupancyDrift, error) {
+	drifts := uc.GetOccupancyDrift()
+
+	parked := uc.parkedCars()
+	for _, d := range drifts {
+		adjustment, err := domain.NewOccupancyAdjustment(d.StationId, d.Drift, time.Now())
+		if err != nil {
+			return nil, ErrInvalidOccupancy
+		}
+
+		if err := uc.occupancyRepo.Save(*adjustment); err != nil {
+			return nil, ErrInvalidOccupancy
+		}
+	}
+
+	for _, s := range uc.stationRepo.FindAll() {
+		station := s
+		if err := uc.refreshIdle(&station, parked[s.ID]); err != nil {
+			return nil, err
+		}
+	}
+
+	return drifts, nil
+}
+
+func (uc occupancyUseCase) parkedCars() map[string]int {
+	parked := make(map[string]int)
+	for _, c := range uc.carRepo.Find(SearchCarParams{Status: uint(domain.Parked)}) {
+		if c.Status == domain.Parked {
+			parked[c.StationId]++
+		}
+	}
+	return parked
+}
+
+func (uc occupancyUseCase) refreshIdle(station *domain.Station, occupancy int) error {
+	if occupancy < 0 {
+		occupancy = 0
+	}
+	if occupancy > int(station.Capacity) {
+		occupancy = int(station.Capacity)
+	}
+
+	if uint(occupancy) == station.Idle {
+		return nil
+	}
+
+	if err := station.SetIdle(uint(occupancy)); err != nil {
+		return ErrInvalidIdle
+	}
+
+	if err := uc.stationRepo.Save(*station); err != nil {
+		return ErrInvalidStation
+	}
+
+	return nil
+}
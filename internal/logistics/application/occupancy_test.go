@@ -0,0 +1,267 @@
+package application
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/domain"
+)
+
+type occupancyRepositoryMock struct {
+	expectedFindOneEntry  *domain.OccupancyEntry
+	expectedFindOneErr    error
+	expectedFindByStation []domain.OccupancyEntry
+	expectedSaveErr       error
+	calls                 map[string]uint
+}
+
+func (m *occupancyRepositoryMock) FindOne(eventId string) (*domain.OccupancyEntry, error) {
+	m.calls["FindOne"] = m.calls["FindOne"] + 1
+	return m.expectedFindOneEntry, m.expectedFindOneErr
+}
+
+func (m *occupancyRepositoryMock) FindByStation(stationId string) []domain.OccupancyEntry {
+	m.calls["FindByStation"] = m.calls["FindByStation"] + 1
+	return m.expectedFindByStation
+}
+
+func (m *occupancyRepositoryMock) Save(entry domain.OccupancyEntry) error {
+	m.calls["Save"] = m.calls["Save"] + 1
+	return m.expectedSaveErr
+}
+
+func newOccupancyEntriesFixture(stationId string, n int) []domain.OccupancyEntry {
+	entries := []domain.OccupancyEntry{}
+	for i := 0; i < n; i++ {
+		entries = append(entries, domain.OccupancyEntry{
+			EventId:   "event",
+			StationId: stationId,
+			Movement:  domain.Arrived,
+			Delta:     1,
+			Date:      time.Now(),
+		})
+	}
+	return entries
+}
+
+func TestOccupancyUseCase_RecordOccupancy(t *testing.T) {
+	newStation := newStationFixture()
+
+	type setup struct {
+		repoFindStation *domain.Station
+		repoFindErr     error
+		repoFindEntry   *domain.OccupancyEntry
+		repoFindEntErr  error
+		repoEntries     []domain.OccupancyEntry
+	}
+
+	type args struct {
+		eventId   string
+		stationId string
+		movement  domain.OccupancyMovement
+	}
+
+	type want struct {
+		err              error
+		saveEntryCalls   uint
+		saveStationCalls uint
+		idle             uint
+	}
+
+	testCases := []struct {
+		name  string
+		setup setup
+		args  args
+		want  want
+	}{
+		{
+			name: "correct input",
+			setup: setup{
+				repoFindStation: newStation,
+				repoFindEntErr:  ErrNotFoundOccupancyEntry,
+				repoEntries:     newOccupancyEntriesFixture(newStation.ID, 3),
+			},
+			args: args{
+				eventId:   "car.parked:1",
+				stationId: newStation.ID,
+				movement:  domain.Arrived,
+			},
+			want: want{
+				err:              nil,
+				saveEntryCalls:   1,
+				saveStationCalls: 1,
+				idle:             3,
+			},
+		},
+		{
+			name: "duplicated event input",
+			setup: setup{
+				repoFindStation: newStation,
+				repoFindEntry:   &domain.OccupancyEntry{EventId: "car.parked:1"},
+			},
+			args: args{
+				eventId:   "car.parked:1",
+				stationId: newStation.ID,
+				movement:  domain.Arrived,
+			},
+			want: want{
+				err:              nil,
+				saveEntryCalls:   0,
+				saveStationCalls: 0,
+			},
+		},
+		{
+			name: "left empty station",
+			setup: setup{
+				repoFindStation: newStation,
+				repoFindEntErr:  ErrNotFoundOccupancyEntry,
+				repoEntries: []domain.OccupancyEntry{
+					{EventId: "car.in-transfer:1", StationId: newStation.ID, Movement: domain.Left, Delta: -1},
+				},
+			},
+			args: args{
+				eventId:   "car.in-transfer:1",
+				stationId: newStation.ID,
+				movement:  domain.Left,
+			},
+			want: want{
+				err:              nil,
+				saveEntryCalls:   1,
+				saveStationCalls: 0,
+				idle:             0,
+			},
+		},
+		{
+			name:  "incorrect id input",
+			setup: setup{},
+			args: args{
+				eventId:   "car.parked:1",
+				stationId: "invalid-id",
+				movement:  domain.Arrived,
+			},
+			want: want{
+				err:              ErrInvalidId,
+				saveEntryCalls:   0,
+				saveStationCalls: 0,
+			},
+		},
+		{
+			name: "not found station",
+			setup: setup{
+				repoFindErr: ErrNotFoundStation,
+			},
+			args: args{
+				eventId:   "car.parked:1",
+				stationId: newStation.ID,
+				movement:  domain.Arrived,
+			},
+			want: want{
+				err:              ErrInvalidStation,
+				saveEntryCalls:   0,
+				saveStationCalls: 0,
+			},
+		},
+		{
+			name: "incorrect event id input",
+			setup: setup{
+				repoFindStation: newStation,
+				repoFindEntErr:  ErrNotFoundOccupancyEntry,
+			},
+			args: args{
+				eventId:   "",
+				stationId: newStation.ID,
+				movement:  domain.Arrived,
+			},
+			want: want{
+				err:              ErrInvalidEntity,
+				saveEntryCalls:   0,
+				saveStationCalls: 0,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setup.repoFindStation != nil {
+				tc.setup.repoFindStation.Idle = 0
+			}
+			stationRepo := &stationRepositoryMock{
+				expectedFindOneStation: tc.setup.repoFindStation,
+				expectedFindOneErr:     tc.setup.repoFindErr,
+				calls:                  make(map[string]uint),
+			}
+			occupancyRepo := &occupancyRepositoryMock{
+				expectedFindOneEntry:  tc.setup.repoFindEntry,
+				expectedFindOneErr:    tc.setup.repoFindEntErr,
+				expectedFindByStation: tc.setup.repoEntries,
+				calls:                 make(map[string]uint),
+			}
+			occupancyUC := NewOccupancyUseCase(occupancyRepo, stationRepo, &carRepositoryMock{calls: make(map[string]uint)})
+			err := occupancyUC.RecordOccupancy(tc.args.eventId, tc.args.stationId, "83369771-f9a4-48b7-b87b-463f19f7b187", tc.args.movement)
+
+			if occupancyRepo.calls["Save"] != tc.want.saveEntryCalls {
+				t.Error("invalid repo call", occupancyRepo.calls["Save"])
+			}
+
+			if stationRepo.calls["Save"] != tc.want.saveStationCalls {
+				t.Error("invalid repo call", stationRepo.calls["Save"])
+			}
+
+			if tc.setup.repoFindStation != nil && tc.setup.repoFindStation.Idle != tc.want.idle {
+				t.Error("unexpected idle", tc.setup.repoFindStation.Idle)
+			}
+
+			if !errors.Is(err, tc.want.err) {
+				t.Error("unexpected error", err)
+			}
+		})
+	}
+}
+
+func TestOccupancyUseCase_ReconcileOccupancy(t *testing.T) {
+	newStation := newStationFixture()
+	newStation.Idle = 1
+
+	parked := newCarFixture()
+	parked.StationId = newStation.ID
+	reserved := newCarFixture()
+	reserved.StationId = newStation.ID
+	reserved.Status = domain.Reserved
+
+	stationRepo := &stationRepositoryMock{
+		expectedFindAllStations: []domain.Station{*newStation},
+		calls:                   make(map[string]uint),
+	}
+	occupancyRepo := &occupancyRepositoryMock{
+		expectedFindByStation: newOccupancyEntriesFixture(newStation.ID, 3),
+		calls:                 make(map[string]uint),
+	}
+	carRepo := &carRepositoryMock{
+		expectedFindAllCars: []domain.Car{*parked, *reserved},
+		calls:               make(map[string]uint),
+	}
+	occupancyUC := NewOccupancyUseCase(occupancyRepo, stationRepo, carRepo)
+
+	drifts := occupancyUC.GetOccupancyDrift()
+	if len(drifts) != 1 || drifts[0].Ledger != 3 || drifts[0].Actual != 1 || drifts[0].Drift != -2 {
+		t.Fatal("unexpected drift", drifts)
+	}
+
+	if occupancyRepo.calls["Save"] != 0 {
+		t.Error("invalid repo call", occupancyRepo.calls["Save"])
+	}
+
+	drifts, err := occupancyUC.ReconcileOccupancy()
+	if err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	if len(drifts) != 1 || occupancyRepo.calls["Save"] != 1 {
+		t.Error("unexpected reconciliation", drifts, occupancyRepo.calls["Save"])
+	}
+
+	if stationRepo.calls["Save"] != 0 {
+		t.Error("invalid repo call", stationRepo.calls["Save"])
+	}
+}
@@ -0,0 +1,69 @@
+package application
+
+import (
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/domain"
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/validation"
+)
+
+type RebalanceUseCase interface {
+	GetRebalancePlan() domain.RebalancePlan
+	ExecuteRebalancePlan(plan domain.RebalancePlan) ([]TransferResult, error)
+}
+
+type TransferResult struct {
+	CarId       string `json:"carId"`
+	StationIdTo string `json:"stationIdTo"`
+	Error       string `json:"error,omitempty"`
+}
+
+type rebalanceUseCase struct {
+	carUC       CarUseCase
+	carRepo     CarReadRepository
+	stationRepo StationReadRepository
+}
+
+func NewRebalanceUseCase(carUC CarUseCase, carRepo CarReadRepository, stationRepo StationReadRepository) *rebalanceUseCase {
+	return &rebalanceUseCase{
+		carUC:       carUC,
+		carRepo:     carRepo,
+		stationRepo: stationRepo,
+	}
+}
+
+func (uc rebalanceUseCase) GetRebalancePlan() domain.RebalancePlan {
+	cars := []domain.Car{}
+	for _, status := range []domain.CarStatus{domain.Parked, domain.Reserved, domain.Transfer} {
+		cars = append(cars, uc.carRepo.Find(SearchCarParams{Status: uint(status)})...)
+	}
+
+	return domain.PlanRebalance(uc.stationRepo.FindAll(), cars)
+}
+
+func (uc rebalanceUseCase) ExecuteRebalancePlan(plan domain.RebalancePlan) ([]TransferResult, error) {
+	if len(plan.Transfers) == 0 {
+		return nil, ErrInvalidRebalancePlan
+	}
+
+	for _, t := range plan.Transfers {
+		if err := validation.ValidateEntity(t); err != nil {
+			return nil, ErrInvalidRebalancePlan
+		}
+	}
+
+	results := []TransferResult{}
+	for _, t := range plan.Transfers {
+		result := TransferResult{CarId: t.CarId, StationIdTo: t.StationIdTo}
+
+		if car, err := uc.carRepo.FindOne(t.CarId); err != nil {
+			result.Error = ErrNotFoundCar.Error()
+		} else if car.StationId != t.StationIdFrom {
+			result.Error = ErrInvalidTransfer.Error()
+		} else if err := uc.carUC.TransferCar(t.CarId, t.StationIdTo); err != nil {
+			result.Error = err.Error()
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
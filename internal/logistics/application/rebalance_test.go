@@ -0,0 +1,95 @@
+package application
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/domain"
+)
+
+func TestRebalanceUseCase_ExecuteRebalancePlan(t *testing.T) {
+	newCar := newCarFixture()
+	newStation := newStationFixture()
+
+	transfer := domain.PlannedTransfer{
+		CarId:         newCar.ID,
+		Model:         newCar.Model,
+		StationIdFrom: newCar.StationId,
+		StationIdTo:   newStation.ID,
+	}
+
+	wrongFrom := transfer
+	wrongFrom.StationIdFrom = newStation.ID
+
+	invalid := transfer
+	invalid.CarId = "invalid-id"
+
+	type want struct {
+		err         error
+		resultErrs  []string
+		carSaveCall uint
+	}
+
+	testCases := []struct {
+		name string
+		plan domain.RebalancePlan
+		want want
+	}{
+		{
+			name: "correct input",
+			plan: domain.RebalancePlan{Transfers: []domain.PlannedTransfer{transfer}},
+			want: want{err: nil, resultErrs: []string{""}, carSaveCall: 1},
+		},
+		{
+			name: "car moved from planned station",
+			plan: domain.RebalancePlan{Transfers: []domain.PlannedTransfer{wrongFrom}},
+			want: want{err: nil, resultErrs: []string{ErrInvalidTransfer.Error()}, carSaveCall: 0},
+		},
+		{
+			name: "empty plan",
+			plan: domain.RebalancePlan{},
+			want: want{err: ErrInvalidRebalancePlan, carSaveCall: 0},
+		},
+		{
+			name: "incorrect transfer input",
+			plan: domain.RebalancePlan{Transfers: []domain.PlannedTransfer{invalid}},
+			want: want{err: ErrInvalidRebalancePlan, carSaveCall: 0},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			car := *newCar
+			carRepo := &carRepositoryMock{
+				expectedFindOneCar: &car,
+				calls:              make(map[string]uint),
+			}
+			stationRepo := &stationRepositoryMock{
+				expectedFindOneStation: newStation,
+				calls:                  make(map[string]uint),
+			}
+			carUC := NewCarUseCase(carRepo, stationRepo, nil)
+			rebalanceUC := NewRebalanceUseCase(carUC, carRepo, stationRepo)
+
+			results, err := rebalanceUC.ExecuteRebalancePlan(tc.plan)
+
+			if !errors.Is(err, tc.want.err) {
+				t.Error("unexpected error", err, tc.want.err)
+			}
+
+			if len(results) != len(tc.want.resultErrs) {
+				t.Fatal("unexpected result", results)
+			}
+
+			for i, r := range results {
+				if r.Error != tc.want.resultErrs[i] {
+					t.Error("unexpected result error", r.Error)
+				}
+			}
+
+			if carRepo.calls["Save"] != tc.want.carSaveCall {
+				t.Error("invalid repo call", carRepo.calls["Save"])
+			}
+		})
+	}
+}
@@ -1,15 +1,21 @@
 package application
 
-import "github.com/thiagotrs/rentalcar-ddd/internal/logistics/domain"
+import (
+	"time"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/domain"
+)
 
 type CarReadRepository interface {
 	Find(search SearchCarParams) []domain.Car
+	Count(search SearchCarParams) int
 	FindOne(id string) (*domain.Car, error)
 }
 
 type CarWriteRepository interface {
 	Save(car domain.Car) error
 	Delete(id string) error
+	UpdateReportedKM(id string, km uint64) error
 }
 
 type CarRepository interface {
@@ -31,3 +37,90 @@ type StationRepository interface {
 	StationReadRepository
 	StationWriteRepository
 }
+
+type OccupancyReadRepository interface {
+	FindOne(eventId string) (*domain.OccupancyEntry, error)
+	FindByStation(stationId string) []domain.OccupancyEntry
+}
+
+type OccupancyWriteRepository interface {
+	Save(entry domain.OccupancyEntry) error
+}
+
+type OccupancyRepository interface {
+	OccupancyReadRepository
+	OccupancyWriteRepository
+}
+
+type TelemetryReadRepository interface {
+	FindByCar(carId string, from, to time.Time) []domain.TelemetryReading
+}
+
+type TelemetryWriteRepository interface {
+	Save(readings []domain.TelemetryReading) error
+}
+
+type TelemetryRepository interface {
+	TelemetryReadRepository
+	TelemetryWriteRepository
+}
+
+type WorkOrderReadRepository interface {
+	FindByCar(carId string) []domain.WorkOrder
+	FindOne(id string) (*domain.WorkOrder, error)
+}
+
+type WorkOrderWriteRepository interface {
+	Save(workOrder domain.WorkOrder) error
+	SaveWithCar(workOrder domain.WorkOrder, car domain.Car) error
+}
+
+type WorkOrderRepository interface {
+	WorkOrderReadRepository
+	WorkOrderWriteRepository
+}
+
+type MaintenancePlanReadRepository interface {
+	FindAll() []domain.MaintenancePlan
+	FindOne(id string) (*domain.MaintenancePlan, error)
+	FindByModel(modelId string) (*domain.MaintenancePlan, error)
+}
+
+type MaintenancePlanWriteRepository interface {
+	Save(plan domain.MaintenancePlan) error
+	Delete(id string) error
+}
+
+type MaintenancePlanRepository interface {
+	MaintenancePlanReadRepository
+	MaintenancePlanWriteRepository
+}
+
+type DocumentReadRepository interface {
+	FindAll() []domain.CarDocument
+	FindByCar(carId string) []domain.CarDocument
+	FindOne(id string) (*domain.CarDocument, error)
+}
+
+type DocumentWriteRepository interface {
+	Save(document domain.CarDocument) error
+}
+
+type DocumentRepository interface {
+	DocumentReadRepository
+	DocumentWriteRepository
+}
+
+type LifecycleReadRepository interface {
+	FindOne(carId string) (*domain.CarLifecycle, error)
+}
+
+type LifecycleWriteRepository interface {
+	Save(lifecycle domain.CarLifecycle) error
+	SaveWithCar(lifecycle domain.CarLifecycle, car domain.Car) error
+}
+
+type LifecycleRepository interface {
+	LifecycleReadRepository
+	LifecycleWriteRepository
+}
@@ -0,0 +1,23 @@
+package application
+
+import (
+	"time"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/domain"
+)
+
+type Geocoder interface {
+	Geocode(cep string) (latitude, longitude float64, err error)
+}
+
+type ModelCatalog interface {
+	GetModel(modelId string) (make, model string, energy domain.EnergyType, err error)
+}
+
+type AssignmentStrategy interface {
+	Rank(candidates []domain.AssignmentCandidate, now time.Time) []domain.AssignmentCandidate
+}
+
+type UpgradeCatalog interface {
+	GetUpgradeModels(modelId string) ([]string, error)
+}
@@ -1,6 +1,9 @@
 package application
 
 import (
+	"sort"
+	"time"
+
 	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/domain"
 	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/validation"
 )
@@ -11,15 +14,26 @@ type StationUseCase interface {
 	AddStation(name, address, complement, state, city, cep string, capacity, idle uint) error
 	DeleteStation(id string) error
 	ChangeStationCapacity(id string, capacity uint) error
-	ChangeStationIdle(id string, op string) error
+	ChangeStationLocation(id string, latitude, longitude float64) error
+	ChangeStationSchedule(id string, schedule []domain.OpeningHours, holidays []domain.Holiday, timezone string, keyDrop bool) error
+	IsStationOpen(id string, at time.Time, keyDrop bool) (bool, error)
+	SearchNearbyStations(latitude, longitude, radius float64, model string) ([]NearbyStation, error)
 }
 
 type stationUseCase struct {
 	stationRepo StationRepository
+	carRepo     CarReadRepository
+	geocoder    Geocoder
+}
+
+func NewStationUseCase(stationRepo StationRepository, carRepo CarReadRepository, geocoder Geocoder) *stationUseCase {
+	return &stationUseCase{stationRepo, carRepo, geocoder}
 }
 
-func NewStationUseCase(stationRepo StationRepository) *stationUseCase {
-	return &stationUseCase{stationRepo}
+type NearbyStation struct {
+	domain.Station
+	Distance      float64 `json:"distance"`
+	AvailableCars uint    `json:"availableCars"`
 }
 
 func (uc stationUseCase) GetStations() []domain.Station {
@@ -47,6 +61,11 @@ func (uc stationUseCase) AddStation(name, address, complement, state, city, cep
 		return ErrInvalidEntity
 	}
 
+	// a station the geocoder can't place is still saved and can be located later
+	if latitude, longitude, err := uc.geocoder.Geocode(cep); err == nil {
+		newStation.SetLocation(latitude, longitude)
+	}
+
 	if err := uc.stationRepo.Save(*newStation); err != nil {
 		return ErrInvalidStation
 	}
@@ -98,32 +117,92 @@ func (uc stationUseCase) ChangeStationCapacity(id string, capacity uint) error {
 	return nil
 }
 
-func (uc stationUseCase) ChangeStationIdle(id string, op string) error {
+func (uc stationUseCase) ChangeStationLocation(id string, latitude, longitude float64) error {
 	if err := validation.ValidId(id); err != nil {
 		return ErrInvalidId
 	}
 
-	m, err := uc.stationRepo.FindOne(id)
+	station, err := uc.stationRepo.FindOne(id)
 	if err != nil {
 		return ErrInvalidStation
 	}
 
-	switch op {
-	case "add":
-		if err := m.SetIdle(m.Idle + 1); err != nil {
-			return err
+	if err := station.SetLocation(latitude, longitude); err != nil {
+		return ErrInvalidLocation
+	}
+
+	if err := uc.stationRepo.Save(*station); err != nil {
+		return ErrInvalidStation
+	}
+
+	return nil
+}
+
+func (uc stationUseCase) SearchNearbyStations(latitude, longitude, radius float64, model string) ([]NearbyStation, error) {
+	if latitude < -90 || latitude > 90 || longitude < -180 || longitude > 180 || radius <= 0 {
+		return nil, ErrInvalidLocation
+	}
+
+	nearby := []NearbyStation{}
+	for _, s := range uc.stationRepo.FindAll() {
+		if !s.HasLocation() {
+			continue
+		}
+
+		distance := s.DistanceTo(latitude, longitude)
+		if distance > radius {
+			continue
+		}
+
+		var available uint
+		for _, c := range uc.carRepo.Find(SearchCarParams{StationId: s.ID, Model: model, Status: uint(domain.Parked)}) {
+			if c.StationId == s.ID && c.Status == domain.Parked && (model == "" || c.Model == model) {
+				available++
+			}
 		}
-	case "del":
-		if err := m.SetIdle(m.Idle - 1); err != nil {
-			return err
+
+		if model != "" && available == 0 {
+			continue
 		}
-	default:
-		return ErrInvalidIdle
+
+		nearby = append(nearby, NearbyStation{Station: s, Distance: distance, AvailableCars: available})
 	}
 
-	if err := uc.stationRepo.Save(*m); err != nil {
+	sort.Slice(nearby, func(i, j int) bool { return nearby[i].Distance < nearby[j].Distance })
+
+	return nearby, nil
+}
+
+func (uc stationUseCase) ChangeStationSchedule(id string, schedule []domain.OpeningHours, holidays []domain.Holiday, timezone string, keyDrop bool) error {
+	if err := validation.ValidId(id); err != nil {
+		return ErrInvalidId
+	}
+
+	station, err := uc.stationRepo.FindOne(id)
+	if err != nil {
+		return ErrInvalidStation
+	}
+
+	if err := station.SetSchedule(schedule, holidays, timezone, keyDrop); err != nil {
+		return ErrInvalidSchedule
+	}
+
+	if err := uc.stationRepo.Save(*station); err != nil {
 		return ErrInvalidStation
 	}
 
 	return nil
 }
+
+func (uc stationUseCase) IsStationOpen(id string, at time.Time, keyDrop bool) (bool, error) {
+	if err := validation.ValidId(id); err != nil {
+		return false, ErrInvalidId
+	}
+
+	station, err := uc.stationRepo.FindOne(id)
+	if err != nil {
+		return false, ErrNotFoundStation
+	}
+
+	return station.AcceptsReturn(at, keyDrop), nil
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/domain"
 )
@@ -51,6 +52,16 @@ func (m *stationRepositoryMock) Delete(id string) error {
 	return m.expectedDeleteErr
 }
 
+type geocoderMock struct {
+	expectedLatitude  float64
+	expectedLongitude float64
+	expectedErr       error
+}
+
+func (m *geocoderMock) Geocode(cep string) (float64, float64, error) {
+	return m.expectedLatitude, m.expectedLongitude, m.expectedErr
+}
+
 func TestStationUseCase_GetStations(t *testing.T) {
 	newStation := newStationFixture()
 
@@ -102,7 +113,7 @@ func TestStationUseCase_GetStations(t *testing.T) {
 				expectedFindAllStations: tc.setup.repoStations,
 				calls:                   make(map[string]uint),
 			}
-			stationUC := NewStationUseCase(stationRepo)
+			stationUC := NewStationUseCase(stationRepo, &carRepositoryMock{calls: make(map[string]uint)}, &geocoderMock{})
 			stations := stationUC.GetStations()
 
 			if stationRepo.calls["FindAll"] != 1 {
@@ -191,7 +202,7 @@ func TestStationUseCase_GetStationById(t *testing.T) {
 				expectedFindOneErr:     tc.setup.repoErr,
 				calls:                  make(map[string]uint),
 			}
-			stationUC := NewStationUseCase(stationRepo)
+			stationUC := NewStationUseCase(stationRepo, &carRepositoryMock{calls: make(map[string]uint)}, &geocoderMock{})
 			stations, err := stationUC.GetStationById(tc.args.id)
 
 			if stationRepo.calls["FindOne"] != tc.want.calls {
@@ -282,7 +293,7 @@ func TestStationUseCase_AddStation(t *testing.T) {
 				expectedSaveErr: tc.setup.repoSaveErr,
 				calls:           make(map[string]uint),
 			}
-			stationUC := NewStationUseCase(stationRepo)
+			stationUC := NewStationUseCase(stationRepo, &carRepositoryMock{calls: make(map[string]uint)}, &geocoderMock{})
 			err := stationUC.AddStation(
 				tc.args.name,
 				tc.args.address,
@@ -404,7 +415,7 @@ func TestStationUseCase_DeleteStation(t *testing.T) {
 				expectedDeleteErr:      tc.setup.repoDelErr,
 				calls:                  make(map[string]uint),
 			}
-			stationUC := NewStationUseCase(stationRepo)
+			stationUC := NewStationUseCase(stationRepo, &carRepositoryMock{calls: make(map[string]uint)}, &geocoderMock{})
 			err := stationUC.DeleteStation(tc.args.id)
 
 			if stationRepo.calls["FindOne"] != tc.want.findCalls {
@@ -526,7 +537,7 @@ func TestStationUseCase_ChangeStationCapacity(t *testing.T) {
 				expectedSaveErr:        tc.setup.repoSaveErr,
 				calls:                  make(map[string]uint),
 			}
-			stationUC := NewStationUseCase(stationRepo)
+			stationUC := NewStationUseCase(stationRepo, &carRepositoryMock{calls: make(map[string]uint)}, &geocoderMock{})
 			err := stationUC.ChangeStationCapacity(tc.args.id, tc.args.capacity)
 
 			if stationRepo.calls["FindOne"] != tc.want.findCalls {
@@ -543,3 +554,195 @@ func TestStationUseCase_ChangeStationCapacity(t *testing.T) {
 		})
 	}
 }
+
+func TestStationUseCase_ChangeStationSchedule(t *testing.T) {
+	schedule := []domain.OpeningHours{{Weekday: time.Monday, Opens: "08:00", Closes: "18:00"}}
+
+	type setup struct {
+		repoFindStation *domain.Station
+		repoFindErr     error
+	}
+
+	type args struct {
+		id       string
+		schedule []domain.OpeningHours
+	}
+
+	type want struct {
+		err       error
+		findCalls uint
+		saveCalls uint
+	}
+
+	testCases := []struct {
+		name  string
+		setup setup
+		args  args
+		want  want
+	}{
+		{
+			name: "correct input",
+			setup: setup{
+				repoFindStation: newStationFixture(),
+			},
+			args: args{
+				id:       "35098f2d-6351-4509-87a2-896bab961a25",
+				schedule: schedule,
+			},
+			want: want{
+				err:       nil,
+				findCalls: 1,
+				saveCalls: 1,
+			},
+		},
+		{
+			name:  "incorrect id input",
+			setup: setup{},
+			args: args{
+				id:       "invalid-id",
+				schedule: schedule,
+			},
+			want: want{
+				err:       ErrInvalidId,
+				findCalls: 0,
+				saveCalls: 0,
+			},
+		},
+		{
+			name: "not found station",
+			setup: setup{
+				repoFindErr: ErrInvalidStation,
+			},
+			args: args{
+				id:       "35098f2d-6351-4509-87a2-896bab961a25",
+				schedule: schedule,
+			},
+			want: want{
+				err:       ErrInvalidStation,
+				findCalls: 1,
+				saveCalls: 0,
+			},
+		},
+		{
+			name: "invalid schedule input",
+			setup: setup{
+				repoFindStation: newStationFixture(),
+			},
+			args: args{
+				id:       "35098f2d-6351-4509-87a2-896bab961a25",
+				schedule: []domain.OpeningHours{{Weekday: time.Monday, Opens: "18:00", Closes: "8h"}},
+			},
+			want: want{
+				err:       ErrInvalidSchedule,
+				findCalls: 1,
+				saveCalls: 0,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			stationRepo := &stationRepositoryMock{
+				expectedFindOneStation: tc.setup.repoFindStation,
+				expectedFindOneErr:     tc.setup.repoFindErr,
+				calls:                  make(map[string]uint),
+			}
+			stationUC := NewStationUseCase(stationRepo, &carRepositoryMock{calls: make(map[string]uint)}, &geocoderMock{})
+			err := stationUC.ChangeStationSchedule(tc.args.id, tc.args.schedule, []domain.Holiday{}, "", false)
+
+			if stationRepo.calls["FindOne"] != tc.want.findCalls {
+				t.Error("invalid repo call", stationRepo.calls["FindOne"])
+			}
+
+			if stationRepo.calls["Save"] != tc.want.saveCalls {
+				t.Error("invalid repo call", stationRepo.calls["Save"])
+			}
+
+			if !errors.Is(err, tc.want.err) {
+				t.Error("unexpected error", err)
+			}
+		})
+	}
+}
+
+func TestStationUseCase_SearchNearbyStations(t *testing.T) {
+	near := newStationFixture()
+	near.SetLocation(-23.5505, -46.6333)
+
+	far := newStationFixture()
+	far.ID = "35098f2d-6351-4509-87a2-896bab961a25"
+	far.SetLocation(-22.9068, -43.1729)
+
+	unknown := newStationFixture()
+	unknown.ID = "5ce5a1a1-f324-4c8b-8c92-d7e820cbb238"
+
+	parked := newCarFixture()
+	parked.StationId = near.ID
+
+	type args struct {
+		radius float64
+		model  string
+	}
+
+	type want struct {
+		stations  []string
+		available []uint
+		err       error
+	}
+
+	testCases := []struct {
+		name string
+		args args
+		want want
+	}{
+		{
+			name: "correct input",
+			args: args{radius: 500},
+			want: want{stations: []string{near.ID, far.ID}, available: []uint{1, 0}, err: nil},
+		},
+		{
+			name: "correct input within radius",
+			args: args{radius: 10},
+			want: want{stations: []string{near.ID}, available: []uint{1}, err: nil},
+		},
+		{
+			name: "correct input with model",
+			args: args{radius: 500, model: parked.Model},
+			want: want{stations: []string{near.ID}, available: []uint{1}, err: nil},
+		},
+		{
+			name: "incorrect radius input",
+			args: args{radius: 0},
+			want: want{err: ErrInvalidLocation},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			stationRepo := &stationRepositoryMock{
+				expectedFindAllStations: []domain.Station{*far, *unknown, *near},
+				calls:                   make(map[string]uint),
+			}
+			carRepo := &carRepositoryMock{
+				expectedFindAllCars: []domain.Car{*parked},
+				calls:               make(map[string]uint),
+			}
+			stationUC := NewStationUseCase(stationRepo, carRepo, &geocoderMock{})
+			nearby, err := stationUC.SearchNearbyStations(-23.5613, -46.6565, tc.args.radius, tc.args.model)
+
+			if !errors.Is(err, tc.want.err) {
+				t.Error("unexpected error", err, tc.want.err)
+			}
+
+			if len(nearby) != len(tc.want.stations) {
+				t.Fatal("unexpected result", nearby)
+			}
+
+			for i, n := range nearby {
+				if n.ID != tc.want.stations[i] || n.AvailableCars != tc.want.available[i] {
+					t.Error("unexpected station", n.ID, n.AvailableCars)
+				}
+			}
+		})
+	}
+}
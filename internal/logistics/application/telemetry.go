@@ -0,0 +1,87 @@
+package application
+
+import (
+	"sort"
+	"time"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/domain"
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/validation"
+)
+
+type TelemetryUseCase interface {
+	IngestTelemetry(readings []domain.TelemetryReading) error
+	GetCarTrack(carId string, from, to time.Time) ([]domain.TelemetryReading, error)
+}
+
+type telemetryUseCase struct {
+	telemetryRepo TelemetryRepository
+	carRepo       CarRepository
+}
+
+func NewTelemetryUseCase(telemetryRepo TelemetryRepository, carRepo CarRepository) *telemetryUseCase {
+	return &telemetryUseCase{
+		telemetryRepo: telemetryRepo,
+		carRepo:       carRepo,
+	}
+}
+
+func (uc telemetryUseCase) IngestTelemetry(readings []domain.TelemetryReading) error {
+	if len(readings) == 0 {
+		return ErrInvalidTelemetry
+	}
+
+	for _, r := range readings {
+		if err := r.Validate(); err != nil {
+			return ErrInvalidTelemetry
+		}
+	}
+
+	cars := make(map[string]*domain.Car)
+	for _, r := range readings {
+		if _, exists := cars[r.CarId]; exists {
+			continue
+		}
+		car, err := uc.carRepo.FindOne(r.CarId)
+		if err != nil {
+			return ErrNotFoundCar
+		}
+		cars[r.CarId] = car
+	}
+
+	sort.SliceStable(readings, func(i, j int) bool { return readings[i].RecordedAt.Before(readings[j].RecordedAt) })
+
+	if err := uc.telemetryRepo.Save(readings); err != nil {
+		return ErrInvalidTelemetry
+	}
+
+	updated := make(map[string]bool)
+	for _, r := range readings {
+		if cars[r.CarId].ReportOdometer(r.KM) {
+			updated[r.CarId] = true
+		}
+	}
+
+	for id := range updated {
+		if err := uc.carRepo.UpdateReportedKM(id, cars[id].LastReportedKM); err != nil {
+			return ErrInvalidCar
+		}
+	}
+
+	return nil
+}
+
+func (uc telemetryUseCase) GetCarTrack(carId string, from, to time.Time) ([]domain.TelemetryReading, error) {
+	if err := validation.ValidId(carId); err != nil {
+		return nil, ErrInvalidId
+	}
+
+	if to.Before(from) {
+		return nil, ErrInvalidTrack
+	}
+
+	if _, err := uc.carRepo.FindOne(carId); err != nil {
+		return nil, ErrNotFoundCar
+	}
+
+	return uc.telemetryRepo.FindByCar(carId, from, to), nil
+}
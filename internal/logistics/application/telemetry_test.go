@@ -0,0 +1,139 @@
+package application
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/logistics/domain"
+)
+
+type telemetryRepositoryMock struct {
+	expectedFindByCar []domain.TelemetryReading
+	expectedSaveErr   error
+	calls             map[string]uint
+}
+
+func (m *telemetryRepositoryMock) FindByCar(carId string, from, to time.Time) []domain.TelemetryReading {
+	m.calls["FindByCar"] = m.calls["FindByCar"] + 1
+	return m.expectedFindByCar
+}
+
+func (m *telemetryRepositoryMock) Save(readings []domain.TelemetryReading) error {
+	m.calls["Save"] = m.calls["Save"] + 1
+	return m.expectedSaveErr
+}
+
+func TestTelemetryUseCase_IngestTelemetry(t *testing.T) {
+	at := time.Date(2022, time.March, 1, 8, 0, 0, 0, time.UTC)
+	carId := "e4ce866a-f5b7-4774-8f9d-5eb74c3900cc"
+
+	type setup struct {
+		repoFindCarErr error
+	}
+
+	type want struct {
+		err          error
+		saveCalls    uint
+		saveCarCalls uint
+	}
+
+	testCases := []struct {
+		name        string
+		setup       setup
+		readingsArg []domain.TelemetryReading
+		want        want
+	}{
+		{
+			name: "correct input",
+			readingsArg: []domain.TelemetryReading{
+				{CarId: carId, RecordedAt: at.Add(time.Minute), KM: 12100},
+				{CarId: carId, RecordedAt: at, KM: 12050},
+			},
+			want: want{
+				err:          nil,
+				saveCalls:    1,
+				saveCarCalls: 1,
+			},
+		},
+		{
+			name: "stale km input",
+			readingsArg: []domain.TelemetryReading{
+				{CarId: carId, RecordedAt: at, KM: 11000},
+			},
+			want: want{
+				err:          nil,
+				saveCalls:    1,
+				saveCarCalls: 0,
+			},
+		},
+		{
+			name:        "empty input",
+			readingsArg: []domain.TelemetryReading{},
+			want: want{
+				err:          ErrInvalidTelemetry,
+				saveCalls:    0,
+				saveCarCalls: 0,
+			},
+		},
+		{
+			name: "incorrect reading input",
+			readingsArg: []domain.TelemetryReading{
+				{CarId: carId, RecordedAt: at, KM: 12100},
+				{CarId: carId, RecordedAt: at, KM: 12100, FuelLevel: 150},
+			},
+			want: want{
+				err:          ErrInvalidTelemetry,
+				saveCalls:    0,
+				saveCarCalls: 0,
+			},
+		},
+		{
+			name: "not found car",
+			setup: setup{
+				repoFindCarErr: ErrNotFoundCar,
+			},
+			readingsArg: []domain.TelemetryReading{
+				{CarId: carId, RecordedAt: at, KM: 12100},
+			},
+			want: want{
+				err:          ErrNotFoundCar,
+				saveCalls:    0,
+				saveCarCalls: 0,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			car := newCarFixture()
+			if tc.setup.repoFindCarErr != nil {
+				car = nil
+			}
+			carRepo := &carRepositoryMock{
+				expectedFindOneCar: car,
+				expectedFindOneErr: tc.setup.repoFindCarErr,
+				calls:              make(map[string]uint),
+			}
+			telemetryRepo := &telemetryRepositoryMock{calls: make(map[string]uint)}
+			telemetryUC := NewTelemetryUseCase(telemetryRepo, carRepo)
+			err := telemetryUC.IngestTelemetry(tc.readingsArg)
+
+			if telemetryRepo.calls["Save"] != tc.want.saveCalls {
+				t.Error("invalid repo call", telemetryRepo.calls["Save"])
+			}
+
+			if carRepo.calls["UpdateReportedKM"] != tc.want.saveCarCalls || carRepo.calls["Save"] != 0 {
+				t.Error("invalid repo call", carRepo.calls)
+			}
+
+			if err == nil && tc.want.saveCarCalls > 0 && (car.LastReportedKM != 12100 || car.KM != newCarFixture().KM) {
+				t.Error("unexpected km", car.KM, car.LastReportedKM)
+			}
+
+			if !errors.Is(err, tc.want.err) {
+				t.Error("unexpected error", err)
+			}
+		})
+	}
+}
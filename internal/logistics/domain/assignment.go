@@ -0,0 +1,88 @@
+package domain
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+type AssignmentCriterion uint
+
+const (
+	LowestKM AssignmentCriterion = iota + 1
+	LongestIdle
+	HighestFuel
+	EvenWear
+)
+
+var assignmentCriteria = map[string]AssignmentCriterion{
+	"lowest-km":    LowestKM,
+	"longest-idle": LongestIdle,
+	"highest-fuel": HighestFuel,
+	"even-wear":    EvenWear,
+}
+
+func ParseAssignmentCriteria(names []string) ([]AssignmentCriterion, error) {
+	criteria := []AssignmentCriterion{}
+	for _, name := range names {
+		criterion, ok := assignmentCriteria[strings.TrimSpace(name)]
+		if !ok {
+			return nil, ErrInvalidAssignmentCriterion
+		}
+		criteria = append(criteria, criterion)
+	}
+
+	return criteria, nil
+}
+
+type AssignmentCandidate struct {
+	Car       Car       `json:"car"`
+	IdleSince time.Time `json:"idleSince"`
+}
+
+func (c AssignmentCandidate) Wear(now time.Time) float64 {
+	years := now.Year() - int(c.Car.Age) + 1
+	if years < 1 {
+		years = 1
+	}
+	return float64(c.Car.KM) / float64(years)
+}
+
+type CriteriaAssignment struct {
+	Criteria []AssignmentCriterion
+}
+
+func NewCriteriaAssignment(criteria ...AssignmentCriterion) *CriteriaAssignment {
+	return &CriteriaAssignment{criteria}
+}
+
+func (a CriteriaAssignment) Rank(candidates []AssignmentCandidate, now time.Time) []AssignmentCandidate {
+	ranked := append([]AssignmentCandidate{}, candidates...)
+
+	sort.SliceStable(ranked, func(i, j int) bool {
+		x, y := ranked[i], ranked[j]
+		for _, criterion := range a.Criteria {
+			switch criterion {
+			case LowestKM:
+				if x.Car.KM != y.Car.KM {
+					return x.Car.KM < y.Car.KM
+				}
+			case LongestIdle:
+				if !x.IdleSince.Equal(y.IdleSince) {
+					return x.IdleSince.Before(y.IdleSince)
+				}
+			case HighestFuel:
+				if x.Car.FuelLevel != y.Car.FuelLevel {
+					return x.Car.FuelLevel > y.Car.FuelLevel
+				}
+			case EvenWear:
+				if x.Wear(now) != y.Wear(now) {
+					return x.Wear(now) < y.Wear(now)
+				}
+			}
+		}
+		return x.Car.Plate < y.Car.Plate
+	})
+
+	return ranked
+}
@@ -0,0 +1,103 @@
+package domain
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParseAssignmentCriteria(t *testing.T) {
+	testCases := []struct {
+		name         string
+		namesArg     []string
+		wantCriteria []AssignmentCriterion
+		wantErr      error
+	}{
+		{
+			name:         "correct criteria",
+			namesArg:     []string{"longest-idle", " lowest-km", "highest-fuel", "even-wear"},
+			wantCriteria: []AssignmentCriterion{LongestIdle, LowestKM, HighestFuel, EvenWear},
+			wantErr:      nil,
+		},
+		{
+			name:         "incorrect criterion",
+			namesArg:     []string{"lowest-km", "newest"},
+			wantCriteria: nil,
+			wantErr:      ErrInvalidAssignmentCriterion,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			criteria, err := ParseAssignmentCriteria(tc.namesArg)
+
+			if !errors.Is(err, tc.wantErr) {
+				t.Error("unexpected error", err)
+			}
+
+			if !reflect.DeepEqual(criteria, tc.wantCriteria) {
+				t.Error("unexpected criteria", criteria, tc.wantCriteria)
+			}
+		})
+	}
+}
+
+func TestCriteriaAssignment_Rank(t *testing.T) {
+	now := time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	newCandidate := func(plate string, age uint16, km uint64, fuel uint8, idleSince time.Time) AssignmentCandidate {
+		return AssignmentCandidate{
+			Car:       Car{Plate: plate, Age: age, KM: km, FuelLevel: fuel},
+			IdleSince: idleSince,
+		}
+	}
+
+	candidates := []AssignmentCandidate{
+		newCandidate("AAA-0001", 2021, 30000, 50, now.Add(-2*time.Hour)),
+		newCandidate("AAA-0002", 2018, 40000, 100, now.Add(-48*time.Hour)),
+		newCandidate("AAA-0003", 2022, 30000, 80, now.Add(-2*time.Hour)),
+	}
+
+	testCases := []struct {
+		name        string
+		criteriaArg []AssignmentCriterion
+		wantPlates  []string
+	}{
+		{
+			name:        "lowest km then highest fuel",
+			criteriaArg: []AssignmentCriterion{LowestKM, HighestFuel},
+			wantPlates:  []string{"AAA-0003", "AAA-0001", "AAA-0002"},
+		},
+		{
+			name:        "longest idle then plate",
+			criteriaArg: []AssignmentCriterion{LongestIdle},
+			wantPlates:  []string{"AAA-0002", "AAA-0001", "AAA-0003"},
+		},
+		{
+			name:        "even wear",
+			criteriaArg: []AssignmentCriterion{EvenWear},
+			wantPlates:  []string{"AAA-0002", "AAA-0001", "AAA-0003"},
+		},
+		{
+			name:        "no criteria",
+			criteriaArg: []AssignmentCriterion{},
+			wantPlates:  []string{"AAA-0001", "AAA-0002", "AAA-0003"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ranked := NewCriteriaAssignment(tc.criteriaArg...).Rank(candidates, now)
+
+			plates := []string{}
+			for _, c := range ranked {
+				plates = append(plates, c.Car.Plate)
+			}
+
+			if !reflect.DeepEqual(plates, tc.wantPlates) {
+				t.Error("unexpected rank", plates, tc.wantPlates)
+			}
+		})
+	}
+}
@@ -15,32 +15,55 @@ const (
 	Parked
 	Reserved
 	Transfer
+	Retired
+	ForSale
+	Sold
 )
 
+type EnergyType uint
+
+const (
+	Combustion EnergyType = iota + 1
+	Electric
+)
+
+const FullLevel uint8 = 100
+
 type Car struct {
-	ID        string         `json:"id" validate:"required,uuid4"`
-	Age       uint16         `json:"age" validate:"required,min=1900,max=2100"`
-	Plate     string         `json:"plate" validate:"required"`
-	Document  string         `json:"document" validate:"required"`
-	Model     string         `json:"model" validate:"required"`
-	Make      string         `json:"make" validate:"required"`
-	StationId string         `json:"stationId" validate:"uuid4" db:"stationId"`
-	KM        uint64         `json:"km" validate:"required"`
-	Status    CarStatus      `json:"status" validate:"required"`
-	Events    []events.Event `json:"-" bson:"-"`
+	ID             string         `json:"id" validate:"required,uuid4"`
+	Age            uint16         `json:"age" validate:"required,min=1900,max=2100"`
+	Plate          string         `json:"plate" validate:"required"`
+	Document       string         `json:"document" validate:"required"`
+	ModelId        string         `json:"modelId" validate:"omitempty,uuid4" db:"modelId"`
+	Model          string         `json:"model" validate:"required"`
+	Make           string         `json:"make" validate:"required"`
+	StationId      string         `json:"stationId" validate:"uuid4" db:"stationId"`
+	KM             uint64         `json:"km" validate:"required"`
+	Status         CarStatus      `json:"status" validate:"required"`
+	Energy         EnergyType     `json:"energy" validate:"required,max=2"`
+	FuelLevel      uint8          `json:"fuelLevel" validate:"max=100" db:"fuelLevel"`
+	LastReportedKM uint64         `json:"lastReportedKM" db:"lastReportedKM"`
+	Events         []events.Event `json:"-" bson:"-"`
 }
 
-func NewCar(age uint16, km uint64, plate, document, stationId, model, make string) (*Car, error) {
+func NewCar(age uint16, km uint64, plate, document, stationId, modelId, model, make string, energy EnergyType, fuelLevel uint8) (*Car, error) {
+	if energy == 0 {
+		energy = Combustion
+	}
+
 	newCar := &Car{
 		ID:        validation.NewId(),
 		Age:       age,
 		Plate:     plate,
 		Document:  document,
+		ModelId:   modelId,
 		Model:     model,
 		Make:      make,
 		StationId: stationId,
 		KM:        km,
 		Status:    Parked,
+		Energy:    energy,
+		FuelLevel: fuelLevel,
 	}
 
 	if err := validation.ValidateEntity(newCar); err != nil {
@@ -48,6 +71,7 @@ func NewCar(age uint16, km uint64, plate, document, stationId, model, make strin
 	}
 
 	newCar.Events = append(newCar.Events, CarAdded{
+		EventId:   validation.NewId(),
 		ID:        newCar.ID,
 		StationId: newCar.StationId,
 	})
@@ -64,13 +88,15 @@ func (c *Car) ToMaintenance(stationId string, km uint64) error {
 		return ErrInvalidMaintenance
 	}
 
+	status := c.Status
 	c.Status = Maintenance
 	c.KM = km
 
 	c.Events = append(c.Events, CarUnderMaintenance{
+		EventId:   validation.NewId(),
 		ID:        c.ID,
 		StationId: c.StationId,
-		CarStatus: Parked,
+		CarStatus: status,
 	})
 
 	c.StationId = stationId
@@ -86,6 +112,7 @@ func (c *Car) Transfer(stationId string) error {
 	c.Status = Transfer
 
 	c.Events = append(c.Events, CarInTransfer{
+		EventId:       validation.NewId(),
 		ID:            c.ID,
 		StationIdFrom: c.StationId,
 		StationIdTo:   stationId,
@@ -96,7 +123,7 @@ func (c *Car) Transfer(stationId string) error {
 	return nil
 }
 
-func (c *Car) Park(stationId string, km uint64) error {
+func (c *Car) Park(stationId string, km uint64, fuelLevel uint8) error {
 	if c.Status != Maintenance && c.Status != Transit && c.Status != Reserved && c.Status != Transfer {
 		return ErrInvalidPark
 	}
@@ -105,14 +132,21 @@ func (c *Car) Park(stationId string, km uint64) error {
 		return ErrInvalidPark
 	}
 
+	if fuelLevel > FullLevel {
+		return ErrInvalidPark
+	}
+
 	c.Status = Parked
 	c.StationId = stationId
 	c.KM = km
+	c.FuelLevel = fuelLevel
 
 	c.Events = append(c.Events, CarParked{
+		EventId:   validation.NewId(),
 		ID:        c.ID,
 		StationId: c.StationId,
 		KM:        c.KM,
+		FuelLevel: c.FuelLevel,
 	})
 
 	return nil
@@ -137,3 +171,37 @@ func (c *Car) Reserve() error {
 
 	return nil
 }
+
+func (c *Car) Retire() error {
+	if c.Status != Maintenance {
+		return ErrInvalidRetirement
+	}
+
+	c.Status = Retired
+
+	return nil
+}
+
+func (c *Car) PutForSale() error {
+	if c.Status != Retired {
+		return ErrInvalidSale
+	}
+
+	c.Status = ForSale
+
+	return nil
+}
+
+func (c *Car) Sell() error {
+	if c.Status != ForSale {
+		return ErrInvalidSale
+	}
+
+	c.Status = Sold
+
+	return nil
+}
+
+func (c Car) InFleet() bool {
+	return c.Status != Retired && c.Status != ForSale && c.Status != Sold
+}
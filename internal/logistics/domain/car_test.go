@@ -17,6 +17,8 @@ func newCarFixture() *Car {
 		StationId: "83369771-f9a4-48b7-b87b-463f19f7b187",
 		KM:        12000,
 		Status:    Parked,
+		Energy:    Combustion,
+		FuelLevel: FullLevel,
 	}
 }
 
@@ -25,10 +27,13 @@ func TestNewCar(t *testing.T) {
 		age       uint16
 		plate     string
 		document  string
+		modelId   string
 		model     string
 		make      string
 		stationId string
 		km        uint64
+		energy    EnergyType
+		fuelLevel uint8
 	}
 
 	type want struct {
@@ -47,10 +52,13 @@ func TestNewCar(t *testing.T) {
 				age:       2020,
 				plate:     "KST-9016",
 				document:  "abc.123.op-x",
+				modelId:   "0b1b5f2a-3f4e-4b7b-9c41-7d8a6c1f2e3d",
 				model:     "Uno",
 				make:      "fiat",
 				stationId: "83369771-f9a4-48b7-b87b-463f19f7b187",
 				km:        12000,
+				energy:    Combustion,
+				fuelLevel: FullLevel,
 			},
 			want: want{
 				isCar: true,
@@ -67,6 +75,80 @@ func TestNewCar(t *testing.T) {
 				make:      "fiat",
 				stationId: "incorrect-id",
 				km:        12000,
+				energy:    Combustion,
+				fuelLevel: FullLevel,
+			},
+			want: want{
+				isCar: false,
+				err:   ErrInvalidEntity,
+			},
+		},
+		{
+			name: "incorrect fuel level input",
+			args: args{
+				age:       2020,
+				plate:     "KST-9016",
+				document:  "abc.123.op-x",
+				model:     "Uno",
+				make:      "fiat",
+				stationId: "83369771-f9a4-48b7-b87b-463f19f7b187",
+				km:        12000,
+				energy:    Electric,
+				fuelLevel: 120,
+			},
+			want: want{
+				isCar: false,
+				err:   ErrInvalidEntity,
+			},
+		},
+		{
+			name: "incorrect model id input",
+			args: args{
+				age:       2020,
+				plate:     "KST-9016",
+				document:  "abc.123.op-x",
+				modelId:   "invalid-id",
+				model:     "Uno",
+				make:      "fiat",
+				stationId: "83369771-f9a4-48b7-b87b-463f19f7b187",
+				km:        12000,
+				energy:    Combustion,
+				fuelLevel: FullLevel,
+			},
+			want: want{
+				isCar: false,
+				err:   ErrInvalidEntity,
+			},
+		},
+		{
+			name: "missing energy input",
+			args: args{
+				age:       2020,
+				plate:     "KST-9016",
+				document:  "abc.123.op-x",
+				model:     "Uno",
+				make:      "fiat",
+				stationId: "83369771-f9a4-48b7-b87b-463f19f7b187",
+				km:        12000,
+				fuelLevel: FullLevel,
+			},
+			want: want{
+				isCar: true,
+				err:   nil,
+			},
+		},
+		{
+			name: "incorrect energy input",
+			args: args{
+				age:       2020,
+				plate:     "KST-9016",
+				document:  "abc.123.op-x",
+				model:     "Uno",
+				make:      "fiat",
+				stationId: "83369771-f9a4-48b7-b87b-463f19f7b187",
+				km:        12000,
+				energy:    Electric + 1,
+				fuelLevel: FullLevel,
 			},
 			want: want{
 				isCar: false,
@@ -83,8 +165,11 @@ func TestNewCar(t *testing.T) {
 				tc.args.plate,
 				tc.args.document,
 				tc.args.stationId,
+				tc.args.modelId,
 				tc.args.model,
 				tc.args.make,
+				tc.args.energy,
+				tc.args.fuelLevel,
 			)
 
 			if reflect.ValueOf(c).IsNil() == tc.want.isCar {
@@ -94,6 +179,10 @@ func TestNewCar(t *testing.T) {
 			if !errors.Is(err, tc.want.err) {
 				t.Error("unexpected error", err)
 			}
+
+			if err == nil && tc.args.energy == 0 && c.Energy != Combustion {
+				t.Error("unexpected energy", c.Energy)
+			}
 		})
 	}
 }
@@ -295,12 +384,14 @@ func TestCar_Park(t *testing.T) {
 	type args struct {
 		stationId string
 		km        uint64
+		fuelLevel uint8
 	}
 
 	type want struct {
 		stationId string
 		status    CarStatus
 		km        uint64
+		fuelLevel uint8
 		err       error
 	}
 
@@ -319,11 +410,13 @@ func TestCar_Park(t *testing.T) {
 			},
 			args: args{
 				km:        12050,
+				fuelLevel: 40,
 				stationId: "11ab50ac-d649-4fdd-b5bb-d9c1ac2fdfdd",
 			},
 			want: want{
 				status:    Parked,
 				km:        12050,
+				fuelLevel: 40,
 				stationId: "11ab50ac-d649-4fdd-b5bb-d9c1ac2fdfdd",
 				err:       nil,
 			},
@@ -337,11 +430,13 @@ func TestCar_Park(t *testing.T) {
 			},
 			args: args{
 				km:        12000,
+				fuelLevel: 40,
 				stationId: "11ab50ac-d649-4fdd-b5bb-d9c1ac2fdfdd",
 			},
 			want: want{
 				status:    Transit,
 				km:        12050,
+				fuelLevel: FullLevel,
 				stationId: "83369771-f9a4-48b7-b87b-463f19f7b187",
 				err:       ErrInvalidPark,
 			},
@@ -355,11 +450,33 @@ func TestCar_Park(t *testing.T) {
 			},
 			args: args{
 				km:        12050,
+				fuelLevel: 40,
 				stationId: "11ab50ac-d649-4fdd-b5bb-d9c1ac2fdfdd",
 			},
 			want: want{
 				status:    Parked,
 				km:        12000,
+				fuelLevel: FullLevel,
+				stationId: "83369771-f9a4-48b7-b87b-463f19f7b187",
+				err:       ErrInvalidPark,
+			},
+		},
+		{
+			name: "incorrect fuel level input",
+			init: init{
+				status:    Transit,
+				km:        12000,
+				stationId: "83369771-f9a4-48b7-b87b-463f19f7b187",
+			},
+			args: args{
+				km:        12050,
+				fuelLevel: 101,
+				stationId: "11ab50ac-d649-4fdd-b5bb-d9c1ac2fdfdd",
+			},
+			want: want{
+				status:    Transit,
+				km:        12000,
+				fuelLevel: FullLevel,
 				stationId: "83369771-f9a4-48b7-b87b-463f19f7b187",
 				err:       ErrInvalidPark,
 			},
@@ -373,7 +490,7 @@ func TestCar_Park(t *testing.T) {
 			newCar.StationId = tc.init.stationId
 			newCar.KM = tc.init.km
 
-			err := newCar.Park(tc.args.stationId, tc.args.km)
+			err := newCar.Park(tc.args.stationId, tc.args.km, tc.args.fuelLevel)
 
 			if newCar.Status != tc.want.status {
 				t.Error("unexpected status value")
@@ -387,6 +504,10 @@ func TestCar_Park(t *testing.T) {
 				t.Error("unexpected stationId value")
 			}
 
+			if newCar.FuelLevel != tc.want.fuelLevel {
+				t.Error("unexpected fuel level value")
+			}
+
 			if !errors.Is(err, tc.want.err) {
 				t.Error("unexpected error")
 			}
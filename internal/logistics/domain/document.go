@@ -0,0 +1,162 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/validation"
+)
+
+type DocumentType uint
+
+const (
+	Registration DocumentType = iota + 1
+	InsurancePolicy
+	InspectionCertificate
+	EmissionsCertificate
+)
+
+var MandatoryDocuments = []DocumentType{Registration, InsurancePolicy, InspectionCertificate}
+
+func (t DocumentType) Mandatory() bool {
+	for _, m := range MandatoryDocuments {
+		if t == m {
+			return true
+		}
+	}
+	return false
+}
+
+type Attachment struct {
+	Name string `json:"name" validate:"required" db:"name"`
+	URL  string `json:"url" validate:"required,url" db:"url"`
+}
+
+type CarDocument struct {
+	ID          string       `json:"id" validate:"required,uuid4" db:"id"`
+	CarId       string       `json:"carId" validate:"required,uuid4" db:"carId"`
+	Type        DocumentType `json:"type" validate:"required,max=4" db:"type"`
+	Number      string       `json:"number" validate:"required" db:"number"`
+	IssuedAt    time.Time    `json:"issuedAt" validate:"required" db:"issuedAt"`
+	ExpiresAt   time.Time    `json:"expiresAt" validate:"required" db:"expiresAt"`
+	Attachments []Attachment `json:"attachments" validate:"dive"`
+	FlaggedAt   *time.Time   `json:"flaggedAt,omitempty" db:"flaggedAt"`
+}
+
+type DocumentExpiry struct {
+	DocumentId string       `json:"documentId"`
+	CarId      string       `json:"carId"`
+	Plate      string       `json:"plate"`
+	Type       DocumentType `json:"type"`
+	Mandatory  bool         `json:"mandatory"`
+	ExpiresAt  time.Time    `json:"expiresAt"`
+	Expired    bool         `json:"expired"`
+}
+
+func NewCarDocument(carId, number string, documentType DocumentType, issuedAt, expiresAt time.Time, attachments []Attachment) (*CarDocument, error) {
+	if attachments == nil {
+		attachments = []Attachment{}
+	}
+
+	document := &CarDocument{
+		ID:          validation.NewId(),
+		CarId:       carId,
+		Type:        documentType,
+		Number:      number,
+		IssuedAt:    issuedAt,
+		ExpiresAt:   expiresAt,
+		Attachments: attachments,
+	}
+
+	if err := validation.ValidateEntity(document); err != nil {
+		return nil, fmt.Errorf("%w\n%v", ErrInvalidEntity, err)
+	}
+
+	if !expiresAt.After(issuedAt) {
+		return nil, ErrInvalidDocument
+	}
+
+	return document, nil
+}
+
+func (d *CarDocument) Renew(number string, issuedAt, expiresAt time.Time, attachments []Attachment) error {
+	if number == "" || !expiresAt.After(issuedAt) || expiresAt.Before(d.ExpiresAt) {
+		return ErrInvalidDocument
+	}
+
+	if attachments == nil {
+		attachments = []Attachment{}
+	}
+
+	for _, a := range attachments {
+		if err := validation.ValidateEntity(a); err != nil {
+			return ErrInvalidDocument
+		}
+	}
+
+	d.Number = number
+	d.IssuedAt = issuedAt
+	d.ExpiresAt = expiresAt
+	d.Attachments = attachments
+	d.FlaggedAt = nil
+
+	return nil
+}
+
+func (d CarDocument) Expired(now time.Time) bool {
+	return !now.Before(d.ExpiresAt)
+}
+
+func (d CarDocument) ExpiresWithin(now time.Time, days uint) bool {
+	return d.ExpiresAt.Before(now.AddDate(0, 0, int(days)))
+}
+
+func (d *CarDocument) Flag(now time.Time, days uint) bool {
+	if d.FlaggedAt != nil || !d.ExpiresWithin(now, days) {
+		return false
+	}
+
+	d.FlaggedAt = &now
+
+	return true
+}
+
+func (d CarDocument) Expiry(car Car, now time.Time) DocumentExpiry {
+	return DocumentExpiry{
+		DocumentId: d.ID,
+		CarId:      d.CarId,
+		Plate:      car.Plate,
+		Type:       d.Type,
+		Mandatory:  d.Type.Mandatory(),
+		ExpiresAt:  d.ExpiresAt,
+		Expired:    d.Expired(now),
+	}
+}
+
+func (d DocumentExpiry) Name() string {
+	return "car.document-expiring"
+}
+
+func CurrentDocuments(documents []CarDocument) []CarDocument {
+	type key struct {
+		carId        string
+		documentType DocumentType
+	}
+
+	current := []CarDocument{}
+	index := make(map[key]int)
+	for _, d := range documents {
+		k := key{d.CarId, d.Type}
+		i, exists := index[k]
+		if !exists {
+			index[k] = len(current)
+			current = append(current, d)
+			continue
+		}
+		if d.ExpiresAt.After(current[i].ExpiresAt) {
+			current[i] = d
+		}
+	}
+
+	return current
+}
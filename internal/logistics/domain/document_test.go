@@ -0,0 +1,121 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func newCarDocumentFixture(documentType DocumentType, expiresAt time.Time) *CarDocument {
+	return &CarDocument{
+		ID:          "0d5f6c9a-3b2e-4c7d-8e1f-2a3b4c5d6e7f",
+		CarId:       "e4ce866a-f5b7-4774-8f9d-5eb74c3900cc",
+		Type:        documentType,
+		Number:      "INS-2022-001",
+		IssuedAt:    expiresAt.AddDate(-1, 0, 0),
+		ExpiresAt:   expiresAt,
+		Attachments: []Attachment{},
+	}
+}
+
+func TestNewCarDocument(t *testing.T) {
+	issuedAt := time.Date(2022, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name           string
+		typeArg        DocumentType
+		expiresAtArg   time.Time
+		attachmentsArg []Attachment
+		wantErr        error
+	}{
+		{
+			name:           "correct input",
+			typeArg:        InsurancePolicy,
+			expiresAtArg:   issuedAt.AddDate(1, 0, 0),
+			attachmentsArg: []Attachment{{Name: "policy.pdf", URL: "https://files.example.com/policy.pdf"}},
+			wantErr:        nil,
+		},
+		{
+			name:         "incorrect expiry input",
+			typeArg:      InsurancePolicy,
+			expiresAtArg: issuedAt,
+			wantErr:      ErrInvalidDocument,
+		},
+		{
+			name:         "incorrect type input",
+			typeArg:      DocumentType(9),
+			expiresAtArg: issuedAt.AddDate(1, 0, 0),
+			wantErr:      ErrInvalidEntity,
+		},
+		{
+			name:           "incorrect attachment input",
+			typeArg:        Registration,
+			expiresAtArg:   issuedAt.AddDate(1, 0, 0),
+			attachmentsArg: []Attachment{{Name: "scan", URL: "not an url"}},
+			wantErr:        ErrInvalidEntity,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			d, err := NewCarDocument("e4ce866a-f5b7-4774-8f9d-5eb74c3900cc", "DOC-1", tc.typeArg, issuedAt, tc.expiresAtArg, tc.attachmentsArg)
+
+			if !errors.Is(err, tc.wantErr) {
+				t.Error("unexpected error", err)
+			}
+
+			if (d == nil) == (tc.wantErr == nil) {
+				t.Error("unexpected document", d)
+			}
+		})
+	}
+}
+
+func TestCarDocument_Flag(t *testing.T) {
+	now := time.Date(2022, time.March, 1, 0, 0, 0, 0, time.UTC)
+	document := newCarDocumentFixture(InsurancePolicy, now.AddDate(0, 0, 10))
+
+	if document.Flag(now, 5) {
+		t.Error("flagged document out of window")
+	}
+
+	if !document.Flag(now, 30) || document.FlaggedAt == nil {
+		t.Error("unflagged expiring document")
+	}
+
+	if document.Flag(now, 30) {
+		t.Error("flagged document twice")
+	}
+
+	if err := document.Renew("INS-2023-001", now, now.AddDate(1, 0, 0), nil); err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	if document.FlaggedAt != nil || document.Flag(now, 30) {
+		t.Error("renewed document flagged", document.FlaggedAt)
+	}
+
+	if err := document.Renew("INS-2021-001", now.AddDate(-2, 0, 0), now.AddDate(-1, 0, 0), nil); !errors.Is(err, ErrInvalidDocument) {
+		t.Error("unexpected error", err)
+	}
+}
+
+func TestCurrentDocuments(t *testing.T) {
+	now := time.Date(2022, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	expired := newCarDocumentFixture(InsurancePolicy, now.AddDate(0, 0, -1))
+	renewed := newCarDocumentFixture(InsurancePolicy, now.AddDate(1, 0, 0))
+	renewed.ID = "9a8b7c6d-5e4f-4a3b-9c1d-0e9f8a7b6c5d"
+	emissions := newCarDocumentFixture(EmissionsCertificate, now.AddDate(0, 0, -1))
+	emissions.ID = "1b2c3d4e-5f6a-4b7c-8d9e-0f1a2b3c4d5e"
+
+	current := CurrentDocuments([]CarDocument{*expired, *emissions, *renewed})
+
+	if len(current) != 2 || current[0].ID != renewed.ID || current[1].ID != emissions.ID {
+		t.Error("unexpected current documents", current)
+	}
+
+	if emissions.Type.Mandatory() || !renewed.Type.Mandatory() {
+		t.Error("unexpected mandatory documents")
+	}
+}
@@ -3,10 +3,13 @@ package domain
 import "errors"
 
 var (
-	ErrInvalidEntity   = errors.New("invalid entity")
-	ErrInvalidCapacity = errors.New("invalid capacity")
-	ErrInvalidCurrCars = errors.New("invalid current cars number")
-	ErrInvalidIdle     = errors.New("invalid idle cars number")
+	ErrInvalidEntity    = errors.New("invalid entity")
+	ErrInvalidCapacity  = errors.New("invalid capacity")
+	ErrInvalidCurrCars  = errors.New("invalid current cars number")
+	ErrInvalidIdle      = errors.New("invalid idle cars number")
+	ErrInvalidLocation  = errors.New("invalid location")
+	ErrInvalidSchedule  = errors.New("invalid schedule")
+	ErrInvalidOccupancy = errors.New("invalid occupancy")
 
 	ErrInvalidMaintenance = errors.New("invalid maintenance")
 	ErrInvalidTransit     = errors.New("invalid transit")
@@ -14,4 +17,14 @@ var (
 	ErrInvalidReserve     = errors.New("invalid reserve")
 	ErrInvalidReservation = errors.New("invalid reservation")
 	ErrInvalidPark        = errors.New("invalid park")
+	ErrInvalidWorkOrder   = errors.New("invalid work order")
+
+	ErrInvalidMaintenancePlan = errors.New("invalid maintenance plan")
+	ErrInvalidTelemetry       = errors.New("invalid telemetry reading")
+	ErrInvalidDocument        = errors.New("invalid car document")
+	ErrInvalidAcquisition     = errors.New("invalid car acquisition")
+	ErrInvalidRetirement      = errors.New("invalid car retirement")
+	ErrInvalidSale            = errors.New("invalid car sale")
+
+	ErrInvalidAssignmentCriterion = errors.New("invalid car assignment criterion")
 )
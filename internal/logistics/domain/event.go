@@ -1,10 +1,13 @@
 package domain
 
+import "time"
+
 type Event interface {
 	Name() string
 }
 
 type CarAdded struct {
+	EventId   string `json:"eventId"`
 	ID        string `json:"id"`
 	StationId string `json:"stationId"`
 }
@@ -14,6 +17,7 @@ func (c CarAdded) Name() string {
 }
 
 type CarUnderMaintenance struct {
+	EventId   string    `json:"eventId"`
 	ID        string    `json:"id"`
 	StationId string    `json:"stationId"`
 	CarStatus CarStatus `json:"carStatus"`
@@ -24,6 +28,7 @@ func (c CarUnderMaintenance) Name() string {
 }
 
 type CarInTransfer struct {
+	EventId       string `json:"eventId"`
 	ID            string `json:"id"`
 	StationIdFrom string `json:"stationIdFrom"`
 	StationIdTo   string `json:"stationIdTo"`
@@ -34,9 +39,11 @@ func (c CarInTransfer) Name() string {
 }
 
 type CarParked struct {
+	EventId   string `json:"eventId"`
 	ID        string `json:"id"`
 	StationId string `json:"stationId"`
 	KM        uint64 `json:"km"`
+	FuelLevel uint8  `json:"fuelLevel"`
 }
 
 func (c CarParked) Name() string {
@@ -44,9 +51,11 @@ func (c CarParked) Name() string {
 }
 
 type SyncCarParked struct {
+	EventId   string `json:"eventId"`
 	ID        string `json:"id"`
 	StationId string `json:"stationId"`
 	KM        uint64 `json:"km"`
+	FuelLevel uint8  `json:"fuelLevel"`
 }
 
 func (c SyncCarParked) Name() string {
@@ -54,6 +63,7 @@ func (c SyncCarParked) Name() string {
 }
 
 type SyncCarReserved struct {
+	EventId   string `json:"eventId"`
 	ID        string `json:"id"`
 	StationId string `json:"stationId"`
 }
@@ -69,3 +79,27 @@ type SyncCarInTransit struct {
 func (c SyncCarInTransit) Name() string {
 	return "sync.car.in-transit"
 }
+
+type WorkOrderCompleted struct {
+	ID        string `json:"id"`
+	CarId     string `json:"carId"`
+	StationId string `json:"stationId"`
+	KM        uint64 `json:"km"`
+}
+
+func (c WorkOrderCompleted) Name() string {
+	return "maintenance.work-order-completed"
+}
+
+type TelemetryRecorded struct {
+	CarId      string    `json:"carId"`
+	RecordedAt time.Time `json:"recordedAt"`
+	Latitude   float64   `json:"latitude"`
+	Longitude  float64   `json:"longitude"`
+	KM         uint64    `json:"km"`
+	FuelLevel  uint8     `json:"fuelLevel"`
+}
+
+func (c TelemetryRecorded) Name() string {
+	return "telemetry.recorded"
+}
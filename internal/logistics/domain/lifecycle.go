@@ -0,0 +1,151 @@
+package domain
+
+import (
+	"math"
+	"time"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/validation"
+)
+
+const hoursPerYear = 365.25 * 24
+
+type CarLifecycle struct {
+	CarId         string     `json:"carId" validate:"required,uuid4" db:"carId"`
+	PurchasedAt   *time.Time `json:"purchasedAt,omitempty" db:"purchasedAt"`
+	PurchaseKM    uint64     `json:"purchaseKM" db:"purchaseKM"`
+	PurchasePrice float64    `json:"purchasePrice" validate:"gte=0" db:"purchasePrice"`
+	Supplier      string     `json:"supplier" db:"supplier"`
+	UsefulLife    uint8      `json:"usefulLife" validate:"max=30" db:"usefulLife"`
+	ResidualValue float64    `json:"residualValue" validate:"gte=0" db:"residualValue"`
+	RetiredAt     *time.Time `json:"retiredAt,omitempty" db:"retiredAt"`
+	ListedAt      *time.Time `json:"listedAt,omitempty" db:"listedAt"`
+	AskingPrice   float64    `json:"askingPrice" validate:"gte=0" db:"askingPrice"`
+	SoldAt        *time.Time `json:"soldAt,omitempty" db:"soldAt"`
+	Buyer         string     `json:"buyer" db:"buyer"`
+	SalePrice     float64    `json:"salePrice" validate:"gte=0" db:"salePrice"`
+}
+
+type Depreciation struct {
+	CarId       string    `json:"carId"`
+	At          time.Time `json:"at"`
+	Accumulated float64   `json:"accumulated"`
+	BookValue   float64   `json:"bookValue"`
+}
+
+type FleetAge struct {
+	StationId  string  `json:"stationId"`
+	Cars       int     `json:"cars"`
+	AverageAge float64 `json:"averageAge"`
+	OldestYear uint16  `json:"oldestYear"`
+	NewestYear uint16  `json:"newestYear"`
+}
+
+func NewCarLifecycle(carId string) *CarLifecycle {
+	return &CarLifecycle{CarId: carId}
+}
+
+func (l *CarLifecycle) Acquire(purchasedAt time.Time, km uint64, price float64, supplier string, usefulLife uint8, residualValue float64) error {
+	if purchasedAt.IsZero() || price <= 0 || supplier == "" || usefulLife == 0 || residualValue < 0 || residualValue > price {
+		return ErrInvalidAcquisition
+	}
+
+	l.PurchasedAt = &purchasedAt
+	l.PurchaseKM = km
+	l.PurchasePrice = price
+	l.Supplier = supplier
+	l.UsefulLife = usefulLife
+	l.ResidualValue = residualValue
+
+	if err := validation.ValidateEntity(l); err != nil {
+		return ErrInvalidAcquisition
+	}
+
+	return nil
+}
+
+func (l CarLifecycle) Acquired() bool {
+	return l.PurchasedAt != nil
+}
+
+func (l CarLifecycle) Depreciation(at time.Time) (*Depreciation, error) {
+	if !l.Acquired() {
+		return nil, ErrInvalidAcquisition
+	}
+
+	if l.SoldAt != nil && at.After(*l.SoldAt) {
+		at = *l.SoldAt
+	}
+
+	years := at.Sub(*l.PurchasedAt).Hours() / hoursPerYear
+	years = math.Max(0, math.Min(years, float64(l.UsefulLife)))
+
+	accumulated := (l.PurchasePrice - l.ResidualValue) * years / float64(l.UsefulLife)
+	accumulated = math.Round(accumulated*100) / 100
+
+	return &Depreciation{
+		CarId:       l.CarId,
+		At:          at,
+		Accumulated: accumulated,
+		BookValue:   math.Round((l.PurchasePrice-accumulated)*100) / 100,
+	}, nil
+}
+
+func (l *CarLifecycle) Retire(at time.Time) error {
+	if l.RetiredAt != nil {
+		return ErrInvalidRetirement
+	}
+
+	l.RetiredAt = &at
+
+	return nil
+}
+
+func (l *CarLifecycle) ListForSale(at time.Time, askingPrice float64) error {
+	if l.RetiredAt == nil || l.SoldAt != nil || askingPrice <= 0 {
+		return ErrInvalidSale
+	}
+
+	l.ListedAt = &at
+	l.AskingPrice = askingPrice
+
+	return nil
+}
+
+func (l *CarLifecycle) Sell(at time.Time, buyer string, price float64) error {
+	if l.ListedAt == nil || l.SoldAt != nil || buyer == "" || price <= 0 {
+		return ErrInvalidSale
+	}
+
+	l.SoldAt = &at
+	l.Buyer = buyer
+	l.SalePrice = price
+
+	return nil
+}
+
+func FleetAgeOf(stationId string, cars []Car, now time.Time) FleetAge {
+	report := FleetAge{StationId: stationId}
+
+	total := 0
+	for _, car := range cars {
+		if !car.InFleet() {
+			continue
+		}
+
+		if report.Cars == 0 || car.Age < report.OldestYear {
+			report.OldestYear = car.Age
+		}
+		if car.Age > report.NewestYear {
+			report.NewestYear = car.Age
+		}
+
+		report.Cars++
+		total += now.Year() - int(car.Age)
+	}
+
+	if report.Cars > 0 {
+		report.AverageAge = math.Round(float64(total)/float64(report.Cars)*10) / 10
+	}
+
+	return report
+}
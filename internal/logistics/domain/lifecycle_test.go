@@ -0,0 +1,164 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestCarLifecycle_Acquire(t *testing.T) {
+	purchasedAt := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	type args struct {
+		purchasedAt   time.Time
+		price         float64
+		supplier      string
+		usefulLife    uint8
+		residualValue float64
+	}
+
+	testCases := []struct {
+		name    string
+		args    args
+		wantErr error
+	}{
+		{
+			name:    "correct input",
+			args:    args{purchasedAt, 60000, "Fiat Dealer", 5, 20000},
+			wantErr: nil,
+		},
+		{
+			name:    "incorrect price input",
+			args:    args{purchasedAt, 0, "Fiat Dealer", 5, 0},
+			wantErr: ErrInvalidAcquisition,
+		},
+		{
+			name:    "incorrect residual value input",
+			args:    args{purchasedAt, 60000, "Fiat Dealer", 5, 70000},
+			wantErr: ErrInvalidAcquisition,
+		},
+		{
+			name:    "incorrect useful life input",
+			args:    args{purchasedAt, 60000, "Fiat Dealer", 0, 20000},
+			wantErr: ErrInvalidAcquisition,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			lifecycle := NewCarLifecycle("e4ce866a-f5b7-4774-8f9d-5eb74c3900cc")
+			err := lifecycle.Acquire(tc.args.purchasedAt, 0, tc.args.price, tc.args.supplier, tc.args.usefulLife, tc.args.residualValue)
+
+			if !errors.Is(err, tc.wantErr) {
+				t.Error("unexpected error", err)
+			}
+
+			if lifecycle.Acquired() != (tc.wantErr == nil) {
+				t.Error("unexpected acquisition", lifecycle)
+			}
+		})
+	}
+}
+
+func TestCarLifecycle_Depreciation(t *testing.T) {
+	purchasedAt := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	lifecycle := NewCarLifecycle("e4ce866a-f5b7-4774-8f9d-5eb74c3900cc")
+	lifecycle.Acquire(purchasedAt, 0, 60000, "Fiat Dealer", 4, 20000)
+
+	testCases := []struct {
+		name          string
+		atArg         time.Time
+		wantBookValue float64
+	}{
+		{
+			name:          "before purchase",
+			atArg:         purchasedAt.AddDate(-1, 0, 0),
+			wantBookValue: 60000,
+		},
+		{
+			name:          "half useful life",
+			atArg:         purchasedAt.Add(2 * hoursPerYear * time.Hour),
+			wantBookValue: 40000,
+		},
+		{
+			name:          "after useful life",
+			atArg:         purchasedAt.AddDate(10, 0, 0),
+			wantBookValue: 20000,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			depreciation, err := lifecycle.Depreciation(tc.atArg)
+			if err != nil {
+				t.Fatal("unexpected error", err)
+			}
+
+			if depreciation.BookValue != tc.wantBookValue || depreciation.Accumulated != 60000-tc.wantBookValue {
+				t.Error("unexpected depreciation", depreciation)
+			}
+		})
+	}
+
+	if _, err := NewCarLifecycle("e4ce866a-f5b7-4774-8f9d-5eb74c3900cc").Depreciation(purchasedAt); !errors.Is(err, ErrInvalidAcquisition) {
+		t.Error("unexpected error", err)
+	}
+}
+
+func TestCar_Retire(t *testing.T) {
+	now := time.Now()
+	car := newCarFixture()
+	lifecycle := NewCarLifecycle(car.ID)
+
+	if err := car.Retire(); !errors.Is(err, ErrInvalidRetirement) {
+		t.Error("unexpected error", err)
+	}
+
+	car.Status = Maintenance
+	if err := car.Sell(); !errors.Is(err, ErrInvalidSale) {
+		t.Error("unexpected error", err)
+	}
+
+	if err := car.Retire(); err != nil || lifecycle.Retire(now) != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	if err := lifecycle.Sell(now, "Buyer Ltda", 30000); !errors.Is(err, ErrInvalidSale) {
+		t.Error("unexpected error", err)
+	}
+
+	if err := car.PutForSale(); err != nil || lifecycle.ListForSale(now, 32000) != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	if err := car.Sell(); err != nil || lifecycle.Sell(now, "Buyer Ltda", 30000) != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	if car.Status != Sold || car.InFleet() || lifecycle.Buyer != "Buyer Ltda" || lifecycle.SalePrice != 30000 {
+		t.Error("unexpected sale", car, lifecycle)
+	}
+}
+
+func TestFleetAgeOf(t *testing.T) {
+	now := time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC)
+
+	older := *newCarFixture()
+	older.Age = 2018
+	newer := *newCarFixture()
+	newer.Age = 2023
+	sold := *newCarFixture()
+	sold.Age = 2010
+	sold.Status = Sold
+
+	report := FleetAgeOf("83369771-f9a4-48b7-b87b-463f19f7b187", []Car{older, newer, sold}, now)
+
+	if report.Cars != 2 || report.AverageAge != 3.5 || report.OldestYear != 2018 || report.NewestYear != 2023 {
+		t.Error("unexpected report", report)
+	}
+
+	if empty := FleetAgeOf("83369771-f9a4-48b7-b87b-463f19f7b187", []Car{}, now); empty.Cars != 0 || empty.AverageAge != 0 {
+		t.Error("unexpected report", empty)
+	}
+}
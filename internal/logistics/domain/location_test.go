@@ -0,0 +1,49 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestStation_SetLocation(t *testing.T) {
+	testCases := []struct {
+		name      string
+		latitude  float64
+		longitude float64
+		want      error
+	}{
+		{
+			name:      "correct input",
+			latitude:  -23.5505,
+			longitude: -46.6333,
+			want:      nil,
+		},
+		{
+			name:      "incorrect latitude input",
+			latitude:  -91,
+			longitude: -46.6333,
+			want:      ErrInvalidLocation,
+		},
+		{
+			name:      "incorrect longitude input",
+			latitude:  -23.5505,
+			longitude: 181,
+			want:      ErrInvalidLocation,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := newStationFixture()
+			err := s.SetLocation(tc.latitude, tc.longitude)
+
+			if !errors.Is(err, tc.want) {
+				t.Error("unexpected error", err)
+			}
+
+			if err == nil && !s.HasLocation() {
+				t.Error("unexpected location", s.Latitude, s.Longitude)
+			}
+		})
+	}
+}
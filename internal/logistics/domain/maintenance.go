@@ -0,0 +1,102 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/events"
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/validation"
+)
+
+type MaintenanceType uint
+
+const (
+	Scheduled MaintenanceType = iota + 1
+	Repair
+	Accident
+)
+
+type WorkOrderStatus uint
+
+const (
+	WorkOrderInProgress WorkOrderStatus = iota + 1
+	WorkOrderDone
+)
+
+type Part struct {
+	Name     string  `json:"name" validate:"required" db:"name"`
+	Quantity uint    `json:"quantity" validate:"required,gt=0" db:"quantity"`
+	Price    float32 `json:"price" validate:"gte=0" db:"price"`
+}
+
+type WorkOrder struct {
+	ID        string          `json:"id" validate:"required,uuid4" db:"id"`
+	CarId     string          `json:"carId" validate:"required,uuid4" db:"carId"`
+	StationId string          `json:"stationId" validate:"required,uuid4" db:"stationId"`
+	Reason    string          `json:"reason" validate:"required" db:"reason"`
+	Type      MaintenanceType `json:"type" validate:"required,max=3" db:"type"`
+	Workshop  string          `json:"workshop" validate:"required" db:"workshop"`
+	Cost      float32         `json:"cost" validate:"gte=0" db:"cost"`
+	Parts     []Part          `json:"parts" validate:"dive"`
+	KM        uint64          `json:"km" db:"km"`
+	DateStart time.Time       `json:"dateStart" validate:"required" db:"dateStart"`
+	DateEnd   *time.Time      `json:"dateEnd,omitempty" db:"dateEnd"`
+	Status    WorkOrderStatus `json:"status" validate:"required" db:"status"`
+	Events    []events.Event  `json:"-" bson:"-"`
+}
+
+func NewWorkOrder(carId, stationId, reason, workshop string, maintenanceType MaintenanceType, km uint64, dateStart time.Time) (*WorkOrder, error) {
+	workOrder := &WorkOrder{
+		ID:        validation.NewId(),
+		CarId:     carId,
+		StationId: stationId,
+		Reason:    reason,
+		Type:      maintenanceType,
+		Workshop:  workshop,
+		Parts:     []Part{},
+		KM:        km,
+		DateStart: dateStart,
+		Status:    WorkOrderInProgress,
+	}
+
+	if err := validation.ValidateEntity(workOrder); err != nil {
+		return nil, fmt.Errorf("%w\n%v", ErrInvalidEntity, err)
+	}
+
+	return workOrder, nil
+}
+
+func (w *WorkOrder) Complete(dateEnd time.Time, cost float32, parts []Part, km uint64) error {
+	if w.Status != WorkOrderInProgress {
+		return ErrInvalidWorkOrder
+	}
+
+	if dateEnd.Before(w.DateStart) {
+		return ErrInvalidWorkOrder
+	}
+
+	if cost < 0 || km < w.KM {
+		return ErrInvalidWorkOrder
+	}
+
+	for _, p := range parts {
+		if err := validation.ValidateEntity(p); err != nil {
+			return ErrInvalidWorkOrder
+		}
+	}
+
+	w.Status = WorkOrderDone
+	w.DateEnd = &dateEnd
+	w.Cost = cost
+	w.Parts = parts
+	w.KM = km
+
+	w.Events = append(w.Events, WorkOrderCompleted{
+		ID:        w.ID,
+		CarId:     w.CarId,
+		StationId: w.StationId,
+		KM:        w.KM,
+	})
+
+	return nil
+}
@@ -0,0 +1,230 @@
+package domain
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func newWorkOrderFixture() *WorkOrder {
+	return &WorkOrder{
+		ID:        "5ce5a1a1-f324-4c8b-8c92-d7e820cbb238",
+		CarId:     "e4ce866a-f5b7-4774-8f9d-5eb74c3900cc",
+		StationId: "83369771-f9a4-48b7-b87b-463f19f7b187",
+		Reason:    "oil change",
+		Type:      Scheduled,
+		Workshop:  "Auto Center",
+		Parts:     []Part{},
+		KM:        12000,
+		DateStart: time.Date(2022, time.March, 1, 8, 0, 0, 0, time.UTC),
+		Status:    WorkOrderInProgress,
+	}
+}
+
+func TestNewWorkOrder(t *testing.T) {
+	type args struct {
+		carId           string
+		stationId       string
+		reason          string
+		workshop        string
+		maintenanceType MaintenanceType
+		km              uint64
+		dateStart       time.Time
+	}
+
+	type want struct {
+		isWorkOrder bool
+		err         error
+	}
+
+	testCases := []struct {
+		name string
+		args args
+		want want
+	}{
+		{
+			name: "correct input",
+			args: args{
+				carId:           "e4ce866a-f5b7-4774-8f9d-5eb74c3900cc",
+				stationId:       "83369771-f9a4-48b7-b87b-463f19f7b187",
+				reason:          "broken headlight",
+				workshop:        "Auto Center",
+				maintenanceType: Repair,
+				km:              12000,
+				dateStart:       time.Now(),
+			},
+			want: want{
+				isWorkOrder: true,
+				err:         nil,
+			},
+		},
+		{
+			name: "incorrect car id input",
+			args: args{
+				carId:           "invalid-id",
+				stationId:       "83369771-f9a4-48b7-b87b-463f19f7b187",
+				reason:          "broken headlight",
+				workshop:        "Auto Center",
+				maintenanceType: Repair,
+				km:              12000,
+				dateStart:       time.Now(),
+			},
+			want: want{
+				isWorkOrder: false,
+				err:         ErrInvalidEntity,
+			},
+		},
+		{
+			name: "incorrect type input",
+			args: args{
+				carId:           "e4ce866a-f5b7-4774-8f9d-5eb74c3900cc",
+				stationId:       "83369771-f9a4-48b7-b87b-463f19f7b187",
+				reason:          "broken headlight",
+				workshop:        "Auto Center",
+				maintenanceType: 4,
+				km:              12000,
+				dateStart:       time.Now(),
+			},
+			want: want{
+				isWorkOrder: false,
+				err:         ErrInvalidEntity,
+			},
+		},
+		{
+			name: "incorrect reason input",
+			args: args{
+				carId:           "e4ce866a-f5b7-4774-8f9d-5eb74c3900cc",
+				stationId:       "83369771-f9a4-48b7-b87b-463f19f7b187",
+				workshop:        "Auto Center",
+				maintenanceType: Accident,
+				km:              12000,
+				dateStart:       time.Now(),
+			},
+			want: want{
+				isWorkOrder: false,
+				err:         ErrInvalidEntity,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			w, err := NewWorkOrder(
+				tc.args.carId,
+				tc.args.stationId,
+				tc.args.reason,
+				tc.args.workshop,
+				tc.args.maintenanceType,
+				tc.args.km,
+				tc.args.dateStart,
+			)
+
+			if reflect.ValueOf(w).IsNil() == tc.want.isWorkOrder {
+				t.Error("unexpected result")
+			}
+
+			if !errors.Is(err, tc.want.err) {
+				t.Error("unexpected error", err)
+			}
+		})
+	}
+}
+
+func TestWorkOrder_Complete(t *testing.T) {
+	type init struct {
+		status WorkOrderStatus
+	}
+
+	type args struct {
+		dateEnd time.Time
+		cost    float32
+		parts   []Part
+		km      uint64
+	}
+
+	type want struct {
+		status WorkOrderStatus
+		events int
+		err    error
+	}
+
+	dateStart := newWorkOrderFixture().DateStart
+
+	testCases := []struct {
+		name string
+		init init
+		args args
+		want want
+	}{
+		{
+			name: "correct input",
+			init: init{status: WorkOrderInProgress},
+			args: args{
+				dateEnd: dateStart.Add(48 * time.Hour),
+				cost:    350.5,
+				parts:   []Part{{Name: "oil filter", Quantity: 1, Price: 50}},
+				km:      12010,
+			},
+			want: want{status: WorkOrderDone, events: 1, err: nil},
+		},
+		{
+			name: "incorrect status",
+			init: init{status: WorkOrderDone},
+			args: args{
+				dateEnd: dateStart.Add(48 * time.Hour),
+				km:      12010,
+			},
+			want: want{status: WorkOrderDone, events: 0, err: ErrInvalidWorkOrder},
+		},
+		{
+			name: "incorrect date end input",
+			init: init{status: WorkOrderInProgress},
+			args: args{
+				dateEnd: dateStart.Add(-48 * time.Hour),
+				km:      12010,
+			},
+			want: want{status: WorkOrderInProgress, events: 0, err: ErrInvalidWorkOrder},
+		},
+		{
+			name: "incorrect km input",
+			init: init{status: WorkOrderInProgress},
+			args: args{
+				dateEnd: dateStart.Add(48 * time.Hour),
+				km:      11000,
+			},
+			want: want{status: WorkOrderInProgress, events: 0, err: ErrInvalidWorkOrder},
+		},
+		{
+			name: "incorrect part input",
+			init: init{status: WorkOrderInProgress},
+			args: args{
+				dateEnd: dateStart.Add(48 * time.Hour),
+				parts:   []Part{{Name: "oil filter", Quantity: 0}},
+				km:      12010,
+			},
+			want: want{status: WorkOrderInProgress, events: 0, err: ErrInvalidWorkOrder},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := newWorkOrderFixture()
+			w.Status = tc.init.status
+
+			err := w.Complete(tc.args.dateEnd, tc.args.cost, tc.args.parts, tc.args.km)
+
+			if w.Status != tc.want.status {
+				t.Error("unexpected status", w.Status)
+			}
+
+			if len(w.Events) != tc.want.events {
+				t.Error("unexpected events", w.Events)
+			}
+
+			if !errors.Is(err, tc.want.err) {
+				t.Error("unexpected error", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/validation"
+)
+
+type OccupancyMovement uint
+
+const (
+	Arrived OccupancyMovement = iota + 1
+	Left
+	Adjusted
+)
+
+type OccupancyEntry struct {
+	EventId   string            `json:"eventId" validate:"required" db:"eventId"`
+	StationId string            `json:"stationId" validate:"required,uuid4" db:"stationId"`
+	CarId     string            `json:"carId,omitempty" db:"carId"`
+	Movement  OccupancyMovement `json:"movement" validate:"required,max=3" db:"movement"`
+	Delta     int               `json:"delta" db:"delta"`
+	Date      time.Time         `json:"date" validate:"required" db:"date"`
+}
+
+func NewOccupancyEntry(eventId, stationId, carId string, movement OccupancyMovement, date time.Time) (*OccupancyEntry, error) {
+	entry := &OccupancyEntry{
+		EventId:   eventId,
+		StationId: stationId,
+		CarId:     carId,
+		Movement:  movement,
+		Date:      date,
+	}
+
+	switch movement {
+	case Arrived:
+		entry.Delta = 1
+	case Left:
+		entry.Delta = -1
+	default:
+		return nil, ErrInvalidOccupancy
+	}
+
+	if err := validation.ValidateEntity(entry); err != nil {
+		return nil, fmt.Errorf("%w\n%v", ErrInvalidEntity, err)
+	}
+
+	return entry, nil
+}
+
+func NewOccupancyAdjustment(stationId string, delta int, date time.Time) (*OccupancyEntry, error) {
+	if delta == 0 {
+		return nil, ErrInvalidOccupancy
+	}
+
+	entry := &OccupancyEntry{
+		EventId:   validation.NewId(),
+		StationId: stationId,
+		Movement:  Adjusted,
+		Delta:     delta,
+		Date:      date,
+	}
+
+	if err := validation.ValidateEntity(entry); err != nil {
+		return nil, fmt.Errorf("%w\n%v", ErrInvalidEntity, err)
+	}
+
+	return entry, nil
+}
+
+func Occupancy(entries []OccupancyEntry) int {
+	n := 0
+	for _, e := range entries {
+		n += e.Delta
+	}
+	return n
+}
+
+type OccupancyDrift struct {
+	StationId string `json:"stationId"`
+	Ledger    int    `json:"ledger"`
+	Actual    int    `json:"actual"`
+	Drift     int    `json:"drift"`
+}
+
+func NewOccupancyDrift(stationId string, ledger, actual int) OccupancyDrift {
+	return OccupancyDrift{
+		StationId: stationId,
+		Ledger:    ledger,
+		Actual:    actual,
+		Drift:     actual - ledger,
+	}
+}
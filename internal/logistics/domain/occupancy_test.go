@@ -0,0 +1,77 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestNewOccupancyEntry(t *testing.T) {
+	testCases := []struct {
+		name      string
+		eventId   string
+		stationId string
+		movement  OccupancyMovement
+		wantDelta int
+		wantErr   error
+	}{
+		{
+			name:      "correct arrived input",
+			eventId:   "car.parked:1",
+			stationId: "e4ce866a-f5b7-4774-8f9d-5eb74c3900cc",
+			movement:  Arrived,
+			wantDelta: 1,
+			wantErr:   nil,
+		},
+		{
+			name:      "correct left input",
+			eventId:   "car.in-transfer:1",
+			stationId: "e4ce866a-f5b7-4774-8f9d-5eb74c3900cc",
+			movement:  Left,
+			wantDelta: -1,
+			wantErr:   nil,
+		},
+		{
+			name:      "incorrect movement input",
+			eventId:   "car.parked:1",
+			stationId: "e4ce866a-f5b7-4774-8f9d-5eb74c3900cc",
+			movement:  Adjusted,
+			wantErr:   ErrInvalidOccupancy,
+		},
+		{
+			name:      "incorrect event id input",
+			eventId:   "",
+			stationId: "e4ce866a-f5b7-4774-8f9d-5eb74c3900cc",
+			movement:  Arrived,
+			wantErr:   ErrInvalidEntity,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			e, err := NewOccupancyEntry(tc.eventId, tc.stationId, "83369771-f9a4-48b7-b87b-463f19f7b187", tc.movement, time.Now())
+
+			if !errors.Is(err, tc.wantErr) {
+				t.Error("unexpected error", err)
+			}
+
+			if err == nil && e.Delta != tc.wantDelta {
+				t.Error("unexpected delta", e.Delta)
+			}
+		})
+	}
+}
+
+func TestOccupancy(t *testing.T) {
+	arrived, _ := NewOccupancyEntry("car.parked:1", "e4ce866a-f5b7-4774-8f9d-5eb74c3900cc", "", Arrived, time.Now())
+	left, _ := NewOccupancyEntry("car.in-transfer:1", "e4ce866a-f5b7-4774-8f9d-5eb74c3900cc", "", Left, time.Now())
+	adjusted, _ := NewOccupancyAdjustment("e4ce866a-f5b7-4774-8f9d-5eb74c3900cc", 3, time.Now())
+
+	if n := Occupancy([]OccupancyEntry{*arrived, *arrived, *left, *adjusted}); n != 4 {
+		t.Error("unexpected occupancy", n)
+	}
+
+	if _, err := NewOccupancyAdjustment("e4ce866a-f5b7-4774-8f9d-5eb74c3900cc", 0, time.Now()); !errors.Is(err, ErrInvalidOccupancy) {
+		t.Error("unexpected error", err)
+	}
+}
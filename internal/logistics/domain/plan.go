@@ -0,0 +1,98 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/validation"
+)
+
+type MaintenancePlan struct {
+	ID             string    `json:"id" validate:"required,uuid4" db:"id"`
+	ModelId        string    `json:"modelId" validate:"required,uuid4" db:"modelId"`
+	IntervalKM     uint64    `json:"intervalKM" db:"intervalKM"`
+	IntervalMonths uint      `json:"intervalMonths" db:"intervalMonths"`
+	CreatedAt      time.Time `json:"createdAt" db:"createdAt"`
+}
+
+type MaintenanceDue struct {
+	CarId   string     `json:"carId"`
+	Plate   string     `json:"plate"`
+	PlanId  string     `json:"planId"`
+	KM      uint64     `json:"km"`
+	DueKM   uint64     `json:"dueKM,omitempty"`
+	DueDate *time.Time `json:"dueDate,omitempty"`
+	Overdue bool       `json:"overdue"`
+}
+
+func NewMaintenancePlan(modelId string, intervalKM uint64, intervalMonths uint) (*MaintenancePlan, error) {
+	plan := &MaintenancePlan{
+		ID:             validation.NewId(),
+		ModelId:        modelId,
+		IntervalKM:     intervalKM,
+		IntervalMonths: intervalMonths,
+		CreatedAt:      time.Now(),
+	}
+
+	if err := validation.ValidateEntity(plan); err != nil {
+		return nil, fmt.Errorf("%w\n%v", ErrInvalidEntity, err)
+	}
+
+	if intervalKM == 0 && intervalMonths == 0 {
+		return nil, ErrInvalidMaintenancePlan
+	}
+
+	return plan, nil
+}
+
+func (p MaintenancePlan) Applies(car Car) bool {
+	return p.ModelId == car.ModelId
+}
+
+func (p MaintenancePlan) Due(car Car, lastService *WorkOrder, acquisition *CarLifecycle, now time.Time) MaintenanceDue {
+	var baseKM uint64
+	baseDate := p.CreatedAt
+	knownKM := false
+
+	switch {
+	case lastService != nil:
+		baseKM, knownKM = lastService.KM, true
+		if lastService.DateEnd != nil {
+			baseDate = *lastService.DateEnd
+		}
+	case acquisition != nil && acquisition.Acquired():
+		baseKM, baseDate, knownKM = acquisition.PurchaseKM, *acquisition.PurchasedAt, true
+	}
+
+	due := MaintenanceDue{
+		CarId:  car.ID,
+		Plate:  car.Plate,
+		PlanId: p.ID,
+		KM:     car.KM,
+	}
+
+	if p.IntervalKM > 0 && knownKM {
+		due.DueKM = baseKM + p.IntervalKM
+		due.Overdue = car.KM >= due.DueKM
+	}
+
+	if p.IntervalMonths > 0 {
+		dueDate := baseDate.AddDate(0, int(p.IntervalMonths), 0)
+		due.DueDate = &dueDate
+		due.Overdue = due.Overdue || !now.Before(dueDate)
+	}
+
+	return due
+}
+
+func (d MaintenanceDue) Name() string {
+	return "maintenance.due"
+}
+
+func (d MaintenanceDue) Within(now time.Time, days uint) bool {
+	if d.Overdue {
+		return true
+	}
+
+	return d.DueDate != nil && d.DueDate.Before(now.AddDate(0, 0, int(days)))
+}
@@ -0,0 +1,152 @@
+package domain
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestNewMaintenancePlan(t *testing.T) {
+	type args struct {
+		modelId        string
+		intervalKM     uint64
+		intervalMonths uint
+	}
+
+	type want struct {
+		isPlan bool
+		err    error
+	}
+
+	testCases := []struct {
+		name string
+		args args
+		want want
+	}{
+		{
+			name: "correct input",
+			args: args{modelId: "0b1b5f2a-3f4e-4b7b-9c41-7d8a6c1f2e3d", intervalKM: 10000, intervalMonths: 12},
+			want: want{isPlan: true, err: nil},
+		},
+		{
+			name: "correct km only input",
+			args: args{modelId: "0b1b5f2a-3f4e-4b7b-9c41-7d8a6c1f2e3d", intervalKM: 10000},
+			want: want{isPlan: true, err: nil},
+		},
+		{
+			name: "incorrect interval input",
+			args: args{modelId: "0b1b5f2a-3f4e-4b7b-9c41-7d8a6c1f2e3d"},
+			want: want{isPlan: false, err: ErrInvalidMaintenancePlan},
+		},
+		{
+			name: "incorrect model input",
+			args: args{modelId: "Uno", intervalKM: 10000},
+			want: want{isPlan: false, err: ErrInvalidEntity},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			p, err := NewMaintenancePlan(tc.args.modelId, tc.args.intervalKM, tc.args.intervalMonths)
+
+			if reflect.ValueOf(p).IsNil() == tc.want.isPlan {
+				t.Error("unexpected result")
+			}
+
+			if !errors.Is(err, tc.want.err) {
+				t.Error("unexpected error", err)
+			}
+		})
+	}
+}
+
+func TestMaintenancePlan_Due(t *testing.T) {
+	now := time.Date(2022, time.June, 1, 0, 0, 0, 0, time.UTC)
+	serviceEnd := time.Date(2022, time.January, 10, 0, 0, 0, 0, time.UTC)
+
+	lastService := newWorkOrderFixture()
+	lastService.Status = WorkOrderDone
+	lastService.KM = 10000
+	lastService.DateEnd = &serviceEnd
+
+	acquisition := NewCarLifecycle(lastService.CarId)
+	acquisition.Acquire(time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC), 1000, 60000, "Fiat Dealer", 4, 20000)
+
+	planCreatedAt := time.Date(2022, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	type args struct {
+		intervalKM     uint64
+		intervalMonths uint
+		km             uint64
+		lastService    *WorkOrder
+		acquisition    *CarLifecycle
+	}
+
+	type want struct {
+		dueKM   uint64
+		dueDate *time.Time
+		overdue bool
+	}
+
+	dueFromService := serviceEnd.AddDate(0, 12, 0)
+	dueFromAcquisition := acquisition.PurchasedAt.AddDate(0, 12, 0)
+	dueFromPlan := planCreatedAt.AddDate(0, 12, 0)
+
+	testCases := []struct {
+		name string
+		args args
+		want want
+	}{
+		{
+			name: "never serviced overdue from acquisition",
+			args: args{intervalKM: 10000, intervalMonths: 12, km: 9000, acquisition: acquisition},
+			want: want{dueKM: 11000, dueDate: &dueFromAcquisition, overdue: true},
+		},
+		{
+			name: "never serviced nor acquired counts from plan",
+			args: args{intervalKM: 10000, intervalMonths: 12, km: 50000},
+			want: want{dueKM: 0, dueDate: &dueFromPlan, overdue: false},
+		},
+		{
+			name: "serviced after acquisition",
+			args: args{intervalKM: 10000, intervalMonths: 12, km: 15000, lastService: lastService, acquisition: acquisition},
+			want: want{dueKM: 20000, dueDate: &dueFromService, overdue: false},
+		},
+		{
+			name: "serviced within intervals",
+			args: args{intervalKM: 10000, intervalMonths: 12, km: 15000, lastService: lastService},
+			want: want{dueKM: 20000, dueDate: &dueFromService, overdue: false},
+		},
+		{
+			name: "serviced overdue by km",
+			args: args{intervalKM: 10000, km: 20000, lastService: lastService},
+			want: want{dueKM: 20000, overdue: true},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			car := newCarFixture()
+			car.KM = tc.args.km
+
+			plan := MaintenancePlan{
+				ID:             "0b6c0ab4-2d1c-4d1b-9b0a-8e2f7c6b1e11",
+				ModelId:        car.ModelId,
+				IntervalKM:     tc.args.intervalKM,
+				IntervalMonths: tc.args.intervalMonths,
+				CreatedAt:      planCreatedAt,
+			}
+
+			due := plan.Due(*car, tc.args.lastService, tc.args.acquisition, now)
+
+			if due.DueKM != tc.want.dueKM || due.Overdue != tc.want.overdue {
+				t.Error("unexpected result", due)
+			}
+
+			if !reflect.DeepEqual(due.DueDate, tc.want.dueDate) {
+				t.Error("unexpected due date", due.DueDate)
+			}
+		})
+	}
+}
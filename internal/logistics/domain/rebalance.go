@@ -0,0 +1,147 @@
+package domain
+
+import "sort"
+
+type PlannedTransfer struct {
+	CarId         string `json:"carId" validate:"required,uuid4"`
+	ModelId       string `json:"modelId"`
+	Model         string `json:"model"`
+	StationIdFrom string `json:"stationIdFrom" validate:"required,uuid4"`
+	StationIdTo   string `json:"stationIdTo" validate:"required,uuid4"`
+}
+
+type RebalancePlan struct {
+	Transfers []PlannedTransfer `json:"transfers"`
+}
+
+func PlanRebalance(stations []Station, cars []Car) RebalancePlan {
+	plan := RebalancePlan{Transfers: []PlannedTransfer{}}
+
+	stations = append([]Station{}, stations...)
+	sort.Slice(stations, func(i, j int) bool { return stations[i].ID < stations[j].ID })
+
+	free := make(map[string]int)
+	byId := make(map[string]Station)
+	for _, s := range stations {
+		free[s.ID] = int(s.Capacity) - int(s.Idle)
+		byId[s.ID] = s
+	}
+
+	parked := make(map[string]map[string][]Car)
+	reserved := make(map[string]map[string]int)
+	models := []string{}
+
+	for _, c := range cars {
+		if _, exists := free[c.StationId]; !exists {
+			continue
+		}
+
+		switch {
+		case c.Status == Transfer:
+			free[c.StationId]--
+		case c.ModelId == "":
+		case c.Status == Parked:
+			if _, exists := parked[c.ModelId]; !exists {
+				parked[c.ModelId] = make(map[string][]Car)
+				models = append(models, c.ModelId)
+			}
+			parked[c.ModelId][c.StationId] = append(parked[c.ModelId][c.StationId], c)
+		case c.Status == Reserved:
+			if _, exists := reserved[c.ModelId]; !exists {
+				reserved[c.ModelId] = make(map[string]int)
+			}
+			reserved[c.ModelId][c.StationId]++
+		}
+	}
+
+	sort.Strings(models)
+
+	for _, model := range models {
+		targets := rebalanceTargets(stations, parked[model], reserved[model])
+
+		surplus := []Car{}
+		for _, s := range stations {
+			if n := len(parked[model][s.ID]) - targets[s.ID]; n > 0 {
+				surplus = append(surplus, parked[model][s.ID][:n]...)
+			}
+		}
+
+		for _, s := range stations {
+			for missing := targets[s.ID] - len(parked[model][s.ID]); missing > 0 && free[s.ID] > 0 && len(surplus) > 0; missing-- {
+				i := nearestCar(s, surplus, byId)
+				car := surplus[i]
+				surplus = append(surplus[:i], surplus[i+1:]...)
+
+				plan.Transfers = append(plan.Transfers, PlannedTransfer{
+					CarId:         car.ID,
+					ModelId:       model,
+					Model:         car.Model,
+					StationIdFrom: car.StationId,
+					StationIdTo:   s.ID,
+				})
+
+				free[s.ID]--
+				free[car.StationId]++
+			}
+		}
+	}
+
+	return plan
+}
+
+func rebalanceTargets(stations []Station, parked map[string][]Car, reserved map[string]int) map[string]int {
+	targets := make(map[string]int)
+
+	total, weights := 0, 0
+	for _, s := range stations {
+		total += len(parked[s.ID])
+		weights += int(s.Capacity) + reserved[s.ID]
+	}
+
+	if weights == 0 {
+		return targets
+	}
+
+	type remainder struct {
+		stationId string
+		value     int
+	}
+
+	assigned := 0
+	remainders := []remainder{}
+	for _, s := range stations {
+		share := total * (int(s.Capacity) + reserved[s.ID])
+		targets[s.ID] = share / weights
+		assigned += targets[s.ID]
+		remainders = append(remainders, remainder{s.ID, share % weights})
+	}
+
+	sort.SliceStable(remainders, func(i, j int) bool { return remainders[i].value > remainders[j].value })
+
+	for i := 0; assigned < total; i++ {
+		targets[remainders[i].stationId]++
+		assigned++
+	}
+
+	return targets
+}
+
+func nearestCar(to Station, cars []Car, stations map[string]Station) int {
+	nearest := 0
+	if !to.HasLocation() {
+		return nearest
+	}
+
+	best := -1.0
+	for i, c := range cars {
+		from := stations[c.StationId]
+		if !from.HasLocation() {
+			continue
+		}
+		if d := to.DistanceTo(from.Latitude, from.Longitude); best < 0 || d < best {
+			nearest, best = i, d
+		}
+	}
+
+	return nearest
+}
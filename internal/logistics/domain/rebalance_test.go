@@ -0,0 +1,155 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPlanRebalance(t *testing.T) {
+	stationA := newStationFixture()
+	stationA.ID = "11111111-1111-4111-8111-111111111111"
+	stationA.Capacity = 10
+
+	stationB := newStationFixture()
+	stationB.ID = "22222222-2222-4222-8222-222222222222"
+	stationB.Capacity = 10
+
+	newCar := func(id, stationId string, status CarStatus) Car {
+		c := newCarFixture()
+		c.ID = id
+		c.StationId = stationId
+		c.Status = status
+		c.ModelId = "0b1b5f2a-3f4e-4b7b-9c41-7d8a6c1f2e3d"
+		return *c
+	}
+
+	otherTrim := func(c Car) Car {
+		c.ModelId = "6f0c1d2e-8a4b-4c3d-9e5f-1a2b3c4d5e6f"
+		return c
+	}
+
+	parkedAtA := []Car{
+		newCar("a0000000-0000-4000-8000-000000000001", stationA.ID, Parked),
+		newCar("a0000000-0000-4000-8000-000000000002", stationA.ID, Parked),
+		newCar("a0000000-0000-4000-8000-000000000003", stationA.ID, Parked),
+		newCar("a0000000-0000-4000-8000-000000000004", stationA.ID, Parked),
+	}
+
+	reservedAtB := []Car{}
+	for _, id := range []string{
+		"b0000000-0000-4000-8000-000000000001",
+		"b0000000-0000-4000-8000-000000000002",
+		"b0000000-0000-4000-8000-000000000003",
+		"b0000000-0000-4000-8000-000000000004",
+		"b0000000-0000-4000-8000-000000000005",
+		"b0000000-0000-4000-8000-000000000006",
+		"b0000000-0000-4000-8000-000000000007",
+		"b0000000-0000-4000-8000-000000000008",
+		"b0000000-0000-4000-8000-000000000009",
+		"b0000000-0000-4000-8000-000000000010",
+	} {
+		reservedAtB = append(reservedAtB, newCar(id, stationB.ID, Reserved))
+	}
+
+	type init struct {
+		idleA uint
+		idleB uint
+		cars  []Car
+	}
+
+	testCases := []struct {
+		name string
+		init init
+		want []string
+	}{
+		{
+			name: "split by capacity",
+			init: init{idleA: 4, idleB: 0, cars: parkedAtA},
+			want: []string{parkedAtA[0].ID, parkedAtA[1].ID},
+		},
+		{
+			name: "split by capacity and reservations",
+			init: init{idleA: 3, idleB: 0, cars: append(append([]Car{}, parkedAtA[:3]...), reservedAtB...)},
+			want: []string{parkedAtA[0].ID, parkedAtA[1].ID},
+		},
+		{
+			name: "destination without free slots",
+			init: init{idleA: 4, idleB: 10, cars: parkedAtA},
+			want: []string{},
+		},
+		{
+			name: "same model name of another catalog model",
+			init: init{idleA: 4, idleB: 0, cars: append(append([]Car{}, parkedAtA[:2]...), otherTrim(parkedAtA[2]), otherTrim(parkedAtA[3]))},
+			want: []string{parkedAtA[0].ID, parkedAtA[2].ID},
+		},
+		{
+			name: "already balanced",
+			init: init{idleA: 2, idleB: 2, cars: append(append([]Car{}, parkedAtA[:2]...), newCar("c0000000-0000-4000-8000-000000000001", stationB.ID, Parked), newCar("c0000000-0000-4000-8000-000000000002", stationB.ID, Parked))},
+			want: []string{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			a, b := *stationA, *stationB
+			a.Idle, b.Idle = tc.init.idleA, tc.init.idleB
+
+			plan := PlanRebalance([]Station{b, a}, tc.init.cars)
+
+			carIds := []string{}
+			for _, transfer := range plan.Transfers {
+				if transfer.StationIdFrom != a.ID || transfer.StationIdTo != b.ID {
+					t.Error("unexpected transfer", transfer)
+				}
+				carIds = append(carIds, transfer.CarId)
+			}
+
+			if !reflect.DeepEqual(carIds, tc.want) {
+				t.Error("unexpected result", carIds, tc.want)
+			}
+		})
+	}
+}
+
+func TestPlanRebalance_Nearest(t *testing.T) {
+	target := newStationFixture()
+	target.ID = "11111111-1111-4111-8111-111111111111"
+	target.Capacity = 10
+	target.SetLocation(-23.5505, -46.6333)
+
+	far := newStationFixture()
+	far.ID = "22222222-2222-4222-8222-222222222222"
+	far.Capacity = 10
+	far.Idle = 2
+	far.SetLocation(-22.9068, -43.1729)
+
+	near := newStationFixture()
+	near.ID = "33333333-3333-4333-8333-333333333333"
+	near.Capacity = 10
+	near.Idle = 2
+	near.SetLocation(-23.9608, -46.3336)
+
+	cars := []Car{}
+	for i, stationId := range []string{far.ID, far.ID, near.ID, near.ID} {
+		c := newCarFixture()
+		c.ID = []string{
+			"a0000000-0000-4000-8000-000000000001",
+			"a0000000-0000-4000-8000-000000000002",
+			"a0000000-0000-4000-8000-000000000003",
+			"a0000000-0000-4000-8000-000000000004",
+		}[i]
+		c.StationId = stationId
+		c.ModelId = "0b1b5f2a-3f4e-4b7b-9c41-7d8a6c1f2e3d"
+		cars = append(cars, *c)
+	}
+
+	plan := PlanRebalance([]Station{*target, *far, *near}, cars)
+
+	if len(plan.Transfers) != 2 {
+		t.Fatal("unexpected result", plan.Transfers)
+	}
+
+	if plan.Transfers[0].StationIdFrom != near.ID || plan.Transfers[0].StationIdTo != target.ID {
+		t.Error("unexpected transfer", plan.Transfers[0])
+	}
+}
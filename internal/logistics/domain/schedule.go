@@ -0,0 +1,115 @@
+package domain
+
+import "time"
+
+const (
+	clockLayout = "15:04"
+	dateLayout  = "2006-01-02"
+)
+
+type OpeningHours struct {
+	Weekday time.Weekday `json:"weekday" validate:"min=0,max=6" db:"weekday"`
+	Opens   string       `json:"opens" validate:"required" db:"opens"`
+	Closes  string       `json:"closes" validate:"required" db:"closes"`
+}
+
+type Holiday struct {
+	Date string `json:"date" validate:"required" db:"date"`
+	Name string `json:"name" db:"name"`
+}
+
+func minutesOf(clock string) (int, bool) {
+	t, err := time.Parse(clockLayout, clock)
+	if err != nil {
+		return 0, false
+	}
+
+	return t.Hour()*60 + t.Minute(), true
+}
+
+func (h OpeningHours) valid() bool {
+	if _, ok := minutesOf(h.Opens); !ok {
+		return false
+	}
+
+	if _, ok := minutesOf(h.Closes); !ok {
+		return false
+	}
+
+	return h.Weekday >= time.Sunday && h.Weekday <= time.Saturday
+}
+
+// Hours closing at or before they open run past midnight into the next weekday.
+func (h OpeningHours) contains(at time.Time) bool {
+	opens, _ := minutesOf(h.Opens)
+	closes, _ := minutesOf(h.Closes)
+	minutes := at.Hour()*60 + at.Minute()
+
+	if opens < closes {
+		return at.Weekday() == h.Weekday && minutes >= opens && minutes < closes
+	}
+
+	next := (h.Weekday + 1) % 7
+	return (at.Weekday() == h.Weekday && minutes >= opens) || (at.Weekday() == next && minutes < closes)
+}
+
+func (s *Station) SetSchedule(schedule []OpeningHours, holidays []Holiday, timezone string, keyDrop bool) error {
+	if _, err := time.LoadLocation(timezone); err != nil {
+		return ErrInvalidSchedule
+	}
+
+	for _, h := range schedule {
+		if !h.valid() {
+			return ErrInvalidSchedule
+		}
+	}
+
+	for _, h := range holidays {
+		if _, err := time.Parse(dateLayout, h.Date); err != nil {
+			return ErrInvalidSchedule
+		}
+	}
+
+	s.Schedule = schedule
+	s.Holidays = holidays
+	s.Timezone = timezone
+	s.KeyDrop = keyDrop
+
+	return nil
+}
+
+func (s Station) IsOpen(at time.Time) bool {
+	at = at.In(s.location())
+
+	date := at.Format(dateLayout)
+	for _, h := range s.Holidays {
+		if h.Date == date {
+			return false
+		}
+	}
+
+	if len(s.Schedule) == 0 {
+		return true
+	}
+
+	for _, h := range s.Schedule {
+		if h.contains(at) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s Station) AcceptsReturn(at time.Time, keyDrop bool) bool {
+	return s.IsOpen(at) || (keyDrop && s.KeyDrop)
+}
+
+func (s Station) location() *time.Location {
+	loc, err := time.LoadLocation(s.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+
+	return loc
+}
@@ -0,0 +1,218 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func newScheduleFixture() []OpeningHours {
+	schedule := []OpeningHours{}
+	for d := time.Monday; d <= time.Friday; d++ {
+		schedule = append(schedule, OpeningHours{Weekday: d, Opens: "08:00", Closes: "18:00"})
+	}
+	return schedule
+}
+
+func TestStation_SetSchedule(t *testing.T) {
+	testCases := []struct {
+		name     string
+		schedule []OpeningHours
+		holidays []Holiday
+		timezone string
+		want     error
+	}{
+		{
+			name:     "correct input",
+			schedule: newScheduleFixture(),
+			holidays: []Holiday{{Date: "2021-12-25", Name: "Christmas"}},
+			timezone: "America/Sao_Paulo",
+			want:     nil,
+		},
+		{
+			name:     "correct hours without leading zero input",
+			schedule: []OpeningHours{{Weekday: time.Monday, Opens: "9:00", Closes: "18:00"}},
+			holidays: []Holiday{},
+			want:     nil,
+		},
+		{
+			name:     "incorrect hours input",
+			schedule: []OpeningHours{{Weekday: time.Monday, Opens: "8h", Closes: "18:00"}},
+			holidays: []Holiday{},
+			want:     ErrInvalidSchedule,
+		},
+		{
+			name:     "correct overnight hours input",
+			schedule: []OpeningHours{{Weekday: time.Monday, Opens: "22:00", Closes: "06:00"}},
+			holidays: []Holiday{},
+			want:     nil,
+		},
+		{
+			name:     "correct hours until midnight input",
+			schedule: []OpeningHours{{Weekday: time.Monday, Opens: "18:00", Closes: "00:00"}},
+			holidays: []Holiday{},
+			want:     nil,
+		},
+		{
+			name:     "incorrect holiday input",
+			schedule: newScheduleFixture(),
+			holidays: []Holiday{{Date: "25/12/2021", Name: "Christmas"}},
+			want:     ErrInvalidSchedule,
+		},
+		{
+			name:     "incorrect timezone input",
+			schedule: newScheduleFixture(),
+			holidays: []Holiday{},
+			timezone: "Mars/Olympus_Mons",
+			want:     ErrInvalidSchedule,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			station := newStationFixture()
+			err := station.SetSchedule(tc.schedule, tc.holidays, tc.timezone, true)
+
+			if !errors.Is(err, tc.want) {
+				t.Error("unexpected error", err)
+			}
+
+			if err == nil && len(station.Schedule) != len(tc.schedule) {
+				t.Error("unexpected schedule", station.Schedule)
+			}
+		})
+	}
+}
+
+func TestStation_IsOpen(t *testing.T) {
+	station := newStationFixture()
+	station.SetSchedule(newScheduleFixture(), []Holiday{{Date: "2021-12-24", Name: "Christmas Eve"}}, "", true)
+
+	testCases := []struct {
+		name    string
+		at      time.Time
+		keyDrop bool
+		open    bool
+		accepts bool
+	}{
+		{
+			name:    "within opening hours",
+			at:      time.Date(2021, 12, 20, 10, 0, 0, 0, time.UTC),
+			keyDrop: false,
+			open:    true,
+			accepts: true,
+		},
+		{
+			name:    "after closing time",
+			at:      time.Date(2021, 12, 20, 18, 0, 0, 0, time.UTC),
+			keyDrop: false,
+			open:    false,
+			accepts: false,
+		},
+		{
+			name:    "after closing time with key drop",
+			at:      time.Date(2021, 12, 20, 3, 0, 0, 0, time.UTC),
+			keyDrop: true,
+			open:    false,
+			accepts: true,
+		},
+		{
+			name:    "weekend",
+			at:      time.Date(2021, 12, 19, 10, 0, 0, 0, time.UTC),
+			keyDrop: false,
+			open:    false,
+			accepts: false,
+		},
+		{
+			name:    "holiday",
+			at:      time.Date(2021, 12, 24, 10, 0, 0, 0, time.UTC),
+			keyDrop: false,
+			open:    false,
+			accepts: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if open := station.IsOpen(tc.at); open != tc.open {
+				t.Error("unexpected open", open)
+			}
+
+			if accepts := station.AcceptsReturn(tc.at, tc.keyDrop); accepts != tc.accepts {
+				t.Error("unexpected accepts return", accepts)
+			}
+		})
+	}
+}
+
+func TestStation_IsOpenOvernight(t *testing.T) {
+	station := newStationFixture()
+	station.SetSchedule([]OpeningHours{
+		{Weekday: time.Friday, Opens: "22:00", Closes: "06:00"},
+		{Weekday: time.Saturday, Opens: "18:00", Closes: "00:00"},
+	}, []Holiday{}, "", false)
+
+	testCases := []struct {
+		name string
+		at   time.Time
+		open bool
+	}{
+		{name: "before midnight", at: time.Date(2021, 12, 17, 23, 0, 0, 0, time.UTC), open: true},
+		{name: "after midnight", at: time.Date(2021, 12, 18, 5, 59, 0, 0, time.UTC), open: true},
+		{name: "after closing time", at: time.Date(2021, 12, 18, 6, 0, 0, 0, time.UTC), open: false},
+		{name: "before opening time", at: time.Date(2021, 12, 17, 21, 59, 0, 0, time.UTC), open: false},
+		{name: "closing at midnight", at: time.Date(2021, 12, 18, 23, 59, 0, 0, time.UTC), open: true},
+		{name: "after closing at midnight", at: time.Date(2021, 12, 19, 0, 0, 0, 0, time.UTC), open: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if open := station.IsOpen(tc.at); open != tc.open {
+				t.Error("unexpected open", open)
+			}
+		})
+	}
+}
+
+func TestStation_IsOpen_WithoutSchedule(t *testing.T) {
+	station := newStationFixture()
+
+	if !station.IsOpen(time.Date(2021, 12, 19, 3, 0, 0, 0, time.UTC)) {
+		t.Error("station without schedule should be always open")
+	}
+}
+
+func TestStation_IsOpenInTimezone(t *testing.T) {
+	station := newStationFixture()
+	station.SetSchedule([]OpeningHours{{Weekday: time.Monday, Opens: "9:00", Closes: "18:00"}}, []Holiday{}, "America/Sao_Paulo", false)
+
+	testCases := []struct {
+		name string
+		at   time.Time
+		open bool
+	}{
+		{
+			name: "opening local time",
+			at:   time.Date(2021, 12, 20, 12, 0, 0, 0, time.UTC),
+			open: true,
+		},
+		{
+			name: "before opening local time",
+			at:   time.Date(2021, 12, 20, 11, 59, 0, 0, time.UTC),
+			open: false,
+		},
+		{
+			name: "after closing local time",
+			at:   time.Date(2021, 12, 20, 21, 0, 0, 0, time.UTC),
+			open: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if open := station.IsOpen(tc.at); open != tc.open {
+				t.Error("unexpected open", open)
+			}
+		})
+	}
+}
@@ -3,19 +3,26 @@ package domain
 import (
 	"fmt"
 
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/geo"
 	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/validation"
 )
 
 type Station struct {
-	ID         string `json:"id" validate:"required,uuid4"`
-	Name       string `json:"name" validate:"required"`
-	Address    string `json:"address" validate:"required"`
-	Complement string `json:"complement" validate:"required"`
-	State      string `json:"state" validate:"required"`
-	City       string `json:"city" validate:"required"`
-	Cep        string `json:"cep" validate:"required,len=8"`
-	Capacity   uint   `json:"capacity" validate:"required,gt=0"`
-	Idle       uint   `json:"idle"`
+	ID         string         `json:"id" validate:"required,uuid4"`
+	Name       string         `json:"name" validate:"required"`
+	Address    string         `json:"address" validate:"required"`
+	Complement string         `json:"complement" validate:"required"`
+	State      string         `json:"state" validate:"required"`
+	City       string         `json:"city" validate:"required"`
+	Cep        string         `json:"cep" validate:"required,len=8"`
+	Capacity   uint           `json:"capacity" validate:"required,gt=0"`
+	Idle       uint           `json:"idle"`
+	Latitude   float64        `json:"latitude" validate:"min=-90,max=90"`
+	Longitude  float64        `json:"longitude" validate:"min=-180,max=180"`
+	KeyDrop    bool           `json:"keyDrop" db:"keyDrop"`
+	Timezone   string         `json:"timezone" db:"timezone"`
+	Schedule   []OpeningHours `json:"schedule" validate:"dive"`
+	Holidays   []Holiday      `json:"holidays" validate:"dive"`
 }
 
 func NewStation(name, address, complement, state, city, cep string, capacity, idle uint) (*Station, error) {
@@ -29,6 +36,8 @@ func NewStation(name, address, complement, state, city, cep string, capacity, id
 		Cep:        cep,
 		Capacity:   capacity,
 		Idle:       idle,
+		Schedule:   []OpeningHours{},
+		Holidays:   []Holiday{},
 	}
 
 	if err := validation.ValidateEntity(station); err != nil {
@@ -61,3 +70,22 @@ func (s *Station) SetIdle(n uint) error {
 
 	return nil
 }
+
+func (s *Station) SetLocation(latitude, longitude float64) error {
+	if latitude < -90 || latitude > 90 || longitude < -180 || longitude > 180 {
+		return ErrInvalidLocation
+	}
+
+	s.Latitude = latitude
+	s.Longitude = longitude
+
+	return nil
+}
+
+func (s Station) HasLocation() bool {
+	return s.Latitude != 0 || s.Longitude != 0
+}
+
+func (s Station) DistanceTo(latitude, longitude float64) float64 {
+	return geo.Distance(s.Latitude, s.Longitude, latitude, longitude)
+}
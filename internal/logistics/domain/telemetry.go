@@ -0,0 +1,60 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/validation"
+)
+
+type TelemetryReading struct {
+	CarId      string    `json:"carId" validate:"required,uuid4" db:"carId"`
+	RecordedAt time.Time `json:"recordedAt" validate:"required" db:"recordedAt"`
+	Latitude   float64   `json:"latitude" validate:"gte=-90,lte=90" db:"latitude"`
+	Longitude  float64   `json:"longitude" validate:"gte=-180,lte=180" db:"longitude"`
+	KM         uint64    `json:"km" db:"km"`
+	FuelLevel  uint8     `json:"fuelLevel" validate:"lte=100" db:"fuelLevel"`
+}
+
+func NewTelemetryReading(carId string, recordedAt time.Time, latitude, longitude float64, km uint64, fuelLevel uint8) (*TelemetryReading, error) {
+	reading := &TelemetryReading{
+		CarId:      carId,
+		RecordedAt: recordedAt,
+		Latitude:   latitude,
+		Longitude:  longitude,
+		KM:         km,
+		FuelLevel:  fuelLevel,
+	}
+
+	if err := reading.Validate(); err != nil {
+		return nil, err
+	}
+
+	return reading, nil
+}
+
+func (r TelemetryReading) Validate() error {
+	if err := validation.ValidateEntity(r); err != nil {
+		return fmt.Errorf("%w\n%v", ErrInvalidTelemetry, err)
+	}
+
+	return nil
+}
+
+func (c *Car) ReportOdometer(km uint64) bool {
+	if km <= c.OdometerKM() {
+		return false
+	}
+
+	c.LastReportedKM = km
+
+	return true
+}
+
+func (c Car) OdometerKM() uint64 {
+	if c.LastReportedKM > c.KM {
+		return c.LastReportedKM
+	}
+
+	return c.KM
+}
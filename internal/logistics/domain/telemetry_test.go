@@ -0,0 +1,77 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestNewTelemetryReading(t *testing.T) {
+	testCases := []struct {
+		name      string
+		carId     string
+		latitude  float64
+		longitude float64
+		fuelLevel uint8
+		want      error
+	}{
+		{
+			name:      "correct input",
+			carId:     "83369771-f9a4-48b7-b87b-463f19f7b187",
+			latitude:  -23.5505,
+			longitude: -46.6333,
+			fuelLevel: 80,
+			want:      nil,
+		},
+		{
+			name:      "incorrect car id input",
+			carId:     "invalid-id",
+			latitude:  -23.5505,
+			longitude: -46.6333,
+			fuelLevel: 80,
+			want:      ErrInvalidTelemetry,
+		},
+		{
+			name:      "incorrect location input",
+			carId:     "83369771-f9a4-48b7-b87b-463f19f7b187",
+			latitude:  -123.5505,
+			longitude: -46.6333,
+			fuelLevel: 80,
+			want:      ErrInvalidTelemetry,
+		},
+		{
+			name:      "incorrect fuel level input",
+			carId:     "83369771-f9a4-48b7-b87b-463f19f7b187",
+			latitude:  -23.5505,
+			longitude: -46.6333,
+			fuelLevel: 101,
+			want:      ErrInvalidTelemetry,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewTelemetryReading(tc.carId, time.Now(), tc.latitude, tc.longitude, 12000, tc.fuelLevel)
+
+			if !errors.Is(err, tc.want) {
+				t.Error("unexpected error", err)
+			}
+		})
+	}
+}
+
+func TestCar_ReportOdometer(t *testing.T) {
+	car := Car{KM: 12000, Status: Transit}
+
+	if !car.ReportOdometer(12100) || car.LastReportedKM != 12100 || car.KM != 12000 {
+		t.Error("unexpected km", car.KM, car.LastReportedKM)
+	}
+
+	if car.ReportOdometer(12050) || car.LastReportedKM != 12100 {
+		t.Error("odometer went back", car.LastReportedKM)
+	}
+
+	if car.OdometerKM() != 12100 {
+		t.Error("unexpected odometer", car.OdometerKM())
+	}
+}
@@ -0,0 +1,61 @@
+package consumer
+
+import (
+	"encoding/json"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/loyalty/domain"
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/events"
+)
+
+type Topic string
+
+const (
+	OrderClosed   Topic = "order.closed"
+	OrderCanceled Topic = "order.canceled"
+)
+
+type closedOrderMsg struct {
+	ID         string  `json:"id"`
+	MemberId   string  `json:"memberId"`
+	CategoryId string  `json:"categoryId"`
+	Amount     float32 `json:"amount"`
+}
+
+type canceledOrderMsg struct {
+	ID       string `json:"id"`
+	MemberId string `json:"memberId"`
+}
+
+type orderConsumer struct {
+	disp events.Dispatcher
+}
+
+func NewOrderConsumer(disp events.Dispatcher) *orderConsumer {
+	return &orderConsumer{disp}
+}
+
+func (c *orderConsumer) ConsumeClosedOrder(data interface{}) {
+	if orderB, ok := data.([]byte); ok {
+		var order closedOrderMsg
+		json.Unmarshal(orderB, &order)
+
+		if order.MemberId == "" {
+			return
+		}
+
+		c.disp.Dispatch([]events.Event{domain.SyncOrderClosed{ID: order.ID, MemberId: order.MemberId, CategoryId: order.CategoryId, Amount: order.Amount}})
+	}
+}
+
+func (c *orderConsumer) ConsumeCanceledOrder(data interface{}) {
+	if orderB, ok := data.([]byte); ok {
+		var order canceledOrderMsg
+		json.Unmarshal(orderB, &order)
+
+		if order.MemberId == "" {
+			return
+		}
+
+		c.disp.Dispatch([]events.Event{domain.SyncOrderCanceled{ID: order.ID, MemberId: order.MemberId}})
+	}
+}
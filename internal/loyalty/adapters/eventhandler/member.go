@@ -0,0 +1,45 @@
+package eventhandler
+
+import (
+	"errors"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/loyalty/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/loyalty/domain"
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/events"
+)
+
+type memberEventHandler struct {
+	memberUC application.MemberUseCase
+}
+
+func NewMemberEventHandler(memberUC application.MemberUseCase) *memberEventHandler {
+	return &memberEventHandler{memberUC}
+}
+
+func (h memberEventHandler) HandleSyncOrderClosed(e events.Event) error {
+	event, ok := e.(domain.SyncOrderClosed)
+
+	if !ok {
+		return errors.New("wrong event")
+	}
+
+	if err := h.memberUC.AccruePoints(event.MemberId, event.ID, event.CategoryId, event.Amount); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (h memberEventHandler) HandleSyncOrderCanceled(e events.Event) error {
+	event, ok := e.(domain.SyncOrderCanceled)
+
+	if !ok {
+		return errors.New("wrong event")
+	}
+
+	if err := h.memberUC.RefundPoints(event.MemberId, event.ID); err != nil {
+		return err
+	}
+
+	return nil
+}
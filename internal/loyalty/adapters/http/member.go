@@ -0,0 +1,130 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/thiagotrs/rentalcar-ddd/internal/loyalty/application"
+)
+
+type memberController struct {
+	memberUC application.MemberUseCase
+}
+
+func NewMemberController(memberUC application.MemberUseCase) *memberController {
+	return &memberController{memberUC}
+}
+
+func (c *memberController) GetMembers(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	members := c.memberUC.GetMembers()
+	json, _ := json.Marshal(members)
+	w.WriteHeader(http.StatusOK)
+	w.Write(json)
+}
+
+func (c *memberController) GetMemberById(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	vars := mux.Vars(r)
+	member, err := c.memberUC.GetMemberById(vars["id"])
+
+	switch err {
+	case application.ErrInvalidId:
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case application.ErrNotFoundMember:
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case nil:
+		json, _ := json.Marshal(member)
+		w.WriteHeader(http.StatusOK)
+		w.Write(json)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	}
+}
+
+func (c *memberController) GetMemberBenefits(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	vars := mux.Vars(r)
+	benefits, err := c.memberUC.GetBenefits(vars["id"])
+
+	switch err {
+	case application.ErrInvalidId:
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case application.ErrNotFoundMember:
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case nil:
+		json, _ := json.Marshal(benefits)
+		w.WriteHeader(http.StatusOK)
+		w.Write(json)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	}
+}
+
+func (c *memberController) CreateMember(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	var params struct {
+		Name     string `json:"name"`
+		Document string `json:"document"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, application.ErrInvalidEntity)
+		return
+	}
+	member, err := c.memberUC.CreateMember(params.Name, params.Document)
+
+	switch err {
+	case application.ErrInvalidEntity:
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case nil:
+		json, _ := json.Marshal(member)
+		w.WriteHeader(http.StatusCreated)
+		w.Write(json)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	}
+}
+
+func (c *memberController) GetEarnRates(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	rates := c.memberUC.GetEarnRates()
+	json, _ := json.Marshal(rates)
+	w.WriteHeader(http.StatusOK)
+	w.Write(json)
+}
+
+func (c *memberController) UpdateEarnRate(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	vars := mux.Vars(r)
+	var params struct {
+		PointsPerUnit float32 `json:"pointsPerUnit"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, application.ErrInvalidEntity)
+		return
+	}
+	err := c.memberUC.SetEarnRate(vars["categoryId"], params.PointsPerUnit)
+
+	switch err {
+	case application.ErrInvalidEarnRate:
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case nil:
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	}
+}
@@ -0,0 +1,35 @@
+package ipc
+
+import (
+	"github.com/thiagotrs/rentalcar-ddd/internal/loyalty/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/ipc"
+)
+
+type memberIPC struct {
+	memberUC application.MemberUseCase
+}
+
+func NewMemberIPC(memberUC application.MemberUseCase) *memberIPC {
+	return &memberIPC{memberUC}
+}
+
+func (uc memberIPC) GetMemberBenefits(memberId string) (*ipc.MemberBenefitsData, error) {
+	benefits, err := uc.memberUC.GetBenefits(memberId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ipc.MemberBenefitsData{
+		Tier:            uint(benefits.Tier),
+		FreePrepaidFuel: benefits.FreePrepaidFuel,
+		UpgradePriority: benefits.UpgradePriority,
+	}, nil
+}
+
+func (uc memberIPC) RedeemPoints(memberId, orderId string, points uint, maxAmount float32) (float32, error) {
+	return uc.memberUC.RedeemPoints(memberId, orderId, points, maxAmount)
+}
+
+func (uc memberIPC) RefundPoints(memberId, orderId string) error {
+	return uc.memberUC.RefundPoints(memberId, orderId)
+}
@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/loyalty/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/loyalty/domain"
+)
+
+type memberRepositoryInMemory struct {
+	members map[string]domain.Member
+	*sync.RWMutex
+}
+
+func NewMemberRepositoryInMemory(members []domain.Member) *memberRepositoryInMemory {
+	membersMap := make(map[string]domain.Member)
+	for _, v := range members {
+		membersMap[v.ID] = v
+	}
+	return &memberRepositoryInMemory{membersMap, &sync.RWMutex{}}
+}
+
+func (repo memberRepositoryInMemory) FindAll() []domain.Member {
+	repo.RLock()
+	defer repo.RUnlock()
+
+	members := []domain.Member{}
+	for _, m := range repo.members {
+		members = append(members, m)
+	}
+
+	sort.Slice(members, func(i, j int) bool {
+		return members[i].Name < members[j].Name
+	})
+
+	return members
+}
+
+func (repo memberRepositoryInMemory) FindOne(id string) (*domain.Member, error) {
+	repo.RLock()
+	defer repo.RUnlock()
+
+	m, exists := repo.members[id]
+	if !exists {
+		return nil, application.ErrNotFoundMember
+	}
+
+	m.Entries = append([]domain.PointEntry{}, m.Entries...)
+
+	return &m, nil
+}
+
+func (repo *memberRepositoryInMemory) Save(member domain.Member) error {
+	repo.Lock()
+	defer repo.Unlock()
+
+	repo.members[member.ID] = member
+
+	return nil
+}
@@ -0,0 +1,134 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/thiagotrs/rentalcar-ddd/internal/loyalty/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/loyalty/domain"
+)
+
+const (
+	findMembers = `SELECT id, name, document, balance, lifetime, tier FROM lmembers ORDER BY name`
+	findMember  = `SELECT id, name, document, balance, lifetime, tier FROM lmembers WHERE id = $1 LIMIT 1`
+
+	findEntriesByMember = `SELECT "orderId", kind, points, date FROM lentries WHERE "memberId" = $1 ORDER BY date, kind`
+
+	upsertMember = `
+	INSERT INTO lmembers (id, name, document, balance, lifetime, tier) 
+	VALUES (:id, :name, :document, :balance, :lifetime, :tier) 
+	ON CONFLICT(id) DO 
+	UPDATE SET name = :name, document = :document 
+	WHERE lmembers.id = :id`
+	lockMember = `UPDATE lmembers SET balance = balance WHERE id = $1`
+
+	updatePointsMember = `
+	UPDATE lmembers SET 
+	balance = (SELECT COALESCE(SUM(points), 0) FROM lentries WHERE "memberId" = $1), 
+	lifetime = (SELECT COALESCE(SUM(points), 0) FROM lentries WHERE "memberId" = $1 AND kind = $2) 
+	WHERE id = $1`
+	findPointsMember = `SELECT balance, lifetime FROM lmembers WHERE id = $1`
+	updateTierMember = `UPDATE lmembers SET tier = $1 WHERE id = $2`
+
+	insertEntryMember = `
+	INSERT INTO lentries ("memberId", "orderId", kind, points, date) 
+	VALUES ($1, $2, $3, $4, $5) 
+	ON CONFLICT("memberId", "orderId", kind) DO NOTHING`
+)
+
+type memberRepositorySqlx struct {
+	ctx context.Context
+	DB  *sqlx.DB
+}
+
+func NewMemberRepositorySqlx(ctx context.Context, DB *sqlx.DB) *memberRepositorySqlx {
+	return &memberRepositorySqlx{ctx, DB}
+}
+
+func (repo *memberRepositorySqlx) FindAll() []domain.Member {
+	members := []domain.Member{}
+
+	if err := repo.DB.SelectContext(repo.ctx, &members, findMembers); err != nil {
+		return []domain.Member{}
+	}
+
+	for i := range members {
+		members[i].Entries = []domain.PointEntry{}
+		if err := repo.DB.SelectContext(repo.ctx, &members[i].Entries, findEntriesByMember, members[i].ID); err != nil {
+			return []domain.Member{}
+		}
+	}
+
+	return members
+}
+
+func (repo *memberRepositorySqlx) FindOne(id string) (*domain.Member, error) {
+	var member domain.Member
+
+	if err := repo.DB.GetContext(repo.ctx, &member, findMember, id); err != nil {
+		return nil, application.ErrNotFoundMember
+	}
+
+	member.Entries = []domain.PointEntry{}
+	if err := repo.DB.SelectContext(repo.ctx, &member.Entries, findEntriesByMember, member.ID); err != nil {
+		return nil, application.ErrNotFoundMember
+	}
+
+	return &member, nil
+}
+
+func (repo *memberRepositorySqlx) Save(member domain.Member) error {
+	tx, err := repo.DB.BeginTxx(repo.ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.NamedExecContext(repo.ctx, upsertMember, member); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	// Same row lock as SELECT ... FOR UPDATE, in a form SQLite takes too.
+	if _, err := tx.ExecContext(repo.ctx, lockMember, member.ID); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	for _, e := range member.Entries {
+		if _, err := tx.ExecContext(repo.ctx, insertEntryMember, member.ID, e.OrderId, e.Kind, e.Points, e.Date); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	if _, err := tx.ExecContext(repo.ctx, updatePointsMember, member.ID, domain.Accrual); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	var points struct {
+		Balance  int64  `db:"balance"`
+		Lifetime uint64 `db:"lifetime"`
+	}
+	if err := tx.GetContext(repo.ctx, &points, findPointsMember, member.ID); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if points.Balance < 0 {
+		tx.Rollback()
+		return application.ErrInsufficientPoints
+	}
+
+	if _, err := tx.ExecContext(repo.ctx, updateTierMember, domain.TierFor(points.Lifetime), member.ID); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,141 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/thiagotrs/rentalcar-ddd/internal/loyalty/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/loyalty/domain"
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/database/dbtest"
+)
+
+func newMemberFixture() domain.Member {
+	return domain.Member{
+		ID:       "0c7e2b4a-6d1f-4e3b-9a8c-5f2d1e0b3a4c",
+		Name:     "Ana Souza",
+		Document: "123.456.789-00",
+		Tier:     domain.Blue,
+		Entries:  []domain.PointEntry{},
+	}
+}
+
+func GetDBConn(t *testing.T) *sqlx.DB {
+	t.Helper()
+	db, err := sqlx.Open("sqlite3", dbtest.Copy(t, "data/loyalty_test.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return db
+}
+
+func ClearDB(t *testing.T, db *sqlx.DB) {
+	t.Helper()
+	const deleteAll = "DELETE FROM lentries; DELETE FROM lmembers; DELETE FROM lrates"
+
+	if _, err := db.Exec(deleteAll); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMemberRepositorySqlx_Save(t *testing.T) {
+	db := GetDBConn(t)
+	defer db.Close()
+
+	defer ClearDB(t, db)
+
+	repo := NewMemberRepositorySqlx(context.Background(), db)
+	rate := domain.EarnRate{CategoryId: "479ab9e7-ad16-4864-8e49-29b15e4b390e", PointsPerUnit: 1}
+	at := time.Date(2022, time.March, 1, 8, 0, 0, 0, time.UTC)
+
+	member := newMemberFixture()
+	if err := repo.Save(member); err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	if err := member.Accrue("c6f31fdd-a77a-464b-9475-2d12441963a6", 1200, rate, at); err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	if err := repo.Save(member); err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	// saving the same ledger again must not duplicate its entries
+	if err := repo.Save(member); err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	got, err := repo.FindOne(member.ID)
+	if err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	if got.Balance != 1200 || got.Lifetime != 1200 || got.Tier != domain.Silver || len(got.Entries) != 1 {
+		t.Error("unexpected member", got)
+	}
+
+	if _, err := repo.FindOne("9a0e5c34-1f4b-4d8e-8d4e-7b1f0c2d3e4f"); !errors.Is(err, application.ErrNotFoundMember) {
+		t.Error("unexpected error", err)
+	}
+}
+
+func TestMemberRepositorySqlx_SaveConcurrentEntries(t *testing.T) {
+	db := GetDBConn(t)
+	defer db.Close()
+
+	defer ClearDB(t, db)
+
+	repo := NewMemberRepositorySqlx(context.Background(), db)
+	rate := domain.EarnRate{CategoryId: "479ab9e7-ad16-4864-8e49-29b15e4b390e", PointsPerUnit: 1}
+	at := time.Date(2022, time.March, 1, 8, 0, 0, 0, time.UTC)
+
+	member := newMemberFixture()
+	if err := member.Accrue("c6f31fdd-a77a-464b-9475-2d12441963a6", 800, rate, at); err != nil {
+		t.Fatal("unexpected error", err)
+	}
+	if err := repo.Save(member); err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	// two updates read the same member before either is saved
+	first, _ := repo.FindOne(member.ID)
+	second, _ := repo.FindOne(member.ID)
+
+	if err := first.Accrue("5b2a8e1d-3c4f-4a6b-9d7e-1f0a2b3c4d5e", 400, rate, at); err != nil {
+		t.Fatal("unexpected error", err)
+	}
+	if _, err := second.Redeem("7e6d5c4b-3a2f-4e1d-8c0b-9a8f7e6d5c4b", 500, 10, at); err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	if err := repo.Save(*first); err != nil {
+		t.Fatal("unexpected error", err)
+	}
+	if err := repo.Save(*second); err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	got, err := repo.FindOne(member.ID)
+	if err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	if got.Balance != 700 || got.Lifetime != 1200 || got.Tier != domain.Silver || len(got.Entries) != 3 {
+		t.Error("unexpected member", got)
+	}
+
+	// a redemption over the balance left by the others is refused
+	third := *got
+	third.Balance = 1200
+	if _, err := third.Redeem("2d3e4f5a-6b7c-4d8e-9f0a-1b2c3d4e5f6a", 1000, 20, at); err != nil {
+		t.Fatal("unexpected error", err)
+	}
+	if err := repo.Save(third); !errors.Is(err, application.ErrInsufficientPoints) {
+		t.Error("unexpected error", err)
+	}
+}
@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/loyalty/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/loyalty/domain"
+)
+
+type earnRateRepositoryInMemory struct {
+	rates map[string]domain.EarnRate
+	*sync.RWMutex
+}
+
+func NewEarnRateRepositoryInMemory(rates []domain.EarnRate) *earnRateRepositoryInMemory {
+	ratesMap := make(map[string]domain.EarnRate)
+	for _, v := range rates {
+		ratesMap[v.CategoryId] = v
+	}
+	return &earnRateRepositoryInMemory{ratesMap, &sync.RWMutex{}}
+}
+
+func (repo earnRateRepositoryInMemory) FindAll() []domain.EarnRate {
+	repo.RLock()
+	defer repo.RUnlock()
+
+	rates := []domain.EarnRate{}
+	for _, r := range repo.rates {
+		rates = append(rates, r)
+	}
+
+	sort.Slice(rates, func(i, j int) bool {
+		return rates[i].CategoryId < rates[j].CategoryId
+	})
+
+	return rates
+}
+
+func (repo earnRateRepositoryInMemory) FindOne(categoryId string) (*domain.EarnRate, error) {
+	repo.RLock()
+	defer repo.RUnlock()
+
+	r, exists := repo.rates[categoryId]
+	if !exists {
+		return nil, application.ErrInvalidEarnRate
+	}
+
+	return &r, nil
+}
+
+func (repo *earnRateRepositoryInMemory) Save(rate domain.EarnRate) error {
+	repo.Lock()
+	defer repo.Unlock()
+
+	repo.rates[rate.CategoryId] = rate
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/thiagotrs/rentalcar-ddd/internal/loyalty/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/loyalty/domain"
+)
+
+const (
+	findRates = `SELECT "categoryId", "pointsPerUnit" FROM lrates ORDER BY "categoryId"`
+	findRate  = `SELECT "categoryId", "pointsPerUnit" FROM lrates WHERE "categoryId" = $1 LIMIT 1`
+
+	upsertRate = `
+	INSERT INTO lrates ("categoryId", "pointsPerUnit") 
+	VALUES (:categoryId, :pointsPerUnit) 
+	ON CONFLICT("categoryId") DO 
+	UPDATE SET "pointsPerUnit" = :pointsPerUnit 
+	WHERE lrates."categoryId" = :categoryId`
+)
+
+type earnRateRepositorySqlx struct {
+	ctx context.Context
+	DB  *sqlx.DB
+}
+
+func NewEarnRateRepositorySqlx(ctx context.Context, DB *sqlx.DB) *earnRateRepositorySqlx {
+	return &earnRateRepositorySqlx{ctx, DB}
+}
+
+func (repo *earnRateRepositorySqlx) FindAll() []domain.EarnRate {
+	rates := []domain.EarnRate{}
+
+	if err := repo.DB.SelectContext(repo.ctx, &rates, findRates); err != nil {
+		return []domain.EarnRate{}
+	}
+
+	return rates
+}
+
+func (repo *earnRateRepositorySqlx) FindOne(categoryId string) (*domain.EarnRate, error) {
+	var rate domain.EarnRate
+
+	if err := repo.DB.GetContext(repo.ctx, &rate, findRate, categoryId); err != nil {
+		return nil, application.ErrInvalidEarnRate
+	}
+
+	return &rate, nil
+}
+
+func (repo *earnRateRepositorySqlx) Save(rate domain.EarnRate) error {
+	_, err := repo.DB.NamedExecContext(repo.ctx, upsertRate, rate)
+	return err
+}
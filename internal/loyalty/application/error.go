@@ -0,0 +1,19 @@
+package application
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/loyalty/domain"
+)
+
+var (
+	ErrInvalidEntity      = fmt.Errorf("%w", domain.ErrInvalidEntity)
+	ErrInvalidEarnRate    = fmt.Errorf("%w", domain.ErrInvalidEarnRate)
+	ErrInvalidRedemption  = fmt.Errorf("%w", domain.ErrInvalidRedemption)
+	ErrInsufficientPoints = fmt.Errorf("%w", domain.ErrInsufficientPoints)
+
+	ErrInvalidId      = errors.New("invalid member id")
+	ErrNotFoundMember = errors.New("not found loyalty member")
+	ErrInvalidMember  = errors.New("invalid loyalty member")
+)
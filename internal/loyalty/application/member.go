@@ -0,0 +1,163 @@
+package application
+
+import (
+	"errors"
+	"time"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/loyalty/domain"
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/validation"
+)
+
+type MemberUseCase interface {
+	GetMembers() []domain.Member
+	GetMemberById(id string) (*domain.Member, error)
+	CreateMember(name, document string) (*domain.Member, error)
+	GetBenefits(id string) (*domain.TierBenefits, error)
+	AccruePoints(id, orderId, categoryId string, amount float32) error
+	RedeemPoints(id, orderId string, points uint, maxAmount float32) (float32, error)
+	RefundPoints(id, orderId string) error
+	GetEarnRates() []domain.EarnRate
+	SetEarnRate(categoryId string, pointsPerUnit float32) error
+}
+
+type memberUseCase struct {
+	memberRepo MemberRepository
+	rateRepo   EarnRateRepository
+}
+
+func NewMemberUseCase(memberRepo MemberRepository, rateRepo EarnRateRepository) *memberUseCase {
+	return &memberUseCase{
+		memberRepo: memberRepo,
+		rateRepo:   rateRepo,
+	}
+}
+
+func (uc memberUseCase) GetMembers() []domain.Member {
+	return uc.memberRepo.FindAll()
+}
+
+func (uc memberUseCase) GetMemberById(id string) (*domain.Member, error) {
+	if err := validation.ValidId(id); err != nil {
+		return nil, ErrInvalidId
+	}
+
+	member, err := uc.memberRepo.FindOne(id)
+	if err != nil {
+		return nil, ErrNotFoundMember
+	}
+
+	return member, nil
+}
+
+func (uc memberUseCase) CreateMember(name, document string) (*domain.Member, error) {
+	member, err := domain.NewMember(name, document)
+	if err != nil {
+		return nil, ErrInvalidEntity
+	}
+
+	if err := uc.memberRepo.Save(*member); err != nil {
+		return nil, ErrInvalidMember
+	}
+
+	return member, nil
+}
+
+func (uc memberUseCase) GetBenefits(id string) (*domain.TierBenefits, error) {
+	member, err := uc.GetMemberById(id)
+	if err != nil {
+		return nil, err
+	}
+
+	benefits := member.Tier.Benefits()
+
+	return &benefits, nil
+}
+
+func (uc memberUseCase) AccruePoints(id, orderId, categoryId string, amount float32) error {
+	member, err := uc.GetMemberById(id)
+	if err != nil {
+		return err
+	}
+
+	rate := domain.EarnRate{CategoryId: categoryId, PointsPerUnit: domain.DefaultPointsPerUnit}
+	if r, err := uc.rateRepo.FindOne(categoryId); err == nil {
+		rate = *r
+	}
+
+	switch err := member.Accrue(orderId, amount, rate, time.Now()); err {
+	case nil:
+	case domain.ErrDuplicatedEntry:
+		return nil
+	default:
+		return err
+	}
+
+	if err := uc.memberRepo.Save(*member); err != nil {
+		return ErrInvalidMember
+	}
+
+	return nil
+}
+
+func (uc memberUseCase) RedeemPoints(id, orderId string, points uint, maxAmount float32) (float32, error) {
+	member, err := uc.GetMemberById(id)
+	if err != nil {
+		return 0, err
+	}
+
+	discount, err := member.Redeem(orderId, points, maxAmount, time.Now())
+	switch err {
+	case nil:
+	case domain.ErrInsufficientPoints:
+		return 0, ErrInsufficientPoints
+	default:
+		return 0, ErrInvalidRedemption
+	}
+
+	if err := uc.memberRepo.Save(*member); err != nil {
+		if errors.Is(err, ErrInsufficientPoints) {
+			return 0, ErrInsufficientPoints
+		}
+		return 0, ErrInvalidMember
+	}
+
+	return discount, nil
+}
+
+func (uc memberUseCase) RefundPoints(id, orderId string) error {
+	member, err := uc.GetMemberById(id)
+	if err != nil {
+		return err
+	}
+
+	switch err := member.Refund(orderId, time.Now()); err {
+	case nil:
+	case domain.ErrNoRedemption, domain.ErrDuplicatedEntry:
+		return nil
+	default:
+		return err
+	}
+
+	if err := uc.memberRepo.Save(*member); err != nil {
+		return ErrInvalidMember
+	}
+
+	return nil
+}
+
+func (uc memberUseCase) GetEarnRates() []domain.EarnRate {
+	return uc.rateRepo.FindAll()
+}
+
+func (uc memberUseCase) SetEarnRate(categoryId string, pointsPerUnit float32) error {
+	rate, err := domain.NewEarnRate(categoryId, pointsPerUnit)
+	if err != nil {
+		return ErrInvalidEarnRate
+	}
+
+	if err := uc.rateRepo.Save(*rate); err != nil {
+		return ErrInvalidEarnRate
+	}
+
+	return nil
+}
@@ -0,0 +1,187 @@
+package application
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/loyalty/domain"
+)
+
+type memberRepositoryMock struct {
+	expectedFindOne    *domain.Member
+	expectedFindOneErr error
+	expectedSaveErr    error
+	saved              []domain.Member
+}
+
+func (m *memberRepositoryMock) FindAll() []domain.Member {
+	return []domain.Member{}
+}
+
+func (m *memberRepositoryMock) FindOne(id string) (*domain.Member, error) {
+	if m.expectedFindOneErr != nil {
+		return nil, m.expectedFindOneErr
+	}
+	member := *m.expectedFindOne
+	member.Entries = append([]domain.PointEntry{}, member.Entries...)
+	return &member, nil
+}
+
+func (m *memberRepositoryMock) Save(member domain.Member) error {
+	m.saved = append(m.saved, member)
+	return m.expectedSaveErr
+}
+
+type earnRateRepositoryMock struct {
+	expectedFindOne    *domain.EarnRate
+	expectedFindOneErr error
+}
+
+func (m *earnRateRepositoryMock) FindAll() []domain.EarnRate {
+	return []domain.EarnRate{}
+}
+
+func (m *earnRateRepositoryMock) FindOne(categoryId string) (*domain.EarnRate, error) {
+	return m.expectedFindOne, m.expectedFindOneErr
+}
+
+func (m *earnRateRepositoryMock) Save(rate domain.EarnRate) error {
+	return nil
+}
+
+func newMemberFixture() *domain.Member {
+	return &domain.Member{
+		ID:       "0c7e2b4a-6d1f-4e3b-9a8c-5f2d1e0b3a4c",
+		Name:     "Ana Souza",
+		Document: "123.456.789-00",
+		Tier:     domain.Blue,
+		Entries:  []domain.PointEntry{},
+	}
+}
+
+func TestMemberUseCase_AccruePoints(t *testing.T) {
+	orderId := "c6f31fdd-a77a-464b-9475-2d12441963a6"
+	categoryId := "479ab9e7-ad16-4864-8e49-29b15e4b390e"
+
+	credited := newMemberFixture()
+	credited.Balance = 150
+	credited.Lifetime = 150
+	credited.Entries = []domain.PointEntry{{OrderId: orderId, Kind: domain.Accrual, Points: 150}}
+
+	testCases := []struct {
+		name        string
+		member      *domain.Member
+		memberErr   error
+		rate        *domain.EarnRate
+		idArg       string
+		want        error
+		wantSaved   int
+		wantBalance int64
+	}{
+		{name: "correct default rate", member: newMemberFixture(), idArg: credited.ID, want: nil, wantSaved: 1, wantBalance: 150},
+		{name: "correct category rate", member: newMemberFixture(), rate: &domain.EarnRate{CategoryId: categoryId, PointsPerUnit: 3}, idArg: credited.ID, want: nil, wantSaved: 1, wantBalance: 450},
+		{name: "order already credited", member: credited, idArg: credited.ID, want: nil, wantSaved: 0},
+		{name: "incorrect id", member: newMemberFixture(), idArg: "invalid-id", want: ErrInvalidId, wantSaved: 0},
+		{name: "member not found", memberErr: ErrNotFoundMember, idArg: credited.ID, want: ErrNotFoundMember, wantSaved: 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			memberRepo := &memberRepositoryMock{expectedFindOne: tc.member, expectedFindOneErr: tc.memberErr}
+			rateRepo := &earnRateRepositoryMock{expectedFindOne: tc.rate, expectedFindOneErr: ErrInvalidEarnRate}
+			if tc.rate != nil {
+				rateRepo.expectedFindOneErr = nil
+			}
+
+			uc := NewMemberUseCase(memberRepo, rateRepo)
+
+			err := uc.AccruePoints(tc.idArg, orderId, categoryId, 150)
+
+			if !errors.Is(err, tc.want) {
+				t.Fatal("unexpected error", err)
+			}
+
+			if len(memberRepo.saved) != tc.wantSaved {
+				t.Fatal("unexpected saves", len(memberRepo.saved))
+			}
+
+			if tc.wantSaved > 0 && memberRepo.saved[0].Balance != tc.wantBalance {
+				t.Error("unexpected balance", memberRepo.saved[0].Balance)
+			}
+		})
+	}
+}
+
+func TestMemberUseCase_RedeemPoints(t *testing.T) {
+	orderId := "c6f31fdd-a77a-464b-9475-2d12441963a6"
+
+	testCases := []struct {
+		name         string
+		balance      int64
+		pointsArg    uint
+		saveErr      error
+		want         error
+		wantDiscount float32
+	}{
+		{name: "correct redemption", balance: 2000, pointsArg: 1500, want: nil, wantDiscount: 15},
+		{name: "insufficient points", balance: 1000, pointsArg: 1500, want: ErrInsufficientPoints},
+		{name: "points spent by a concurrent redemption", balance: 2000, pointsArg: 1500, saveErr: ErrInsufficientPoints, want: ErrInsufficientPoints},
+		{name: "over the order price", balance: 20000, pointsArg: 20000, want: ErrInvalidRedemption},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			member := newMemberFixture()
+			member.Balance = tc.balance
+			memberRepo := &memberRepositoryMock{expectedFindOne: member, expectedSaveErr: tc.saveErr}
+
+			uc := NewMemberUseCase(memberRepo, &earnRateRepositoryMock{})
+
+			discount, err := uc.RedeemPoints(member.ID, orderId, tc.pointsArg, 150)
+
+			if !errors.Is(err, tc.want) {
+				t.Fatal("unexpected error", err)
+			}
+
+			if discount != tc.wantDiscount {
+				t.Error("unexpected discount", discount)
+			}
+		})
+	}
+}
+
+func TestMemberUseCase_RefundPoints(t *testing.T) {
+	orderId := "c6f31fdd-a77a-464b-9475-2d12441963a6"
+
+	redeemed := newMemberFixture()
+	redeemed.Entries = []domain.PointEntry{{OrderId: orderId, Kind: domain.Redemption, Points: -500}}
+
+	testCases := []struct {
+		name      string
+		member    *domain.Member
+		wantSaved int
+	}{
+		{name: "refund redeemed points", member: redeemed, wantSaved: 1},
+		{name: "nothing redeemed", member: newMemberFixture(), wantSaved: 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			memberRepo := &memberRepositoryMock{expectedFindOne: tc.member}
+
+			uc := NewMemberUseCase(memberRepo, &earnRateRepositoryMock{})
+
+			if err := uc.RefundPoints(tc.member.ID, orderId); err != nil {
+				t.Fatal("unexpected error", err)
+			}
+
+			if len(memberRepo.saved) != tc.wantSaved {
+				t.Fatal("unexpected saves", len(memberRepo.saved))
+			}
+
+			if tc.wantSaved > 0 && memberRepo.saved[0].Balance != 500 {
+				t.Error("unexpected balance", memberRepo.saved[0].Balance)
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package application
+
+import "github.com/thiagotrs/rentalcar-ddd/internal/loyalty/domain"
+
+type MemberReaderRepository interface {
+	FindAll() []domain.Member
+	FindOne(id string) (*domain.Member, error)
+}
+
+type MemberWriterRepository interface {
+	Save(member domain.Member) error
+}
+
+type MemberRepository interface {
+	MemberReaderRepository
+	MemberWriterRepository
+}
+
+type EarnRateReaderRepository interface {
+	FindAll() []domain.EarnRate
+	FindOne(categoryId string) (*domain.EarnRate, error)
+}
+
+type EarnRateWriterRepository interface {
+	Save(rate domain.EarnRate) error
+}
+
+type EarnRateRepository interface {
+	EarnRateReaderRepository
+	EarnRateWriterRepository
+}
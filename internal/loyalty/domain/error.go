@@ -0,0 +1,12 @@
+package domain
+
+import "errors"
+
+var (
+	ErrInvalidEntity      = errors.New("invalid entity")
+	ErrInvalidEarnRate    = errors.New("invalid points earn rate")
+	ErrInvalidRedemption  = errors.New("invalid points redemption")
+	ErrInsufficientPoints = errors.New("not enough points to redeem")
+	ErrDuplicatedEntry    = errors.New("points already recorded for this order")
+	ErrNoRedemption       = errors.New("no points redeemed for this order")
+)
@@ -0,0 +1,21 @@
+package domain
+
+type SyncOrderClosed struct {
+	ID         string  `json:"id"`
+	MemberId   string  `json:"memberId"`
+	CategoryId string  `json:"categoryId"`
+	Amount     float32 `json:"amount"`
+}
+
+func (c SyncOrderClosed) Name() string {
+	return "sync.order.closed"
+}
+
+type SyncOrderCanceled struct {
+	ID       string `json:"id"`
+	MemberId string `json:"memberId"`
+}
+
+func (c SyncOrderCanceled) Name() string {
+	return "sync.order.canceled"
+}
@@ -0,0 +1,119 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/validation"
+)
+
+const PointValue float32 = 0.01
+
+type EntryKind uint
+
+const (
+	Accrual EntryKind = iota + 1
+	Redemption
+	Refund
+)
+
+type PointEntry struct {
+	OrderId string    `json:"orderId" db:"orderId"`
+	Kind    EntryKind `json:"kind" db:"kind"`
+	Points  int64     `json:"points" db:"points"`
+	Date    time.Time `json:"date" db:"date"`
+}
+
+type Member struct {
+	ID       string       `json:"id" validate:"required,uuid4" db:"id"`
+	Name     string       `json:"name" validate:"required" db:"name"`
+	Document string       `json:"document" validate:"required" db:"document"`
+	Balance  int64        `json:"balance" db:"balance"`
+	Lifetime uint64       `json:"lifetime" db:"lifetime"`
+	Tier     Tier         `json:"tier" db:"tier"`
+	Entries  []PointEntry `json:"entries"`
+}
+
+func NewMember(name, document string) (*Member, error) {
+	member := &Member{
+		ID:       validation.NewId(),
+		Name:     name,
+		Document: document,
+		Tier:     Blue,
+		Entries:  []PointEntry{},
+	}
+
+	if err := validation.ValidateEntity(member); err != nil {
+		return nil, fmt.Errorf("%w\n%v", ErrInvalidEntity, err)
+	}
+
+	return member, nil
+}
+
+func (m Member) entry(orderId string, kind EntryKind) (PointEntry, bool) {
+	for _, e := range m.Entries {
+		if e.OrderId == orderId && e.Kind == kind {
+			return e, true
+		}
+	}
+	return PointEntry{}, false
+}
+
+func (m *Member) Accrue(orderId string, amount float32, rate EarnRate, at time.Time) error {
+	if _, exists := m.entry(orderId, Accrual); exists {
+		return ErrDuplicatedEntry
+	}
+
+	var points int64
+	if amount > 0 {
+		points = int64(math.Floor(float64(amount * rate.PointsPerUnit * m.Tier.Benefits().EarnMultiplier)))
+	}
+
+	m.Entries = append(m.Entries, PointEntry{OrderId: orderId, Kind: Accrual, Points: points, Date: at})
+	m.Balance += points
+	m.Lifetime += uint64(points)
+	m.Tier = TierFor(m.Lifetime)
+
+	return nil
+}
+
+func (m *Member) Redeem(orderId string, points uint, maxAmount float32, at time.Time) (float32, error) {
+	if points == 0 {
+		return 0, ErrInvalidRedemption
+	}
+
+	if _, exists := m.entry(orderId, Redemption); exists {
+		return 0, ErrDuplicatedEntry
+	}
+
+	if int64(points) > m.Balance {
+		return 0, ErrInsufficientPoints
+	}
+
+	discount := float32(points) * PointValue
+	if discount > maxAmount {
+		return 0, ErrInvalidRedemption
+	}
+
+	m.Entries = append(m.Entries, PointEntry{OrderId: orderId, Kind: Redemption, Points: -int64(points), Date: at})
+	m.Balance -= int64(points)
+
+	return discount, nil
+}
+
+func (m *Member) Refund(orderId string, at time.Time) error {
+	redemption, exists := m.entry(orderId, Redemption)
+	if !exists {
+		return ErrNoRedemption
+	}
+
+	if _, exists := m.entry(orderId, Refund); exists {
+		return ErrDuplicatedEntry
+	}
+
+	m.Entries = append(m.Entries, PointEntry{OrderId: orderId, Kind: Refund, Points: -redemption.Points, Date: at})
+	m.Balance -= redemption.Points
+
+	return nil
+}
@@ -0,0 +1,110 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func newMemberFixture() *Member {
+	return &Member{
+		ID:       "0c7e2b4a-6d1f-4e3b-9a8c-5f2d1e0b3a4c",
+		Name:     "Ana Souza",
+		Document: "123.456.789-00",
+		Tier:     Blue,
+		Entries:  []PointEntry{},
+	}
+}
+
+func TestMember_Accrue(t *testing.T) {
+	rate := EarnRate{CategoryId: "479ab9e7-ad16-4864-8e49-29b15e4b390e", PointsPerUnit: 2}
+
+	testCases := []struct {
+		name       string
+		lifetime   uint64
+		amount     float32
+		wantPoints int64
+		wantTier   Tier
+	}{
+		{name: "blue", lifetime: 0, amount: 152.5, wantPoints: 305, wantTier: Blue},
+		{name: "blue to silver", lifetime: 800, amount: 152.5, wantPoints: 305, wantTier: Silver},
+		{name: "silver multiplier", lifetime: 1000, amount: 100, wantPoints: 250, wantTier: Silver},
+		{name: "platinum multiplier", lifetime: 20000, amount: 100, wantPoints: 400, wantTier: Platinum},
+		{name: "nothing charged", lifetime: 0, amount: 0, wantPoints: 0, wantTier: Blue},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			member := newMemberFixture()
+			member.Lifetime = tc.lifetime
+			member.Tier = TierFor(tc.lifetime)
+
+			if err := member.Accrue("c6f31fdd-a77a-464b-9475-2d12441963a6", tc.amount, rate, time.Now()); err != nil {
+				t.Fatal("unexpected error", err)
+			}
+
+			if member.Balance != tc.wantPoints || member.Tier != tc.wantTier {
+				t.Error("unexpected points or tier", member.Balance, member.Tier)
+			}
+
+			if err := member.Accrue("c6f31fdd-a77a-464b-9475-2d12441963a6", tc.amount, rate, time.Now()); !errors.Is(err, ErrDuplicatedEntry) {
+				t.Error("unexpected error", err)
+			}
+
+			if member.Balance != tc.wantPoints || len(member.Entries) != 1 {
+				t.Error("unexpected second accrual", member.Balance, member.Entries)
+			}
+		})
+	}
+}
+
+func TestMember_Redeem(t *testing.T) {
+	testCases := []struct {
+		name      string
+		points    uint
+		maxAmount float32
+		want      error
+		discount  float32
+	}{
+		{name: "correct redemption", points: 1000, maxAmount: 150, want: nil, discount: 10},
+		{name: "incorrect not enough points", points: 3000, maxAmount: 150, want: ErrInsufficientPoints},
+		{name: "incorrect over the order price", points: 2000, maxAmount: 15, want: ErrInvalidRedemption},
+		{name: "incorrect no points", points: 0, maxAmount: 150, want: ErrInvalidRedemption},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			member := newMemberFixture()
+			member.Balance = 2000
+
+			discount, err := member.Redeem("c6f31fdd-a77a-464b-9475-2d12441963a6", tc.points, tc.maxAmount, time.Now())
+
+			if !errors.Is(err, tc.want) {
+				t.Fatal("unexpected error", err)
+			}
+
+			if err != nil {
+				if member.Balance != 2000 {
+					t.Error("unexpected balance", member.Balance)
+				}
+				return
+			}
+
+			if discount != tc.discount || member.Balance != 2000-int64(tc.points) {
+				t.Error("unexpected redemption", discount, member.Balance)
+			}
+
+			if err := member.Refund("c6f31fdd-a77a-464b-9475-2d12441963a6", time.Now()); err != nil {
+				t.Fatal("unexpected error", err)
+			}
+
+			if err := member.Refund("c6f31fdd-a77a-464b-9475-2d12441963a6", time.Now()); !errors.Is(err, ErrDuplicatedEntry) {
+				t.Error("unexpected error", err)
+			}
+
+			if member.Balance != 2000 {
+				t.Error("unexpected balance after refund", member.Balance)
+			}
+		})
+	}
+}
@@ -0,0 +1,20 @@
+package domain
+
+import "github.com/thiagotrs/rentalcar-ddd/internal/pkg/validation"
+
+const DefaultPointsPerUnit float32 = 1
+
+type EarnRate struct {
+	CategoryId    string  `json:"categoryId" validate:"required,uuid4" db:"categoryId"`
+	PointsPerUnit float32 `json:"pointsPerUnit" validate:"gte=0" db:"pointsPerUnit"`
+}
+
+func NewEarnRate(categoryId string, pointsPerUnit float32) (*EarnRate, error) {
+	rate := &EarnRate{CategoryId: categoryId, PointsPerUnit: pointsPerUnit}
+
+	if err := validation.ValidateEntity(rate); err != nil {
+		return nil, ErrInvalidEarnRate
+	}
+
+	return rate, nil
+}
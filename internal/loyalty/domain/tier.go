@@ -0,0 +1,49 @@
+package domain
+
+type Tier uint
+
+const (
+	Blue Tier = iota + 1
+	Silver
+	Gold
+	Platinum
+)
+
+var tierThresholds = []struct {
+	tier   Tier
+	points uint64
+}{
+	{Platinum, 15000},
+	{Gold, 5000},
+	{Silver, 1000},
+	{Blue, 0},
+}
+
+type TierBenefits struct {
+	Tier            Tier    `json:"tier"`
+	EarnMultiplier  float32 `json:"earnMultiplier"`
+	FreePrepaidFuel bool    `json:"freePrepaidFuel"`
+	UpgradePriority bool    `json:"upgradePriority"`
+}
+
+func TierFor(lifetimePoints uint64) Tier {
+	for _, t := range tierThresholds {
+		if lifetimePoints >= t.points {
+			return t.tier
+		}
+	}
+	return Blue
+}
+
+func (t Tier) Benefits() TierBenefits {
+	switch t {
+	case Silver:
+		return TierBenefits{Tier: t, EarnMultiplier: 1.25}
+	case Gold:
+		return TierBenefits{Tier: t, EarnMultiplier: 1.5, UpgradePriority: true}
+	case Platinum:
+		return TierBenefits{Tier: t, EarnMultiplier: 2, FreePrepaidFuel: true, UpgradePriority: true}
+	default:
+		return TierBenefits{Tier: Blue, EarnMultiplier: 1}
+	}
+}
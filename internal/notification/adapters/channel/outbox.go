@@ -0,0 +1,38 @@
+package channel
+
+import (
+	"encoding/json"
+	"os"
+	"sync"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/notification/domain"
+)
+
+type outboxSender struct {
+	path string
+	mu   *sync.Mutex
+}
+
+func NewOutboxSender(path string) *outboxSender {
+	return &outboxSender{path, &sync.Mutex{}}
+}
+
+func (s outboxSender) Send(notification domain.Notification) error {
+	data, err := json.Marshal(notification)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	file, err := os.OpenFile(s.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = file.Write(append(data, '\n'))
+
+	return err
+}
@@ -0,0 +1,18 @@
+package channel
+
+import "github.com/thiagotrs/rentalcar-ddd/internal/notification/domain"
+
+type smsSender struct {
+	gatewayURL string
+}
+
+func NewSMSSender(gatewayURL string) *smsSender {
+	return &smsSender{gatewayURL}
+}
+
+func (s smsSender) Send(notification domain.Notification) error {
+	return postJSON(s.gatewayURL, struct {
+		To   string `json:"to"`
+		Text string `json:"text"`
+	}{notification.Address, notification.Body})
+}
@@ -0,0 +1,54 @@
+package channel
+
+import (
+	"errors"
+	"fmt"
+	"net/smtp"
+	"strings"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/notification/domain"
+)
+
+var errInvalidAddress = errors.New("invalid address")
+
+type smtpSender struct {
+	addr string
+	auth smtp.Auth
+	from string
+}
+
+func NewSMTPSender(host string, port uint, user, pass, from string) *smtpSender {
+	var auth smtp.Auth
+	if user != "" {
+		auth = smtp.PlainAuth("", user, pass, host)
+	}
+
+	return &smtpSender{fmt.Sprintf("%s:%d", host, port), auth, from}
+}
+
+func (s smtpSender) Send(notification domain.Notification) error {
+	msg, err := s.message(notification)
+	if err != nil {
+		return err
+	}
+
+	return smtp.SendMail(s.addr, s.auth, s.from, []string{notification.Address}, msg)
+}
+
+// A line break in a header would start headers of its own.
+func (s smtpSender) message(notification domain.Notification) ([]byte, error) {
+	if strings.ContainsAny(notification.Address, "\r\n") {
+		return nil, errInvalidAddress
+	}
+
+	subject := strings.NewReplacer("\r", " ", "\n", " ").Replace(notification.Subject)
+
+	var msg strings.Builder
+	fmt.Fprintf(&msg, "From: %s\r\n", s.from)
+	fmt.Fprintf(&msg, "To: %s\r\n", notification.Address)
+	fmt.Fprintf(&msg, "Subject: %s\r\n", subject)
+	fmt.Fprintf(&msg, "Content-Type: text/plain; charset=UTF-8\r\n\r\n")
+	msg.WriteString(notification.Body)
+
+	return []byte(msg.String()), nil
+}
@@ -0,0 +1,39 @@
+package channel
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/notification/domain"
+)
+
+func TestSMTPSender_Message(t *testing.T) {
+	testCases := []struct {
+		name        string
+		address     string
+		subject     string
+		errWant     error
+		wantSubject string
+	}{
+		{name: "correct input", address: "ana@mail.com", subject: "Your car is held", errWant: nil, wantSubject: "Subject: Your car is held\r\n"},
+		{name: "subject with line break", address: "ana@mail.com", subject: "Held\r\nBcc: all@mail.com", errWant: nil, wantSubject: "Subject: Held  Bcc: all@mail.com\r\n"},
+		{name: "address with line break", address: "ana@mail.com\nBcc: all@mail.com", subject: "Held", errWant: errInvalidAddress},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sender := NewSMTPSender("localhost", 25, "", "", "noreply@rentalcar.com")
+
+			msg, err := sender.message(domain.Notification{Address: tc.address, Subject: tc.subject, Body: "Book it."})
+			if !errors.Is(err, tc.errWant) {
+				t.Fatal("unexpected error", err)
+			}
+
+			if err == nil && (!strings.Contains(string(msg), tc.wantSubject) || strings.Count(string(msg), "Bcc:") > 1 ||
+				strings.Contains(string(msg), "\r\nBcc:")) {
+				t.Error("unexpected message", string(msg))
+			}
+		})
+	}
+}
@@ -0,0 +1,18 @@
+package channel
+
+import "github.com/thiagotrs/rentalcar-ddd/internal/notification/domain"
+
+type webhookSender struct{}
+
+func NewWebhookSender() *webhookSender {
+	return &webhookSender{}
+}
+
+func (s webhookSender) Send(notification domain.Notification) error {
+	return postJSON(notification.Address, struct {
+		ID      string `json:"id"`
+		Event   string `json:"event"`
+		Subject string `json:"subject,omitempty"`
+		Body    string `json:"body"`
+	}{notification.ID, notification.Event, notification.Subject, notification.Body})
+}
@@ -0,0 +1,118 @@
+package consumer
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/notification/domain"
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/broker"
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/events"
+)
+
+type Topic string
+
+const (
+	OrderOpened      Topic = "order.opened"
+	OrderConfirmed   Topic = "order.confirmed"
+	OrderClosed      Topic = "order.closed"
+	OrderCanceled    Topic = "order.canceled"
+	CarInTransfer    Topic = "car.in-transfer"
+	MaintenanceDue   Topic = "maintenance.due"
+	DocumentExpiring Topic = "car.document-expiring"
+	WaitlistCarHeld  Topic = "waitlist.car-held"
+)
+
+var idFields = map[Topic][]string{
+	OrderOpened:      {"id"},
+	OrderConfirmed:   {"id"},
+	OrderClosed:      {"id"},
+	OrderCanceled:    {"id"},
+	CarInTransfer:    {"eventId"},
+	MaintenanceDue:   {"carId", "planId", "dueKM", "dueDate"},
+	DocumentExpiring: {"documentId", "expiresAt"},
+	WaitlistCarHeld:  {"id", "carId"},
+}
+
+var customerFields = map[Topic]struct{ contact, reference string }{
+	WaitlistCarHeld: {"contact", "id"},
+}
+
+type eventConsumer struct {
+	disp events.Dispatcher
+}
+
+func NewEventConsumer(disp events.Dispatcher) *eventConsumer {
+	return &eventConsumer{disp}
+}
+
+func (c *eventConsumer) ConsumeOpenedOrder(data interface{}) {
+	c.consume(OrderOpened, data)
+}
+
+func (c *eventConsumer) ConsumeConfirmedOrder(data interface{}) {
+	c.consume(OrderConfirmed, data)
+}
+
+func (c *eventConsumer) ConsumeClosedOrder(data interface{}) {
+	c.consume(OrderClosed, data)
+}
+
+func (c *eventConsumer) ConsumeCanceledOrder(data interface{}) {
+	c.consume(OrderCanceled, data)
+}
+
+func (c *eventConsumer) ConsumeCarInTransfer(data interface{}) {
+	c.consume(CarInTransfer, data)
+}
+
+func (c *eventConsumer) ConsumeMaintenanceDue(data interface{}) {
+	c.consume(MaintenanceDue, data)
+}
+
+func (c *eventConsumer) ConsumeDocumentExpiring(data interface{}) {
+	c.consume(DocumentExpiring, data)
+}
+
+func (c *eventConsumer) ConsumeWaitlistCarHeld(data interface{}) {
+	c.consume(WaitlistCarHeld, data)
+}
+
+func (c *eventConsumer) consume(topic Topic, data interface{}) {
+	if msgB, ok := data.([]byte); ok {
+		msg := map[string]interface{}{}
+		if err := json.Unmarshal(msgB, &msg); err != nil {
+			return
+		}
+
+		references := []string{}
+		for _, v := range msg {
+			if ref, ok := v.(string); ok && ref != "" {
+				references = append(references, ref)
+			}
+		}
+		sort.Strings(references)
+
+		c.disp.Dispatch([]events.Event{domain.SyncNoticeReceived{
+			EventId:    broker.MessageId(string(topic), msg, idFields[topic]...),
+			Event:      string(topic),
+			References: references,
+			Data:       msg,
+			Customer:   customer(topic, msg),
+		}})
+	}
+}
+
+func customer(topic Topic, msg map[string]interface{}) *domain.Customer {
+	fields, exists := customerFields[topic]
+	if !exists {
+		return nil
+	}
+
+	contact, _ := msg[fields.contact].(string)
+	reference, _ := msg[fields.reference].(string)
+	if contact == "" || reference == "" {
+		return nil
+	}
+
+	return &domain.Customer{Contact: contact, Reference: reference}
+}
@@ -0,0 +1,35 @@
+package eventhandler
+
+import (
+	"errors"
+	"time"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/notification/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/notification/domain"
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/events"
+)
+
+type noticeEventHandler struct {
+	notificationUC application.NotificationUseCase
+	recipientUC    application.RecipientUseCase
+}
+
+func NewNoticeEventHandler(notificationUC application.NotificationUseCase, recipientUC application.RecipientUseCase) *noticeEventHandler {
+	return &noticeEventHandler{notificationUC, recipientUC}
+}
+
+func (h noticeEventHandler) HandleSyncNoticeReceived(e events.Event) error {
+	event, ok := e.(domain.SyncNoticeReceived)
+
+	if !ok {
+		return errors.New("wrong event")
+	}
+
+	if event.Customer != nil {
+		if _, err := h.recipientUC.AddCustomer(event.Customer.Contact, event.Customer.Reference, event.Event); err != nil {
+			return err
+		}
+	}
+
+	return h.notificationUC.Notify(event.EventId, event.Event, event.References, event.Data, time.Now())
+}
@@ -0,0 +1,82 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/thiagotrs/rentalcar-ddd/internal/notification/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/notification/domain"
+)
+
+type notificationController struct {
+	notificationUC application.NotificationUseCase
+}
+
+func NewNotificationController(notificationUC application.NotificationUseCase) *notificationController {
+	return &notificationController{notificationUC}
+}
+
+func (c *notificationController) GetNotifications(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	var status uint64
+	if param := r.URL.Query().Get("status"); param != "" {
+		var err error
+		if status, err = strconv.ParseUint(param, 10, 32); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, `{"error":"%v"}`, err)
+			return
+		}
+	}
+	notifications := c.notificationUC.GetNotifications(domain.Status(status))
+	json, _ := json.Marshal(notifications)
+	w.WriteHeader(http.StatusOK)
+	w.Write(json)
+}
+
+func (c *notificationController) GetNotificationById(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	vars := mux.Vars(r)
+	notification, err := c.notificationUC.GetNotificationById(vars["id"])
+
+	switch err {
+	case application.ErrInvalidId:
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case application.ErrNotFoundNotification:
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case nil:
+		json, _ := json.Marshal(notification)
+		w.WriteHeader(http.StatusOK)
+		w.Write(json)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	}
+}
+
+func (c *notificationController) RetryNotification(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	vars := mux.Vars(r)
+	err := c.notificationUC.Retry(vars["id"])
+
+	switch err {
+	case application.ErrInvalidId, application.ErrInvalidNotification:
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case application.ErrNotFoundNotification:
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case application.ErrNotDeliverable:
+		w.WriteHeader(http.StatusConflict)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case nil:
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	}
+}
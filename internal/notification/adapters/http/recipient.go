@@ -0,0 +1,100 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/thiagotrs/rentalcar-ddd/internal/notification/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/notification/domain"
+)
+
+type recipientController struct {
+	recipientUC application.RecipientUseCase
+}
+
+func NewRecipientController(recipientUC application.RecipientUseCase) *recipientController {
+	return &recipientController{recipientUC}
+}
+
+func (c *recipientController) GetRecipients(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	recipients := c.recipientUC.GetRecipients()
+	json, _ := json.Marshal(recipients)
+	w.WriteHeader(http.StatusOK)
+	w.Write(json)
+}
+
+func (c *recipientController) GetRecipientById(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	vars := mux.Vars(r)
+	recipient, err := c.recipientUC.GetRecipientById(vars["id"])
+
+	switch err {
+	case application.ErrInvalidId:
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case application.ErrNotFoundRecipient:
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case nil:
+		json, _ := json.Marshal(recipient)
+		w.WriteHeader(http.StatusOK)
+		w.Write(json)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	}
+}
+
+func (c *recipientController) CreateRecipient(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	var params struct {
+		Name      string         `json:"name"`
+		Channel   domain.Channel `json:"channel"`
+		Address   string         `json:"address"`
+		Locale    string         `json:"locale"`
+		Reference string         `json:"reference"`
+		Events    []string       `json:"events"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, application.ErrInvalidEntity)
+		return
+	}
+	recipient, err := c.recipientUC.AddRecipient(params.Name, params.Channel, params.Address, params.Locale, params.Reference, params.Events)
+
+	switch err {
+	case application.ErrInvalidRecipient, application.ErrInvalidChannel:
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case nil:
+		json, _ := json.Marshal(recipient)
+		w.WriteHeader(http.StatusCreated)
+		w.Write(json)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	}
+}
+
+func (c *recipientController) DeleteRecipient(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	vars := mux.Vars(r)
+	err := c.recipientUC.DelRecipient(vars["id"])
+
+	switch err {
+	case application.ErrInvalidId, application.ErrInvalidRecipient:
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case application.ErrNotFoundRecipient:
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case nil:
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	}
+}
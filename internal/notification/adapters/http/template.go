@@ -0,0 +1,99 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/thiagotrs/rentalcar-ddd/internal/notification/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/notification/domain"
+)
+
+type templateController struct {
+	templateUC application.TemplateUseCase
+}
+
+func NewTemplateController(templateUC application.TemplateUseCase) *templateController {
+	return &templateController{templateUC}
+}
+
+func (c *templateController) GetTemplates(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	templates := c.templateUC.GetTemplates()
+	json, _ := json.Marshal(templates)
+	w.WriteHeader(http.StatusOK)
+	w.Write(json)
+}
+
+func (c *templateController) GetTemplateById(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	vars := mux.Vars(r)
+	tmpl, err := c.templateUC.GetTemplateById(vars["id"])
+
+	switch err {
+	case application.ErrInvalidId:
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case application.ErrNotFoundTemplate:
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case nil:
+		json, _ := json.Marshal(tmpl)
+		w.WriteHeader(http.StatusOK)
+		w.Write(json)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	}
+}
+
+func (c *templateController) UpdateTemplate(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	var params struct {
+		Event   string         `json:"event"`
+		Locale  string         `json:"locale"`
+		Channel domain.Channel `json:"channel"`
+		Subject string         `json:"subject"`
+		Body    string         `json:"body"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, application.ErrInvalidEntity)
+		return
+	}
+	tmpl, err := c.templateUC.SetTemplate(params.Event, params.Locale, params.Channel, params.Subject, params.Body)
+
+	switch err {
+	case application.ErrInvalidTemplate, application.ErrInvalidChannel:
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case nil:
+		json, _ := json.Marshal(tmpl)
+		w.WriteHeader(http.StatusOK)
+		w.Write(json)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	}
+}
+
+func (c *templateController) DeleteTemplate(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	vars := mux.Vars(r)
+	err := c.templateUC.DelTemplate(vars["id"])
+
+	switch err {
+	case application.ErrInvalidId, application.ErrInvalidTemplate:
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case application.ErrNotFoundTemplate:
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case nil:
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	}
+}
@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/notification/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/notification/domain"
+)
+
+type notificationRepositoryInMemory struct {
+	notifications map[string]domain.Notification
+	*sync.RWMutex
+}
+
+func NewNotificationRepositoryInMemory(notifications []domain.Notification) *notificationRepositoryInMemory {
+	notificationsMap := make(map[string]domain.Notification)
+	for _, v := range notifications {
+		notificationsMap[v.ID] = v
+	}
+	return &notificationRepositoryInMemory{notificationsMap, &sync.RWMutex{}}
+}
+
+func (repo notificationRepositoryInMemory) FindAll(status domain.Status) []domain.Notification {
+	return repo.findBy(func(n domain.Notification) bool {
+		return status == 0 || n.Status == status
+	})
+}
+
+func (repo notificationRepositoryInMemory) FindOne(id string) (*domain.Notification, error) {
+	repo.RLock()
+	defer repo.RUnlock()
+
+	n, exists := repo.notifications[id]
+	if !exists {
+		return nil, application.ErrNotFoundNotification
+	}
+
+	return &n, nil
+}
+
+func (repo notificationRepositoryInMemory) FindByEvent(eventId, recipientId string) (*domain.Notification, error) {
+	notifications := repo.findBy(func(n domain.Notification) bool {
+		return n.EventId == eventId && n.RecipientId == recipientId
+	})
+	if len(notifications) == 0 {
+		return nil, application.ErrNotFoundNotification
+	}
+
+	return &notifications[0], nil
+}
+
+func (repo notificationRepositoryInMemory) FindDue(at time.Time) []domain.Notification {
+	return repo.findBy(func(n domain.Notification) bool {
+		return n.Due(at)
+	})
+}
+
+func (repo *notificationRepositoryInMemory) Save(notification domain.Notification) error {
+	repo.Lock()
+	defer repo.Unlock()
+
+	repo.notifications[notification.ID] = notification
+
+	return nil
+}
+
+func (repo notificationRepositoryInMemory) findBy(match func(domain.Notification) bool) []domain.Notification {
+	repo.RLock()
+	defer repo.RUnlock()
+
+	notifications := []domain.Notification{}
+	for _, n := range repo.notifications {
+		if match(n) {
+			notifications = append(notifications, n)
+		}
+	}
+
+	sort.Slice(notifications, func(i, j int) bool {
+		return notifications[i].CreatedAt.Before(notifications[j].CreatedAt)
+	})
+
+	return notifications
+}
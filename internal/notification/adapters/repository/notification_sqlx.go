@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/thiagotrs/rentalcar-ddd/internal/notification/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/notification/domain"
+)
+
+const (
+	findNotifications         = `SELECT id, "eventId", event, "recipientId", channel, address, subject, body, status, attempts, "lastError", "createdAt", "nextAttempt", "sentAt" FROM notifications ORDER BY "createdAt"`
+	findNotificationsByStatus = `SELECT id, "eventId", event, "recipientId", channel, address, subject, body, status, attempts, "lastError", "createdAt", "nextAttempt", "sentAt" FROM notifications WHERE status = $1 ORDER BY "createdAt"`
+	findNotification          = `SELECT id, "eventId", event, "recipientId", channel, address, subject, body, status, attempts, "lastError", "createdAt", "nextAttempt", "sentAt" FROM notifications WHERE id = $1 LIMIT 1`
+	findNotificationByEvent   = `SELECT id, "eventId", event, "recipientId", channel, address, subject, body, status, attempts, "lastError", "createdAt", "nextAttempt", "sentAt" FROM notifications WHERE "eventId" = $1 AND "recipientId" = $2 LIMIT 1`
+	findDueNotifications      = `SELECT id, "eventId", event, "recipientId", channel, address, subject, body, status, attempts, "lastError", "createdAt", "nextAttempt", "sentAt" FROM notifications WHERE status = $1 AND "nextAttempt" <= $2 ORDER BY "nextAttempt"`
+
+	upsertNotification = `
+	INSERT INTO notifications (id, "eventId", event, "recipientId", channel, address, subject, body, status, attempts, "lastError", "createdAt", "nextAttempt", "sentAt") 
+	VALUES (:id, :eventId, :event, :recipientId, :channel, :address, :subject, :body, :status, :attempts, :lastError, :createdAt, :nextAttempt, :sentAt) 
+	ON CONFLICT(id) DO 
+	UPDATE SET status = :status, attempts = :attempts, "lastError" = :lastError, "nextAttempt" = :nextAttempt, "sentAt" = :sentAt 
+	WHERE notifications.id = :id`
+)
+
+type notificationRepositorySqlx struct {
+	ctx context.Context
+	DB  *sqlx.DB
+}
+
+func NewNotificationRepositorySqlx(ctx context.Context, DB *sqlx.DB) *notificationRepositorySqlx {
+	return &notificationRepositorySqlx{ctx, DB}
+}
+
+func (repo *notificationRepositorySqlx) FindAll(status domain.Status) []domain.Notification {
+	if status == 0 {
+		return repo.findBy(findNotifications)
+	}
+
+	return repo.findBy(findNotificationsByStatus, status)
+}
+
+func (repo *notificationRepositorySqlx) FindOne(id string) (*domain.Notification, error) {
+	var notification domain.Notification
+
+	if err := repo.DB.GetContext(repo.ctx, &notification, findNotification, id); err != nil {
+		return nil, application.ErrNotFoundNotification
+	}
+
+	return &notification, nil
+}
+
+func (repo *notificationRepositorySqlx) FindByEvent(eventId, recipientId string) (*domain.Notification, error) {
+	var notification domain.Notification
+
+	if err := repo.DB.GetContext(repo.ctx, &notification, findNotificationByEvent, eventId, recipientId); err != nil {
+		return nil, application.ErrNotFoundNotification
+	}
+
+	return &notification, nil
+}
+
+func (repo *notificationRepositorySqlx) FindDue(at time.Time) []domain.Notification {
+	return repo.findBy(findDueNotifications, domain.Pending, at)
+}
+
+func (repo *notificationRepositorySqlx) Save(notification domain.Notification) error {
+	if _, err := repo.DB.NamedExecContext(repo.ctx, upsertNotification, notification); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (repo *notificationRepositorySqlx) findBy(query string, args ...interface{}) []domain.Notification {
+	notifications := []domain.Notification{}
+
+	if err := repo.DB.SelectContext(repo.ctx, &notifications, query, args...); err != nil {
+		return []domain.Notification{}
+	}
+
+	return notifications
+}
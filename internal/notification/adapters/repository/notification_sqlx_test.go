@@ -0,0 +1,143 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/thiagotrs/rentalcar-ddd/internal/notification/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/notification/domain"
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/database/dbtest"
+)
+
+func newRecipientFixture() domain.Recipient {
+	return domain.Recipient{
+		ID:        "3b2f7c1e-9a4d-4e6b-8c5f-1d0e2a3b4c5d",
+		Name:      "Station Staff",
+		Channel:   domain.Email,
+		Address:   "staff@rentalcar.com",
+		Locale:    domain.DefaultLocale,
+		Reference: "d6d9e3e1-0f4a-4b8e-a5a3-6b2c7d8e9f01",
+		Events:    []string{"car.in-transfer", "maintenance.due"},
+	}
+}
+
+func newNotificationFixture(at time.Time) domain.Notification {
+	return domain.Notification{
+		ID:          "6c5b4a39-2817-4f6e-9d5c-4b3a29180f7e",
+		EventId:     "car.in-transfer:42",
+		Event:       "car.in-transfer",
+		RecipientId: "3b2f7c1e-9a4d-4e6b-8c5f-1d0e2a3b4c5d",
+		Channel:     domain.Email,
+		Address:     "staff@rentalcar.com",
+		Subject:     "Car in transfer",
+		Body:        "A car is coming to your station.",
+		Status:      domain.Pending,
+		CreatedAt:   at,
+		NextAttempt: &at,
+	}
+}
+
+func GetDBConn(t *testing.T) *sqlx.DB {
+	t.Helper()
+	db, err := sqlx.Open("sqlite3", dbtest.Copy(t, "data/notification_test.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return db
+}
+
+func ClearDB(t *testing.T, db *sqlx.DB) {
+	t.Helper()
+	const deleteAll = "DELETE FROM notifications; DELETE FROM nevents; DELETE FROM nrecipients; DELETE FROM ntemplates"
+
+	if _, err := db.Exec(deleteAll); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRecipientRepositorySqlx_Save(t *testing.T) {
+	db := GetDBConn(t)
+	defer db.Close()
+
+	defer ClearDB(t, db)
+
+	repo := NewRecipientRepositorySqlx(context.Background(), db)
+
+	recipient := newRecipientFixture()
+	if err := repo.Save(recipient); err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	recipient.Events = []string{"car.in-transfer"}
+	if err := repo.Save(recipient); err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	found := repo.FindByEvent("car.in-transfer")
+	if len(found) != 1 || found[0].Reference != recipient.Reference || len(found[0].Events) != 1 {
+		t.Fatal("unexpected recipients", found)
+	}
+
+	if found := repo.FindByEvent("maintenance.due"); len(found) != 0 {
+		t.Error("unexpected recipients of dropped event", found)
+	}
+
+	if err := repo.Delete(recipient.ID); err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	if _, err := repo.FindOne(recipient.ID); !errors.Is(err, application.ErrNotFoundRecipient) {
+		t.Error("unexpected error", err)
+	}
+}
+
+func TestNotificationRepositorySqlx_FindDue(t *testing.T) {
+	db := GetDBConn(t)
+	defer db.Close()
+
+	defer ClearDB(t, db)
+
+	repo := NewNotificationRepositorySqlx(context.Background(), db)
+	at := time.Date(2022, time.March, 1, 8, 0, 0, 0, time.UTC)
+
+	notification := newNotificationFixture(at)
+	if err := repo.Save(notification); err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	if _, err := repo.FindByEvent(notification.EventId, notification.RecipientId); err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	notification.DeliveryFailed(errors.New("unreachable"), at)
+	if err := repo.Save(notification); err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	if due := repo.FindDue(at); len(due) != 0 {
+		t.Error("unexpected due notifications", due)
+	}
+
+	due := repo.FindDue(at.Add(domain.Backoff.Delay))
+	if len(due) != 1 || due[0].Attempts != 1 || due[0].LastError != "unreachable" {
+		t.Fatal("unexpected due notifications", due)
+	}
+
+	notification.Delivered(at.Add(domain.Backoff.Delay))
+	if err := repo.Save(notification); err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	if sent := repo.FindAll(domain.Sent); len(sent) != 1 || sent[0].SentAt == nil || sent[0].NextAttempt != nil {
+		t.Error("unexpected sent notifications", sent)
+	}
+
+	if pending := repo.FindAll(domain.Pending); len(pending) != 0 {
+		t.Error("unexpected pending notifications", pending)
+	}
+}
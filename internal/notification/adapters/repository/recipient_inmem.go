@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/notification/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/notification/domain"
+)
+
+type recipientRepositoryInMemory struct {
+	recipients map[string]domain.Recipient
+	*sync.RWMutex
+}
+
+func NewRecipientRepositoryInMemory(recipients []domain.Recipient) *recipientRepositoryInMemory {
+	recipientsMap := make(map[string]domain.Recipient)
+	for _, v := range recipients {
+		recipientsMap[v.ID] = v
+	}
+	return &recipientRepositoryInMemory{recipientsMap, &sync.RWMutex{}}
+}
+
+func (repo recipientRepositoryInMemory) FindAll() []domain.Recipient {
+	return repo.findBy(func(r domain.Recipient) bool {
+		return true
+	})
+}
+
+func (repo recipientRepositoryInMemory) FindOne(id string) (*domain.Recipient, error) {
+	repo.RLock()
+	defer repo.RUnlock()
+
+	r, exists := repo.recipients[id]
+	if !exists {
+		return nil, application.ErrNotFoundRecipient
+	}
+
+	return &r, nil
+}
+
+func (repo recipientRepositoryInMemory) FindByEvent(event string) []domain.Recipient {
+	return repo.findBy(func(r domain.Recipient) bool {
+		for _, e := range r.Events {
+			if e == event {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+func (repo *recipientRepositoryInMemory) Save(recipient domain.Recipient) error {
+	repo.Lock()
+	defer repo.Unlock()
+
+	repo.recipients[recipient.ID] = recipient
+
+	return nil
+}
+
+func (repo *recipientRepositoryInMemory) Delete(id string) error {
+	repo.Lock()
+	defer repo.Unlock()
+
+	delete(repo.recipients, id)
+
+	return nil
+}
+
+func (repo recipientRepositoryInMemory) findBy(match func(domain.Recipient) bool) []domain.Recipient {
+	repo.RLock()
+	defer repo.RUnlock()
+
+	recipients := []domain.Recipient{}
+	for _, r := range repo.recipients {
+		if match(r) {
+			recipients = append(recipients, r)
+		}
+	}
+
+	sort.Slice(recipients, func(i, j int) bool {
+		return recipients[i].Name < recipients[j].Name
+	})
+
+	return recipients
+}
@@ -0,0 +1,137 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/thiagotrs/rentalcar-ddd/internal/notification/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/notification/domain"
+)
+
+const (
+	findRecipients        = `SELECT id, name, channel, address, locale, reference FROM nrecipients ORDER BY name`
+	findRecipient         = `SELECT id, name, channel, address, locale, reference FROM nrecipients WHERE id = $1 LIMIT 1`
+	findRecipientsByEvent = `
+	SELECT nrecipients.id, name, channel, address, locale, reference FROM nrecipients JOIN nevents ON nevents."recipientId" = nrecipients.id 
+	WHERE nevents.event = $1 ORDER BY name`
+
+	findEventsByRecipient = `SELECT event FROM nevents WHERE "recipientId" = $1 ORDER BY event`
+
+	upsertRecipient = `
+	INSERT INTO nrecipients (id, name, channel, address, locale, reference) 
+	VALUES (:id, :name, :channel, :address, :locale, :reference) 
+	ON CONFLICT(id) DO 
+	UPDATE SET name = :name, channel = :channel, address = :address, locale = :locale, reference = :reference 
+	WHERE nrecipients.id = :id`
+
+	deleteRecipient       = `DELETE FROM nrecipients WHERE id = $1`
+	deleteEventsRecipient = `DELETE FROM nevents WHERE "recipientId" = $1`
+	insertEventRecipient  = `INSERT INTO nevents ("recipientId", event) VALUES ($1, $2)`
+)
+
+type recipientRepositorySqlx struct {
+	ctx context.Context
+	DB  *sqlx.DB
+}
+
+func NewRecipientRepositorySqlx(ctx context.Context, DB *sqlx.DB) *recipientRepositorySqlx {
+	return &recipientRepositorySqlx{ctx, DB}
+}
+
+func (repo *recipientRepositorySqlx) FindAll() []domain.Recipient {
+	return repo.findBy(findRecipients)
+}
+
+func (repo *recipientRepositorySqlx) FindOne(id string) (*domain.Recipient, error) {
+	var recipient domain.Recipient
+
+	if err := repo.DB.GetContext(repo.ctx, &recipient, findRecipient, id); err != nil {
+		return nil, application.ErrNotFoundRecipient
+	}
+
+	if err := repo.loadEvents(&recipient); err != nil {
+		return nil, application.ErrNotFoundRecipient
+	}
+
+	return &recipient, nil
+}
+
+func (repo *recipientRepositorySqlx) FindByEvent(event string) []domain.Recipient {
+	return repo.findBy(findRecipientsByEvent, event)
+}
+
+func (repo *recipientRepositorySqlx) Save(recipient domain.Recipient) error {
+	tx, err := repo.DB.BeginTxx(repo.ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.NamedExecContext(repo.ctx, upsertRecipient, recipient); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if _, err := tx.ExecContext(repo.ctx, deleteEventsRecipient, recipient.ID); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	for _, e := range recipient.Events {
+		if _, err := tx.ExecContext(repo.ctx, insertEventRecipient, recipient.ID, e); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return nil
+}
+
+func (repo *recipientRepositorySqlx) Delete(id string) error {
+	tx, err := repo.DB.BeginTxx(repo.ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(repo.ctx, deleteEventsRecipient, id); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if _, err := tx.ExecContext(repo.ctx, deleteRecipient, id); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return nil
+}
+
+func (repo *recipientRepositorySqlx) findBy(query string, args ...interface{}) []domain.Recipient {
+	recipients := []domain.Recipient{}
+
+	if err := repo.DB.SelectContext(repo.ctx, &recipients, query, args...); err != nil {
+		return []domain.Recipient{}
+	}
+
+	for i := range recipients {
+		if err := repo.loadEvents(&recipients[i]); err != nil {
+			return []domain.Recipient{}
+		}
+	}
+
+	return recipients
+}
+
+func (repo *recipientRepositorySqlx) loadEvents(recipient *domain.Recipient) error {
+	recipient.Events = []string{}
+	return repo.DB.SelectContext(repo.ctx, &recipient.Events, findEventsByRecipient, recipient.ID)
+}
@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/notification/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/notification/domain"
+)
+
+type templateRepositoryInMemory struct {
+	templates map[string]domain.Template
+	*sync.RWMutex
+}
+
+func NewTemplateRepositoryInMemory(templates []domain.Template) *templateRepositoryInMemory {
+	templatesMap := make(map[string]domain.Template)
+	for _, v := range templates {
+		templatesMap[v.ID] = v
+	}
+	return &templateRepositoryInMemory{templatesMap, &sync.RWMutex{}}
+}
+
+func (repo templateRepositoryInMemory) FindAll() []domain.Template {
+	repo.RLock()
+	defer repo.RUnlock()
+
+	templates := []domain.Template{}
+	for _, t := range repo.templates {
+		templates = append(templates, t)
+	}
+
+	sort.Slice(templates, func(i, j int) bool {
+		if templates[i].Event != templates[j].Event {
+			return templates[i].Event < templates[j].Event
+		}
+		if templates[i].Locale != templates[j].Locale {
+			return templates[i].Locale < templates[j].Locale
+		}
+		return templates[i].Channel < templates[j].Channel
+	})
+
+	return templates
+}
+
+func (repo templateRepositoryInMemory) FindOne(id string) (*domain.Template, error) {
+	repo.RLock()
+	defer repo.RUnlock()
+
+	t, exists := repo.templates[id]
+	if !exists {
+		return nil, application.ErrNotFoundTemplate
+	}
+
+	return &t, nil
+}
+
+func (repo templateRepositoryInMemory) FindFor(event, locale string, channel domain.Channel) (*domain.Template, error) {
+	repo.RLock()
+	defer repo.RUnlock()
+
+	for _, t := range repo.templates {
+		if t.Event == event && t.Locale == locale && t.Channel == channel {
+			return &t, nil
+		}
+	}
+
+	return nil, application.ErrNotFoundTemplate
+}
+
+func (repo *templateRepositoryInMemory) Save(tmpl domain.Template) error {
+	repo.Lock()
+	defer repo.Unlock()
+
+	repo.templates[tmpl.ID] = tmpl
+
+	return nil
+}
+
+func (repo *templateRepositoryInMemory) Delete(id string) error {
+	repo.Lock()
+	defer repo.Unlock()
+
+	delete(repo.templates, id)
+
+	return nil
+}
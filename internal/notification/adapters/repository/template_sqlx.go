@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/thiagotrs/rentalcar-ddd/internal/notification/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/notification/domain"
+)
+
+const (
+	findTemplates   = `SELECT id, event, locale, channel, subject, body FROM ntemplates ORDER BY event, locale, channel`
+	findTemplate    = `SELECT id, event, locale, channel, subject, body FROM ntemplates WHERE id = $1 LIMIT 1`
+	findTemplateFor = `SELECT id, event, locale, channel, subject, body FROM ntemplates WHERE event = $1 AND locale = $2 AND channel = $3 LIMIT 1`
+
+	upsertTemplate = `
+	INSERT INTO ntemplates (id, event, locale, channel, subject, body) 
+	VALUES (:id, :event, :locale, :channel, :subject, :body) 
+	ON CONFLICT(id) DO 
+	UPDATE SET event = :event, locale = :locale, channel = :channel, subject = :subject, body = :body 
+	WHERE ntemplates.id = :id`
+
+	deleteTemplate = `DELETE FROM ntemplates WHERE id = $1`
+)
+
+type templateRepositorySqlx struct {
+	ctx context.Context
+	DB  *sqlx.DB
+}
+
+func NewTemplateRepositorySqlx(ctx context.Context, DB *sqlx.DB) *templateRepositorySqlx {
+	return &templateRepositorySqlx{ctx, DB}
+}
+
+func (repo *templateRepositorySqlx) FindAll() []domain.Template {
+	templates := []domain.Template{}
+
+	if err := repo.DB.SelectContext(repo.ctx, &templates, findTemplates); err != nil {
+		return []domain.Template{}
+	}
+
+	return templates
+}
+
+func (repo *templateRepositorySqlx) FindOne(id string) (*domain.Template, error) {
+	var tmpl domain.Template
+
+	if err := repo.DB.GetContext(repo.ctx, &tmpl, findTemplate, id); err != nil {
+		return nil, application.ErrNotFoundTemplate
+	}
+
+	return &tmpl, nil
+}
+
+func (repo *templateRepositorySqlx) FindFor(event, locale string, channel domain.Channel) (*domain.Template, error) {
+	var tmpl domain.Template
+
+	if err := repo.DB.GetContext(repo.ctx, &tmpl, findTemplateFor, event, locale, channel); err != nil {
+		return nil, application.ErrNotFoundTemplate
+	}
+
+	return &tmpl, nil
+}
+
+func (repo *templateRepositorySqlx) Save(tmpl domain.Template) error {
+	if _, err := repo.DB.NamedExecContext(repo.ctx, upsertTemplate, tmpl); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (repo *templateRepositorySqlx) Delete(id string) error {
+	if _, err := repo.DB.ExecContext(repo.ctx, deleteTemplate, id); err != nil {
+		return err
+	}
+
+	return nil
+}
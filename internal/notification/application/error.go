@@ -0,0 +1,23 @@
+package application
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/notification/domain"
+)
+
+var (
+	ErrInvalidEntity    = fmt.Errorf("%w", domain.ErrInvalidEntity)
+	ErrInvalidChannel   = fmt.Errorf("%w", domain.ErrInvalidChannel)
+	ErrInvalidTemplate  = fmt.Errorf("%w", domain.ErrInvalidTemplate)
+	ErrInvalidRecipient = fmt.Errorf("%w", domain.ErrInvalidRecipient)
+	ErrNotDeliverable   = fmt.Errorf("%w", domain.ErrNotDeliverable)
+
+	ErrInvalidId            = errors.New("invalid id")
+	ErrNotFoundTemplate     = errors.New("not found notification template")
+	ErrNotFoundRecipient    = errors.New("not found notification recipient")
+	ErrNotFoundNotification = errors.New("not found notification")
+	ErrInvalidNotification  = errors.New("invalid notification")
+	ErrUnsupportedChannel   = errors.New("no sender for the notification channel")
+)
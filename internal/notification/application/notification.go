@@ -0,0 +1,125 @@
+package application
+
+import (
+	"time"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/notification/domain"
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/validation"
+)
+
+type NotificationUseCase interface {
+	GetNotifications(status domain.Status) []domain.Notification
+	GetNotificationById(id string) (*domain.Notification, error)
+	Notify(eventId, event string, references []string, data map[string]interface{}, at time.Time) error
+	DeliverDue(at time.Time) []domain.Notification
+	Retry(id string) error
+}
+
+type notificationUseCase struct {
+	notificationRepo NotificationRepository
+	recipientRepo    RecipientReaderRepository
+	templateRepo     TemplateReaderRepository
+	senders          map[domain.Channel]Sender
+}
+
+func NewNotificationUseCase(notificationRepo NotificationRepository, recipientRepo RecipientReaderRepository, templateRepo TemplateReaderRepository, senders map[domain.Channel]Sender) *notificationUseCase {
+	return &notificationUseCase{
+		notificationRepo: notificationRepo,
+		recipientRepo:    recipientRepo,
+		templateRepo:     templateRepo,
+		senders:          senders,
+	}
+}
+
+func (uc notificationUseCase) GetNotifications(status domain.Status) []domain.Notification {
+	return uc.notificationRepo.FindAll(status)
+}
+
+func (uc notificationUseCase) GetNotificationById(id string) (*domain.Notification, error) {
+	if err := validation.ValidId(id); err != nil {
+		return nil, ErrInvalidId
+	}
+
+	notification, err := uc.notificationRepo.FindOne(id)
+	if err != nil {
+		return nil, ErrNotFoundNotification
+	}
+
+	return notification, nil
+}
+
+func (uc notificationUseCase) Notify(eventId, event string, references []string, data map[string]interface{}, at time.Time) error {
+	for _, recipient := range uc.recipientRepo.FindByEvent(event) {
+		if !recipient.Follows(event, references) {
+			continue
+		}
+
+		if _, err := uc.notificationRepo.FindByEvent(eventId, recipient.ID); err == nil {
+			continue
+		}
+
+		tmpl, err := uc.templateRepo.FindFor(event, recipient.Locale, recipient.Channel)
+		if err != nil {
+			tmpl, err = uc.templateRepo.FindFor(event, domain.DefaultLocale, recipient.Channel)
+		}
+		if err != nil {
+			continue
+		}
+
+		notification, err := domain.NewNotification(eventId, event, recipient, *tmpl, data, at)
+		if err != nil {
+			return ErrInvalidTemplate
+		}
+
+		if err := uc.deliver(notification, at); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (uc notificationUseCase) DeliverDue(at time.Time) []domain.Notification {
+	delivered := []domain.Notification{}
+
+	for _, notification := range uc.notificationRepo.FindDue(at) {
+		if err := uc.deliver(&notification, at); err != nil {
+			continue
+		}
+		delivered = append(delivered, notification)
+	}
+
+	return delivered
+}
+
+func (uc notificationUseCase) Retry(id string) error {
+	notification, err := uc.GetNotificationById(id)
+	if err != nil {
+		return err
+	}
+
+	if err := notification.Retry(time.Now()); err != nil {
+		return ErrNotDeliverable
+	}
+
+	return uc.deliver(notification, time.Now())
+}
+
+func (uc notificationUseCase) deliver(notification *domain.Notification, at time.Time) error {
+	err := ErrUnsupportedChannel
+	if sender, exists := uc.senders[notification.Channel]; exists {
+		err = sender.Send(*notification)
+	}
+
+	if err == nil {
+		notification.Delivered(at)
+	} else {
+		notification.DeliveryFailed(err, at)
+	}
+
+	if err := uc.notificationRepo.Save(*notification); err != nil {
+		return ErrInvalidNotification
+	}
+
+	return nil
+}
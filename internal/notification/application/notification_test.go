@@ -0,0 +1,339 @@
+package application
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/notification/domain"
+)
+
+type notificationRepositoryMock struct {
+	notifications map[string]domain.Notification
+}
+
+func (m *notificationRepositoryMock) FindAll(status domain.Status) []domain.Notification {
+	notifications := []domain.Notification{}
+	for _, n := range m.notifications {
+		if status == 0 || n.Status == status {
+			notifications = append(notifications, n)
+		}
+	}
+	return notifications
+}
+
+func (m *notificationRepositoryMock) FindOne(id string) (*domain.Notification, error) {
+	if n, exists := m.notifications[id]; exists {
+		return &n, nil
+	}
+	return nil, errors.New("not found")
+}
+
+func (m *notificationRepositoryMock) FindByEvent(eventId, recipientId string) (*domain.Notification, error) {
+	for _, n := range m.notifications {
+		if n.EventId == eventId && n.RecipientId == recipientId {
+			return &n, nil
+		}
+	}
+	return nil, errors.New("not found")
+}
+
+func (m *notificationRepositoryMock) FindDue(at time.Time) []domain.Notification {
+	notifications := []domain.Notification{}
+	for _, n := range m.notifications {
+		if n.Due(at) {
+			notifications = append(notifications, n)
+		}
+	}
+	return notifications
+}
+
+func (m *notificationRepositoryMock) Save(notification domain.Notification) error {
+	m.notifications[notification.ID] = notification
+	return nil
+}
+
+type recipientRepositoryMock struct {
+	recipients []domain.Recipient
+}
+
+func (m *recipientRepositoryMock) FindAll() []domain.Recipient {
+	return m.recipients
+}
+
+func (m *recipientRepositoryMock) FindOne(id string) (*domain.Recipient, error) {
+	return nil, errors.New("not found")
+}
+
+func (m *recipientRepositoryMock) FindByEvent(event string) []domain.Recipient {
+	return m.recipients
+}
+
+func (m *recipientRepositoryMock) Save(recipient domain.Recipient) error {
+	m.recipients = append(m.recipients, recipient)
+	return nil
+}
+
+func (m *recipientRepositoryMock) Delete(id string) error {
+	return nil
+}
+
+type templateRepositoryMock struct {
+	templates []domain.Template
+}
+
+func (m *templateRepositoryMock) FindAll() []domain.Template {
+	return m.templates
+}
+
+func (m *templateRepositoryMock) FindOne(id string) (*domain.Template, error) {
+	return nil, errors.New("not found")
+}
+
+func (m *templateRepositoryMock) FindFor(event, locale string, channel domain.Channel) (*domain.Template, error) {
+	for _, t := range m.templates {
+		if t.Event == event && t.Locale == locale && t.Channel == channel {
+			return &t, nil
+		}
+	}
+	return nil, errors.New("not found")
+}
+
+type senderMock struct {
+	expectedSendErr error
+	sent            []domain.Notification
+}
+
+func (m *senderMock) Send(notification domain.Notification) error {
+	m.sent = append(m.sent, notification)
+	return m.expectedSendErr
+}
+
+func newRecipientsFixture() []domain.Recipient {
+	return []domain.Recipient{
+		{
+			ID:      "3b2f7c1e-9a4d-4e6b-8c5f-1d0e2a3b4c5d",
+			Name:    "Ana Souza",
+			Channel: domain.Outbox,
+			Address: "ana@mail.com",
+			Locale:  "pt-br",
+			Events:  []string{"order.opened"},
+		},
+		{
+			ID:        "5d4e3f2a-1b0c-4d9e-8f7a-6b5c4d3e2f1a",
+			Name:      "Other Customer",
+			Channel:   domain.Outbox,
+			Address:   "other@mail.com",
+			Locale:    domain.DefaultLocale,
+			Reference: "d6d9e3e1-0f4a-4b8e-a5a3-6b2c7d8e9f01",
+			Events:    []string{"order.opened"},
+		},
+		{
+			ID:      "9a8b7c6d-5e4f-4a3b-9c2d-1e0f9a8b7c6d",
+			Name:    "Staff",
+			Channel: domain.SMS,
+			Address: "+5511999999999",
+			Locale:  domain.DefaultLocale,
+			Events:  []string{"order.opened"},
+		},
+	}
+}
+
+func newTemplatesFixture() []domain.Template {
+	return []domain.Template{
+		{
+			ID:      "8e1c4a2b-7f3d-4b5e-9a6c-0d1e2f3a4b5c",
+			Event:   "order.opened",
+			Locale:  domain.DefaultLocale,
+			Channel: domain.Outbox,
+			Subject: "Order {{.id}} opened",
+			Body:    "Your car is reserved.",
+		},
+		{
+			ID:      "1f2e3d4c-5b6a-4978-8695-a4b3c2d1e0f9",
+			Event:   "order.opened",
+			Locale:  domain.DefaultLocale,
+			Channel: domain.SMS,
+			Body:    "Order {{.id}} opened",
+		},
+	}
+}
+
+func TestNotificationUseCase_Notify(t *testing.T) {
+	at := time.Date(2022, time.March, 1, 8, 0, 0, 0, time.UTC)
+	data := map[string]interface{}{"id": "42"}
+
+	testCases := []struct {
+		name          string
+		templates     []domain.Template
+		sendErr       error
+		senders       []domain.Channel
+		wantSent      int
+		wantStatus    domain.Status
+		wantAttempted uint
+		wantSubject   string
+	}{
+		{
+			name:          "delivered in default locale",
+			templates:     newTemplatesFixture()[:1],
+			senders:       []domain.Channel{domain.Outbox, domain.SMS},
+			wantSent:      1,
+			wantStatus:    domain.Sent,
+			wantAttempted: 1,
+			wantSubject:   "Order 42 opened",
+		},
+		{
+			name: "delivered in recipient locale",
+			templates: append(newTemplatesFixture()[:1], domain.Template{
+				ID:      "2a3b4c5d-6e7f-4a8b-9c0d-1e2f3a4b5c6d",
+				Event:   "order.opened",
+				Locale:  "pt-br",
+				Channel: domain.Outbox,
+				Subject: "Pedido {{.id}} aberto",
+				Body:    "Seu carro foi reservado.",
+			}),
+			senders:       []domain.Channel{domain.Outbox},
+			wantSent:      1,
+			wantStatus:    domain.Sent,
+			wantAttempted: 1,
+			wantSubject:   "Pedido 42 aberto",
+		},
+		{
+			name:          "failed delivery",
+			templates:     newTemplatesFixture()[:1],
+			sendErr:       errors.New("unreachable"),
+			senders:       []domain.Channel{domain.Outbox},
+			wantSent:      1,
+			wantStatus:    domain.Pending,
+			wantAttempted: 1,
+			wantSubject:   "Order 42 opened",
+		},
+		{
+			name:          "unsupported channel",
+			templates:     newTemplatesFixture()[1:],
+			senders:       []domain.Channel{domain.Outbox},
+			wantSent:      0,
+			wantStatus:    domain.Pending,
+			wantAttempted: 1,
+			wantSubject:   "",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			notificationRepo := &notificationRepositoryMock{map[string]domain.Notification{}}
+			sender := &senderMock{expectedSendErr: tc.sendErr}
+			senders := map[domain.Channel]Sender{}
+			for _, c := range tc.senders {
+				senders[c] = sender
+			}
+			uc := NewNotificationUseCase(notificationRepo, &recipientRepositoryMock{newRecipientsFixture()},
+				&templateRepositoryMock{tc.templates}, senders)
+
+			for i := 0; i < 2; i++ {
+				if err := uc.Notify("order.opened:42", "order.opened", []string{"42"}, data, at); err != nil {
+					t.Fatal("unexpected error", err)
+				}
+			}
+
+			if len(sender.sent) != tc.wantSent || len(notificationRepo.notifications) != 1 {
+				t.Fatal("unexpected notifications", sender.sent, notificationRepo.notifications)
+			}
+
+			for _, n := range notificationRepo.notifications {
+				if n.Status != tc.wantStatus || n.Attempts != tc.wantAttempted {
+					t.Error("unexpected notification", n.Status, n.Attempts)
+				}
+				if n.Status == domain.Pending && !n.NextAttempt.Equal(at.Add(domain.Backoff.Delay)) {
+					t.Error("unexpected next attempt", n.NextAttempt)
+				}
+				if n.Subject != tc.wantSubject {
+					t.Error("unexpected subject", n.Subject)
+				}
+			}
+		})
+	}
+}
+
+func TestNotificationUseCase_Retry(t *testing.T) {
+	at := time.Date(2022, time.March, 1, 8, 0, 0, 0, time.UTC)
+	notification := domain.Notification{
+		ID:          "6c5b4a39-2817-4f6e-9d5c-4b3a29180f7e",
+		EventId:     "order.opened:42",
+		Event:       "order.opened",
+		RecipientId: "3b2f7c1e-9a4d-4e6b-8c5f-1d0e2a3b4c5d",
+		Channel:     domain.Outbox,
+		Address:     "ana@mail.com",
+		Body:        "Your car is reserved.",
+		Status:      domain.Failed,
+		Attempts:    domain.Backoff.MaxAttempts,
+		LastError:   "unreachable",
+		CreatedAt:   at,
+	}
+
+	testCases := []struct {
+		name       string
+		idArg      string
+		status     domain.Status
+		errWant    error
+		wantStatus domain.Status
+	}{
+		{name: "correct input", idArg: notification.ID, status: domain.Failed, errWant: nil, wantStatus: domain.Sent},
+		{name: "pending notification", idArg: notification.ID, status: domain.Pending, errWant: ErrNotDeliverable, wantStatus: domain.Pending},
+		{name: "incorrect id input", idArg: "42", status: domain.Failed, errWant: ErrInvalidId, wantStatus: domain.Failed},
+		{name: "not found notification", idArg: "5ce5a1a1-f324-4c8b-8c92-d7e820cbb238", status: domain.Failed, errWant: ErrNotFoundNotification, wantStatus: domain.Failed},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			n := notification
+			n.Status = tc.status
+			notificationRepo := &notificationRepositoryMock{map[string]domain.Notification{n.ID: n}}
+			uc := NewNotificationUseCase(notificationRepo, &recipientRepositoryMock{}, &templateRepositoryMock{},
+				map[domain.Channel]Sender{domain.Outbox: &senderMock{}})
+
+			if err := uc.Retry(tc.idArg); !errors.Is(err, tc.errWant) {
+				t.Fatal("wrong err", err, tc.errWant)
+			}
+
+			if got := notificationRepo.notifications[n.ID]; got.Status != tc.wantStatus {
+				t.Error("unexpected status", got.Status)
+			}
+		})
+	}
+}
+
+func TestNotificationUseCase_DeliverDue(t *testing.T) {
+	at := time.Date(2022, time.March, 1, 8, 0, 0, 0, time.UTC)
+	next := at.Add(domain.Backoff.Delay)
+	due := domain.Notification{
+		ID:          "6c5b4a39-2817-4f6e-9d5c-4b3a29180f7e",
+		EventId:     "order.opened:42",
+		Event:       "order.opened",
+		RecipientId: "3b2f7c1e-9a4d-4e6b-8c5f-1d0e2a3b4c5d",
+		Channel:     domain.Outbox,
+		Address:     "ana@mail.com",
+		Body:        "Your car is reserved.",
+		Status:      domain.Pending,
+		Attempts:    1,
+		CreatedAt:   at,
+		NextAttempt: &next,
+	}
+	notificationRepo := &notificationRepositoryMock{map[string]domain.Notification{due.ID: due}}
+	sender := &senderMock{}
+	uc := NewNotificationUseCase(notificationRepo, &recipientRepositoryMock{}, &templateRepositoryMock{},
+		map[domain.Channel]Sender{domain.Outbox: sender})
+
+	if delivered := uc.DeliverDue(at); len(delivered) != 0 || len(sender.sent) != 0 {
+		t.Fatal("unexpected delivery before next attempt", delivered)
+	}
+
+	delivered := uc.DeliverDue(next)
+	if len(delivered) != 1 || delivered[0].Status != domain.Sent || delivered[0].Attempts != 2 {
+		t.Fatal("unexpected delivery", delivered)
+	}
+
+	if got := notificationRepo.notifications[due.ID]; got.Status != domain.Sent {
+		t.Error("unexpected saved status", got.Status)
+	}
+}
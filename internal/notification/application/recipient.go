@@ -0,0 +1,88 @@
+package application
+
+import (
+	"errors"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/notification/domain"
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/validation"
+)
+
+type RecipientUseCase interface {
+	GetRecipients() []domain.Recipient
+	GetRecipientById(id string) (*domain.Recipient, error)
+	AddRecipient(name string, channel domain.Channel, address, locale, reference string, events []string) (*domain.Recipient, error)
+	AddCustomer(contact, reference, event string) (*domain.Recipient, error)
+	DelRecipient(id string) error
+}
+
+type recipientUseCase struct {
+	recipientRepo RecipientRepository
+}
+
+func NewRecipientUseCase(recipientRepo RecipientRepository) *recipientUseCase {
+	return &recipientUseCase{recipientRepo}
+}
+
+func (uc recipientUseCase) GetRecipients() []domain.Recipient {
+	return uc.recipientRepo.FindAll()
+}
+
+func (uc recipientUseCase) GetRecipientById(id string) (*domain.Recipient, error) {
+	if err := validation.ValidId(id); err != nil {
+		return nil, ErrInvalidId
+	}
+
+	recipient, err := uc.recipientRepo.FindOne(id)
+	if err != nil {
+		return nil, ErrNotFoundRecipient
+	}
+
+	return recipient, nil
+}
+
+func (uc recipientUseCase) AddRecipient(name string, channel domain.Channel, address, locale, reference string, events []string) (*domain.Recipient, error) {
+	recipient, err := domain.NewRecipient(name, channel, address, locale, reference, events)
+	if errors.Is(err, domain.ErrInvalidChannel) {
+		return nil, ErrInvalidChannel
+	}
+	if err != nil {
+		return nil, ErrInvalidRecipient
+	}
+
+	if err := uc.recipientRepo.Save(*recipient); err != nil {
+		return nil, ErrInvalidRecipient
+	}
+
+	return recipient, nil
+}
+
+func (uc recipientUseCase) AddCustomer(contact, reference, event string) (*domain.Recipient, error) {
+	for _, r := range uc.recipientRepo.FindByEvent(event) {
+		if r.Address == contact && r.Reference == reference {
+			return &r, nil
+		}
+	}
+
+	recipient, err := domain.NewCustomerRecipient(contact, reference, event)
+	if err != nil {
+		return nil, ErrInvalidRecipient
+	}
+
+	if err := uc.recipientRepo.Save(*recipient); err != nil {
+		return nil, ErrInvalidRecipient
+	}
+
+	return recipient, nil
+}
+
+func (uc recipientUseCase) DelRecipient(id string) error {
+	if _, err := uc.GetRecipientById(id); err != nil {
+		return err
+	}
+
+	if err := uc.recipientRepo.Delete(id); err != nil {
+		return ErrInvalidRecipient
+	}
+
+	return nil
+}
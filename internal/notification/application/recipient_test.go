@@ -0,0 +1,54 @@
+package application
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/notification/domain"
+)
+
+func TestRecipientUseCase_AddCustomer(t *testing.T) {
+	entryId := "d6d9e3e1-0f4a-4b8e-a5a3-6b2c7d8e9f01"
+	customer := domain.Recipient{
+		ID:        "5d4e3f2a-1b0c-4d9e-8f7a-6b5c4d3e2f1a",
+		Name:      "ana@mail.com",
+		Channel:   domain.Email,
+		Address:   "ana@mail.com",
+		Locale:    domain.DefaultLocale,
+		Reference: entryId,
+		Events:    []string{"waitlist.car-held"},
+	}
+
+	testCases := []struct {
+		name           string
+		contactArg     string
+		referenceArg   string
+		errWant        error
+		wantRecipients int
+	}{
+		{name: "new customer", contactArg: "+5511999999999", referenceArg: entryId, errWant: nil, wantRecipients: 2},
+		{name: "customer of another entry", contactArg: customer.Address, referenceArg: "5ce5a1a1-f324-4c8b-8c92-d7e820cbb238", errWant: nil, wantRecipients: 2},
+		{name: "already a recipient", contactArg: customer.Address, referenceArg: entryId, errWant: nil, wantRecipients: 1},
+		{name: "incorrect contact input", contactArg: "ana@mail.com\nBcc: all@mail.com", referenceArg: entryId, errWant: ErrInvalidRecipient, wantRecipients: 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			recipientRepo := &recipientRepositoryMock{[]domain.Recipient{customer}}
+			uc := NewRecipientUseCase(recipientRepo)
+
+			recipient, err := uc.AddCustomer(tc.contactArg, tc.referenceArg, "waitlist.car-held")
+			if !errors.Is(err, tc.errWant) {
+				t.Fatal("wrong err", err, tc.errWant)
+			}
+
+			if len(recipientRepo.recipients) != tc.wantRecipients {
+				t.Error("unexpected recipients", len(recipientRepo.recipients))
+			}
+
+			if err == nil && (recipient.Address != tc.contactArg || recipient.Reference != tc.referenceArg) {
+				t.Error("unexpected recipient", recipient)
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package application
+
+import (
+	"time"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/notification/domain"
+)
+
+type TemplateReaderRepository interface {
+	FindAll() []domain.Template
+	FindOne(id string) (*domain.Template, error)
+	FindFor(event, locale string, channel domain.Channel) (*domain.Template, error)
+}
+
+type TemplateWriterRepository interface {
+	Save(tmpl domain.Template) error
+	Delete(id string) error
+}
+
+type TemplateRepository interface {
+	TemplateReaderRepository
+	TemplateWriterRepository
+}
+
+type RecipientReaderRepository interface {
+	FindAll() []domain.Recipient
+	FindOne(id string) (*domain.Recipient, error)
+	FindByEvent(event string) []domain.Recipient
+}
+
+type RecipientWriterRepository interface {
+	Save(recipient domain.Recipient) error
+	Delete(id string) error
+}
+
+type RecipientRepository interface {
+	RecipientReaderRepository
+	RecipientWriterRepository
+}
+
+type NotificationReaderRepository interface {
+	FindAll(status domain.Status) []domain.Notification
+	FindOne(id string) (*domain.Notification, error)
+	FindByEvent(eventId, recipientId string) (*domain.Notification, error)
+	FindDue(at time.Time) []domain.Notification
+}
+
+type NotificationWriterRepository interface {
+	Save(notification domain.Notification) error
+}
+
+type NotificationRepository interface {
+	NotificationReaderRepository
+	NotificationWriterRepository
+}
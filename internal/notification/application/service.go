@@ -0,0 +1,7 @@
+package application
+
+import "github.com/thiagotrs/rentalcar-ddd/internal/notification/domain"
+
+type Sender interface {
+	Send(notification domain.Notification) error
+}
@@ -0,0 +1,72 @@
+package application
+
+import (
+	"errors"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/notification/domain"
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/validation"
+)
+
+type TemplateUseCase interface {
+	GetTemplates() []domain.Template
+	GetTemplateById(id string) (*domain.Template, error)
+	SetTemplate(event, locale string, channel domain.Channel, subject, body string) (*domain.Template, error)
+	DelTemplate(id string) error
+}
+
+type templateUseCase struct {
+	templateRepo TemplateRepository
+}
+
+func NewTemplateUseCase(templateRepo TemplateRepository) *templateUseCase {
+	return &templateUseCase{templateRepo}
+}
+
+func (uc templateUseCase) GetTemplates() []domain.Template {
+	return uc.templateRepo.FindAll()
+}
+
+func (uc templateUseCase) GetTemplateById(id string) (*domain.Template, error) {
+	if err := validation.ValidId(id); err != nil {
+		return nil, ErrInvalidId
+	}
+
+	tmpl, err := uc.templateRepo.FindOne(id)
+	if err != nil {
+		return nil, ErrNotFoundTemplate
+	}
+
+	return tmpl, nil
+}
+
+func (uc templateUseCase) SetTemplate(event, locale string, channel domain.Channel, subject, body string) (*domain.Template, error) {
+	tmpl, err := domain.NewTemplate(event, locale, channel, subject, body)
+	if errors.Is(err, domain.ErrInvalidChannel) {
+		return nil, ErrInvalidChannel
+	}
+	if err != nil {
+		return nil, ErrInvalidTemplate
+	}
+
+	if current, err := uc.templateRepo.FindFor(tmpl.Event, tmpl.Locale, tmpl.Channel); err == nil {
+		tmpl.ID = current.ID
+	}
+
+	if err := uc.templateRepo.Save(*tmpl); err != nil {
+		return nil, ErrInvalidTemplate
+	}
+
+	return tmpl, nil
+}
+
+func (uc templateUseCase) DelTemplate(id string) error {
+	if _, err := uc.GetTemplateById(id); err != nil {
+		return err
+	}
+
+	if err := uc.templateRepo.Delete(id); err != nil {
+		return ErrInvalidTemplate
+	}
+
+	return nil
+}
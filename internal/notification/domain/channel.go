@@ -0,0 +1,18 @@
+package domain
+
+type Channel string
+
+const (
+	Email   Channel = "email"
+	SMS     Channel = "sms"
+	Webhook Channel = "webhook"
+	Outbox  Channel = "outbox"
+)
+
+func (c Channel) Valid() bool {
+	switch c {
+	case Email, SMS, Webhook, Outbox:
+		return true
+	}
+	return false
+}
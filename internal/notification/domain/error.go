@@ -0,0 +1,11 @@
+package domain
+
+import "errors"
+
+var (
+	ErrInvalidEntity    = errors.New("invalid entity")
+	ErrInvalidChannel   = errors.New("invalid notification channel")
+	ErrInvalidTemplate  = errors.New("invalid notification template")
+	ErrInvalidRecipient = errors.New("invalid notification recipient")
+	ErrNotDeliverable   = errors.New("notification not pending delivery")
+)
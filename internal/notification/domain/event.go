@@ -0,0 +1,18 @@
+package domain
+
+type SyncNoticeReceived struct {
+	EventId    string                 `json:"eventId"`
+	Event      string                 `json:"event"`
+	References []string               `json:"references"`
+	Data       map[string]interface{} `json:"data"`
+	Customer   *Customer              `json:"customer,omitempty"`
+}
+
+type Customer struct {
+	Contact   string `json:"contact"`
+	Reference string `json:"reference"`
+}
+
+func (c SyncNoticeReceived) Name() string {
+	return "sync.notice.received"
+}
@@ -0,0 +1,112 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/retry"
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/validation"
+)
+
+type Status uint
+
+const (
+	Pending Status = iota + 1
+	Sent
+	Failed
+)
+
+var Backoff = retry.Backoff{MaxAttempts: 5, Delay: time.Minute}
+
+type Notification struct {
+	ID          string     `json:"id" validate:"required,uuid4" db:"id"`
+	EventId     string     `json:"eventId" validate:"required" db:"eventId"`
+	Event       string     `json:"event" validate:"required" db:"event"`
+	RecipientId string     `json:"recipientId" validate:"required,uuid4" db:"recipientId"`
+	Channel     Channel    `json:"channel" validate:"required" db:"channel"`
+	Address     string     `json:"address" validate:"required" db:"address"`
+	Subject     string     `json:"subject,omitempty" db:"subject"`
+	Body        string     `json:"body" validate:"required" db:"body"`
+	Status      Status     `json:"status" validate:"required" db:"status"`
+	Attempts    uint       `json:"attempts" db:"attempts"`
+	LastError   string     `json:"lastError,omitempty" db:"lastError"`
+	CreatedAt   time.Time  `json:"createdAt" db:"createdAt"`
+	NextAttempt *time.Time `json:"nextAttempt,omitempty" db:"nextAttempt"`
+	SentAt      *time.Time `json:"sentAt,omitempty" db:"sentAt"`
+}
+
+func NewNotification(eventId, event string, recipient Recipient, tmpl Template, data map[string]interface{}, at time.Time) (*Notification, error) {
+	subject, body, err := tmpl.Render(data)
+	if err != nil {
+		return nil, err
+	}
+
+	notification := &Notification{
+		ID:          validation.NewId(),
+		EventId:     eventId,
+		Event:       event,
+		RecipientId: recipient.ID,
+		Channel:     recipient.Channel,
+		Address:     recipient.Address,
+		Subject:     subject,
+		Body:        body,
+		Status:      Pending,
+		CreatedAt:   at,
+		NextAttempt: &at,
+	}
+
+	if err := validation.ValidateEntity(notification); err != nil {
+		return nil, ErrInvalidEntity
+	}
+
+	return notification, nil
+}
+
+func (n Notification) Due(at time.Time) bool {
+	return n.Status == Pending && n.NextAttempt != nil && !at.Before(*n.NextAttempt)
+}
+
+func (n *Notification) Delivered(at time.Time) error {
+	if n.Status != Pending {
+		return ErrNotDeliverable
+	}
+
+	n.Attempts++
+	n.Status = Sent
+	n.LastError = ""
+	n.NextAttempt = nil
+	n.SentAt = &at
+
+	return nil
+}
+
+func (n *Notification) DeliveryFailed(reason error, at time.Time) error {
+	if n.Status != Pending {
+		return ErrNotDeliverable
+	}
+
+	n.Attempts++
+	n.LastError = reason.Error()
+
+	next, ok := Backoff.Next(n.Attempts, at)
+	if !ok {
+		n.Status = Failed
+		n.NextAttempt = nil
+		return nil
+	}
+
+	n.NextAttempt = &next
+
+	return nil
+}
+
+func (n *Notification) Retry(at time.Time) error {
+	if n.Status != Failed {
+		return ErrNotDeliverable
+	}
+
+	n.Status = Pending
+	n.Attempts = 0
+	n.NextAttempt = &at
+
+	return nil
+}
@@ -0,0 +1,182 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func newRecipientFixture() Recipient {
+	return Recipient{
+		ID:      "3b2f7c1e-9a4d-4e6b-8c5f-1d0e2a3b4c5d",
+		Name:    "Station Staff",
+		Channel: Outbox,
+		Address: "staff@rentalcar.com",
+		Locale:  DefaultLocale,
+		Events:  []string{"order.opened", "car.in-transfer"},
+	}
+}
+
+func newTemplateFixture() Template {
+	return Template{
+		ID:      "8e1c4a2b-7f3d-4b5e-9a6c-0d1e2f3a4b5c",
+		Event:   "order.opened",
+		Locale:  DefaultLocale,
+		Channel: Outbox,
+		Subject: "Order {{.id}} opened",
+		Body:    "Your car is reserved from {{.dateFrom}}.",
+	}
+}
+
+func TestNewTemplate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		channel Channel
+		body    string
+		errWant error
+	}{
+		{name: "correct input", channel: Email, body: "Hi {{.name}}", errWant: nil},
+		{name: "incorrect channel input", channel: "fax", body: "Hi {{.name}}", errWant: ErrInvalidChannel},
+		{name: "incorrect body input", channel: Email, body: "Hi {{.name", errWant: ErrInvalidTemplate},
+		{name: "empty body input", channel: Email, body: "", errWant: ErrInvalidEntity},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tmpl, err := NewTemplate("order.opened", "PT-BR", tc.channel, "Order", tc.body)
+
+			if !errors.Is(err, tc.errWant) {
+				t.Fatal("unexpected error", err)
+			}
+
+			if err == nil && tmpl.Locale != "pt-br" {
+				t.Error("unexpected locale", tmpl.Locale)
+			}
+		})
+	}
+}
+
+func TestTemplate_Render(t *testing.T) {
+	tmpl := newTemplateFixture()
+
+	subject, body, err := tmpl.Render(map[string]interface{}{"id": "42", "dateFrom": "2022-03-01"})
+	if err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	if subject != "Order 42 opened" || body != "Your car is reserved from 2022-03-01." {
+		t.Error("unexpected render", subject, body)
+	}
+
+	if _, _, err := tmpl.Render(map[string]interface{}{"id": "42"}); !errors.Is(err, ErrInvalidTemplate) {
+		t.Error("unexpected error for missing key", err)
+	}
+}
+
+func TestRecipient_Follows(t *testing.T) {
+	recipient := newRecipientFixture()
+	staff := newRecipientFixture()
+	staff.Reference = "d6d9e3e1-0f4a-4b8e-a5a3-6b2c7d8e9f01"
+
+	testCases := []struct {
+		name       string
+		recipient  Recipient
+		event      string
+		references []string
+		want       bool
+	}{
+		{name: "followed event", recipient: recipient, event: "order.opened", references: nil, want: true},
+		{name: "unfollowed event", recipient: recipient, event: "order.closed", references: nil, want: false},
+		{name: "followed reference", recipient: staff, event: "car.in-transfer", references: []string{"a", staff.Reference}, want: true},
+		{name: "unfollowed reference", recipient: staff, event: "car.in-transfer", references: []string{"a"}, want: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.recipient.Follows(tc.event, tc.references); got != tc.want {
+				t.Error("unexpected follows", got)
+			}
+		})
+	}
+}
+
+func TestNewCustomerRecipient(t *testing.T) {
+	testCases := []struct {
+		name        string
+		contact     string
+		errWant     error
+		wantChannel Channel
+	}{
+		{name: "email contact", contact: "ana@mail.com", errWant: nil, wantChannel: Email},
+		{name: "phone contact", contact: "+5511999999999", errWant: nil, wantChannel: SMS},
+		{name: "empty contact", contact: "", errWant: ErrInvalidRecipient},
+		{name: "contact with line break", contact: "ana@mail.com\r\nBcc: all@mail.com", errWant: ErrInvalidRecipient},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			recipient, err := NewCustomerRecipient(tc.contact, "d6d9e3e1-0f4a-4b8e-a5a3-6b2c7d8e9f01", "waitlist.car-held")
+
+			if !errors.Is(err, tc.errWant) {
+				t.Fatal("unexpected error", err)
+			}
+
+			if err == nil && (recipient.Channel != tc.wantChannel || !recipient.Follows("waitlist.car-held", []string{recipient.Reference})) {
+				t.Error("unexpected recipient", recipient)
+			}
+		})
+	}
+}
+
+func TestNotification_DeliveryFailed(t *testing.T) {
+	at := time.Date(2022, time.March, 1, 8, 0, 0, 0, time.UTC)
+	notification, err := NewNotification("order.opened:42", "order.opened", newRecipientFixture(), newTemplateFixture(),
+		map[string]interface{}{"id": "42", "dateFrom": "2022-03-01"}, at)
+	if err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	if !notification.Due(at) {
+		t.Fatal("expected due notification")
+	}
+
+	for attempt, delay := range []time.Duration{time.Minute, 2 * time.Minute, 4 * time.Minute, 8 * time.Minute} {
+		if err := notification.DeliveryFailed(errors.New("unreachable"), at); err != nil {
+			t.Fatal("unexpected error", err)
+		}
+
+		if notification.Status != Pending || !notification.NextAttempt.Equal(at.Add(delay)) {
+			t.Fatal("unexpected schedule", attempt, notification.Status, notification.NextAttempt)
+		}
+
+		if notification.Due(at) || !notification.Due(at.Add(delay)) {
+			t.Fatal("unexpected due", attempt)
+		}
+	}
+
+	if err := notification.Retry(at); !errors.Is(err, ErrNotDeliverable) {
+		t.Error("unexpected retry of pending notification", err)
+	}
+
+	notification.DeliveryFailed(errors.New("unreachable"), at)
+
+	if notification.Status != Failed || notification.Attempts != Backoff.MaxAttempts || notification.NextAttempt != nil {
+		t.Fatal("unexpected given up notification", notification.Status, notification.Attempts)
+	}
+
+	if err := notification.Delivered(at); !errors.Is(err, ErrNotDeliverable) {
+		t.Error("unexpected delivery of failed notification", err)
+	}
+
+	if err := notification.Retry(at); err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	if err := notification.Delivered(at); err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	if notification.Status != Sent || notification.Attempts != 1 || notification.LastError != "" || !notification.SentAt.Equal(at) {
+		t.Error("unexpected delivered notification", notification)
+	}
+}
@@ -0,0 +1,80 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/validation"
+)
+
+type Recipient struct {
+	ID        string   `json:"id" validate:"required,uuid4" db:"id"`
+	Name      string   `json:"name" validate:"required" db:"name"`
+	Channel   Channel  `json:"channel" validate:"required" db:"channel"`
+	Address   string   `json:"address" validate:"required" db:"address"`
+	Locale    string   `json:"locale" validate:"required" db:"locale"`
+	Reference string   `json:"reference,omitempty" db:"reference"`
+	Events    []string `json:"events" validate:"required,min=1,dive,required"`
+}
+
+func NewRecipient(name string, channel Channel, address, locale, reference string, events []string) (*Recipient, error) {
+	if locale == "" {
+		locale = DefaultLocale
+	}
+
+	recipient := &Recipient{
+		ID:        validation.NewId(),
+		Name:      name,
+		Channel:   channel,
+		Address:   address,
+		Locale:    strings.ToLower(locale),
+		Reference: reference,
+		Events:    events,
+	}
+
+	if err := validation.ValidateEntity(recipient); err != nil {
+		return nil, fmt.Errorf("%w\n%v", ErrInvalidRecipient, err)
+	}
+
+	if !channel.Valid() {
+		return nil, ErrInvalidChannel
+	}
+
+	// A line break in a mail header would start headers of its own.
+	if strings.ContainsAny(address, "\r\n") {
+		return nil, ErrInvalidRecipient
+	}
+
+	return recipient, nil
+}
+
+func NewCustomerRecipient(contact, reference, event string) (*Recipient, error) {
+	channel := SMS
+	if strings.Contains(contact, "@") {
+		channel = Email
+	}
+
+	return NewRecipient(contact, channel, contact, DefaultLocale, reference, []string{event})
+}
+
+func (r Recipient) Follows(event string, references []string) bool {
+	followed := false
+	for _, e := range r.Events {
+		if e == event {
+			followed = true
+			break
+		}
+	}
+
+	if !followed || r.Reference == "" {
+		return followed
+	}
+
+	for _, ref := range references {
+		if ref == r.Reference {
+			return true
+		}
+	}
+
+	return false
+}
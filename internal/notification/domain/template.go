@@ -0,0 +1,77 @@
+package domain
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/validation"
+)
+
+const DefaultLocale = "en"
+
+type Template struct {
+	ID      string  `json:"id" validate:"required,uuid4" db:"id"`
+	Event   string  `json:"event" validate:"required" db:"event"`
+	Locale  string  `json:"locale" validate:"required" db:"locale"`
+	Channel Channel `json:"channel" validate:"required" db:"channel"`
+	Subject string  `json:"subject" db:"subject"`
+	Body    string  `json:"body" validate:"required" db:"body"`
+}
+
+func NewTemplate(event, locale string, channel Channel, subject, body string) (*Template, error) {
+	tmpl := &Template{
+		ID:      validation.NewId(),
+		Event:   event,
+		Locale:  strings.ToLower(locale),
+		Channel: channel,
+		Subject: subject,
+		Body:    body,
+	}
+
+	if err := validation.ValidateEntity(tmpl); err != nil {
+		return nil, fmt.Errorf("%w\n%v", ErrInvalidEntity, err)
+	}
+
+	if !channel.Valid() {
+		return nil, ErrInvalidChannel
+	}
+
+	if _, _, err := tmpl.parse(); err != nil {
+		return nil, fmt.Errorf("%w\n%v", ErrInvalidTemplate, err)
+	}
+
+	return tmpl, nil
+}
+
+func (t Template) Render(data map[string]interface{}) (string, string, error) {
+	subject, body, err := t.parse()
+	if err != nil {
+		return "", "", fmt.Errorf("%w\n%v", ErrInvalidTemplate, err)
+	}
+
+	var subjectB, bodyB bytes.Buffer
+	if err := subject.Execute(&subjectB, data); err != nil {
+		return "", "", fmt.Errorf("%w\n%v", ErrInvalidTemplate, err)
+	}
+	if err := body.Execute(&bodyB, data); err != nil {
+		return "", "", fmt.Errorf("%w\n%v", ErrInvalidTemplate, err)
+	}
+
+	return subjectB.String(), bodyB.String(), nil
+}
+
+func (t Template) parse() (*template.Template, *template.Template, error) {
+	subject, err := template.New("subject").Option("missingkey=error").Parse(t.Subject)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	body, err := template.New("body").Option("missingkey=error").Parse(t.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return subject, body, nil
+}
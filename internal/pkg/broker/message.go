@@ -0,0 +1,14 @@
+package broker
+
+import (
+	"fmt"
+	"strings"
+)
+
+func MessageId(topic string, msg map[string]interface{}, fields ...string) string {
+	parts := []string{topic}
+	for _, f := range fields {
+		parts = append(parts, fmt.Sprintf("%v", msg[f]))
+	}
+	return strings.Join(parts, ":")
+}
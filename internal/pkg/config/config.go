@@ -21,9 +21,35 @@ type DBConfig struct {
 	Name string
 }
 
+type GeocoderConfig struct {
+	CepTable string
+	URL      string
+}
+
+type LogisticsConfig struct {
+	Assignment []string
+	Geocoder   GeocoderConfig
+}
+
+type SMTPConfig struct {
+	Host string
+	Port uint
+	User string
+	Pass string
+	From string
+}
+
+type NotificationConfig struct {
+	Outbox     string
+	SMTP       SMTPConfig
+	SMSGateway string
+}
+
 type AppConfig struct {
-	Server   ServerConfig
-	Database DBConfig
+	Server       ServerConfig
+	Database     DBConfig
+	Logistics    LogisticsConfig
+	Notification NotificationConfig
 }
 
 func GetConfig() AppConfig {
@@ -33,6 +59,9 @@ func GetConfig() AppConfig {
 	viper.SetDefault("database.user", "postgres")
 	viper.SetDefault("database.host", "localhost")
 	viper.SetDefault("database.port", 5432)
+	viper.SetDefault("logistics.assignment", []string{"longest-idle", "lowest-km"})
+	viper.SetDefault("notification.outbox", "notifications.outbox")
+	viper.SetDefault("notification.smtp.port", 25)
 
 	viper.AddConfigPath(".")
 	viper.SetConfigName("config")
@@ -50,6 +79,16 @@ func GetConfig() AppConfig {
 	viper.BindEnv("database.host")
 	viper.BindEnv("database.port")
 	viper.BindEnv("database.name")
+	viper.BindEnv("logistics.assignment")
+	viper.BindEnv("logistics.geocoder.ceptable")
+	viper.BindEnv("logistics.geocoder.url")
+	viper.BindEnv("notification.outbox")
+	viper.BindEnv("notification.smtp.host")
+	viper.BindEnv("notification.smtp.port")
+	viper.BindEnv("notification.smtp.user")
+	viper.BindEnv("notification.smtp.pass")
+	viper.BindEnv("notification.smtp.from")
+	viper.BindEnv("notification.smsgateway")
 
 	if err := viper.ReadInConfig(); err != nil {
 		log.Println(err)
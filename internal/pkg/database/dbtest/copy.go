@@ -0,0 +1,23 @@
+package dbtest
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+func Copy(t testing.TB, path string) string {
+	t.Helper()
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	copyPath := filepath.Join(t.TempDir(), filepath.Base(path))
+	if err := ioutil.WriteFile(copyPath, data, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	return copyPath
+}
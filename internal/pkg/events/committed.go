@@ -0,0 +1,23 @@
+package events
+
+import "errors"
+
+// Committed is dispatched after the transaction commits, to handlers whose
+// effects a rollback could not undo.
+type Committed struct {
+	Event
+}
+
+func (e Committed) Name() string {
+	return e.Event.Name() + ".committed"
+}
+
+func DispatchCommitted(d Dispatcher, events []Event) error {
+	for _, e := range events {
+		if err := d.Dispatch([]Event{Committed{e}}); err != nil && !errors.Is(err, ErrNoneHandler) {
+			return err
+		}
+	}
+
+	return nil
+}
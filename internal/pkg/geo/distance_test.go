@@ -0,0 +1,38 @@
+package geo
+
+import (
+	"math"
+	"testing"
+)
+
+func TestDistance(t *testing.T) {
+	testCases := []struct {
+		name string
+		from [2]float64
+		to   [2]float64
+		want float64
+	}{
+		{
+			name: "same point",
+			from: [2]float64{-23.5505, -46.6333},
+			to:   [2]float64{-23.5505, -46.6333},
+			want: 0,
+		},
+		{
+			name: "sao paulo to rio de janeiro",
+			from: [2]float64{-23.5505, -46.6333},
+			to:   [2]float64{-22.9068, -43.1729},
+			want: 361,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := Distance(tc.from[0], tc.from[1], tc.to[0], tc.to[1])
+
+			if math.Abs(d-tc.want) > 1 {
+				t.Error("unexpected distance", d)
+			}
+		})
+	}
+}
@@ -1,29 +1,88 @@
 package ipc
 
+import (
+	"errors"
+	"time"
+)
+
+var ErrNotFoundModel = errors.New("not found vehicle model")
+
 type CarData struct {
 	ID        string `json:"id"`
 	Age       uint16 `json:"age"`
 	Plate     string `json:"plate"`
 	Document  string `json:"document"`
+	ModelId   string `json:"modelId"`
 	Model     string `json:"model"`
 	Make      string `json:"make"`
 	StationId string `json:"stationId"`
 	KM        uint64 `json:"km"`
 	Status    uint   `json:"status"`
+	Energy    uint   `json:"energy"`
+	FuelLevel uint8  `json:"fuelLevel"`
 }
 
 type PolicyData struct {
-	ID      string  `json:"id"`
-	Name    string  `json:"name"`
-	Price   float32 `json:"price"`
-	Unit    uint    `json:"unit"`
-	MinUnit uint    `json:"minUnit"`
+	ID               string  `json:"id"`
+	Name             string  `json:"name"`
+	Price            float32 `json:"price"`
+	Unit             uint    `json:"unit"`
+	MinUnit          uint    `json:"minUnit"`
+	RefuelPrice      float32 `json:"refuelPrice"`
+	RechargePrice    float32 `json:"rechargePrice"`
+	PrepaidFuelPrice float32 `json:"prepaidFuelPrice"`
+	KMAllowance      uint64  `json:"kmAllowance"`
+	ExcessKMPrice    float32 `json:"excessKMPrice"`
+}
+
+type ModelData struct {
+	ID           string `json:"id"`
+	Make         string `json:"make"`
+	Model        string `json:"model"`
+	Trim         string `json:"trim"`
+	Seats        uint8  `json:"seats"`
+	Transmission uint   `json:"transmission"`
+	FuelType     uint   `json:"fuelType"`
+	Electric     bool   `json:"electric"`
+	Retired      bool   `json:"retired"`
+}
+
+type MemberBenefitsData struct {
+	Tier            uint `json:"tier"`
+	FreePrepaidFuel bool `json:"freePrepaidFuel"`
+	UpgradePriority bool `json:"upgradePriority"`
+}
+
+type CarIPC interface {
+	GetCar(stationId, modelId string, upgrade bool) (*CarData, error)
+	GetCars(stationId string, modelIds []string) ([]CarData, error)
+	ReserveCars(reservationId, stationId string, carIds []string) error
+	ReleaseCars(reservationId, stationId string, carIds []string) error
+	GetCarKM(carId string) (uint64, error)
+}
+
+type StationIPC interface {
+	IsStationOpen(stationId string, at time.Time, keyDrop bool) (bool, error)
+	GetStationLocation(stationId string) (latitude, longitude float64, err error)
 }
 
 type LogisticsIPC interface {
-	GetCar(stationId, carModel string) (*CarData, error)
+	CarIPC
+	StationIPC
 }
 
 type PricingIPC interface {
 	GetPolicy(categoryId, carModel, policyId string) (*PolicyData, error)
+	GetUpgradeModels(modelId string) ([]string, error)
+	GetModelCategory(modelId string) (string, error)
+}
+
+type CatalogIPC interface {
+	GetModel(modelId string) (*ModelData, error)
+}
+
+type LoyaltyIPC interface {
+	GetMemberBenefits(memberId string) (*MemberBenefitsData, error)
+	RedeemPoints(memberId, orderId string, points uint, maxAmount float32) (float32, error)
+	RefundPoints(memberId, orderId string) error
 }
@@ -0,0 +1,20 @@
+package retry
+
+import "time"
+
+type Backoff struct {
+	MaxAttempts uint
+	Delay       time.Duration
+}
+
+func (b Backoff) Next(attempts uint, at time.Time) (time.Time, bool) {
+	if attempts == 0 {
+		return at, true
+	}
+
+	if attempts >= b.MaxAttempts {
+		return time.Time{}, false
+	}
+
+	return at.Add(b.Delay << (attempts - 1)), true
+}
@@ -0,0 +1,33 @@
+package retry
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBackoff_Next(t *testing.T) {
+	at := time.Date(2022, time.March, 1, 8, 0, 0, 0, time.UTC)
+	backoff := Backoff{MaxAttempts: 4, Delay: time.Minute}
+
+	testCases := []struct {
+		name     string
+		attempts uint
+		wantNext time.Time
+		wantOk   bool
+	}{
+		{name: "not attempted", attempts: 0, wantNext: at, wantOk: true},
+		{name: "first attempt failed", attempts: 1, wantNext: at.Add(time.Minute), wantOk: true},
+		{name: "third attempt failed", attempts: 3, wantNext: at.Add(4 * time.Minute), wantOk: true},
+		{name: "last attempt failed", attempts: 4, wantOk: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			next, ok := backoff.Next(tc.attempts, at)
+
+			if ok != tc.wantOk || (ok && !next.Equal(tc.wantNext)) {
+				t.Error("unexpected next attempt", next, ok)
+			}
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package catalog
+
+import (
+	"errors"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/ipc"
+)
+
+type modelCatalogIPC struct {
+	catalog ipc.CatalogIPC
+}
+
+func NewModelCatalogIPC(catalog ipc.CatalogIPC) *modelCatalogIPC {
+	return &modelCatalogIPC{catalog}
+}
+
+func (svc modelCatalogIPC) IsModelInCatalog(modelId string) (bool, error) {
+	m, err := svc.catalog.GetModel(modelId)
+	if errors.Is(err, ipc.ErrNotFoundModel) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return !m.Retired, nil
+}
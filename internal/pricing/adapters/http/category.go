@@ -96,19 +96,19 @@ func (c *categoryController) UpdateAddModelInCategory(w http.ResponseWriter, r *
 	w.Header().Set("content-type", "application/json")
 	vars := mux.Vars(r)
 	var params struct {
-		ModelName string `json:"modelName"`
+		ModelId string `json:"modelId"`
 	}
 	if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
 		w.WriteHeader(http.StatusBadRequest)
 		fmt.Fprintf(w, `{"error":"%v"}`, application.ErrInvalidEntity)
 		return
 	}
-	err := c.categoryUC.AddModelInCategory(vars["id"], params.ModelName)
+	err := c.categoryUC.AddModelInCategory(vars["id"], params.ModelId)
 	switch err {
 	case application.ErrInvalidId, application.ErrInvalidModel:
 		w.WriteHeader(http.StatusBadRequest)
 		fmt.Fprintf(w, `{"error":"%v"}`, err)
-	case application.ErrInvalidCategory:
+	case application.ErrInvalidCategory, application.ErrNotFoundModel:
 		w.WriteHeader(http.StatusNotFound)
 		fmt.Fprintf(w, `{"error":"%v"}`, err)
 	case nil:
@@ -123,14 +123,14 @@ func (c *categoryController) UpdateDelModelInCategory(w http.ResponseWriter, r *
 	w.Header().Set("content-type", "application/json")
 	vars := mux.Vars(r)
 	var params struct {
-		ModelName string `json:"modelName"`
+		ModelId string `json:"modelId"`
 	}
 	if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
 		w.WriteHeader(http.StatusBadRequest)
 		fmt.Fprintf(w, `{"error":"%v"}`, application.ErrInvalidEntity)
 		return
 	}
-	err := c.categoryUC.DeleteModelInCategory(vars["id"], params.ModelName)
+	err := c.categoryUC.DeleteModelInCategory(vars["id"], params.ModelId)
 	switch err {
 	case application.ErrInvalidId, application.ErrInvalidModel:
 		w.WriteHeader(http.StatusBadRequest)
@@ -154,15 +154,18 @@ func (c *categoryController) UpdateAddPolicyInCategory(w http.ResponseWriter, r
 		Price   float32 `json:"price"`
 		Unit    uint    `json:"unit"`
 		MinUnit uint    `json:"minUnit"`
+
+		KMAllowance   uint64  `json:"kmAllowance"`
+		ExcessKMPrice float32 `json:"excessKMPrice"`
 	}
 	if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
 		w.WriteHeader(http.StatusBadRequest)
 		fmt.Fprintf(w, `{"error":"%v"}`, application.ErrInvalidEntity)
 		return
 	}
-	err := c.categoryUC.AddPolicyInCategory(vars["id"], params.Name, params.Price, params.Unit, params.MinUnit)
+	err := c.categoryUC.AddPolicyInCategory(vars["id"], params.Name, params.Price, params.Unit, params.MinUnit, params.KMAllowance, params.ExcessKMPrice)
 	switch err {
-	case application.ErrInvalidId, application.ErrInvalidEntity, application.ErrInvalidPolicy:
+	case application.ErrInvalidId, application.ErrInvalidEntity, application.ErrInvalidPolicy, application.ErrInvalidAllowance:
 		w.WriteHeader(http.StatusBadRequest)
 		fmt.Fprintf(w, `{"error":"%v"}`, err)
 	case application.ErrInvalidCategory:
@@ -194,3 +197,56 @@ func (c *categoryController) UpdateDelPolicyInCategory(w http.ResponseWriter, r
 		fmt.Fprintf(w, `{"error":"%v"}`, err)
 	}
 }
+
+func (c *categoryController) UpdateCategoryEnergyPrices(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	vars := mux.Vars(r)
+	var params struct {
+		RefuelPrice      float32 `json:"refuelPrice"`
+		RechargePrice    float32 `json:"rechargePrice"`
+		PrepaidFuelPrice float32 `json:"prepaidFuelPrice"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, application.ErrInvalidEntity)
+		return
+	}
+	err := c.categoryUC.ChangeCategoryEnergyPrices(vars["id"], params.RefuelPrice, params.RechargePrice, params.PrepaidFuelPrice)
+	switch err {
+	case application.ErrInvalidPrice:
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case application.ErrInvalidCategory:
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case nil:
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	}
+}
+
+func (c *categoryController) UpdateCategoryTier(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	vars := mux.Vars(r)
+	var params struct {
+		Tier uint8 `json:"tier"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, application.ErrInvalidEntity)
+		return
+	}
+	err := c.categoryUC.ChangeCategoryTier(vars["id"], params.Tier)
+	switch err {
+	case application.ErrInvalidCategory:
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case nil:
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	}
+}
@@ -14,13 +14,25 @@ import (
 	"github.com/thiagotrs/rentalcar-ddd/internal/pricing/domain"
 )
 
+const (
+	unoModelId    = "0b1b5f2a-3f4e-4b7b-9c41-7d8a6c1f2e3d"
+	merivaModelId = "6f0c1d2e-8a4b-4c3d-9e5f-1a2b3c4d5e6f"
+	palioModelId  = "9d8c7b6a-5f4e-4d3c-8b2a-1f0e9d8c7b6a"
+)
+
+type modelCatalogStub struct{}
+
+func (modelCatalogStub) IsModelInCatalog(modelId string) (bool, error) {
+	return modelId == unoModelId || modelId == merivaModelId || modelId == palioModelId, nil
+}
+
 func newCategoryFixture() *domain.Category {
 	p1, _ := domain.NewPolicy("Promo 1", 0.2, domain.PerKM, 500)
 	p2, _ := domain.NewPolicy("Promo 2", 30.5, domain.PerDay, 5)
 	c, _ := domain.NewCategory(
 		"Basic",
 		"basic cars",
-		[]string{"UNO", "MERIVA"},
+		[]string{unoModelId, merivaModelId},
 		[]domain.Policy{*p1, *p2})
 	return c
 }
@@ -28,7 +40,7 @@ func newCategoryFixture() *domain.Category {
 func TestCategoryController_GetCategories(t *testing.T) {
 	categories := []domain.Category{*newCategoryFixture(), *newCategoryFixture()}
 	stationRepo := repository.NewCategoryRepositoryInMemory(categories)
-	stationUC := application.NewCategoryUseCase(stationRepo)
+	stationUC := application.NewCategoryUseCase(stationRepo, modelCatalogStub{})
 	stationController := NewCategoryController(stationUC)
 
 	req := httptest.NewRequest("GET", "/categories", nil)
@@ -53,7 +65,7 @@ func TestCategoryController_GetCategories(t *testing.T) {
 func TestCategoryController_GetCategoryById(t *testing.T) {
 	categories := []domain.Category{*newCategoryFixture(), *newCategoryFixture()}
 	categoryRepo := repository.NewCategoryRepositoryInMemory(categories)
-	categoryUC := application.NewCategoryUseCase(categoryRepo)
+	categoryUC := application.NewCategoryUseCase(categoryRepo, modelCatalogStub{})
 	categoryController := NewCategoryController(categoryUC)
 
 	testCases := []struct {
@@ -108,7 +120,7 @@ func TestCategoryController_GetCategoryById(t *testing.T) {
 func TestCategoryController_CreateCategory(t *testing.T) {
 	categories := []domain.Category{*newCategoryFixture(), *newCategoryFixture()}
 	categoryRepo := repository.NewCategoryRepositoryInMemory(categories)
-	categoryUC := application.NewCategoryUseCase(categoryRepo)
+	categoryUC := application.NewCategoryUseCase(categoryRepo, modelCatalogStub{})
 	categoryController := NewCategoryController(categoryUC)
 
 	type params struct {
@@ -179,7 +191,7 @@ func TestCategoryController_CreateCategory(t *testing.T) {
 func TestCategoryController_DeleteCategory(t *testing.T) {
 	categories := []domain.Category{*newCategoryFixture(), *newCategoryFixture()}
 	categoryRepo := repository.NewCategoryRepositoryInMemory(categories)
-	categoryUC := application.NewCategoryUseCase(categoryRepo)
+	categoryUC := application.NewCategoryUseCase(categoryRepo, modelCatalogStub{})
 	categoryController := NewCategoryController(categoryUC)
 
 	testCases := []struct {
@@ -237,11 +249,11 @@ func TestCategoryController_DeleteCategory(t *testing.T) {
 func TestCategoryController_UpdateAddModelInCategory(t *testing.T) {
 	categories := []domain.Category{*newCategoryFixture(), *newCategoryFixture()}
 	categoryRepo := repository.NewCategoryRepositoryInMemory(categories)
-	categoryUC := application.NewCategoryUseCase(categoryRepo)
+	categoryUC := application.NewCategoryUseCase(categoryRepo, modelCatalogStub{})
 	categoryController := NewCategoryController(categoryUC)
 
 	type params struct {
-		ModelName string
+		ModelId string
 	}
 
 	testCases := []struct {
@@ -256,7 +268,7 @@ func TestCategoryController_UpdateAddModelInCategory(t *testing.T) {
 			idArg:          categories[0].ID,
 			wantStatusCode: http.StatusNoContent,
 			bodyArg: params{
-				ModelName: "PALIO",
+				ModelId: palioModelId,
 			},
 			wantBody: nil,
 		},
@@ -265,7 +277,7 @@ func TestCategoryController_UpdateAddModelInCategory(t *testing.T) {
 			idArg:          "invalid-id",
 			wantStatusCode: http.StatusNotFound,
 			bodyArg: params{
-				ModelName: "PALIO",
+				ModelId: palioModelId,
 			},
 			wantBody: map[string]string{"error": application.ErrInvalidCategory.Error()},
 		},
@@ -274,7 +286,7 @@ func TestCategoryController_UpdateAddModelInCategory(t *testing.T) {
 			idArg:          "e4ce866a-f5b7-4774-8f9d-5eb74c3900cc",
 			wantStatusCode: http.StatusNotFound,
 			bodyArg: params{
-				ModelName: "PALIO",
+				ModelId: palioModelId,
 			},
 			wantBody: map[string]string{"error": application.ErrInvalidCategory.Error()},
 		},
@@ -283,10 +295,19 @@ func TestCategoryController_UpdateAddModelInCategory(t *testing.T) {
 			idArg:          categories[0].ID,
 			wantStatusCode: http.StatusBadRequest,
 			bodyArg: params{
-				ModelName: "UNO",
+				ModelId: unoModelId,
 			},
 			wantBody: map[string]string{"error": application.ErrInvalidModel.Error()},
 		},
+		{
+			name:           "incorrect model not in catalog req",
+			idArg:          categories[0].ID,
+			wantStatusCode: http.StatusNotFound,
+			bodyArg: params{
+				ModelId: "2c9e4a1b-7d3f-4e8a-b5c6-0f1e2d3c4b5a",
+			},
+			wantBody: map[string]string{"error": application.ErrNotFoundModel.Error()},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -320,11 +341,11 @@ func TestCategoryController_UpdateAddModelInCategory(t *testing.T) {
 func TestCategoryController_UpdateDelModelInCategory(t *testing.T) {
 	categories := []domain.Category{*newCategoryFixture(), *newCategoryFixture()}
 	categoryRepo := repository.NewCategoryRepositoryInMemory(categories)
-	categoryUC := application.NewCategoryUseCase(categoryRepo)
+	categoryUC := application.NewCategoryUseCase(categoryRepo, modelCatalogStub{})
 	categoryController := NewCategoryController(categoryUC)
 
 	type params struct {
-		ModelName string
+		ModelId string
 	}
 
 	testCases := []struct {
@@ -339,7 +360,7 @@ func TestCategoryController_UpdateDelModelInCategory(t *testing.T) {
 			idArg:          categories[0].ID,
 			wantStatusCode: http.StatusNoContent,
 			bodyArg: params{
-				ModelName: "UNO",
+				ModelId: unoModelId,
 			},
 			wantBody: nil,
 		},
@@ -348,7 +369,7 @@ func TestCategoryController_UpdateDelModelInCategory(t *testing.T) {
 			idArg:          "invalid-id",
 			wantStatusCode: http.StatusNotFound,
 			bodyArg: params{
-				ModelName: "UNO",
+				ModelId: unoModelId,
 			},
 			wantBody: map[string]string{"error": application.ErrInvalidCategory.Error()},
 		},
@@ -357,7 +378,7 @@ func TestCategoryController_UpdateDelModelInCategory(t *testing.T) {
 			idArg:          "e4ce866a-f5b7-4774-8f9d-5eb74c3900cc",
 			wantStatusCode: http.StatusNotFound,
 			bodyArg: params{
-				ModelName: "UNO",
+				ModelId: unoModelId,
 			},
 			wantBody: map[string]string{"error": application.ErrInvalidCategory.Error()},
 		},
@@ -366,7 +387,7 @@ func TestCategoryController_UpdateDelModelInCategory(t *testing.T) {
 			idArg:          categories[0].ID,
 			wantStatusCode: http.StatusBadRequest,
 			bodyArg: params{
-				ModelName: "PALIO",
+				ModelId: palioModelId,
 			},
 			wantBody: map[string]string{"error": application.ErrInvalidModel.Error()},
 		},
@@ -403,7 +424,7 @@ func TestCategoryController_UpdateDelModelInCategory(t *testing.T) {
 func TestCategoryController_UpdateAddPolicyInCategory(t *testing.T) {
 	categories := []domain.Category{*newCategoryFixture(), *newCategoryFixture()}
 	categoryRepo := repository.NewCategoryRepositoryInMemory(categories)
-	categoryUC := application.NewCategoryUseCase(categoryRepo)
+	categoryUC := application.NewCategoryUseCase(categoryRepo, modelCatalogStub{})
 	categoryController := NewCategoryController(categoryUC)
 
 	type params struct {
@@ -481,7 +502,7 @@ func TestCategoryController_UpdateAddPolicyInCategory(t *testing.T) {
 func TestCategoryController_UpdateDelPolicyInCategory(t *testing.T) {
 	categories := []domain.Category{*newCategoryFixture(), *newCategoryFixture()}
 	categoryRepo := repository.NewCategoryRepositoryInMemory(categories)
-	categoryUC := application.NewCategoryUseCase(categoryRepo)
+	categoryUC := application.NewCategoryUseCase(categoryRepo, modelCatalogStub{})
 	categoryController := NewCategoryController(categoryUC)
 
 	testCases := []struct {
@@ -45,7 +45,27 @@ func (uc categoryIPC) GetPolicy(categoryId, carModel, policyId string) (*ipc.Pol
 		Price:   policy.Price,
 		Unit:    uint(policy.Unit),
 		MinUnit: policy.MinUnit,
+
+		KMAllowance:   policy.KMAllowance,
+		ExcessKMPrice: policy.ExcessKMPrice,
+
+		RefuelPrice:      category.RefuelPrice,
+		RechargePrice:    category.RechargePrice,
+		PrepaidFuelPrice: category.PrepaidFuelPrice,
 	}
 
 	return policyData, nil
 }
+
+func (uc categoryIPC) GetUpgradeModels(modelId string) ([]string, error) {
+	return domain.UpgradeModels(uc.categoryUC.GetCategories(), modelId), nil
+}
+
+func (uc categoryIPC) GetModelCategory(modelId string) (string, error) {
+	category := domain.ModelCategory(uc.categoryUC.GetCategories(), modelId)
+	if category == nil {
+		return "", application.ErrNotFoundCategory
+	}
+
+	return category.ID, nil
+}
@@ -13,20 +13,21 @@ const (
 	findCategory   = `SELECT * FROM categories WHERE id = $1 LIMIT 1`
 
 	upsertCategory = `
-	INSERT INTO categories VALUES (:id, :name, :description) 
-	ON CONFLICT(id) DO UPDATE SET name = :name, description = :description WHERE categories.id = :id`
+	INSERT INTO categories VALUES (:id, :name, :description, :refuelPrice, :rechargePrice, :prepaidFuelPrice, :tier) 
+	ON CONFLICT(id) DO UPDATE SET name = :name, description = :description, "refuelPrice" = :refuelPrice, "rechargePrice" = :rechargePrice, "prepaidFuelPrice" = :prepaidFuelPrice, tier = :tier 
+	WHERE categories.id = :id`
 	deleteCategory = `DELETE FROM categories WHERE id = $1`
 
 	insertModelCategory = `INSERT INTO cmodels (name, "categoryId") VALUES ($1, $2)`
 	deleteModels        = `DELETE FROM cmodels WHERE "categoryId" = $1`
 
 	insertPolicyCategory = `
-	INSERT INTO cpolicies (id, name, price, unit, "minUnit", "categoryId") VALUES ($1, $2, $3, $4, $5, $6) 
-	ON CONFLICT(id) DO UPDATE SET name = $2, price = $3, unit = $4, "minUnit" = $5 WHERE cpolicies.id = $1`
+	INSERT INTO cpolicies (id, name, price, unit, "minUnit", "categoryId", "kmAllowance", "excessKMPrice") VALUES ($1, $2, $3, $4, $5, $6, $7, $8) 
+	ON CONFLICT(id) DO UPDATE SET name = $2, price = $3, unit = $4, "minUnit" = $5, "kmAllowance" = $7, "excessKMPrice" = $8 WHERE cpolicies.id = $1`
 	deletePolicies = `DELETE FROM cpolicies WHERE "categoryId" = $1`
 
 	findModelsByCategory   = `SELECT name FROM cmodels WHERE "categoryId" = $1`
-	findPoliciesByCategory = `SELECT id, name, price, unit, "minUnit" FROM cpolicies WHERE "categoryId" = $1`
+	findPoliciesByCategory = `SELECT id, name, price, unit, "minUnit", "kmAllowance", "excessKMPrice" FROM cpolicies WHERE "categoryId" = $1`
 )
 
 type categoryRepositorySqlx struct {
@@ -122,7 +123,9 @@ func (repo *categoryRepositorySqlx) Save(category domain.Category) error {
 			p.Price,
 			p.Unit,
 			p.MinUnit,
-			category.ID)
+			category.ID,
+			p.KMAllowance,
+			p.ExcessKMPrice)
 		if err != nil {
 			tx.Rollback()
 			return err
@@ -12,16 +12,19 @@ type CategoryUseCase interface {
 	DeleteCategory(id string) error
 	AddModelInCategory(categoryId, modelId string) error
 	DeleteModelInCategory(categoryId, modelId string) error
-	AddPolicyInCategory(categoryId, name string, price float32, unit, minUnit uint) error
+	AddPolicyInCategory(categoryId, name string, price float32, unit, minUnit uint, kmAllowance uint64, excessKMPrice float32) error
 	DeletePolicyInCategory(categoryId, policyId string) error
+	ChangeCategoryEnergyPrices(categoryId string, refuel, recharge, prepaid float32) error
+	ChangeCategoryTier(categoryId string, tier uint8) error
 }
 
 type categoryUseCase struct {
 	categoryRepo CategoryRepository
+	catalog      ModelCatalog
 }
 
-func NewCategoryUseCase(categoryRepo CategoryRepository) *categoryUseCase {
-	return &categoryUseCase{categoryRepo}
+func NewCategoryUseCase(categoryRepo CategoryRepository, catalog ModelCatalog) *categoryUseCase {
+	return &categoryUseCase{categoryRepo, catalog}
 }
 
 func (uc categoryUseCase) GetCategories() []domain.Category {
@@ -68,14 +71,26 @@ func (uc categoryUseCase) DeleteCategory(id string) error {
 	return nil
 }
 
-func (uc categoryUseCase) AddModelInCategory(categoryId, modelName string) error {
+func (uc categoryUseCase) AddModelInCategory(categoryId, modelId string) error {
 	category, err := uc.categoryRepo.FindOne(categoryId)
 
 	if err != nil {
 		return ErrInvalidCategory
 	}
 
-	if err := category.AddModel(modelName); err != nil {
+	if err := validation.ValidId(modelId); err != nil {
+		return ErrInvalidModel
+	}
+
+	exists, err := uc.catalog.IsModelInCatalog(modelId)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return ErrNotFoundModel
+	}
+
+	if err := category.AddModel(modelId); err != nil {
 		return ErrInvalidModel
 	}
 
@@ -86,14 +101,14 @@ func (uc categoryUseCase) AddModelInCategory(categoryId, modelName string) error
 	return nil
 }
 
-func (uc categoryUseCase) DeleteModelInCategory(categoryId, modelName string) error {
+func (uc categoryUseCase) DeleteModelInCategory(categoryId, modelId string) error {
 	category, err := uc.categoryRepo.FindOne(categoryId)
 
 	if err != nil {
 		return ErrInvalidCategory
 	}
 
-	if err := category.DelModel(modelName); err != nil {
+	if err := category.DelModel(modelId); err != nil {
 		return ErrInvalidModel
 	}
 
@@ -104,7 +119,7 @@ func (uc categoryUseCase) DeleteModelInCategory(categoryId, modelName string) er
 	return nil
 }
 
-func (uc categoryUseCase) AddPolicyInCategory(categoryId, name string, price float32, unit, minUnit uint) error {
+func (uc categoryUseCase) AddPolicyInCategory(categoryId, name string, price float32, unit, minUnit uint, kmAllowance uint64, excessKMPrice float32) error {
 	category, err := uc.categoryRepo.FindOne(categoryId)
 
 	if err != nil {
@@ -117,6 +132,10 @@ func (uc categoryUseCase) AddPolicyInCategory(categoryId, name string, price flo
 		return ErrInvalidEntity
 	}
 
+	if err := policy.SetKMAllowance(kmAllowance, excessKMPrice); err != nil {
+		return ErrInvalidAllowance
+	}
+
 	if err := category.AddPolicy(*policy); err != nil {
 		return ErrInvalidPolicy
 	}
@@ -145,3 +164,37 @@ func (uc categoryUseCase) DeletePolicyInCategory(categoryId, policyId string) er
 
 	return nil
 }
+
+func (uc categoryUseCase) ChangeCategoryEnergyPrices(categoryId string, refuel, recharge, prepaid float32) error {
+	category, err := uc.categoryRepo.FindOne(categoryId)
+
+	if err != nil {
+		return ErrInvalidCategory
+	}
+
+	if err := category.SetEnergyPrices(refuel, recharge, prepaid); err != nil {
+		return ErrInvalidPrice
+	}
+
+	if err := uc.categoryRepo.Save(*category); err != nil {
+		return ErrInvalidCategory
+	}
+
+	return nil
+}
+
+func (uc categoryUseCase) ChangeCategoryTier(categoryId string, tier uint8) error {
+	category, err := uc.categoryRepo.FindOne(categoryId)
+
+	if err != nil {
+		return ErrInvalidCategory
+	}
+
+	category.SetTier(tier)
+
+	if err := uc.categoryRepo.Save(*category); err != nil {
+		return ErrInvalidCategory
+	}
+
+	return nil
+}
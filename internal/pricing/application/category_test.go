@@ -8,12 +8,18 @@ import (
 	"github.com/thiagotrs/rentalcar-ddd/internal/pricing/domain"
 )
 
+const (
+	unoModelId     = "0b1b5f2a-3f4e-4b7b-9c41-7d8a6c1f2e3d"
+	merivaModelId  = "6f0c1d2e-8a4b-4c3d-9e5f-1a2b3c4d5e6f"
+	corollaModelId = "9d8c7b6a-5f4e-4d3c-8b2a-1f0e9d8c7b6a"
+)
+
 func newCategoryFixture() *domain.Category {
 	return &domain.Category{
 		ID:          "e4ce866a-f5b7-4774-8f9d-5eb74c3900cc",
 		Name:        "Basic",
 		Description: "basic cars",
-		CarModels:   []string{"UNO", "MERIVA"},
+		CarModels:   []string{unoModelId, merivaModelId},
 		Policies: []domain.Policy{{
 			ID:      "83369771-f9a4-48b7-b87b-463f19f7b187",
 			Name:    "Promo 1",
@@ -59,6 +65,19 @@ func (m *categoryRepositoryMock) Delete(id string) error {
 	return m.expectedDeleteErr
 }
 
+var errCatalogUnavailable = errors.New("catalog unavailable")
+
+type modelCatalogMock struct {
+	expectedInCatalog bool
+	expectedErr       error
+	calls             map[string]uint
+}
+
+func (m *modelCatalogMock) IsModelInCatalog(modelId string) (bool, error) {
+	m.calls["IsModelInCatalog"] = m.calls["IsModelInCatalog"] + 1
+	return m.expectedInCatalog, m.expectedErr
+}
+
 func TestCategoryUseCase_GetCategories(t *testing.T) {
 	newCategory := newCategoryFixture()
 
@@ -110,7 +129,7 @@ func TestCategoryUseCase_GetCategories(t *testing.T) {
 				expectedFindAllCategories: tc.setup.repoCategories,
 				calls:                     make(map[string]uint),
 			}
-			categoryUC := NewCategoryUseCase(categoryRepo)
+			categoryUC := NewCategoryUseCase(categoryRepo, nil)
 			categories := categoryUC.GetCategories()
 
 			if categoryRepo.calls["FindAll"] != 1 {
@@ -199,7 +218,7 @@ func TestCategoryUseCase_GetCategoryById(t *testing.T) {
 				expectedFindOneErr:      tc.setup.repoErr,
 				calls:                   make(map[string]uint),
 			}
-			categoryUC := NewCategoryUseCase(categoryRepo)
+			categoryUC := NewCategoryUseCase(categoryRepo, nil)
 			category, err := categoryUC.GetCategoryById(tc.args.id)
 
 			if categoryRepo.calls["FindOne"] != tc.want.calls {
@@ -284,7 +303,7 @@ func TestCategoryUseCase_DeleteCategory(t *testing.T) {
 				expectedDeleteErr: tc.setup.repoDelErr,
 				calls:             make(map[string]uint),
 			}
-			categoryUC := NewCategoryUseCase(categoryRepo)
+			categoryUC := NewCategoryUseCase(categoryRepo, nil)
 			err := categoryUC.DeleteCategory(tc.args.id)
 
 			if categoryRepo.calls["Delete"] != tc.want.delCalls {
@@ -355,7 +374,7 @@ func TestCategoryUseCase_AddCategory(t *testing.T) {
 				expectedSaveErr: tc.setup.repoSaveErr,
 				calls:           make(map[string]uint),
 			}
-			categoryUC := NewCategoryUseCase(categoryRepo)
+			categoryUC := NewCategoryUseCase(categoryRepo, nil)
 			err := categoryUC.AddCategory(
 				tc.args.name,
 				tc.args.description,
@@ -379,11 +398,13 @@ func TestCategoryUseCase_AddModelInCategory(t *testing.T) {
 		repoFindOne *domain.Category
 		repoFindErr error
 		repoSaveErr error
+		inCatalog   bool
+		catalogErr  error
 	}
 
 	type args struct {
 		categoryId string
-		modelName  string
+		modelId    string
 	}
 
 	type want struct {
@@ -404,10 +425,11 @@ func TestCategoryUseCase_AddModelInCategory(t *testing.T) {
 				repoFindOne: newCategory,
 				repoFindErr: nil,
 				repoSaveErr: nil,
+				inCatalog:   true,
 			},
 			args: args{
 				categoryId: newCategory.ID,
-				modelName:  "COROLA",
+				modelId:    corollaModelId,
 			},
 			want: want{
 				err:       nil,
@@ -424,7 +446,7 @@ func TestCategoryUseCase_AddModelInCategory(t *testing.T) {
 			},
 			args: args{
 				categoryId: "invalid-id",
-				modelName:  "COROLA",
+				modelId:    corollaModelId,
 			},
 			want: want{
 				err:       ErrInvalidCategory,
@@ -438,10 +460,29 @@ func TestCategoryUseCase_AddModelInCategory(t *testing.T) {
 				repoFindOne: newCategory,
 				repoFindErr: nil,
 				repoSaveErr: nil,
+				inCatalog:   true,
+			},
+			args: args{
+				categoryId: newCategory.ID,
+				modelId:    unoModelId,
+			},
+			want: want{
+				err:       ErrInvalidModel,
+				findCalls: 1,
+				saveCalls: 0,
+			},
+		},
+		{
+			name: "incorrect model id input",
+			setup: setup{
+				repoFindOne: newCategory,
+				repoFindErr: nil,
+				repoSaveErr: nil,
+				inCatalog:   true,
 			},
 			args: args{
 				categoryId: newCategory.ID,
-				modelName:  "UNO",
+				modelId:    "UNO",
 			},
 			want: want{
 				err:       ErrInvalidModel,
@@ -449,6 +490,40 @@ func TestCategoryUseCase_AddModelInCategory(t *testing.T) {
 				saveCalls: 0,
 			},
 		},
+		{
+			name: "model not in catalog input",
+			setup: setup{
+				repoFindOne: newCategory,
+				repoFindErr: nil,
+				repoSaveErr: nil,
+				inCatalog:   false,
+			},
+			args: args{
+				categoryId: newCategory.ID,
+				modelId:    "2c9e4a1b-7d3f-4e8a-b5c6-0f1e2d3c4b5a",
+			},
+			want: want{
+				err:       ErrNotFoundModel,
+				findCalls: 1,
+				saveCalls: 0,
+			},
+		},
+		{
+			name: "catalog unavailable input",
+			setup: setup{
+				repoFindOne: newCategory,
+				catalogErr:  errCatalogUnavailable,
+			},
+			args: args{
+				categoryId: newCategory.ID,
+				modelId:    "2c9e4a1b-7d3f-4e8a-b5c6-0f1e2d3c4b5a",
+			},
+			want: want{
+				err:       errCatalogUnavailable,
+				findCalls: 1,
+				saveCalls: 0,
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -459,10 +534,15 @@ func TestCategoryUseCase_AddModelInCategory(t *testing.T) {
 				expectedSaveErr:         tc.setup.repoSaveErr,
 				calls:                   make(map[string]uint),
 			}
-			categoryUC := NewCategoryUseCase(categoryRepo)
+			catalog := &modelCatalogMock{
+				expectedInCatalog: tc.setup.inCatalog,
+				expectedErr:       tc.setup.catalogErr,
+				calls:             make(map[string]uint),
+			}
+			categoryUC := NewCategoryUseCase(categoryRepo, catalog)
 			err := categoryUC.AddModelInCategory(
 				tc.args.categoryId,
-				tc.args.modelName,
+				tc.args.modelId,
 			)
 
 			if categoryRepo.calls["FindOne"] != tc.want.findCalls {
@@ -491,7 +571,7 @@ func TestCategoryUseCase_DeleteModelInCategory(t *testing.T) {
 
 	type args struct {
 		categoryId string
-		modelName  string
+		modelId    string
 	}
 
 	type want struct {
@@ -515,7 +595,7 @@ func TestCategoryUseCase_DeleteModelInCategory(t *testing.T) {
 			},
 			args: args{
 				categoryId: newCategory.ID,
-				modelName:  "UNO",
+				modelId:    unoModelId,
 			},
 			want: want{
 				err:       nil,
@@ -532,7 +612,7 @@ func TestCategoryUseCase_DeleteModelInCategory(t *testing.T) {
 			},
 			args: args{
 				categoryId: "invalid-id",
-				modelName:  "UNO",
+				modelId:    unoModelId,
 			},
 			want: want{
 				err:       ErrInvalidCategory,
@@ -549,7 +629,7 @@ func TestCategoryUseCase_DeleteModelInCategory(t *testing.T) {
 			},
 			args: args{
 				categoryId: newCategory.ID,
-				modelName:  "COROLA",
+				modelId:    corollaModelId,
 			},
 			want: want{
 				err:       ErrInvalidModel,
@@ -567,10 +647,10 @@ func TestCategoryUseCase_DeleteModelInCategory(t *testing.T) {
 				expectedSaveErr:         tc.setup.repoSaveErr,
 				calls:                   make(map[string]uint),
 			}
-			categoryUC := NewCategoryUseCase(categoryRepo)
+			categoryUC := NewCategoryUseCase(categoryRepo, nil)
 			err := categoryUC.DeleteModelInCategory(
 				tc.args.categoryId,
-				tc.args.modelName,
+				tc.args.modelId,
 			)
 
 			if categoryRepo.calls["FindOne"] != tc.want.findCalls {
@@ -601,6 +681,8 @@ func TestCategoryUseCase_AddPolicyInCategory(t *testing.T) {
 		categoryId, name string
 		price            float32
 		unit, minUnit    uint
+		kmAllowance      uint64
+		excessKMPrice    float32
 	}
 
 	type want struct {
@@ -635,6 +717,50 @@ func TestCategoryUseCase_AddPolicyInCategory(t *testing.T) {
 				saveCalls: 1,
 			},
 		},
+		{
+			name: "correct km allowance input",
+			setup: setup{
+				repoFindOne: newCategory,
+				repoFindErr: nil,
+				repoSaveErr: nil,
+			},
+			args: args{
+				categoryId:    newCategory.ID,
+				name:          "policy 2",
+				price:         30.5,
+				unit:          uint(domain.PerDay),
+				minUnit:       2,
+				kmAllowance:   200,
+				excessKMPrice: 0.8,
+			},
+			want: want{
+				err:       nil,
+				findCalls: 1,
+				saveCalls: 1,
+			},
+		},
+		{
+			name: "incorrect km allowance per km input",
+			setup: setup{
+				repoFindOne: newCategory,
+				repoFindErr: nil,
+				repoSaveErr: nil,
+			},
+			args: args{
+				categoryId:    newCategory.ID,
+				name:          "policy 3",
+				price:         1.5,
+				unit:          uint(domain.PerKM),
+				minUnit:       20,
+				kmAllowance:   200,
+				excessKMPrice: 0.8,
+			},
+			want: want{
+				err:       ErrInvalidAllowance,
+				findCalls: 1,
+				saveCalls: 0,
+			},
+		},
 		{
 			name: "incorrect category input",
 			setup: setup{
@@ -685,13 +811,15 @@ func TestCategoryUseCase_AddPolicyInCategory(t *testing.T) {
 				expectedSaveErr:         tc.setup.repoSaveErr,
 				calls:                   make(map[string]uint),
 			}
-			categoryUC := NewCategoryUseCase(categoryRepo)
+			categoryUC := NewCategoryUseCase(categoryRepo, nil)
 			err := categoryUC.AddPolicyInCategory(
 				tc.args.categoryId,
 				tc.args.name,
 				tc.args.price,
 				tc.args.unit,
 				tc.args.minUnit,
+				tc.args.kmAllowance,
+				tc.args.excessKMPrice,
 			)
 
 			if categoryRepo.calls["FindOne"] != tc.want.findCalls {
@@ -796,7 +924,7 @@ func TestCategoryUseCase_DeletePolicyInCategory(t *testing.T) {
 				expectedSaveErr:         tc.setup.repoSaveErr,
 				calls:                   make(map[string]uint),
 			}
-			categoryUC := NewCategoryUseCase(categoryRepo)
+			categoryUC := NewCategoryUseCase(categoryRepo, nil)
 			err := categoryUC.DeletePolicyInCategory(
 				tc.args.categoryId,
 				tc.args.policyId,
@@ -816,3 +944,62 @@ func TestCategoryUseCase_DeletePolicyInCategory(t *testing.T) {
 		})
 	}
 }
+
+func TestCategoryUseCase_ChangeCategoryTier(t *testing.T) {
+	newCategory := newCategoryFixture()
+
+	type setup struct {
+		repoCategory *domain.Category
+		repoErr      error
+	}
+
+	type want struct {
+		err       error
+		tier      uint8
+		saveCalls uint
+	}
+
+	testCases := []struct {
+		name  string
+		setup setup
+		tier  uint8
+		want  want
+	}{
+		{
+			name:  "correct input",
+			setup: setup{repoCategory: newCategory},
+			tier:  2,
+			want:  want{err: nil, tier: 2, saveCalls: 1},
+		},
+		{
+			name:  "not found category",
+			setup: setup{repoErr: ErrNotFoundCategory},
+			tier:  2,
+			want:  want{err: ErrInvalidCategory, saveCalls: 0},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			categoryRepo := &categoryRepositoryMock{
+				expectedFindOneCategory: tc.setup.repoCategory,
+				expectedFindOneErr:      tc.setup.repoErr,
+				calls:                   make(map[string]uint),
+			}
+			categoryUC := NewCategoryUseCase(categoryRepo, nil)
+			err := categoryUC.ChangeCategoryTier(newCategory.ID, tc.tier)
+
+			if !errors.Is(err, tc.want.err) {
+				t.Error("unexpected error", err)
+			}
+
+			if categoryRepo.calls["Save"] != tc.want.saveCalls {
+				t.Error("invalid repo call", categoryRepo.calls["Save"])
+			}
+
+			if tc.setup.repoCategory != nil && tc.setup.repoCategory.Tier != tc.want.tier {
+				t.Error("unexpected tier", tc.setup.repoCategory.Tier)
+			}
+		})
+	}
+}
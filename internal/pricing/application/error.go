@@ -11,9 +11,13 @@ var (
 	ErrInvalidEntity = fmt.Errorf("%w", domain.ErrInvalidEntity)
 	ErrInvalidModel  = fmt.Errorf("%w", domain.ErrInvalidModel)
 	ErrInvalidPolicy = fmt.Errorf("%w", domain.ErrInvalidPolicy)
+	ErrInvalidPrice  = fmt.Errorf("%w", domain.ErrInvalidPrice)
+
+	ErrInvalidAllowance = fmt.Errorf("%w", domain.ErrInvalidAllowance)
 
 	ErrInvalidCategory  = errors.New("invalid category")
 	ErrNotFoundCategory = errors.New("not found category")
 	ErrNotFoundPolicy   = errors.New("not found policy")
+	ErrNotFoundModel    = errors.New("not found model in catalog")
 	ErrInvalidId        = errors.New("invalid category id")
 )
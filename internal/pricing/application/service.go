@@ -0,0 +1,5 @@
+package application
+
+type ModelCatalog interface {
+	IsModelInCatalog(modelId string) (bool, error)
+}
@@ -2,16 +2,21 @@ package domain
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/validation"
 )
 
 type Category struct {
-	ID          string   `json:"id" db:"id"`
-	Name        string   `json:"name" validate:"required" db:"name"`
-	Description string   `json:"description" db:"description"`
-	CarModels   []string `json:"carModels" validate:"required,dive,required"`
-	Policies    []Policy `json:"policies" validate:"required,dive,required"`
+	ID               string   `json:"id" db:"id"`
+	Name             string   `json:"name" validate:"required" db:"name"`
+	Description      string   `json:"description" db:"description"`
+	CarModels        []string `json:"carModels" validate:"required,dive,required"`
+	Policies         []Policy `json:"policies" validate:"required,dive,required"`
+	RefuelPrice      float32  `json:"refuelPrice" validate:"gte=0" db:"refuelPrice"`
+	RechargePrice    float32  `json:"rechargePrice" validate:"gte=0" db:"rechargePrice"`
+	PrepaidFuelPrice float32  `json:"prepaidFuelPrice" validate:"gte=0" db:"prepaidFuelPrice"`
+	Tier             uint8    `json:"tier" db:"tier"`
 }
 
 func NewCategory(name, description string, carModels []string, policies []Policy) (*Category, error) {
@@ -95,3 +100,64 @@ func (c *Category) IsPolicyAvailable(policyId string) bool {
 	}
 	return flag
 }
+
+func (c *Category) SetEnergyPrices(refuel, recharge, prepaid float32) error {
+	if refuel < 0 || recharge < 0 || prepaid < 0 {
+		return ErrInvalidPrice
+	}
+
+	c.RefuelPrice = refuel
+	c.RechargePrice = recharge
+	c.PrepaidFuelPrice = prepaid
+
+	return nil
+}
+
+func (c *Category) SetTier(tier uint8) {
+	c.Tier = tier
+}
+
+func ModelCategory(categories []Category, modelId string) *Category {
+	var from *Category
+	for i, c := range categories {
+		if c.IsModelAvailable(modelId) && (from == nil || c.Tier < from.Tier) {
+			from = &categories[i]
+		}
+	}
+
+	return from
+}
+
+func UpgradeModels(categories []Category, modelId string) []string {
+	from := ModelCategory(categories, modelId)
+	if from == nil {
+		return []string{}
+	}
+
+	upper := []Category{}
+	seen := map[string]bool{}
+	for _, c := range categories {
+		if c.Tier > from.Tier {
+			upper = append(upper, c)
+			continue
+		}
+		for _, m := range c.CarModels {
+			seen[m] = true
+		}
+	}
+	sort.SliceStable(upper, func(i, j int) bool {
+		return upper[i].Tier < upper[j].Tier
+	})
+
+	models := []string{}
+	for _, c := range upper {
+		for _, m := range c.CarModels {
+			if !seen[m] {
+				seen[m] = true
+				models = append(models, m)
+			}
+		}
+	}
+
+	return models
+}
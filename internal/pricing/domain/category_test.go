@@ -373,3 +373,88 @@ func TestCategory_DelPolicy(t *testing.T) {
 		})
 	}
 }
+
+func TestUpgradeModels(t *testing.T) {
+	basic := newCategoryFixture()
+	basic.Tier = 1
+	compact := newCategoryFixture()
+	compact.ID = "0a52d2a4-3b5f-4a24-9d3c-0d7f0b1e2a11"
+	compact.CarModels = []string{"ONIX", "MERIVA"}
+	compact.Tier = 2
+	premium := newCategoryFixture()
+	premium.ID = "5b6e2d7f-1c3a-4f8e-9b0d-2e4f6a8c0b13"
+	premium.CarModels = []string{"COROLLA"}
+	premium.Tier = 3
+	categories := []Category{*premium, *basic, *compact}
+
+	testCases := []struct {
+		name       string
+		modelArg   string
+		wantModels []string
+	}{
+		{
+			name:       "upgrade from the lowest tier",
+			modelArg:   "UNO",
+			wantModels: []string{"ONIX", "COROLLA"},
+		},
+		{
+			name:       "upgrade from the lowest category of the model",
+			modelArg:   "MERIVA",
+			wantModels: []string{"ONIX", "COROLLA"},
+		},
+		{
+			name:       "no upgrade from the highest tier",
+			modelArg:   "COROLLA",
+			wantModels: []string{},
+		},
+		{
+			name:       "no upgrade of unknown model",
+			modelArg:   "GOL",
+			wantModels: []string{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			models := UpgradeModels(categories, tc.modelArg)
+
+			if !reflect.DeepEqual(models, tc.wantModels) {
+				t.Error("unexpected models", models, tc.wantModels)
+			}
+		})
+	}
+}
+
+func TestModelCategory(t *testing.T) {
+	basic := newCategoryFixture()
+	basic.Tier = 1
+	compact := newCategoryFixture()
+	compact.ID = "0a52d2a4-3b5f-4a24-9d3c-0d7f0b1e2a11"
+	compact.CarModels = []string{"ONIX", "MERIVA"}
+	compact.Tier = 2
+	categories := []Category{*compact, *basic}
+
+	testCases := []struct {
+		name     string
+		modelArg string
+		wantId   string
+	}{
+		{name: "model of a single category", modelArg: "ONIX", wantId: compact.ID},
+		{name: "model of several categories", modelArg: "MERIVA", wantId: basic.ID},
+		{name: "unknown model", modelArg: "GOL", wantId: ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			category := ModelCategory(categories, tc.modelArg)
+
+			if tc.wantId == "" && category != nil {
+				t.Error("unexpected category", category)
+			}
+
+			if tc.wantId != "" && (category == nil || category.ID != tc.wantId) {
+				t.Error("unexpected category", category)
+			}
+		})
+	}
+}
@@ -6,4 +6,7 @@ var (
 	ErrInvalidEntity = errors.New("invalid entity")
 	ErrInvalidModel  = errors.New("invalid model")
 	ErrInvalidPolicy = errors.New("invalid policy")
+	ErrInvalidPrice  = errors.New("invalid price")
+
+	ErrInvalidAllowance = errors.New("invalid km allowance")
 )
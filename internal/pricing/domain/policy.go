@@ -12,6 +12,7 @@ const (
 	PerKM Unit = iota + 1
 	PerDay
 	PerWeek
+	PerMonth
 )
 
 type Policy struct {
@@ -20,6 +21,9 @@ type Policy struct {
 	Price   float32 `json:"price" validate:"required,gte=0" db:"price"`
 	Unit    Unit    `json:"unit" validate:"required,gt=0" db:"unit"`
 	MinUnit uint    `json:"minUnit" validate:"required,gt=0" db:"minUnit"`
+
+	KMAllowance   uint64  `json:"kmAllowance" db:"kmAllowance"`
+	ExcessKMPrice float32 `json:"excessKMPrice" validate:"gte=0" db:"excessKMPrice"`
 }
 
 func NewPolicy(name string, price float32, unit Unit, minUnit uint) (*Policy, error) {
@@ -37,3 +41,18 @@ func NewPolicy(name string, price float32, unit Unit, minUnit uint) (*Policy, er
 
 	return policy, nil
 }
+
+func (p *Policy) SetKMAllowance(kmPerUnit uint64, excessPrice float32) error {
+	if excessPrice < 0 || (excessPrice > 0 && p.Unit == PerKM) {
+		return ErrInvalidAllowance
+	}
+
+	if excessPrice == 0 {
+		kmPerUnit = 0
+	}
+
+	p.KMAllowance = kmPerUnit
+	p.ExcessKMPrice = excessPrice
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package consumer
+
+import (
+	"encoding/json"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/events"
+	"github.com/thiagotrs/rentalcar-ddd/internal/rental/domain"
+)
+
+type Topic string
+
+const (
+	CarAvailable Topic = "car.available"
+)
+
+type availableCarMsg struct {
+	EventId   string `json:"eventId"`
+	ID        string `json:"id"`
+	Age       uint16 `json:"age"`
+	Plate     string `json:"plate"`
+	Document  string `json:"document"`
+	ModelId   string `json:"modelId"`
+	StationId string `json:"stationId"`
+	KM        uint64 `json:"km"`
+	Energy    uint   `json:"energy"`
+	FuelLevel uint8  `json:"fuelLevel"`
+}
+
+type carConsumer struct {
+	disp events.Dispatcher
+}
+
+func NewCarConsumer(disp events.Dispatcher) *carConsumer {
+	return &carConsumer{disp}
+}
+
+func (c *carConsumer) ConsumeAvailableCar(data interface{}) {
+	if carB, ok := data.([]byte); ok {
+		var car availableCarMsg
+		json.Unmarshal(carB, &car)
+
+		c.disp.Dispatch([]events.Event{domain.SyncCarAvailable{
+			EventId: car.EventId,
+			Car: domain.Car{
+				ID:          car.ID,
+				Age:         car.Age,
+				Plate:       car.Plate,
+				Document:    car.Document,
+				CarModel:    car.ModelId,
+				InitialKM:   car.KM,
+				Status:      domain.Parked,
+				StationId:   car.StationId,
+				Energy:      domain.EnergyType(car.Energy),
+				InitialFuel: car.FuelLevel,
+			},
+		}})
+	}
+}
@@ -0,0 +1,42 @@
+package consumer
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/events"
+	"github.com/thiagotrs/rentalcar-ddd/internal/rental/domain"
+)
+
+const (
+	TelemetryRecorded Topic = "telemetry.recorded"
+)
+
+type telemetryRecordedMsg struct {
+	CarId      string    `json:"carId"`
+	RecordedAt time.Time `json:"recordedAt"`
+	Latitude   float64   `json:"latitude"`
+	Longitude  float64   `json:"longitude"`
+}
+
+type telemetryConsumer struct {
+	disp events.Dispatcher
+}
+
+func NewTelemetryConsumer(disp events.Dispatcher) *telemetryConsumer {
+	return &telemetryConsumer{disp}
+}
+
+func (c *telemetryConsumer) ConsumeTelemetryRecorded(data interface{}) {
+	if readingB, ok := data.([]byte); ok {
+		var reading telemetryRecordedMsg
+		json.Unmarshal(readingB, &reading)
+
+		c.disp.Dispatch([]events.Event{domain.SyncCarPositionRecorded{
+			CarId:      reading.CarId,
+			Latitude:   reading.Latitude,
+			Longitude:  reading.Longitude,
+			RecordedAt: reading.RecordedAt,
+		}})
+	}
+}
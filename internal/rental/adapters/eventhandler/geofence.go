@@ -0,0 +1,61 @@
+package eventhandler
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/broker"
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/events"
+	"github.com/thiagotrs/rentalcar-ddd/internal/rental/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/rental/domain"
+)
+
+type geofenceEventHandler struct {
+	geofenceUC application.GeofenceUseCase
+	broker     broker.Publisher
+}
+
+func NewGeofenceEventHandler(geofenceUC application.GeofenceUseCase, broker broker.Publisher) *geofenceEventHandler {
+	return &geofenceEventHandler{geofenceUC, broker}
+}
+
+func (eh geofenceEventHandler) HandleSyncCarPositionRecorded(e events.Event) error {
+	event, ok := e.(domain.SyncCarPositionRecorded)
+
+	if !ok {
+		return errors.New("wrong event")
+	}
+
+	err := eh.geofenceUC.ReportPosition(event.CarId, event.Latitude, event.Longitude, event.RecordedAt)
+	if errors.Is(err, application.ErrCarNotRented) {
+		return nil
+	}
+
+	return err
+}
+
+func (eh geofenceEventHandler) HandleCarLeftZone(e events.Event) error {
+	event, ok := e.(domain.CarLeftZone)
+
+	if !ok {
+		return errors.New("wrong event")
+	}
+
+	data, _ := json.Marshal(event)
+	eh.broker.Publish(e.Name(), data)
+
+	return nil
+}
+
+func (eh geofenceEventHandler) HandleCarOverdueOutsideStation(e events.Event) error {
+	event, ok := e.(domain.CarOverdueOutsideStation)
+
+	if !ok {
+		return errors.New("wrong event")
+	}
+
+	data, _ := json.Marshal(event)
+	eh.broker.Publish(e.Name(), data)
+
+	return nil
+}
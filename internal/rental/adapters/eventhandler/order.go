@@ -68,3 +68,16 @@ func (eh orderEventHandler) HandleCanceledOrder(e events.Event) error {
 
 	return nil
 }
+
+func (eh orderEventHandler) HandleCarSwappedOrder(e events.Event) error {
+	event, ok := e.(domain.CarSwappedOrder)
+
+	if !ok {
+		return errors.New("wrong event")
+	}
+
+	data, _ := json.Marshal(event)
+	eh.broker.Publish(e.Name(), data)
+
+	return nil
+}
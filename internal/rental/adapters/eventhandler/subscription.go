@@ -0,0 +1,83 @@
+package eventhandler
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/broker"
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/events"
+	"github.com/thiagotrs/rentalcar-ddd/internal/rental/domain"
+)
+
+type subscriptionEventHandler struct {
+	broker broker.Publisher
+}
+
+func NewSubscriptionEventHandler(broker broker.Publisher) *subscriptionEventHandler {
+	return &subscriptionEventHandler{broker}
+}
+
+func (eh subscriptionEventHandler) HandleStartedSubscription(e events.Event) error {
+	event, ok := e.(domain.SubscriptionStarted)
+
+	if !ok {
+		return errors.New("wrong event")
+	}
+
+	data, _ := json.Marshal(event)
+	eh.broker.Publish(e.Name(), data)
+
+	return nil
+}
+
+func (eh subscriptionEventHandler) HandleCarSwappedSubscription(e events.Event) error {
+	event, ok := e.(domain.SubscriptionCarSwapped)
+
+	if !ok {
+		return errors.New("wrong event")
+	}
+
+	data, _ := json.Marshal(event)
+	eh.broker.Publish(e.Name(), data)
+
+	return nil
+}
+
+func (eh subscriptionEventHandler) HandlePausedSubscription(e events.Event) error {
+	event, ok := e.(domain.SubscriptionPaused)
+
+	if !ok {
+		return errors.New("wrong event")
+	}
+
+	data, _ := json.Marshal(event)
+	eh.broker.Publish(e.Name(), data)
+
+	return nil
+}
+
+func (eh subscriptionEventHandler) HandleResumedSubscription(e events.Event) error {
+	event, ok := e.(domain.SubscriptionResumed)
+
+	if !ok {
+		return errors.New("wrong event")
+	}
+
+	data, _ := json.Marshal(event)
+	eh.broker.Publish(e.Name(), data)
+
+	return nil
+}
+
+func (eh subscriptionEventHandler) HandleCanceledSubscription(e events.Event) error {
+	event, ok := e.(domain.SubscriptionCanceled)
+
+	if !ok {
+		return errors.New("wrong event")
+	}
+
+	data, _ := json.Marshal(event)
+	eh.broker.Publish(e.Name(), data)
+
+	return nil
+}
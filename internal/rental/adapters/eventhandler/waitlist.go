@@ -0,0 +1,57 @@
+package eventhandler
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/broker"
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/events"
+	"github.com/thiagotrs/rentalcar-ddd/internal/rental/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/rental/domain"
+)
+
+type waitlistEventHandler struct {
+	waitlistUC application.WaitlistUseCase
+	broker     broker.Publisher
+}
+
+func NewWaitlistEventHandler(waitlistUC application.WaitlistUseCase, broker broker.Publisher) *waitlistEventHandler {
+	return &waitlistEventHandler{waitlistUC, broker}
+}
+
+func (eh waitlistEventHandler) HandleSyncCarAvailable(e events.Event) error {
+	event, ok := e.(domain.SyncCarAvailable)
+
+	if !ok {
+		return errors.New("wrong event")
+	}
+
+	return eh.waitlistUC.OfferCar(event.Car, time.Now())
+}
+
+func (eh waitlistEventHandler) HandleWaitlistCarHeld(e events.Event) error {
+	event, ok := e.(domain.WaitlistCarHeld)
+
+	if !ok {
+		return errors.New("wrong event")
+	}
+
+	data, _ := json.Marshal(event)
+	eh.broker.Publish(e.Name(), data)
+
+	return nil
+}
+
+func (eh waitlistEventHandler) HandleWaitlistHoldReleased(e events.Event) error {
+	event, ok := e.(domain.WaitlistHoldReleased)
+
+	if !ok {
+		return errors.New("wrong event")
+	}
+
+	data, _ := json.Marshal(event)
+	eh.broker.Publish(e.Name(), data)
+
+	return nil
+}
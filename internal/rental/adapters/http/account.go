@@ -0,0 +1,191 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/thiagotrs/rentalcar-ddd/internal/rental/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/rental/domain"
+)
+
+type accountController struct {
+	accountUC application.AccountUseCase
+}
+
+func NewAccountController(accountUC application.AccountUseCase) *accountController {
+	return &accountController{accountUC}
+}
+
+func (c *accountController) GetAccounts(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	accounts := c.accountUC.GetAccounts()
+	json, _ := json.Marshal(accounts)
+	w.WriteHeader(http.StatusOK)
+	w.Write(json)
+}
+
+func (c *accountController) GetAccountById(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	vars := mux.Vars(r)
+	account, err := c.accountUC.GetAccountById(vars["id"])
+
+	switch err {
+	case application.ErrInvalidId:
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case application.ErrNotFoundAccount:
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case nil:
+		json, _ := json.Marshal(account)
+		w.WriteHeader(http.StatusOK)
+		w.Write(json)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	}
+}
+
+func (c *accountController) CreateAccount(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	var params struct {
+		Name        string  `json:"name"`
+		Document    string  `json:"document"`
+		CreditLimit float32 `json:"creditLimit"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, application.ErrInvalidEntity)
+		return
+	}
+	account, err := c.accountUC.CreateAccount(params.Name, params.Document, params.CreditLimit)
+
+	switch err {
+	case application.ErrInvalidEntity:
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case nil:
+		json, _ := json.Marshal(account)
+		w.WriteHeader(http.StatusCreated)
+		w.Write(json)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	}
+}
+
+func (c *accountController) UpdateAccountCreditLimit(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	vars := mux.Vars(r)
+	var params struct {
+		CreditLimit float32 `json:"creditLimit"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, application.ErrInvalidEntity)
+		return
+	}
+	err := c.accountUC.ChangeCreditLimit(vars["id"], params.CreditLimit)
+	writeAccountUpdate(w, err)
+}
+
+func (c *accountController) UpdateAddDriverInAccount(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	vars := mux.Vars(r)
+	var params struct {
+		License string `json:"license"`
+		Name    string `json:"name"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, application.ErrInvalidEntity)
+		return
+	}
+	err := c.accountUC.AuthorizeDriver(vars["id"], params.License, params.Name)
+	writeAccountUpdate(w, err)
+}
+
+func (c *accountController) UpdateDelDriverInAccount(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	vars := mux.Vars(r)
+	err := c.accountUC.RevokeDriver(vars["id"], vars["license"])
+	writeAccountUpdate(w, err)
+}
+
+func (c *accountController) UpdateAccountRate(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	vars := mux.Vars(r)
+	unit, errUnit := strconv.Atoi(vars["unit"])
+	var params struct {
+		Price float32 `json:"price"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&params); err != nil || errUnit != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, application.ErrInvalidEntity)
+		return
+	}
+	err := c.accountUC.SetRate(vars["id"], vars["categoryId"], domain.Unit(unit), params.Price)
+	writeAccountUpdate(w, err)
+}
+
+func (c *accountController) UpdateDelRateInAccount(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	vars := mux.Vars(r)
+	unit, err := strconv.Atoi(vars["unit"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, application.ErrInvalidEntity)
+		return
+	}
+	err = c.accountUC.DelRate(vars["id"], vars["categoryId"], domain.Unit(unit))
+	writeAccountUpdate(w, err)
+}
+
+func (c *accountController) GetAccountMonthlyInvoice(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	vars := mux.Vars(r)
+	year, errYear := strconv.Atoi(vars["year"])
+	month, errMonth := strconv.Atoi(vars["month"])
+	if errYear != nil || errMonth != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, application.ErrInvalidEntity)
+		return
+	}
+	invoice, err := c.accountUC.GetMonthlyInvoice(vars["id"], year, time.Month(month))
+
+	switch err {
+	case application.ErrInvalidId, application.ErrInvalidEntity:
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case application.ErrNotFoundAccount:
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case nil:
+		json, _ := json.Marshal(invoice)
+		w.WriteHeader(http.StatusOK)
+		w.Write(json)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	}
+}
+
+func writeAccountUpdate(w http.ResponseWriter, err error) {
+	switch err {
+	case application.ErrInvalidId, application.ErrInvalidAccount, application.ErrInvalidDriver, application.ErrInvalidRate:
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case application.ErrNotFoundAccount:
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case nil:
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	}
+}
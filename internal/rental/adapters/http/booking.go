@@ -0,0 +1,144 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/thiagotrs/rentalcar-ddd/internal/rental/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/rental/domain"
+)
+
+type bookingController struct {
+	bookingUC application.BookingUseCase
+}
+
+func NewBookingController(bookingUC application.BookingUseCase) *bookingController {
+	return &bookingController{bookingUC}
+}
+
+func (c *bookingController) GetBookingById(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	vars := mux.Vars(r)
+	booking, err := c.bookingUC.GetBookingById(vars["id"])
+
+	switch err {
+	case application.ErrInvalidId:
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case application.ErrNotFoundBooking:
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case nil:
+		json, _ := json.Marshal(booking)
+		w.WriteHeader(http.StatusOK)
+		w.Write(json)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	}
+}
+
+func (c *bookingController) GetBookingInvoice(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	vars := mux.Vars(r)
+	invoice, err := c.bookingUC.GetBookingInvoice(vars["id"])
+
+	switch err {
+	case application.ErrInvalidId:
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case application.ErrNotFoundBooking:
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case nil:
+		json, _ := json.Marshal(invoice)
+		w.WriteHeader(http.StatusOK)
+		w.Write(json)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	}
+}
+
+func (c *bookingController) QuoteBooking(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	var params struct {
+		DateReservFrom time.Time                 `json:"dateReservFrom"`
+		DateReservTo   time.Time                 `json:"dateReservTo"`
+		Items          []application.BookingItem `json:"items"`
+		PrepaidFuel    bool                      `json:"prepaidFuel"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, application.ErrInvalidEntity)
+		return
+	}
+	quote, err := c.bookingUC.QuoteBooking(params.DateReservFrom, params.DateReservTo, params.Items, params.PrepaidFuel)
+
+	switch err {
+	case application.ErrInvalidEntity, application.ErrInvalidBooking, application.ErrInvalidPolicy:
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case nil:
+		json, _ := json.Marshal(quote)
+		w.WriteHeader(http.StatusOK)
+		w.Write(json)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	}
+}
+
+func (c *bookingController) CreateBooking(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	var params struct {
+		DateReservFrom          time.Time                 `json:"dateReservFrom"`
+		DateReservTo            time.Time                 `json:"dateReservTo"`
+		StationFromId           string                    `json:"stationFromId"`
+		StationToId             string                    `json:"stationToId"`
+		Items                   []application.BookingItem `json:"items"`
+		DriverLicense           string                    `json:"driverLicense"`
+		DriverBirthDate         time.Time                 `json:"driverBirthDate"`
+		DriverLicensedAt        time.Time                 `json:"driverLicensedAt"`
+		DriverLicenseCategories []string                  `json:"driverLicenseCategories"`
+		PrepaidFuel             bool                      `json:"prepaidFuel"`
+		KeyDropReturn           bool                      `json:"keyDropReturn"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, application.ErrInvalidEntity)
+		return
+	}
+	driver := domain.DriverProfile{
+		License:           params.DriverLicense,
+		BirthDate:         params.DriverBirthDate,
+		LicensedAt:        params.DriverLicensedAt,
+		LicenseCategories: params.DriverLicenseCategories,
+	}
+	booking, err := c.bookingUC.OpenBooking(
+		params.DateReservFrom, params.DateReservTo, params.StationFromId,
+		params.StationToId, params.Items, driver, params.PrepaidFuel, params.KeyDropReturn)
+
+	if writeRefusal(w, err) {
+		return
+	}
+
+	switch err {
+	case application.ErrInvalidBooking, application.ErrInvalidPolicy, application.ErrStationClosed:
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case application.ErrInvalidCar:
+		w.WriteHeader(http.StatusConflict)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case nil:
+		json, _ := json.Marshal(booking)
+		w.WriteHeader(http.StatusCreated)
+		w.Write(json)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	}
+}
@@ -0,0 +1,156 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/thiagotrs/rentalcar-ddd/internal/rental/application"
+)
+
+type eligibilityController struct {
+	eligibilityUC application.EligibilityUseCase
+}
+
+func NewEligibilityController(eligibilityUC application.EligibilityUseCase) *eligibilityController {
+	return &eligibilityController{eligibilityUC}
+}
+
+func (c *eligibilityController) GetRules(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	rules := c.eligibilityUC.GetRules()
+	json, _ := json.Marshal(rules)
+	w.WriteHeader(http.StatusOK)
+	w.Write(json)
+}
+
+func (c *eligibilityController) GetRuleByCategory(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	vars := mux.Vars(r)
+	rule, err := c.eligibilityUC.GetRule(vars["categoryId"])
+
+	switch err {
+	case application.ErrInvalidId:
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case application.ErrNotFoundEligibilityRule:
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case nil:
+		json, _ := json.Marshal(rule)
+		w.WriteHeader(http.StatusOK)
+		w.Write(json)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	}
+}
+
+func (c *eligibilityController) UpdateRuleOfCategory(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	vars := mux.Vars(r)
+	var params struct {
+		MinAge            uint     `json:"minAge"`
+		MinLicenseYears   uint     `json:"minLicenseYears"`
+		YoungDriverAge    uint     `json:"youngDriverAge"`
+		YoungDriverFee    float32  `json:"youngDriverFee"`
+		LicenseCategories []string `json:"licenseCategories"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, application.ErrInvalidEntity)
+		return
+	}
+	rule, err := c.eligibilityUC.SetRule(
+		vars["categoryId"], params.MinAge, params.MinLicenseYears,
+		params.YoungDriverAge, params.YoungDriverFee, params.LicenseCategories)
+
+	switch err {
+	case application.ErrInvalidId, application.ErrInvalidEligibilityRule:
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case nil:
+		json, _ := json.Marshal(rule)
+		w.WriteHeader(http.StatusOK)
+		w.Write(json)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	}
+}
+
+func (c *eligibilityController) DeleteRuleOfCategory(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	vars := mux.Vars(r)
+	err := c.eligibilityUC.DelRule(vars["categoryId"])
+
+	switch err {
+	case application.ErrInvalidId, application.ErrInvalidEligibilityRule:
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case application.ErrNotFoundEligibilityRule:
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case nil:
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	}
+}
+
+func (c *eligibilityController) GetBlockedDrivers(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	blocked := c.eligibilityUC.GetBlockedDrivers()
+	json, _ := json.Marshal(blocked)
+	w.WriteHeader(http.StatusOK)
+	w.Write(json)
+}
+
+func (c *eligibilityController) UpdateToBlockDriver(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	vars := mux.Vars(r)
+	var params struct {
+		Reason string `json:"reason"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, application.ErrInvalidEntity)
+		return
+	}
+	blocked, err := c.eligibilityUC.BlockDriver(vars["license"], params.Reason)
+
+	switch err {
+	case application.ErrInvalidBlockedDriver:
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case nil:
+		json, _ := json.Marshal(blocked)
+		w.WriteHeader(http.StatusOK)
+		w.Write(json)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	}
+}
+
+func (c *eligibilityController) UpdateToUnblockDriver(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	vars := mux.Vars(r)
+	err := c.eligibilityUC.UnblockDriver(vars["license"])
+
+	switch err {
+	case application.ErrInvalidBlockedDriver:
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case application.ErrNotFoundBlockedDriver:
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case nil:
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	}
+}
@@ -0,0 +1,100 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/thiagotrs/rentalcar-ddd/internal/rental/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/rental/domain"
+)
+
+type geofenceController struct {
+	geofenceUC application.GeofenceUseCase
+}
+
+func NewGeofenceController(geofenceUC application.GeofenceUseCase) *geofenceController {
+	return &geofenceController{geofenceUC}
+}
+
+func (c *geofenceController) GetGeofences(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	query := r.URL.Query()
+	geofences := c.geofenceUC.GetGeofences(query.Get("categoryId"), query.Get("orderId"))
+
+	json, _ := json.Marshal(geofences)
+	w.WriteHeader(http.StatusOK)
+	w.Write(json)
+}
+
+func (c *geofenceController) CreateGeofence(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	var params struct {
+		Name       string              `json:"name"`
+		Kind       domain.GeofenceKind `json:"kind"`
+		CategoryId string              `json:"categoryId"`
+		OrderId    string              `json:"orderId"`
+		Polygon    []domain.Point      `json:"polygon"`
+		StationId  string              `json:"stationId"`
+		Radius     float64             `json:"radius"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, application.ErrInvalidEntity)
+		return
+	}
+
+	var err error
+	switch params.Kind {
+	case domain.ZoneFence:
+		err = c.geofenceUC.AddZoneGeofence(params.Name, params.CategoryId, params.OrderId, params.Polygon)
+	case domain.StationFence:
+		err = c.geofenceUC.AddStationGeofence(params.Name, params.CategoryId, params.OrderId, params.StationId, params.Radius)
+	default:
+		err = application.ErrInvalidGeofence
+	}
+
+	switch err {
+	case application.ErrInvalidEntity, application.ErrInvalidGeofence:
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case application.ErrStationNotLocated:
+		w.WriteHeader(http.StatusConflict)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case nil:
+		w.WriteHeader(http.StatusCreated)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	}
+}
+
+func (c *geofenceController) DeleteGeofence(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	vars := mux.Vars(r)
+	err := c.geofenceUC.DeleteGeofence(vars["id"])
+
+	switch err {
+	case application.ErrInvalidId:
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case application.ErrNotFoundGeofence:
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case nil:
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	}
+}
+
+func (c *geofenceController) GetAlerts(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	alerts := c.geofenceUC.GetAlerts(r.URL.Query().Get("orderId"))
+
+	json, _ := json.Marshal(alerts)
+	w.WriteHeader(http.StatusOK)
+	w.Write(json)
+}
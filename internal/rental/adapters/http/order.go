@@ -2,12 +2,14 @@ package http
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/thiagotrs/rentalcar-ddd/internal/rental/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/rental/domain"
 )
 
 type orderController struct {
@@ -43,31 +45,86 @@ func (c *orderController) GetOrderById(w http.ResponseWriter, r *http.Request) {
 func (c *orderController) CreateOrder(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("content-type", "application/json")
 	var params struct {
-		DateReservFrom time.Time `json:"dateReservFrom"`
-		DateReservTo   time.Time `json:"dateReservTo"`
-		StationFromId  string    `json:"stationFromId"`
-		StationToId    string    `json:"stationToId"`
-		CategoryId     string    `json:"categoryId"`
-		CarModel       string    `json:"carModel"`
-		PolicyId       string    `json:"policyId"`
+		DateReservFrom          time.Time `json:"dateReservFrom"`
+		DateReservTo            time.Time `json:"dateReservTo"`
+		StationFromId           string    `json:"stationFromId"`
+		StationToId             string    `json:"stationToId"`
+		CategoryId              string    `json:"categoryId"`
+		CarModel                string    `json:"carModel"`
+		PolicyId                string    `json:"policyId"`
+		AccountId               string    `json:"accountId"`
+		DriverLicense           string    `json:"driverLicense"`
+		DriverBirthDate         time.Time `json:"driverBirthDate"`
+		DriverLicensedAt        time.Time `json:"driverLicensedAt"`
+		DriverLicenseCategories []string  `json:"driverLicenseCategories"`
+		MemberId                string    `json:"memberId"`
+		RedeemPoints            uint      `json:"redeemPoints"`
+		PrepaidFuel             bool      `json:"prepaidFuel"`
+		KeyDropReturn           bool      `json:"keyDropReturn"`
+		AllowUpgrade            bool      `json:"allowUpgrade"`
 	}
 	if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
 		w.WriteHeader(http.StatusBadRequest)
 		fmt.Fprintf(w, `{"error":"%v"}`, application.ErrInvalidEntity)
 		return
 	}
-	err := c.orderUC.Open(
-		params.DateReservFrom, params.DateReservTo, params.StationFromId,
-		params.StationToId, params.CategoryId, params.CarModel, params.PolicyId)
+	driver := domain.DriverProfile{
+		License:           params.DriverLicense,
+		BirthDate:         params.DriverBirthDate,
+		LicensedAt:        params.DriverLicensedAt,
+		LicenseCategories: params.DriverLicenseCategories,
+	}
+	err := c.orderUC.Open(application.OpenOrderInput{
+		DateReservFrom: params.DateReservFrom,
+		DateReservTo:   params.DateReservTo,
+		StationFromId:  params.StationFromId,
+		StationToId:    params.StationToId,
+		CategoryId:     params.CategoryId,
+		CarModel:       params.CarModel,
+		PolicyId:       params.PolicyId,
+		AccountId:      params.AccountId,
+		Driver:         driver,
+		MemberId:       params.MemberId,
+		RedeemPoints:   params.RedeemPoints,
+		PrepaidFuel:    params.PrepaidFuel,
+		KeyDropReturn:  params.KeyDropReturn,
+		AllowUpgrade:   params.AllowUpgrade,
+	})
+
+	writeOpenedOrder(w, err)
+}
+
+func writeRefusal(w http.ResponseWriter, err error) bool {
+	var refusal *domain.IneligibleDriverError
+	if !errors.As(err, &refusal) {
+		return false
+	}
+
+	json, _ := json.Marshal(struct {
+		Error   string                 `json:"error"`
+		Reasons []domain.RefusalReason `json:"reasons"`
+	}{refusal.Error(), refusal.Reasons})
+	w.WriteHeader(http.StatusUnprocessableEntity)
+	w.Write(json)
+
+	return true
+}
+
+func writeOpenedOrder(w http.ResponseWriter, err error) {
+	if writeRefusal(w, err) {
+		return
+	}
 
 	switch err {
-	case application.ErrInvalidEntity:
+	case application.ErrInvalidEntity, application.ErrStationClosed, application.ErrNotFoundAccount, application.ErrUnauthorizedDriver,
+		application.ErrNotFoundMember, application.ErrInvalidRedemption:
 		w.WriteHeader(http.StatusBadRequest)
 		fmt.Fprintf(w, `{"error":"%v"}`, err)
-		return
+	case application.ErrCreditLimitExceeded, application.ErrInvalidCar:
+		w.WriteHeader(http.StatusConflict)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
 	case nil:
 		w.WriteHeader(http.StatusCreated)
-		return
 	default:
 		w.WriteHeader(http.StatusInternalServerError)
 		fmt.Fprintf(w, `{"error":"%v"}`, err)
@@ -78,14 +135,15 @@ func (c *orderController) UpdateToComfirmOrder(w http.ResponseWriter, r *http.Re
 	w.Header().Set("content-type", "application/json")
 	vars := mux.Vars(r)
 	var params struct {
-		DateFrom time.Time `json:"dateFrom"`
+		DateFrom  time.Time `json:"dateFrom"`
+		FuelLevel *uint8    `json:"fuelLevel"`
 	}
 	if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
 		w.WriteHeader(http.StatusBadRequest)
 		fmt.Fprintf(w, "{error: %v}", err)
 		return
 	}
-	err := c.orderUC.Confirm(vars["id"], params.DateFrom)
+	err := c.orderUC.Confirm(vars["id"], params.DateFrom, params.FuelLevel)
 
 	switch err {
 	case application.ErrInvalidOrder:
@@ -103,17 +161,18 @@ func (c *orderController) UpdateToCloseOrder(w http.ResponseWriter, r *http.Requ
 	w.Header().Set("content-type", "application/json")
 	vars := mux.Vars(r)
 	var params struct {
-		Discount float32   `json:"discount"`
-		Tax      float32   `json:"tax"`
-		DateTo   time.Time `json:"dateTo"`
-		KM       uint64    `json:"km"`
+		Discount  float32   `json:"discount"`
+		Tax       float32   `json:"tax"`
+		DateTo    time.Time `json:"dateTo"`
+		KM        uint64    `json:"km"`
+		FuelLevel uint8     `json:"fuelLevel"`
 	}
 	if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
 		w.WriteHeader(http.StatusBadRequest)
 		fmt.Fprintf(w, "{error: %v}", err)
 		return
 	}
-	err := c.orderUC.Close(vars["id"], params.Discount, params.Tax, params.DateTo, params.KM)
+	err := c.orderUC.Close(vars["id"], params.Discount, params.Tax, params.DateTo, params.KM, params.FuelLevel)
 	switch err {
 	case application.ErrInvalidOrder:
 		w.WriteHeader(http.StatusBadRequest)
@@ -141,3 +200,34 @@ func (c *orderController) UpdateToCancelOrder(w http.ResponseWriter, r *http.Req
 		fmt.Fprintf(w, `{"error":"%v"}`, err)
 	}
 }
+
+func (c *orderController) UpdateToSwapCarOrder(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	vars := mux.Vars(r)
+	var params struct {
+		CategoryId string `json:"categoryId"`
+		CarModel   string `json:"carModel"`
+		PolicyId   string `json:"policyId"`
+		Reason     string `json:"reason"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, application.ErrInvalidEntity)
+		return
+	}
+	err := c.orderUC.SwapCar(vars["id"], params.CategoryId, params.CarModel, params.PolicyId, params.Reason)
+
+	switch err {
+	case application.ErrInvalidOrder, application.ErrInvalidPolicy, application.ErrInvalidSwap, application.ErrNotFoundAccount:
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case application.ErrInvalidCar:
+		w.WriteHeader(http.StatusConflict)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case nil:
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	}
+}
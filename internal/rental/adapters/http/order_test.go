@@ -48,6 +48,8 @@ func newOrderFixture() *domain.Order {
 		"83369771-f9a4-48b7-b87b-463f19f7b187",
 		"2520aade-a397-4e3c-a589-39c6ae5c2eff",
 		*newPolicyFixture(),
+		false,
+		false,
 	)
 	return o
 }
@@ -63,15 +65,55 @@ func (m *orderOrderServiceMock) GetPolicy(categoryId, modelId, policyId string)
 	return m.expectedGetPolicy, m.expectedGetPolicyErr
 }
 
-func (m *orderOrderServiceMock) GetCar(stationId, modelId string) (*domain.Car, error) {
+func (m *orderOrderServiceMock) GetModelCategory(modelId string) (string, error) {
+	return "", nil
+}
+
+func (m *orderOrderServiceMock) GetCar(stationId, modelId string, upgrade bool) (*domain.Car, error) {
 	return m.expectedGetCar, m.expectedGetCarErr
 }
 
+func (m *orderOrderServiceMock) GetCars(stationId string, modelIds []string) ([]domain.Car, error) {
+	return nil, nil
+}
+
+func (m *orderOrderServiceMock) ReserveCars(reservationId, stationId string, carIds []string) error {
+	return nil
+}
+
+func (m *orderOrderServiceMock) ReleaseCars(reservationId, stationId string, carIds []string) error {
+	return nil
+}
+
+func (m *orderOrderServiceMock) GetCarKM(carId string) (uint64, error) {
+	return 0, nil
+}
+
+func (m *orderOrderServiceMock) IsStationOpen(stationId string, at time.Time, keyDrop bool) (bool, error) {
+	return true, nil
+}
+
+func (m *orderOrderServiceMock) GetStationLocation(stationId string) (float64, float64, error) {
+	return -23.5, -46.6, nil
+}
+
+func (m *orderOrderServiceMock) GetMemberBenefits(memberId string) (*application.MemberBenefits, error) {
+	return nil, application.ErrNotFoundMember
+}
+
+func (m *orderOrderServiceMock) RedeemPoints(memberId, orderId string, points uint, maxAmount float32) (float32, error) {
+	return 0, application.ErrInvalidRedemption
+}
+
+func (m *orderOrderServiceMock) RefundPoints(memberId, orderId string) error {
+	return nil
+}
+
 func TestOrderController_GetOrderById(t *testing.T) {
 	orders := []domain.Order{*newOrderFixture(), *newOrderFixture()}
 	orderRepo := repository.NewOrderRepositoryInMemory(orders)
 	orderSvc := &orderOrderServiceMock{}
-	orderUC := application.NewOrderUseCase(orderRepo, orderSvc)
+	orderUC := application.NewOrderUseCase(orderRepo, orderSvc, repository.NewAccountRepositoryInMemory(nil, orderRepo), repository.NewEligibilityRepositoryInMemory(nil, nil), repository.NewWaitlistRepositoryInMemory(nil))
 	orderController := NewOrderController(orderUC)
 
 	testCases := []struct {
@@ -131,7 +173,10 @@ func TestOrderController_CreateOrder(t *testing.T) {
 		expectedGetPolicy: newPolicyFixture(),
 		expectedGetCar:    newCarFixture(),
 	}
-	orderUC := application.NewOrderUseCase(orderRepo, orderSvc)
+	eligibilityRepo := repository.NewEligibilityRepositoryInMemory(nil, []domain.BlockedDriver{
+		{License: "04512345678", Reason: "unpaid damages", Date: time.Now()},
+	})
+	orderUC := application.NewOrderUseCase(orderRepo, orderSvc, repository.NewAccountRepositoryInMemory(nil, orderRepo), eligibilityRepo, repository.NewWaitlistRepositoryInMemory(nil))
 	orderController := NewOrderController(orderUC)
 
 	type params struct {
@@ -142,6 +187,7 @@ func TestOrderController_CreateOrder(t *testing.T) {
 		CategoryId     string
 		CarModel       string
 		PolicyId       string
+		DriverLicense  string
 	}
 
 	testCases := []struct {
@@ -184,6 +230,24 @@ func TestOrderController_CreateOrder(t *testing.T) {
 			bodyArg:        "",
 			wantBody:       map[string]string{"error": application.ErrInvalidEntity.Error()},
 		},
+		{
+			name:           "incorrect blocked driver req",
+			wantStatusCode: http.StatusUnprocessableEntity,
+			bodyArg: params{
+				DateReservFrom: newOrder.DateReservFrom,
+				DateReservTo:   newOrder.DateReservTo,
+				StationFromId:  newOrder.StationFromId,
+				StationToId:    newOrder.StationToId,
+				CategoryId:     newOrder.Policy.CategoryId,
+				CarModel:       newOrder.Policy.CarModel,
+				PolicyId:       newOrder.Policy.ID,
+				DriverLicense:  "04512345678",
+			},
+			wantBody: map[string]interface{}{
+				"error":   domain.ErrIneligibleDriver.Error(),
+				"reasons": []domain.RefusalReason{{Code: domain.DriverBlocked, Message: "driver is blocked: unpaid damages"}},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -222,7 +286,7 @@ func TestOrderController_UpdateToComfirmOrder(t *testing.T) {
 		expectedGetPolicy: newPolicyFixture(),
 		expectedGetCar:    newCarFixture(),
 	}
-	orderUC := application.NewOrderUseCase(orderRepo, orderSvc)
+	orderUC := application.NewOrderUseCase(orderRepo, orderSvc, repository.NewAccountRepositoryInMemory(nil, orderRepo), repository.NewEligibilityRepositoryInMemory(nil, nil), repository.NewWaitlistRepositoryInMemory(nil))
 	orderController := NewOrderController(orderUC)
 
 	type params struct {
@@ -312,7 +376,7 @@ func TestOrderController_UpdateToCloseOrder(t *testing.T) {
 		expectedGetPolicy: newPolicyFixture(),
 		expectedGetCar:    newCarFixture(),
 	}
-	orderUC := application.NewOrderUseCase(orderRepo, orderSvc)
+	orderUC := application.NewOrderUseCase(orderRepo, orderSvc, repository.NewAccountRepositoryInMemory(nil, orderRepo), repository.NewEligibilityRepositoryInMemory(nil, nil), repository.NewWaitlistRepositoryInMemory(nil))
 	orderController := NewOrderController(orderUC)
 
 	type params struct {
@@ -414,7 +478,7 @@ func TestOrderController_UpdateToCancelOrder(t *testing.T) {
 		expectedGetPolicy: newPolicyFixture(),
 		expectedGetCar:    newCarFixture(),
 	}
-	orderUC := application.NewOrderUseCase(orderRepo, orderSvc)
+	orderUC := application.NewOrderUseCase(orderRepo, orderSvc, repository.NewAccountRepositoryInMemory(nil, orderRepo), repository.NewEligibilityRepositoryInMemory(nil, nil), repository.NewWaitlistRepositoryInMemory(nil))
 	orderController := NewOrderController(orderUC)
 
 	testCases := []struct {
@@ -0,0 +1,142 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/thiagotrs/rentalcar-ddd/internal/rental/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/rental/domain"
+)
+
+type waitlistController struct {
+	waitlistUC application.WaitlistUseCase
+	orderUC    application.OrderUseCase
+}
+
+func NewWaitlistController(waitlistUC application.WaitlistUseCase, orderUC application.OrderUseCase) *waitlistController {
+	return &waitlistController{waitlistUC, orderUC}
+}
+
+func (c *waitlistController) GetWaitlistEntryById(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	vars := mux.Vars(r)
+	entry, err := c.waitlistUC.GetEntryById(vars["id"])
+
+	switch err {
+	case application.ErrInvalidId:
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case application.ErrNotFoundWaitlist:
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case nil:
+		json, _ := json.Marshal(entry)
+		w.WriteHeader(http.StatusOK)
+		w.Write(json)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	}
+}
+
+func (c *waitlistController) CreateWaitlistEntry(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	var params struct {
+		StationId  string    `json:"stationId"`
+		CategoryId string    `json:"categoryId"`
+		CarModel   string    `json:"carModel"`
+		PolicyId   string    `json:"policyId"`
+		Contact    string    `json:"contact"`
+		DateFrom   time.Time `json:"dateFrom"`
+		DateTo     time.Time `json:"dateTo"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, application.ErrInvalidEntity)
+		return
+	}
+	entry, err := c.waitlistUC.Join(
+		params.StationId, params.CategoryId, params.CarModel,
+		params.PolicyId, params.Contact, params.DateFrom, params.DateTo)
+
+	switch err {
+	case application.ErrInvalidEntity, application.ErrInvalidPolicy:
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case nil:
+		json, _ := json.Marshal(entry)
+		w.WriteHeader(http.StatusCreated)
+		w.Write(json)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	}
+}
+
+func (c *waitlistController) UpdateToWithdrawWaitlistEntry(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	vars := mux.Vars(r)
+	err := c.waitlistUC.Withdraw(vars["id"])
+
+	switch err {
+	case application.ErrInvalidId, application.ErrInvalidWaitlist:
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case application.ErrNotFoundWaitlist:
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case nil:
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	}
+}
+
+func (c *waitlistController) UpdateToBookWaitlistEntry(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	vars := mux.Vars(r)
+	var params struct {
+		StationToId             string    `json:"stationToId"`
+		AccountId               string    `json:"accountId"`
+		DriverLicense           string    `json:"driverLicense"`
+		DriverBirthDate         time.Time `json:"driverBirthDate"`
+		DriverLicensedAt        time.Time `json:"driverLicensedAt"`
+		DriverLicenseCategories []string  `json:"driverLicenseCategories"`
+		MemberId                string    `json:"memberId"`
+		RedeemPoints            uint      `json:"redeemPoints"`
+		PrepaidFuel             bool      `json:"prepaidFuel"`
+		KeyDropReturn           bool      `json:"keyDropReturn"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, application.ErrInvalidEntity)
+		return
+	}
+	driver := domain.DriverProfile{
+		License:           params.DriverLicense,
+		BirthDate:         params.DriverBirthDate,
+		LicensedAt:        params.DriverLicensedAt,
+		LicenseCategories: params.DriverLicenseCategories,
+	}
+	err := c.orderUC.OpenFromWaitlist(
+		vars["id"], params.StationToId, params.AccountId, driver,
+		params.MemberId, params.RedeemPoints, params.PrepaidFuel, params.KeyDropReturn)
+
+	switch err {
+	case application.ErrInvalidId:
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case application.ErrNotFoundWaitlist:
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case application.ErrInvalidHold:
+		w.WriteHeader(http.StatusConflict)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	default:
+		writeOpenedOrder(w, err)
+	}
+}
@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/rental/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/rental/domain"
+)
+
+type accountRepositoryInMemory struct {
+	accounts map[string]domain.CorporateAccount
+	orders   *orderRepositoryInMemory
+	*sync.RWMutex
+}
+
+func NewAccountRepositoryInMemory(accounts []domain.CorporateAccount, orders *orderRepositoryInMemory) *accountRepositoryInMemory {
+	accountsMap := make(map[string]domain.CorporateAccount)
+	for _, v := range accounts {
+		accountsMap[v.ID] = v
+	}
+	return &accountRepositoryInMemory{accountsMap, orders, &sync.RWMutex{}}
+}
+
+func (repo accountRepositoryInMemory) FindAll() []domain.CorporateAccount {
+	repo.RLock()
+	defer repo.RUnlock()
+
+	accounts := []domain.CorporateAccount{}
+	for _, a := range repo.accounts {
+		accounts = append(accounts, a)
+	}
+
+	sort.Slice(accounts, func(i, j int) bool {
+		return accounts[i].Name < accounts[j].Name
+	})
+
+	return accounts
+}
+
+func (repo accountRepositoryInMemory) FindOne(id string) (*domain.CorporateAccount, error) {
+	repo.RLock()
+	defer repo.RUnlock()
+
+	a, exists := repo.accounts[id]
+	if !exists {
+		return nil, application.ErrNotFoundAccount
+	}
+
+	return &a, nil
+}
+
+func (repo accountRepositoryInMemory) FindOrders(accountId string) []domain.Order {
+	return repo.orders.findBy(func(o domain.Order) bool {
+		return o.AccountId == accountId
+	})
+}
+
+func (repo *accountRepositoryInMemory) Save(account domain.CorporateAccount) error {
+	repo.Lock()
+	defer repo.Unlock()
+
+	repo.accounts[account.ID] = account
+
+	return nil
+}
@@ -0,0 +1,145 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/thiagotrs/rentalcar-ddd/internal/rental/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/rental/domain"
+)
+
+const (
+	findAccounts = `SELECT id, name, document, "creditLimit" FROM accounts ORDER BY name`
+	findAccount  = `SELECT id, name, document, "creditLimit" FROM accounts WHERE id = $1 LIMIT 1`
+
+	findDriversByAccount = `SELECT license, name FROM adrivers WHERE "accountId" = $1 ORDER BY license`
+	findRatesByAccount   = `SELECT "categoryId", unit, price FROM arates WHERE "accountId" = $1 ORDER BY "categoryId", unit`
+	findOrdersByAccount  = `SELECT id FROM orders WHERE "accountId" = $1 ORDER BY id`
+
+	upsertAccount = `
+	INSERT INTO accounts (id, name, document, "creditLimit") 
+	VALUES (:id, :name, :document, :creditLimit) 
+	ON CONFLICT(id) DO 
+	UPDATE SET name = :name, document = :document, "creditLimit" = :creditLimit 
+	WHERE accounts.id = :id`
+
+	deleteDriversAccount = `DELETE FROM adrivers WHERE "accountId" = $1`
+	insertDriverAccount  = `INSERT INTO adrivers ("accountId", license, name) VALUES ($1, $2, $3)`
+	deleteRatesAccount   = `DELETE FROM arates WHERE "accountId" = $1`
+	insertRateAccount    = `INSERT INTO arates ("accountId", "categoryId", unit, price) VALUES ($1, $2, $3, $4)`
+)
+
+type accountRepositorySqlx struct {
+	ctx    context.Context
+	DB     *sqlx.DB
+	orders *orderRepositorySqlx
+}
+
+func NewAccountRepositorySqlx(ctx context.Context, DB *sqlx.DB) *accountRepositorySqlx {
+	return &accountRepositorySqlx{ctx, DB, NewOrderRepositorySqlx(ctx, DB, nil)}
+}
+
+func (repo *accountRepositorySqlx) FindAll() []domain.CorporateAccount {
+	accounts := []domain.CorporateAccount{}
+
+	if err := repo.DB.SelectContext(repo.ctx, &accounts, findAccounts); err != nil {
+		return []domain.CorporateAccount{}
+	}
+
+	for i := range accounts {
+		if err := repo.loadAccount(&accounts[i]); err != nil {
+			return []domain.CorporateAccount{}
+		}
+	}
+
+	return accounts
+}
+
+func (repo *accountRepositorySqlx) FindOne(id string) (*domain.CorporateAccount, error) {
+	var account domain.CorporateAccount
+
+	if err := repo.DB.GetContext(repo.ctx, &account, findAccount, id); err != nil {
+		return nil, application.ErrNotFoundAccount
+	}
+
+	if err := repo.loadAccount(&account); err != nil {
+		return nil, application.ErrNotFoundAccount
+	}
+
+	return &account, nil
+}
+
+func (repo *accountRepositorySqlx) loadAccount(account *domain.CorporateAccount) error {
+	account.Drivers = []domain.Driver{}
+	if err := repo.DB.SelectContext(repo.ctx, &account.Drivers, findDriversByAccount, account.ID); err != nil {
+		return err
+	}
+
+	account.Rates = []domain.NegotiatedRate{}
+	if err := repo.DB.SelectContext(repo.ctx, &account.Rates, findRatesByAccount, account.ID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (repo *accountRepositorySqlx) FindOrders(accountId string) []domain.Order {
+	ids := []string{}
+	if err := repo.DB.SelectContext(repo.ctx, &ids, findOrdersByAccount, accountId); err != nil {
+		return []domain.Order{}
+	}
+
+	orders := []domain.Order{}
+	for _, id := range ids {
+		order, err := repo.orders.FindOne(id)
+		if err != nil {
+			continue
+		}
+		orders = append(orders, *order)
+	}
+
+	return orders
+}
+
+func (repo *accountRepositorySqlx) Save(account domain.CorporateAccount) error {
+	tx, err := repo.DB.BeginTxx(repo.ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.NamedExecContext(repo.ctx, upsertAccount, account); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if _, err := tx.ExecContext(repo.ctx, deleteDriversAccount, account.ID); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	for _, d := range account.Drivers {
+		if _, err := tx.ExecContext(repo.ctx, insertDriverAccount, account.ID, d.License, d.Name); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	if _, err := tx.ExecContext(repo.ctx, deleteRatesAccount, account.ID); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	for _, r := range account.Rates {
+		if _, err := tx.ExecContext(repo.ctx, insertRateAccount, account.ID, r.CategoryId, r.Unit, r.Price); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/rental/domain"
+)
+
+func TestAccountRepositorySqlx_Save(t *testing.T) {
+	db := GetDBConn(t)
+	defer db.Close()
+
+	ClearDB(t, db)
+	defer ClearDB(t, db)
+
+	account, err := domain.NewCorporateAccount("ACME", "12.345.678/0001-90", 5000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	account.AuthorizeDriver(domain.Driver{License: "04512345678", Name: "Ana Souza"})
+	account.SetRate(domain.NegotiatedRate{CategoryId: "479ab9e7-ad16-4864-8e49-29b15e4b390e", Unit: domain.PerDay, Price: 25})
+
+	repo := NewAccountRepositorySqlx(context.Background(), db)
+
+	if err := repo.Save(*account); err != nil {
+		t.Fatal(err)
+	}
+
+	order := *newOrderFixture()
+	if err := account.Charge(&order, "04512345678", []domain.Order{}, order.DateReservFrom); err != nil {
+		t.Fatal(err)
+	}
+
+	orderRepo := NewOrderRepositorySqlx(context.Background(), db, &dispatcherMock{calls: make(map[string]uint)})
+	if err := orderRepo.Save(order); err != nil {
+		t.Fatal(err)
+	}
+
+	account.RevokeDriver("04512345678")
+	if err := repo.Save(*account); err != nil {
+		t.Fatal(err)
+	}
+
+	saved, err := repo.FindOne(account.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(saved.Drivers) != 0 || len(saved.Rates) != 1 || saved.Rates[0].Price != 25 || saved.Rates[0].Unit != domain.PerDay || saved.CreditLimit != 5000 {
+		t.Error("unexpected account", saved)
+	}
+
+	orders := repo.FindOrders(account.ID)
+	if len(orders) != 1 || orders[0].ID != order.ID || orders[0].DriverLicense != "04512345678" {
+		t.Error("unexpected orders", orders)
+	}
+
+	if accounts := repo.FindAll(); len(accounts) != 1 {
+		t.Error("unexpected accounts", accounts)
+	}
+}
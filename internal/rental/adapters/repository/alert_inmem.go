@@ -0,0 +1,60 @@
+package repository
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/rental/domain"
+)
+
+type alertRepositoryInMemory struct {
+	alerts map[string]domain.GeofenceAlert
+	*sync.RWMutex
+}
+
+func NewAlertRepositoryInMemory(alerts []domain.GeofenceAlert) *alertRepositoryInMemory {
+	alertsMap := make(map[string]domain.GeofenceAlert)
+	for _, v := range alerts {
+		alertsMap[v.ID] = v
+	}
+	return &alertRepositoryInMemory{alertsMap, &sync.RWMutex{}}
+}
+
+func (repo alertRepositoryInMemory) FindAll() []domain.GeofenceAlert {
+	repo.Lock()
+	defer repo.Unlock()
+
+	alerts := []domain.GeofenceAlert{}
+	for k := range repo.alerts {
+		alerts = append(alerts, repo.alerts[k])
+	}
+
+	sort.Slice(alerts, func(i, j int) bool { return alerts[i].Date.Before(alerts[j].Date) })
+
+	return alerts
+}
+
+func (repo alertRepositoryInMemory) FindByOrder(orderId string) []domain.GeofenceAlert {
+	repo.Lock()
+	defer repo.Unlock()
+
+	alerts := []domain.GeofenceAlert{}
+	for _, a := range repo.alerts {
+		if a.OrderId == orderId {
+			alerts = append(alerts, a)
+		}
+	}
+
+	sort.Slice(alerts, func(i, j int) bool { return alerts[i].Date.Before(alerts[j].Date) })
+
+	return alerts
+}
+
+func (repo *alertRepositoryInMemory) Save(alert domain.GeofenceAlert) error {
+	repo.Lock()
+	defer repo.Unlock()
+
+	repo.alerts[alert.ID] = alert
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/events"
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/validation"
+	"github.com/thiagotrs/rentalcar-ddd/internal/rental/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/rental/domain"
+)
+
+const (
+	findAlerts        = `SELECT * FROM alerts ORDER BY date`
+	findAlertsByOrder = `SELECT * FROM alerts WHERE "orderId" = $1 ORDER BY date`
+	insertAlert       = `INSERT INTO alerts VALUES (:id, :orderId, :carId, :kind, :latitude, :longitude, :date)`
+)
+
+type alertRepositorySqlx struct {
+	ctx  context.Context
+	DB   *sqlx.DB
+	disp events.Dispatcher
+}
+
+func NewAlertRepositorySqlx(ctx context.Context, DB *sqlx.DB, disp events.Dispatcher) *alertRepositorySqlx {
+	return &alertRepositorySqlx{ctx, DB, disp}
+}
+
+func (repo *alertRepositorySqlx) FindAll() []domain.GeofenceAlert {
+	alerts := []domain.GeofenceAlert{}
+
+	if err := repo.DB.SelectContext(repo.ctx, &alerts, findAlerts); err != nil {
+		return alerts
+	}
+
+	return alerts
+}
+
+func (repo *alertRepositorySqlx) FindByOrder(orderId string) []domain.GeofenceAlert {
+	alerts := []domain.GeofenceAlert{}
+
+	if err := repo.DB.SelectContext(repo.ctx, &alerts, findAlertsByOrder, orderId); err != nil {
+		return alerts
+	}
+
+	return alerts
+}
+
+func (repo *alertRepositorySqlx) Save(alert domain.GeofenceAlert) error {
+	if err := validation.ValidateEntity(alert); err != nil {
+		return application.ErrInvalidAlert
+	}
+
+	tx, err := repo.DB.BeginTxx(repo.ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.NamedExecContext(repo.ctx, insertAlert, alert); err != nil {
+		tx.Rollback()
+		return application.ErrInvalidAlert
+	}
+
+	if len(alert.Events) > 0 {
+		if err := repo.disp.Dispatch(alert.Events); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"sync"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/rental/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/rental/domain"
+)
+
+type bookingRepositoryInMemory struct {
+	bookings map[string]domain.Booking
+	orders   *orderRepositoryInMemory
+	*sync.RWMutex
+}
+
+func NewBookingRepositoryInMemory(bookings []domain.Booking, orders *orderRepositoryInMemory) *bookingRepositoryInMemory {
+	bookingsMap := make(map[string]domain.Booking)
+	for _, v := range bookings {
+		bookingsMap[v.ID] = v
+		for _, o := range v.Orders {
+			orders.Save(o)
+		}
+	}
+	return &bookingRepositoryInMemory{bookingsMap, orders, &sync.RWMutex{}}
+}
+
+func (repo bookingRepositoryInMemory) FindOne(id string) (*domain.Booking, error) {
+	repo.RLock()
+	defer repo.RUnlock()
+
+	b, exists := repo.bookings[id]
+	if !exists {
+		return nil, application.ErrNotFoundBooking
+	}
+
+	b.Orders = repo.orders.findByBooking(id)
+
+	return &b, nil
+}
+
+func (repo *bookingRepositoryInMemory) Save(booking domain.Booking) error {
+	repo.Lock()
+	defer repo.Unlock()
+
+	for _, o := range booking.Orders {
+		repo.orders.Save(o)
+	}
+
+	booking.Orders = nil
+	repo.bookings[booking.ID] = booking
+
+	return nil
+}
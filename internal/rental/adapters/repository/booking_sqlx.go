@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/events"
+	"github.com/thiagotrs/rentalcar-ddd/internal/rental/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/rental/domain"
+)
+
+const (
+	findBooking = `SELECT id, "dateReservFrom", "dateReservTo", "stationFromId", "stationToId" FROM bookings WHERE id = $1 LIMIT 1`
+
+	findOrdersByBooking = `SELECT id FROM orders WHERE "bookingId" = $1 ORDER BY id`
+
+	upsertBooking = `
+	INSERT INTO bookings (id, "dateReservFrom", "dateReservTo", "stationFromId", "stationToId") 
+	VALUES (:id, :dateReservFrom, :dateReservTo, :stationFromId, :stationToId) 
+	ON CONFLICT(id) DO 
+	UPDATE SET "dateReservFrom" = :dateReservFrom, "dateReservTo" = :dateReservTo, "stationFromId" = :stationFromId, "stationToId" = :stationToId 
+	WHERE bookings.id = :id`
+)
+
+type bookingRepositorySqlx struct {
+	ctx    context.Context
+	DB     *sqlx.DB
+	disp   events.Dispatcher
+	orders *orderRepositorySqlx
+}
+
+func NewBookingRepositorySqlx(ctx context.Context, DB *sqlx.DB, disp events.Dispatcher) *bookingRepositorySqlx {
+	return &bookingRepositorySqlx{ctx, DB, disp, NewOrderRepositorySqlx(ctx, DB, disp)}
+}
+
+func (repo *bookingRepositorySqlx) FindOne(id string) (*domain.Booking, error) {
+	var booking domain.Booking
+
+	if err := repo.DB.GetContext(repo.ctx, &booking, findBooking, id); err != nil {
+		return nil, application.ErrNotFoundBooking
+	}
+
+	ids := []string{}
+	if err := repo.DB.SelectContext(repo.ctx, &ids, findOrdersByBooking, booking.ID); err != nil {
+		return nil, application.ErrNotFoundBooking
+	}
+
+	booking.Orders = []domain.Order{}
+	for _, orderId := range ids {
+		order, err := repo.orders.FindOne(orderId)
+		if err != nil {
+			return nil, application.ErrNotFoundBooking
+		}
+		booking.Orders = append(booking.Orders, *order)
+	}
+
+	return &booking, nil
+}
+
+func (repo *bookingRepositorySqlx) Save(booking domain.Booking) error {
+	tx, err := repo.DB.BeginTxx(repo.ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.NamedExecContext(repo.ctx, upsertBooking, booking); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	evts := []events.Event{}
+	for _, order := range booking.Orders {
+		if err := saveOrder(repo.ctx, tx, order); err != nil {
+			tx.Rollback()
+			return err
+		}
+		evts = append(evts, order.Events...)
+	}
+
+	if len(evts) > 0 {
+		if err := repo.disp.Dispatch(evts); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return nil
+}
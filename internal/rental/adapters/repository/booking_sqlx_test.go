@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/rental/domain"
+)
+
+func TestBookingRepositorySqlx_Save(t *testing.T) {
+	db := GetDBConn(t)
+	defer db.Close()
+
+	ClearDB(t, db)
+	defer ClearDB(t, db)
+
+	otherCar := *newCarFixture()
+	otherCar.ID = "2f6a7c1e-8b4d-4e3a-9f5c-6d1b2a3c4e5f"
+	otherCar.CarModel = "COROLLA"
+
+	otherPolicy := *newPolicyFixture()
+	otherPolicy.ID = "7d3e1f2a-4b5c-4d6e-8f9a-0b1c2d3e4f5a"
+	otherPolicy.CarModel = "COROLLA"
+
+	booking, err := domain.NewBooking(
+		time.Now(),
+		time.Now().Add(time.Hour*24*5),
+		[]domain.Car{*newCarFixture(), otherCar},
+		[]domain.Policy{*newPolicyFixture(), otherPolicy},
+		"83369771-f9a4-48b7-b87b-463f19f7b187",
+		"2520aade-a397-4e3c-a589-39c6ae5c2eff",
+		false,
+		false,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	disp := &dispatcherMock{calls: make(map[string]uint)}
+	repo := NewBookingRepositorySqlx(context.Background(), db, disp)
+
+	if err := repo.Save(*booking); err != nil {
+		t.Fatal(err)
+	}
+
+	if disp.calls["Dispatch"] != 1 {
+		t.Error("unexpected dispatches", disp.calls["Dispatch"])
+	}
+
+	saved, err := repo.FindOne(booking.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(saved.Orders) != 2 {
+		t.Fatal("unexpected orders", saved.Orders)
+	}
+
+	for _, o := range saved.Orders {
+		if o.BookingId != booking.ID || o.Status != domain.Opened {
+			t.Error("unexpected order", o)
+		}
+	}
+
+	if invoice := saved.Invoice(); invoice.Total != booking.Invoice().Total {
+		t.Error("unexpected invoice", invoice)
+	}
+}
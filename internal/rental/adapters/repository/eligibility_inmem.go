@@ -0,0 +1,127 @@
+package repository
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/rental/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/rental/domain"
+)
+
+type eligibilityRepositoryInMemory struct {
+	rules   map[string]domain.EligibilityRule
+	blocked map[string]domain.BlockedDriver
+	*sync.RWMutex
+}
+
+func NewEligibilityRepositoryInMemory(rules []domain.EligibilityRule, blocked []domain.BlockedDriver) *eligibilityRepositoryInMemory {
+	rulesMap := make(map[string]domain.EligibilityRule)
+	for _, v := range rules {
+		rulesMap[v.CategoryId] = v
+	}
+	blockedMap := make(map[string]domain.BlockedDriver)
+	for _, v := range blocked {
+		blockedMap[v.License] = v
+	}
+	return &eligibilityRepositoryInMemory{rulesMap, blockedMap, &sync.RWMutex{}}
+}
+
+func (repo eligibilityRepositoryInMemory) FindRules() []domain.EligibilityRule {
+	repo.RLock()
+	defer repo.RUnlock()
+
+	rules := []domain.EligibilityRule{}
+	for _, r := range repo.rules {
+		rules = append(rules, r)
+	}
+
+	sort.Slice(rules, func(i, j int) bool {
+		return rules[i].CategoryId < rules[j].CategoryId
+	})
+
+	return rules
+}
+
+func (repo eligibilityRepositoryInMemory) FindRule(categoryId string) (*domain.EligibilityRule, error) {
+	repo.RLock()
+	defer repo.RUnlock()
+
+	r, exists := repo.rules[categoryId]
+	if !exists {
+		return nil, application.ErrNotFoundEligibilityRule
+	}
+
+	return &r, nil
+}
+
+func (repo eligibilityRepositoryInMemory) FindBlockedDrivers() []domain.BlockedDriver {
+	repo.RLock()
+	defer repo.RUnlock()
+
+	blocked := []domain.BlockedDriver{}
+	for _, b := range repo.blocked {
+		blocked = append(blocked, b)
+	}
+
+	sort.Slice(blocked, func(i, j int) bool {
+		return blocked[i].License < blocked[j].License
+	})
+
+	return blocked
+}
+
+func (repo eligibilityRepositoryInMemory) FindBlockedDriver(license string) (*domain.BlockedDriver, error) {
+	repo.RLock()
+	defer repo.RUnlock()
+
+	b, exists := repo.blocked[license]
+	if !exists {
+		return nil, application.ErrNotFoundBlockedDriver
+	}
+
+	return &b, nil
+}
+
+func (repo *eligibilityRepositoryInMemory) SaveRule(rule domain.EligibilityRule) error {
+	repo.Lock()
+	defer repo.Unlock()
+
+	repo.rules[rule.CategoryId] = rule
+
+	return nil
+}
+
+func (repo *eligibilityRepositoryInMemory) DeleteRule(categoryId string) error {
+	repo.Lock()
+	defer repo.Unlock()
+
+	if _, exists := repo.rules[categoryId]; !exists {
+		return application.ErrNotFoundEligibilityRule
+	}
+
+	delete(repo.rules, categoryId)
+
+	return nil
+}
+
+func (repo *eligibilityRepositoryInMemory) SaveBlockedDriver(blocked domain.BlockedDriver) error {
+	repo.Lock()
+	defer repo.Unlock()
+
+	repo.blocked[blocked.License] = blocked
+
+	return nil
+}
+
+func (repo *eligibilityRepositoryInMemory) DeleteBlockedDriver(license string) error {
+	repo.Lock()
+	defer repo.Unlock()
+
+	if _, exists := repo.blocked[license]; !exists {
+		return application.ErrNotFoundBlockedDriver
+	}
+
+	delete(repo.blocked, license)
+
+	return nil
+}
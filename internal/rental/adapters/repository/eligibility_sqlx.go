@@ -0,0 +1,183 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/thiagotrs/rentalcar-ddd/internal/rental/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/rental/domain"
+)
+
+const (
+	findRules = `SELECT "categoryId", "minAge", "minLicenseYears", "youngDriverAge", "youngDriverFee" FROM erules ORDER BY "categoryId"`
+	findRule  = `SELECT "categoryId", "minAge", "minLicenseYears", "youngDriverAge", "youngDriverFee" FROM erules WHERE "categoryId" = $1 LIMIT 1`
+
+	findLicensesByRule = `SELECT "licenseCategory" FROM elicenses WHERE "categoryId" = $1 ORDER BY "licenseCategory"`
+
+	upsertRule = `
+	INSERT INTO erules ("categoryId", "minAge", "minLicenseYears", "youngDriverAge", "youngDriverFee") 
+	VALUES (:categoryId, :minAge, :minLicenseYears, :youngDriverAge, :youngDriverFee) 
+	ON CONFLICT("categoryId") DO 
+	UPDATE SET "minAge" = :minAge, "minLicenseYears" = :minLicenseYears, "youngDriverAge" = :youngDriverAge, "youngDriverFee" = :youngDriverFee 
+	WHERE erules."categoryId" = :categoryId`
+
+	deleteRule         = `DELETE FROM erules WHERE "categoryId" = $1`
+	deleteLicensesRule = `DELETE FROM elicenses WHERE "categoryId" = $1`
+	insertLicenseRule  = `INSERT INTO elicenses ("categoryId", "licenseCategory") VALUES ($1, $2)`
+
+	findBlockedDrivers = `SELECT license, reason, date FROM eblocked ORDER BY license`
+	findBlockedDriver  = `SELECT license, reason, date FROM eblocked WHERE license = $1 LIMIT 1`
+
+	upsertBlockedDriver = `
+	INSERT INTO eblocked (license, reason, date) 
+	VALUES (:license, :reason, :date) 
+	ON CONFLICT(license) DO 
+	UPDATE SET reason = :reason, date = :date 
+	WHERE eblocked.license = :license`
+
+	deleteBlockedDriver = `DELETE FROM eblocked WHERE license = $1`
+)
+
+type eligibilityRepositorySqlx struct {
+	ctx context.Context
+	DB  *sqlx.DB
+}
+
+func NewEligibilityRepositorySqlx(ctx context.Context, DB *sqlx.DB) *eligibilityRepositorySqlx {
+	return &eligibilityRepositorySqlx{ctx, DB}
+}
+
+func (repo *eligibilityRepositorySqlx) FindRules() []domain.EligibilityRule {
+	rules := []domain.EligibilityRule{}
+
+	if err := repo.DB.SelectContext(repo.ctx, &rules, findRules); err != nil {
+		return []domain.EligibilityRule{}
+	}
+
+	for i := range rules {
+		if err := repo.loadRule(&rules[i]); err != nil {
+			return []domain.EligibilityRule{}
+		}
+	}
+
+	return rules
+}
+
+func (repo *eligibilityRepositorySqlx) FindRule(categoryId string) (*domain.EligibilityRule, error) {
+	var rule domain.EligibilityRule
+
+	switch err := repo.DB.GetContext(repo.ctx, &rule, findRule, categoryId); err {
+	case nil:
+	case sql.ErrNoRows:
+		return nil, application.ErrNotFoundEligibilityRule
+	default:
+		return nil, err
+	}
+
+	if err := repo.loadRule(&rule); err != nil {
+		return nil, err
+	}
+
+	return &rule, nil
+}
+
+func (repo *eligibilityRepositorySqlx) loadRule(rule *domain.EligibilityRule) error {
+	rule.LicenseCategories = []string{}
+	return repo.DB.SelectContext(repo.ctx, &rule.LicenseCategories, findLicensesByRule, rule.CategoryId)
+}
+
+func (repo *eligibilityRepositorySqlx) FindBlockedDrivers() []domain.BlockedDriver {
+	blocked := []domain.BlockedDriver{}
+
+	if err := repo.DB.SelectContext(repo.ctx, &blocked, findBlockedDrivers); err != nil {
+		return []domain.BlockedDriver{}
+	}
+
+	return blocked
+}
+
+func (repo *eligibilityRepositorySqlx) FindBlockedDriver(license string) (*domain.BlockedDriver, error) {
+	var blocked domain.BlockedDriver
+
+	switch err := repo.DB.GetContext(repo.ctx, &blocked, findBlockedDriver, license); err {
+	case nil:
+	case sql.ErrNoRows:
+		return nil, application.ErrNotFoundBlockedDriver
+	default:
+		return nil, err
+	}
+
+	return &blocked, nil
+}
+
+func (repo *eligibilityRepositorySqlx) SaveRule(rule domain.EligibilityRule) error {
+	tx, err := repo.DB.BeginTxx(repo.ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.NamedExecContext(repo.ctx, upsertRule, rule); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if _, err := tx.ExecContext(repo.ctx, deleteLicensesRule, rule.CategoryId); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	for _, c := range rule.LicenseCategories {
+		if _, err := tx.ExecContext(repo.ctx, insertLicenseRule, rule.CategoryId, c); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return nil
+}
+
+func (repo *eligibilityRepositorySqlx) DeleteRule(categoryId string) error {
+	tx, err := repo.DB.BeginTxx(repo.ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(repo.ctx, deleteLicensesRule, categoryId); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if _, err := tx.ExecContext(repo.ctx, deleteRule, categoryId); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return nil
+}
+
+func (repo *eligibilityRepositorySqlx) SaveBlockedDriver(blocked domain.BlockedDriver) error {
+	if _, err := repo.DB.NamedExecContext(repo.ctx, upsertBlockedDriver, blocked); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (repo *eligibilityRepositorySqlx) DeleteBlockedDriver(license string) error {
+	if _, err := repo.DB.ExecContext(repo.ctx, deleteBlockedDriver, license); err != nil {
+		return err
+	}
+
+	return nil
+}
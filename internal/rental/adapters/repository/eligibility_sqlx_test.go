@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/thiagotrs/rentalcar-ddd/internal/rental/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/rental/domain"
+)
+
+func ClearEligibilityDB(t *testing.T, db *sqlx.DB) {
+	t.Helper()
+
+	for _, q := range []string{`DELETE FROM elicenses`, `DELETE FROM erules`, `DELETE FROM eblocked`} {
+		if _, err := db.Exec(q); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestEligibilityRepositorySqlx_SaveRule(t *testing.T) {
+	db := GetDBConn(t)
+	defer db.Close()
+
+	ClearEligibilityDB(t, db)
+	defer ClearEligibilityDB(t, db)
+
+	repo := NewEligibilityRepositorySqlx(context.Background(), db)
+
+	rule, _ := domain.NewEligibilityRule("479ab9e7-ad16-4864-8e49-29b15e4b390e", 21, 2, 25, 15, []string{"B"})
+	if err := repo.SaveRule(*rule); err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	rule, _ = domain.NewEligibilityRule("479ab9e7-ad16-4864-8e49-29b15e4b390e", 23, 2, 25, 15, []string{"C", "D"})
+	if err := repo.SaveRule(*rule); err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	saved, err := repo.FindRule(rule.CategoryId)
+	if err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	if !reflect.DeepEqual(*saved, *rule) {
+		t.Error("unexpected rule", saved)
+	}
+
+	if rules := repo.FindRules(); len(rules) != 1 {
+		t.Error("unexpected rules", rules)
+	}
+
+	if err := repo.DeleteRule(rule.CategoryId); err != nil {
+		t.Error("unexpected error", err)
+	}
+
+	if _, err := repo.FindRule(rule.CategoryId); !errors.Is(err, application.ErrNotFoundEligibilityRule) {
+		t.Error("unexpected error", err)
+	}
+}
+
+func TestEligibilityRepositorySqlx_SaveBlockedDriver(t *testing.T) {
+	db := GetDBConn(t)
+	defer db.Close()
+
+	ClearEligibilityDB(t, db)
+	defer ClearEligibilityDB(t, db)
+
+	repo := NewEligibilityRepositorySqlx(context.Background(), db)
+
+	blocked, _ := domain.NewBlockedDriver("04512345678", "unpaid damages", time.Date(2022, time.March, 1, 10, 0, 0, 0, time.UTC))
+	if err := repo.SaveBlockedDriver(*blocked); err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	saved, err := repo.FindBlockedDriver(blocked.License)
+	if err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	if saved.Reason != blocked.Reason || !saved.Date.Equal(blocked.Date) {
+		t.Error("unexpected blocked driver", saved)
+	}
+
+	if err := repo.DeleteBlockedDriver(blocked.License); err != nil {
+		t.Error("unexpected error", err)
+	}
+
+	if all := repo.FindBlockedDrivers(); len(all) != 0 {
+		t.Error("unexpected blocked drivers", all)
+	}
+}
@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"sync"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/rental/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/rental/domain"
+)
+
+type geofenceRepositoryInMemory struct {
+	geofences map[string]domain.Geofence
+	*sync.RWMutex
+}
+
+func NewGeofenceRepositoryInMemory(geofences []domain.Geofence) *geofenceRepositoryInMemory {
+	geofencesMap := make(map[string]domain.Geofence)
+	for _, v := range geofences {
+		geofencesMap[v.ID] = v
+	}
+	return &geofenceRepositoryInMemory{geofencesMap, &sync.RWMutex{}}
+}
+
+func (repo geofenceRepositoryInMemory) FindAll() []domain.Geofence {
+	repo.Lock()
+	defer repo.Unlock()
+
+	geofences := []domain.Geofence{}
+	for k := range repo.geofences {
+		geofences = append(geofences, repo.geofences[k])
+	}
+
+	return geofences
+}
+
+func (repo geofenceRepositoryInMemory) FindByScope(categoryId, orderId string) []domain.Geofence {
+	repo.Lock()
+	defer repo.Unlock()
+
+	geofences := []domain.Geofence{}
+	for _, g := range repo.geofences {
+		if (categoryId != "" && g.CategoryId == categoryId) || (orderId != "" && g.OrderId == orderId) {
+			geofences = append(geofences, g)
+		}
+	}
+
+	return geofences
+}
+
+func (repo geofenceRepositoryInMemory) FindOne(id string) (*domain.Geofence, error) {
+	repo.Lock()
+	defer repo.Unlock()
+
+	g, exists := repo.geofences[id]
+	if !exists {
+		return nil, application.ErrNotFoundGeofence
+	}
+
+	return &g, nil
+}
+
+func (repo *geofenceRepositoryInMemory) Save(geofence domain.Geofence) error {
+	repo.Lock()
+	defer repo.Unlock()
+
+	repo.geofences[geofence.ID] = geofence
+
+	return nil
+}
+
+func (repo *geofenceRepositoryInMemory) Delete(id string) error {
+	repo.Lock()
+	defer repo.Unlock()
+
+	if _, exists := repo.geofences[id]; !exists {
+		return application.ErrNotFoundGeofence
+	}
+
+	delete(repo.geofences, id)
+
+	return nil
+}
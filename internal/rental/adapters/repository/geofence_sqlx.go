@@ -0,0 +1,141 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/validation"
+	"github.com/thiagotrs/rentalcar-ddd/internal/rental/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/rental/domain"
+)
+
+const (
+	findGeofences        = `SELECT * FROM geofences`
+	findGeofence         = `SELECT * FROM geofences WHERE id = $1 LIMIT 1`
+	findGeofencesByScope = `
+	SELECT * FROM geofences 
+	WHERE ("categoryId" <> '' AND "categoryId" = $1) OR ("orderId" <> '' AND "orderId" = $2)`
+	upsertGeofence = `
+	INSERT INTO geofences VALUES (:id, :name, :kind, :categoryId, :orderId, :stationId, :latitude, :longitude, :radius) 
+	ON CONFLICT(id) DO UPDATE SET name = :name, kind = :kind, "categoryId" = :categoryId, "orderId" = :orderId, "stationId" = :stationId, latitude = :latitude, longitude = :longitude, radius = :radius 
+	WHERE geofences.id = :id`
+	deleteGeofence = `DELETE FROM geofences WHERE id = $1`
+
+	findPointsByGeofence   = `SELECT latitude, longitude FROM gpoints WHERE "geofenceId" = $1 ORDER BY seq`
+	deletePointsByGeofence = `DELETE FROM gpoints WHERE "geofenceId" = $1`
+	insertPointGeofence    = `INSERT INTO gpoints ("geofenceId", seq, latitude, longitude) VALUES ($1, $2, $3, $4)`
+)
+
+type geofenceRepositorySqlx struct {
+	ctx context.Context
+	DB  *sqlx.DB
+}
+
+func NewGeofenceRepositorySqlx(ctx context.Context, DB *sqlx.DB) *geofenceRepositorySqlx {
+	return &geofenceRepositorySqlx{ctx, DB}
+}
+
+func (repo *geofenceRepositorySqlx) FindAll() []domain.Geofence {
+	return repo.find(findGeofences)
+}
+
+func (repo *geofenceRepositorySqlx) FindByScope(categoryId, orderId string) []domain.Geofence {
+	return repo.find(findGeofencesByScope, categoryId, orderId)
+}
+
+func (repo *geofenceRepositorySqlx) find(query string, args ...interface{}) []domain.Geofence {
+	geofences := []domain.Geofence{}
+
+	if err := repo.DB.SelectContext(repo.ctx, &geofences, query, args...); err != nil {
+		return []domain.Geofence{}
+	}
+
+	for i := range geofences {
+		geofences[i].Polygon = []domain.Point{}
+		if err := repo.DB.SelectContext(repo.ctx, &geofences[i].Polygon, findPointsByGeofence, geofences[i].ID); err != nil {
+			return []domain.Geofence{}
+		}
+	}
+
+	return geofences
+}
+
+func (repo *geofenceRepositorySqlx) FindOne(id string) (*domain.Geofence, error) {
+	var geofence domain.Geofence
+
+	if err := repo.DB.GetContext(repo.ctx, &geofence, findGeofence, id); err != nil {
+		return nil, application.ErrNotFoundGeofence
+	}
+
+	geofence.Polygon = []domain.Point{}
+	if err := repo.DB.SelectContext(repo.ctx, &geofence.Polygon, findPointsByGeofence, geofence.ID); err != nil {
+		return nil, application.ErrNotFoundGeofence
+	}
+
+	return &geofence, nil
+}
+
+func (repo *geofenceRepositorySqlx) Save(geofence domain.Geofence) error {
+	if err := validation.ValidateEntity(geofence); err != nil {
+		return application.ErrInvalidGeofence
+	}
+
+	tx, err := repo.DB.BeginTxx(repo.ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.NamedExecContext(repo.ctx, upsertGeofence, geofence); err != nil {
+		tx.Rollback()
+		return application.ErrInvalidGeofence
+	}
+
+	if _, err := tx.ExecContext(repo.ctx, deletePointsByGeofence, geofence.ID); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	for i, p := range geofence.Polygon {
+		if _, err := tx.ExecContext(repo.ctx, insertPointGeofence, geofence.ID, i, p.Latitude, p.Longitude); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return nil
+}
+
+func (repo *geofenceRepositorySqlx) Delete(id string) error {
+	tx, err := repo.DB.BeginTxx(repo.ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(repo.ctx, deletePointsByGeofence, id); err != nil {
+		tx.Rollback()
+		return application.ErrNotFoundGeofence
+	}
+
+	r, err := tx.ExecContext(repo.ctx, deleteGeofence, id)
+	if err != nil {
+		tx.Rollback()
+		return application.ErrNotFoundGeofence
+	}
+	n, err := r.RowsAffected()
+	if err != nil || n == 0 {
+		tx.Rollback()
+		return application.ErrNotFoundGeofence
+	}
+
+	if err := tx.Commit(); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return nil
+}
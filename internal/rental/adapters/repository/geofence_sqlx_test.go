@@ -0,0 +1,107 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/thiagotrs/rentalcar-ddd/internal/rental/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/rental/domain"
+)
+
+func ClearGeofenceDB(t *testing.T, db *sqlx.DB) {
+	t.Helper()
+
+	for _, q := range []string{`DELETE FROM gpoints`, `DELETE FROM geofences`, `DELETE FROM alerts`} {
+		if _, err := db.Exec(q); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestGeofenceRepositorySqlx_Save(t *testing.T) {
+	db := GetDBConn(t)
+	defer db.Close()
+
+	ClearGeofenceDB(t, db)
+	defer ClearGeofenceDB(t, db)
+
+	repo := NewGeofenceRepositorySqlx(context.Background(), db)
+
+	zone, _ := domain.NewZoneGeofence("SP", "479ab9e7-ad16-4864-8e49-29b15e4b390e", "", []domain.Point{
+		{Latitude: -23.0, Longitude: -47.0},
+		{Latitude: -23.0, Longitude: -46.0},
+		{Latitude: -24.0, Longitude: -46.0},
+	})
+
+	if err := repo.Save(*zone); err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	zone.Polygon = append(zone.Polygon, domain.Point{Latitude: -24.0, Longitude: -47.0})
+	if err := repo.Save(*zone); err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	geofences := repo.FindByScope(zone.CategoryId, "c6f31fdd-a77a-464b-9475-2d12441963a6")
+	if len(geofences) != 1 || len(geofences[0].Polygon) != 4 || geofences[0].Polygon[3].Longitude != -47.0 {
+		t.Error("unexpected geofences", geofences)
+	}
+
+	if err := repo.Delete(zone.ID); err != nil {
+		t.Error("unexpected error", err)
+	}
+
+	if _, err := repo.FindOne(zone.ID); !errors.Is(err, application.ErrNotFoundGeofence) {
+		t.Error("unexpected error", err)
+	}
+
+	if err := repo.Delete(zone.ID); !errors.Is(err, application.ErrNotFoundGeofence) {
+		t.Error("unexpected error", err)
+	}
+}
+
+func TestAlertRepositorySqlx_Save(t *testing.T) {
+	db := GetDBConn(t)
+	defer db.Close()
+
+	ClearGeofenceDB(t, db)
+	defer ClearGeofenceDB(t, db)
+
+	dispatcher := &dispatcherMock{calls: make(map[string]uint)}
+	repo := NewAlertRepositorySqlx(context.Background(), db, dispatcher)
+
+	order := *newOrderFixture()
+	order.Status = domain.Confirmed
+	position := domain.CarPosition{
+		OrderId:    order.ID,
+		CarId:      order.Car.ID,
+		Latitude:   -22.5,
+		Longitude:  -46.5,
+		RecordedAt: time.Now(),
+	}
+	fences := []domain.Geofence{{Kind: domain.StationFence, Latitude: -23.5, Longitude: -46.6, Radius: 2}}
+	alerts := order.CheckPosition(nil, position, fences, false)
+
+	if len(alerts) != 1 {
+		t.Fatal("unexpected alerts", alerts)
+	}
+
+	if err := repo.Save(alerts[0]); err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	if dispatcher.calls["Dispatch"] != 1 {
+		t.Error("invalid dispatcher call", dispatcher.calls["Dispatch"])
+	}
+
+	if found := repo.FindByOrder(order.ID); len(found) != 1 || found[0].Kind != domain.ZoneExitAlert {
+		t.Error("unexpected alerts", found)
+	}
+
+	if err := repo.Save(domain.GeofenceAlert{}); !errors.Is(err, application.ErrInvalidAlert) {
+		t.Error("unexpected error", err)
+	}
+}
@@ -1,7 +1,9 @@
 package repository
 
 import (
+	"sort"
 	"sync"
+	"time"
 
 	"github.com/thiagotrs/rentalcar-ddd/internal/rental/application"
 	"github.com/thiagotrs/rentalcar-ddd/internal/rental/domain"
@@ -32,6 +34,25 @@ func (repo orderRepositoryInMemory) FindOne(id string) (*domain.Order, error) {
 	return &s, nil
 }
 
+func (repo orderRepositoryInMemory) FindRentedByCar(carId string) (*domain.Order, error) {
+	repo.Lock()
+	defer repo.Unlock()
+
+	for _, o := range repo.orders {
+		if o.Car.ID == carId && o.Status == domain.Confirmed {
+			return &o, nil
+		}
+	}
+
+	return nil, application.ErrNotFoundOrder
+}
+
+func (repo orderRepositoryInMemory) FindOverdue(at time.Time) []domain.Order {
+	return repo.findBy(func(o domain.Order) bool {
+		return o.Status == domain.Confirmed && o.DateReservTo.Before(at)
+	})
+}
+
 func (repo *orderRepositoryInMemory) Save(order domain.Order) error {
 	repo.Lock()
 	defer repo.Unlock()
@@ -40,3 +61,47 @@ func (repo *orderRepositoryInMemory) Save(order domain.Order) error {
 
 	return nil
 }
+
+func (repo *orderRepositoryInMemory) SaveCharged(order domain.Order, account domain.CorporateAccount, now time.Time) error {
+	repo.Lock()
+	defer repo.Unlock()
+
+	orders := []domain.Order{}
+	for _, o := range repo.orders {
+		if o.AccountId == account.ID {
+			orders = append(orders, o)
+		}
+	}
+
+	if err := account.CheckCredit(order, orders, now); err != nil {
+		return application.ErrCreditLimitExceeded
+	}
+
+	repo.orders[order.ID] = order
+
+	return nil
+}
+
+func (repo orderRepositoryInMemory) findByBooking(bookingId string) []domain.Order {
+	return repo.findBy(func(o domain.Order) bool {
+		return o.BookingId == bookingId
+	})
+}
+
+func (repo orderRepositoryInMemory) findBy(match func(o domain.Order) bool) []domain.Order {
+	repo.Lock()
+	defer repo.Unlock()
+
+	orders := []domain.Order{}
+	for _, o := range repo.orders {
+		if match(o) {
+			orders = append(orders, o)
+		}
+	}
+
+	sort.Slice(orders, func(i, j int) bool {
+		return orders[i].ID < orders[j].ID
+	})
+
+	return orders
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/jmoiron/sqlx"
 	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/events"
@@ -10,36 +11,62 @@ import (
 )
 
 const (
-	findOrder = `SELECT id, "dateFrom", "dateTo", "dateReservFrom", "dateReservTo", status, "stationFromId", "stationToId", discount,	tax FROM orders WHERE id = $1 LIMIT 1`
+	findOrder = `SELECT id, "dateFrom", "dateTo", "dateReservFrom", "dateReservTo", status, "stationFromId", "stationToId", discount,	tax, "prepaidFuel", "keyDropReturn", "bookingId", "accountId", "driverLicense", "memberId", "waitlistId" FROM orders WHERE id = $1 LIMIT 1`
+
+	findRentedOrderByCar = `
+	SELECT orders.id FROM orders JOIN ocars ON ocars."orderId" = orders.id 
+	WHERE ocars.id = $1 AND orders.status = $2 LIMIT 1`
+
+	findOrdersByAccountId = `SELECT id FROM orders WHERE "accountId" = $1 ORDER BY id`
+
+	lockAccountCredit = `UPDATE accounts SET "creditLimit" = "creditLimit" WHERE id = $1`
+	findAccountCredit = `SELECT "creditLimit" FROM accounts WHERE id = $1`
+
+	findOverdueOrders = `SELECT id FROM orders WHERE status = $1 AND "dateReservTo" < $2 ORDER BY id`
 
 	findCarByOrder = `
-	SELECT id, age, plate, document, "carModel", "initialKM", "finalKM", status, "stationId" FROM ocars 
+	SELECT id, age, plate, document, "carModel", "initialKM", "finalKM", status, "stationId", energy, "initialFuel", "finalFuel", upgraded FROM ocars 
 	WHERE "orderId" = $1 LIMIT 1`
 
 	findPolicyByOrder = `
-	SELECT id, name, price, unit, "minUnit", "carModel", "categoryId" FROM opolicies 
+	SELECT id, name, price, unit, "minUnit", "carModel", "categoryId", "refuelPrice", "rechargePrice", "prepaidFuelPrice", "kmAllowance", "excessKMPrice" FROM opolicies 
 	WHERE "orderId" = $1 LIMIT 1`
 
 	upsertOrder = `
 	INSERT INTO orders 
-	VALUES (:id, :dateFrom, :dateTo, :dateReservFrom, :dateReservTo, :status, :stationFromId, :stationToId, :discount, :tax) 
+	VALUES (:id, :dateFrom, :dateTo, :dateReservFrom, :dateReservTo, :status, :stationFromId, :stationToId, :discount, :tax, :prepaidFuel, :keyDropReturn, :bookingId, :accountId, :driverLicense, :memberId, :waitlistId) 
 	ON CONFLICT(id) DO 
-	UPDATE SET "dateFrom" = :dateFrom, "dateTo" = :dateTo, "dateReservFrom" = :dateReservFrom, "dateReservTo" = :dateReservTo, status = :status, "stationFromId" = :stationFromId, "stationToId" = :stationToId, discount = :discount, tax = :tax 
+	UPDATE SET "dateFrom" = :dateFrom, "dateTo" = :dateTo, "dateReservFrom" = :dateReservFrom, "dateReservTo" = :dateReservTo, status = :status, "stationFromId" = :stationFromId, "stationToId" = :stationToId, discount = :discount, tax = :tax, "prepaidFuel" = :prepaidFuel, "keyDropReturn" = :keyDropReturn, "bookingId" = :bookingId, "accountId" = :accountId, "driverLicense" = :driverLicense, "memberId" = :memberId, "waitlistId" = :waitlistId 
 	WHERE orders.id = :id`
 
 	upsertCarOrder = `
-	INSERT INTO ocars (id, "orderId", age, plate, document, "carModel", "initialKM", "finalKM", status, "stationId") 
-	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+	INSERT INTO ocars (id, "orderId", age, plate, document, "carModel", "initialKM", "finalKM", status, "stationId", energy, "initialFuel", "finalFuel", upgraded) 
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
 	ON CONFLICT(id, "orderId") DO 
-	UPDATE SET age = $3, plate = $4, document = $5, "carModel" = $6, "initialKM" = $7, "finalKM" = $8, status = $9, "stationId" = $10 
+	UPDATE SET age = $3, plate = $4, document = $5, "carModel" = $6, "initialKM" = $7, "finalKM" = $8, status = $9, "stationId" = $10, energy = $11, "initialFuel" = $12, "finalFuel" = $13, upgraded = $14 
 	WHERE ocars.id = $1 AND ocars."orderId" = $2`
 
 	upsertPolicyOrder = `
-	INSERT INTO opolicies (id, "orderId", name, price, unit, "minUnit", "carModel", "categoryId") 
-	VALUES ($1, $2, $3, $4, $5, $6, $7, $8) 
+	INSERT INTO opolicies (id, "orderId", name, price, unit, "minUnit", "carModel", "categoryId", "refuelPrice", "rechargePrice", "prepaidFuelPrice", "kmAllowance", "excessKMPrice") 
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13) 
 	ON CONFLICT(id, "orderId") DO 
-	UPDATE SET name = $3, price = $4, unit = $5, "minUnit" = $6, "carModel" = $7, "categoryId" = $8 
+	UPDATE SET name = $3, price = $4, unit = $5, "minUnit" = $6, "carModel" = $7, "categoryId" = $8, "refuelPrice" = $9, "rechargePrice" = $10, "prepaidFuelPrice" = $11, "kmAllowance" = $12, "excessKMPrice" = $13 
 	WHERE opolicies.id = $1 AND opolicies."orderId" = $2`
+
+	findChargesByOrder = `SELECT kind, description, amount FROM ocharges WHERE "orderId" = $1`
+	deleteChargesOrder = `DELETE FROM ocharges WHERE "orderId" = $1`
+	insertChargeOrder  = `INSERT INTO ocharges ("orderId", kind, description, amount) VALUES ($1, $2, $3, $4)`
+
+	deleteSwappedCarsOrder     = `DELETE FROM ocars WHERE "orderId" = $1 AND id <> $2`
+	deleteSwappedPoliciesOrder = `DELETE FROM opolicies WHERE "orderId" = $1 AND id <> $2`
+
+	findSwapsByOrder = `
+	SELECT "fromCarId", "toCarId", "fromCarModel", "toCarModel", repriced, reason, date FROM oswaps 
+	WHERE "orderId" = $1 ORDER BY date`
+	deleteSwapsOrder = `DELETE FROM oswaps WHERE "orderId" = $1`
+	insertSwapOrder  = `
+	INSERT INTO oswaps ("orderId", "fromCarId", "toCarId", "fromCarModel", "toCarModel", repriced, reason, date) 
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
 )
 
 type orderRepositorySqlx struct {
@@ -53,41 +80,159 @@ func NewOrderRepositorySqlx(ctx context.Context, DB *sqlx.DB, disp events.Dispat
 }
 
 func (repo *orderRepositorySqlx) FindOne(id string) (*domain.Order, error) {
+	return findOrderWith(repo.ctx, repo.DB, id)
+}
+
+func findOrderWith(ctx context.Context, q sqlx.QueryerContext, id string) (*domain.Order, error) {
 	var order domain.Order
 
-	if err := repo.DB.GetContext(repo.ctx, &order, findOrder, id); err != nil {
+	if err := sqlx.GetContext(ctx, q, &order, findOrder, id); err != nil {
 		return nil, application.ErrNotFoundOrder
 	}
 
-	if err := repo.DB.GetContext(repo.ctx, &order.Car, findCarByOrder, order.ID); err != nil {
+	if err := sqlx.GetContext(ctx, q, &order.Car, findCarByOrder, order.ID); err != nil {
+		return nil, application.ErrNotFoundOrder
+	}
+	if err := sqlx.GetContext(ctx, q, &order.Policy, findPolicyByOrder, order.ID); err != nil {
 		return nil, application.ErrNotFoundOrder
 	}
-	if err := repo.DB.GetContext(repo.ctx, &order.Policy, findPolicyByOrder, order.ID); err != nil {
+
+	order.Charges = []domain.Charge{}
+	if err := sqlx.SelectContext(ctx, q, &order.Charges, findChargesByOrder, order.ID); err != nil {
+		return nil, application.ErrNotFoundOrder
+	}
+
+	order.CarSwaps = []domain.CarSwap{}
+	if err := sqlx.SelectContext(ctx, q, &order.CarSwaps, findSwapsByOrder, order.ID); err != nil {
 		return nil, application.ErrNotFoundOrder
 	}
 
 	return &order, nil
 }
 
+func (repo *orderRepositorySqlx) FindRentedByCar(carId string) (*domain.Order, error) {
+	var id string
+
+	if err := repo.DB.GetContext(repo.ctx, &id, findRentedOrderByCar, carId, domain.Confirmed); err != nil {
+		return nil, application.ErrNotFoundOrder
+	}
+
+	return repo.FindOne(id)
+}
+
+func (repo *orderRepositorySqlx) FindOverdue(at time.Time) []domain.Order {
+	orders := []domain.Order{}
+
+	ids := []string{}
+	if err := repo.DB.SelectContext(repo.ctx, &ids, findOverdueOrders, domain.Confirmed, at); err != nil {
+		return orders
+	}
+
+	for _, id := range ids {
+		if order, err := repo.FindOne(id); err == nil {
+			orders = append(orders, *order)
+		}
+	}
+
+	return orders
+}
+
 func (repo *orderRepositorySqlx) Save(order domain.Order) error {
 	tx, err := repo.DB.BeginTxx(repo.ctx, nil)
 	if err != nil {
 		return err
 	}
 
-	result, err := tx.NamedExecContext(repo.ctx, upsertOrder, order)
+	if err := saveOrder(repo.ctx, tx, order); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if len(order.Events) > 0 {
+		if err := repo.disp.Dispatch(order.Events); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return nil
+}
+
+// The account row is locked so its credit is checked by one order at a time.
+func (repo *orderRepositorySqlx) SaveCharged(order domain.Order, account domain.CorporateAccount, now time.Time) error {
+	tx, err := repo.DB.BeginTxx(repo.ctx, nil)
 	if err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(repo.ctx, lockAccountCredit, account.ID); err != nil {
 		tx.Rollback()
 		return err
 	}
+
+	if err := tx.GetContext(repo.ctx, &account.CreditLimit, findAccountCredit, account.ID); err != nil {
+		tx.Rollback()
+		return application.ErrNotFoundAccount
+	}
+
+	ids := []string{}
+	if err := tx.SelectContext(repo.ctx, &ids, findOrdersByAccountId, account.ID); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	orders := []domain.Order{}
+	for _, id := range ids {
+		o, err := findOrderWith(repo.ctx, tx, id)
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+		orders = append(orders, *o)
+	}
+
+	if err := account.CheckCredit(order, orders, now); err != nil {
+		tx.Rollback()
+		return application.ErrCreditLimitExceeded
+	}
+
+	if err := saveOrder(repo.ctx, tx, order); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if len(order.Events) > 0 {
+		if err := repo.disp.Dispatch(order.Events); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return nil
+}
+
+func saveOrder(ctx context.Context, tx *sqlx.Tx, order domain.Order) error {
+	result, err := tx.NamedExecContext(ctx, upsertOrder, order)
+	if err != nil {
+		return err
+	}
 	n, err := result.RowsAffected()
 	if err != nil || n == 0 {
-		tx.Rollback()
 		return err
 	}
 
 	result, err = tx.ExecContext(
-		repo.ctx,
+		ctx,
 		upsertCarOrder,
 		order.Car.ID,
 		order.ID,
@@ -98,19 +243,21 @@ func (repo *orderRepositorySqlx) Save(order domain.Order) error {
 		order.Car.InitialKM,
 		order.Car.FinalKM,
 		order.Car.Status,
-		order.Car.StationId)
+		order.Car.StationId,
+		order.Car.Energy,
+		order.Car.InitialFuel,
+		order.Car.FinalFuel,
+		order.Car.Upgraded)
 	if err != nil {
-		tx.Rollback()
 		return err
 	}
 	n, err = result.RowsAffected()
 	if err != nil || n == 0 {
-		tx.Rollback()
 		return err
 	}
 
 	result, err = tx.ExecContext(
-		repo.ctx,
+		ctx,
 		upsertPolicyOrder,
 		order.Policy.ID,
 		order.ID,
@@ -119,28 +266,47 @@ func (repo *orderRepositorySqlx) Save(order domain.Order) error {
 		order.Policy.Unit,
 		order.Policy.MinUnit,
 		order.Policy.CarModel,
-		order.Policy.CategoryId)
+		order.Policy.CategoryId,
+		order.Policy.RefuelPrice,
+		order.Policy.RechargePrice,
+		order.Policy.PrepaidFuelPrice,
+		order.Policy.KMAllowance,
+		order.Policy.ExcessKMPrice)
 	if err != nil {
-		tx.Rollback()
 		return err
 	}
 	n, err = result.RowsAffected()
 	if err != nil || n == 0 {
-		tx.Rollback()
 		return err
 	}
 
-	if len(order.Events) > 0 {
-		if err := repo.disp.Dispatch(order.Events); err != nil {
-			tx.Rollback()
+	if _, err := tx.ExecContext(ctx, deleteSwappedCarsOrder, order.ID, order.Car.ID); err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, deleteSwappedPoliciesOrder, order.ID, order.Policy.ID); err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, deleteChargesOrder, order.ID); err != nil {
+		return err
+	}
+
+	for _, c := range order.Charges {
+		if _, err := tx.ExecContext(ctx, insertChargeOrder, order.ID, c.Kind, c.Description, c.Amount); err != nil {
 			return err
 		}
 	}
 
-	if err := tx.Commit(); err != nil {
-		tx.Rollback()
+	if _, err := tx.ExecContext(ctx, deleteSwapsOrder, order.ID); err != nil {
 		return err
 	}
 
+	for _, s := range order.CarSwaps {
+		if _, err := tx.ExecContext(ctx, insertSwapOrder, order.ID, s.FromCarId, s.ToCarId, s.FromCarModel, s.ToCarModel, s.Repriced, s.Reason, s.Date); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
@@ -0,0 +1,46 @@
+package repository
+
+import (
+	"sync"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/rental/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/rental/domain"
+)
+
+type positionRepositoryInMemory struct {
+	positions map[string]domain.CarPosition
+	*sync.RWMutex
+}
+
+func NewPositionRepositoryInMemory(positions []domain.CarPosition) *positionRepositoryInMemory {
+	positionsMap := make(map[string]domain.CarPosition)
+	for _, v := range positions {
+		if last, exists := positionsMap[v.OrderId]; !exists || v.RecordedAt.After(last.RecordedAt) {
+			positionsMap[v.OrderId] = v
+		}
+	}
+	return &positionRepositoryInMemory{positionsMap, &sync.RWMutex{}}
+}
+
+func (repo positionRepositoryInMemory) FindLastByOrder(orderId string) (*domain.CarPosition, error) {
+	repo.Lock()
+	defer repo.Unlock()
+
+	p, exists := repo.positions[orderId]
+	if !exists {
+		return nil, application.ErrInvalidPosition
+	}
+
+	return &p, nil
+}
+
+func (repo *positionRepositoryInMemory) Save(position domain.CarPosition) error {
+	repo.Lock()
+	defer repo.Unlock()
+
+	if last, exists := repo.positions[position.OrderId]; !exists || !position.RecordedAt.Before(last.RecordedAt) {
+		repo.positions[position.OrderId] = position
+	}
+
+	return nil
+}
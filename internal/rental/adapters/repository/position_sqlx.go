@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/validation"
+	"github.com/thiagotrs/rentalcar-ddd/internal/rental/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/rental/domain"
+)
+
+const (
+	findLastPositionByOrder = `SELECT * FROM positions WHERE "orderId" = $1 ORDER BY "recordedAt" DESC LIMIT 1`
+	insertPosition          = `
+	INSERT INTO positions VALUES (:orderId, :carId, :latitude, :longitude, :recordedAt) 
+	ON CONFLICT("orderId", "recordedAt") DO NOTHING`
+)
+
+type positionRepositorySqlx struct {
+	ctx context.Context
+	DB  *sqlx.DB
+}
+
+func NewPositionRepositorySqlx(ctx context.Context, DB *sqlx.DB) *positionRepositorySqlx {
+	return &positionRepositorySqlx{ctx, DB}
+}
+
+func (repo *positionRepositorySqlx) FindLastByOrder(orderId string) (*domain.CarPosition, error) {
+	var position domain.CarPosition
+
+	if err := repo.DB.GetContext(repo.ctx, &position, findLastPositionByOrder, orderId); err != nil {
+		return nil, application.ErrInvalidPosition
+	}
+
+	return &position, nil
+}
+
+func (repo *positionRepositorySqlx) Save(position domain.CarPosition) error {
+	if err := validation.ValidateEntity(position); err != nil {
+		return application.ErrInvalidPosition
+	}
+	if _, err := repo.DB.NamedExecContext(repo.ctx, insertPosition, position); err != nil {
+		return application.ErrInvalidPosition
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/rental/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/rental/domain"
+)
+
+type subscriptionRepositoryInMemory struct {
+	subscriptions map[string]domain.Subscription
+	*sync.RWMutex
+}
+
+func NewSubscriptionRepositoryInMemory(subscriptions []domain.Subscription) *subscriptionRepositoryInMemory {
+	subscriptionsMap := make(map[string]domain.Subscription)
+	for _, v := range subscriptions {
+		subscriptionsMap[v.ID] = v
+	}
+	return &subscriptionRepositoryInMemory{subscriptionsMap, &sync.RWMutex{}}
+}
+
+func (repo subscriptionRepositoryInMemory) FindOne(id string) (*domain.Subscription, error) {
+	repo.RLock()
+	defer repo.RUnlock()
+
+	s, exists := repo.subscriptions[id]
+	if !exists {
+		return nil, application.ErrNotFoundSubscription
+	}
+
+	s.Cycles = append([]domain.BillingCycle{}, s.Cycles...)
+	s.CarSwaps = append([]domain.CarSwap{}, s.CarSwaps...)
+
+	return &s, nil
+}
+
+func (repo subscriptionRepositoryInMemory) FindDueForBilling(at time.Time) []domain.Subscription {
+	repo.RLock()
+	defer repo.RUnlock()
+
+	subscriptions := []domain.Subscription{}
+	for _, s := range repo.subscriptions {
+		if s.BillingDue(at) {
+			s.Cycles = append([]domain.BillingCycle{}, s.Cycles...)
+			s.CarSwaps = append([]domain.CarSwap{}, s.CarSwaps...)
+			subscriptions = append(subscriptions, s)
+		}
+	}
+
+	sort.Slice(subscriptions, func(i, j int) bool {
+		return subscriptions[i].ID < subscriptions[j].ID
+	})
+
+	return subscriptions
+}
+
+func (repo *subscriptionRepositoryInMemory) Save(subscription domain.Subscription) error {
+	repo.Lock()
+	defer repo.Unlock()
+
+	repo.subscriptions[subscription.ID] = subscription
+
+	return nil
+}
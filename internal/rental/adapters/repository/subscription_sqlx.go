@@ -0,0 +1,219 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/events"
+	"github.com/thiagotrs/rentalcar-ddd/internal/rental/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/rental/domain"
+)
+
+const (
+	findSubscription = `
+	SELECT id, status, "dateFrom", "dateTo", "pausedAt", pauses, "stationId", "lastKM", "terminationFee" FROM subscriptions 
+	WHERE id = $1 LIMIT 1`
+
+	findDueSubscriptions = `
+	SELECT subscriptions.id FROM subscriptions JOIN scycles ON scycles."subscriptionId" = subscriptions.id 
+	WHERE subscriptions.status = $1 AND scycles.billed = false AND scycles."dateTo" <= $2 
+	ORDER BY subscriptions.id`
+
+	findCarBySubscription = `
+	SELECT id, age, plate, document, "carModel", "initialKM", "finalKM", status, "stationId", energy, "initialFuel", "finalFuel" FROM scars 
+	WHERE "subscriptionId" = $1 LIMIT 1`
+
+	findPolicyBySubscription = `
+	SELECT id, name, price, unit, "minUnit", "carModel", "categoryId", "refuelPrice", "rechargePrice", "prepaidFuelPrice", "kmAllowance", "excessKMPrice" FROM spolicies 
+	WHERE "subscriptionId" = $1 LIMIT 1`
+
+	upsertSubscription = `
+	INSERT INTO subscriptions (id, status, "dateFrom", "dateTo", "pausedAt", pauses, "stationId", "lastKM", "terminationFee") 
+	VALUES (:id, :status, :dateFrom, :dateTo, :pausedAt, :pauses, :stationId, :lastKM, :terminationFee) 
+	ON CONFLICT(id) DO 
+	UPDATE SET status = :status, "dateFrom" = :dateFrom, "dateTo" = :dateTo, "pausedAt" = :pausedAt, pauses = :pauses, "stationId" = :stationId, "lastKM" = :lastKM, "terminationFee" = :terminationFee 
+	WHERE subscriptions.id = :id`
+
+	upsertCarSubscription = `
+	INSERT INTO scars (id, "subscriptionId", age, plate, document, "carModel", "initialKM", "finalKM", status, "stationId", energy, "initialFuel", "finalFuel") 
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+	ON CONFLICT(id, "subscriptionId") DO 
+	UPDATE SET age = $3, plate = $4, document = $5, "carModel" = $6, "initialKM" = $7, "finalKM" = $8, status = $9, "stationId" = $10, energy = $11, "initialFuel" = $12, "finalFuel" = $13 
+	WHERE scars.id = $1 AND scars."subscriptionId" = $2`
+	deleteSwappedCarsSubscription = `DELETE FROM scars WHERE "subscriptionId" = $1 AND id <> $2`
+
+	upsertPolicySubscription = `
+	INSERT INTO spolicies (id, "subscriptionId", name, price, unit, "minUnit", "carModel", "categoryId", "refuelPrice", "rechargePrice", "prepaidFuelPrice", "kmAllowance", "excessKMPrice") 
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13) 
+	ON CONFLICT(id, "subscriptionId") DO 
+	UPDATE SET name = $3, price = $4, unit = $5, "minUnit" = $6, "carModel" = $7, "categoryId" = $8, "refuelPrice" = $9, "rechargePrice" = $10, "prepaidFuelPrice" = $11, "kmAllowance" = $12, "excessKMPrice" = $13 
+	WHERE spolicies.id = $1 AND spolicies."subscriptionId" = $2`
+
+	findCyclesBySubscription = `
+	SELECT number, "dateFrom", "dateTo", km, fee, overage, billed FROM scycles 
+	WHERE "subscriptionId" = $1 ORDER BY number`
+	upsertCycleSubscription = `
+	INSERT INTO scycles ("subscriptionId", number, "dateFrom", "dateTo", km, fee, overage, billed) 
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8) 
+	ON CONFLICT("subscriptionId", number) DO 
+	UPDATE SET "dateFrom" = $3, "dateTo" = $4, km = $5, fee = $6, overage = $7, billed = $8 
+	WHERE scycles."subscriptionId" = $1 AND scycles.number = $2`
+
+	findSwapsBySubscription = `
+	SELECT "fromCarId", "toCarId", "fromCarModel", "toCarModel", repriced, reason, date FROM sswaps 
+	WHERE "subscriptionId" = $1 ORDER BY date`
+	deleteSwapsSubscription = `DELETE FROM sswaps WHERE "subscriptionId" = $1`
+	insertSwapSubscription  = `
+	INSERT INTO sswaps ("subscriptionId", "fromCarId", "toCarId", "fromCarModel", "toCarModel", repriced, reason, date) 
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+)
+
+type subscriptionRepositorySqlx struct {
+	ctx  context.Context
+	DB   *sqlx.DB
+	disp events.Dispatcher
+}
+
+func NewSubscriptionRepositorySqlx(ctx context.Context, DB *sqlx.DB, disp events.Dispatcher) *subscriptionRepositorySqlx {
+	return &subscriptionRepositorySqlx{ctx, DB, disp}
+}
+
+func (repo *subscriptionRepositorySqlx) FindOne(id string) (*domain.Subscription, error) {
+	var subscription domain.Subscription
+
+	if err := repo.DB.GetContext(repo.ctx, &subscription, findSubscription, id); err != nil {
+		return nil, application.ErrNotFoundSubscription
+	}
+
+	if err := repo.DB.GetContext(repo.ctx, &subscription.Car, findCarBySubscription, subscription.ID); err != nil {
+		return nil, application.ErrNotFoundSubscription
+	}
+	if err := repo.DB.GetContext(repo.ctx, &subscription.Policy, findPolicyBySubscription, subscription.ID); err != nil {
+		return nil, application.ErrNotFoundSubscription
+	}
+	subscription.Car.Upgraded = subscription.Car.CarModel != subscription.Policy.CarModel
+
+	subscription.Cycles = []domain.BillingCycle{}
+	if err := repo.DB.SelectContext(repo.ctx, &subscription.Cycles, findCyclesBySubscription, subscription.ID); err != nil {
+		return nil, application.ErrNotFoundSubscription
+	}
+
+	subscription.CarSwaps = []domain.CarSwap{}
+	if err := repo.DB.SelectContext(repo.ctx, &subscription.CarSwaps, findSwapsBySubscription, subscription.ID); err != nil {
+		return nil, application.ErrNotFoundSubscription
+	}
+
+	return &subscription, nil
+}
+
+func (repo *subscriptionRepositorySqlx) FindDueForBilling(at time.Time) []domain.Subscription {
+	subscriptions := []domain.Subscription{}
+
+	ids := []string{}
+	if err := repo.DB.SelectContext(repo.ctx, &ids, findDueSubscriptions, domain.ActiveSubscription, at); err != nil {
+		return subscriptions
+	}
+
+	for _, id := range ids {
+		if subscription, err := repo.FindOne(id); err == nil {
+			subscriptions = append(subscriptions, *subscription)
+		}
+	}
+
+	return subscriptions
+}
+
+func (repo *subscriptionRepositorySqlx) Save(subscription domain.Subscription) error {
+	tx, err := repo.DB.BeginTxx(repo.ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := saveSubscription(repo.ctx, tx, subscription); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if len(subscription.Events) > 0 {
+		if err := repo.disp.Dispatch(subscription.Events); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return nil
+}
+
+func saveSubscription(ctx context.Context, tx *sqlx.Tx, subscription domain.Subscription) error {
+	if _, err := tx.NamedExecContext(ctx, upsertSubscription, subscription); err != nil {
+		return err
+	}
+
+	car := subscription.Car
+	if _, err := tx.ExecContext(
+		ctx,
+		upsertCarSubscription,
+		car.ID,
+		subscription.ID,
+		car.Age,
+		car.Plate,
+		car.Document,
+		car.CarModel,
+		car.InitialKM,
+		car.FinalKM,
+		car.Status,
+		car.StationId,
+		car.Energy,
+		car.InitialFuel,
+		car.FinalFuel); err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, deleteSwappedCarsSubscription, subscription.ID, car.ID); err != nil {
+		return err
+	}
+
+	policy := subscription.Policy
+	if _, err := tx.ExecContext(
+		ctx,
+		upsertPolicySubscription,
+		policy.ID,
+		subscription.ID,
+		policy.Name,
+		policy.Price,
+		policy.Unit,
+		policy.MinUnit,
+		policy.CarModel,
+		policy.CategoryId,
+		policy.RefuelPrice,
+		policy.RechargePrice,
+		policy.PrepaidFuelPrice,
+		policy.KMAllowance,
+		policy.ExcessKMPrice); err != nil {
+		return err
+	}
+
+	for _, c := range subscription.Cycles {
+		if _, err := tx.ExecContext(ctx, upsertCycleSubscription, subscription.ID, c.Number, c.DateFrom, c.DateTo, c.KM, c.Fee, c.Overage, c.Billed); err != nil {
+			return err
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, deleteSwapsSubscription, subscription.ID); err != nil {
+		return err
+	}
+
+	for _, s := range subscription.CarSwaps {
+		if _, err := tx.ExecContext(ctx, insertSwapSubscription, subscription.ID, s.FromCarId, s.ToCarId, s.FromCarModel, s.ToCarModel, s.Repriced, s.Reason, s.Date); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
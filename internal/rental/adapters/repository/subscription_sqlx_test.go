@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/thiagotrs/rentalcar-ddd/internal/rental/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/rental/domain"
+)
+
+func ClearSubscriptionDB(t *testing.T, db *sqlx.DB) {
+	t.Helper()
+	const deleteAllSubscriptions = "DELETE FROM sswaps; DELETE FROM scycles; DELETE FROM scars; DELETE FROM spolicies; DELETE FROM subscriptions"
+
+	if _, err := db.Exec(deleteAllSubscriptions); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSubscriptionRepositorySqlx_Save(t *testing.T) {
+	db := GetDBConn(t)
+	defer db.Close()
+
+	ClearSubscriptionDB(t, db)
+	defer ClearSubscriptionDB(t, db)
+
+	at := time.Date(2022, time.March, 1, 10, 0, 0, 0, time.UTC)
+
+	policy := *newPolicyFixture()
+	policy.Unit = domain.PerMonth
+	policy.MinUnit = 3
+	policy.KMAllowance = 1500
+	policy.ExcessKMPrice = 0.5
+
+	subscription, err := domain.NewSubscription(*newCarFixture(), "83369771-f9a4-48b7-b87b-463f19f7b187", policy, at)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	disp := &dispatcherMock{calls: make(map[string]uint)}
+	repo := NewSubscriptionRepositorySqlx(context.Background(), db, disp)
+
+	if err := repo.Save(*subscription); err != nil {
+		t.Fatal(err)
+	}
+
+	otherCar := *newCarFixture()
+	otherCar.ID = "2f6a7c1e-8b4d-4e3a-9f5c-6d1b2a3c4e5f"
+	otherCar.InitialKM = 30000
+
+	subscription.Events = nil
+	if err := subscription.Bill(at.AddDate(0, 1, 0), 14000); err != nil {
+		t.Fatal(err)
+	}
+	if err := subscription.SwapCar(otherCar, 14100, 80, "scheduled maintenance", at.AddDate(0, 1, 3)); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := repo.Save(*subscription); err != nil {
+		t.Fatal(err)
+	}
+
+	if disp.calls["Dispatch"] != 2 {
+		t.Error("unexpected dispatches", disp.calls["Dispatch"])
+	}
+
+	saved, err := repo.FindOne(subscription.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if saved.Car.ID != otherCar.ID || saved.LastKM != 30000 || saved.Policy.Unit != domain.PerMonth {
+		t.Error("unexpected subscription", saved)
+	}
+
+	if len(saved.Cycles) != 2 || !saved.Cycles[0].Billed || saved.Cycles[0].Overage != 250 || saved.Cycles[1].KM != 100 {
+		t.Error("unexpected cycles", saved.Cycles)
+	}
+
+	if len(saved.CarSwaps) != 1 || saved.CarSwaps[0].FromCarId != subscription.CarSwaps[0].FromCarId {
+		t.Error("unexpected swaps", saved.CarSwaps)
+	}
+
+	if _, err := repo.FindOne("9a0e5c34-1f4b-4d8e-8d4e-7b1f0c2d3e4f"); err != application.ErrNotFoundSubscription {
+		t.Error("unexpected error", err)
+	}
+
+	if due := repo.FindDueForBilling(at.AddDate(0, 2, -1)); len(due) != 0 {
+		t.Error("unexpected subscriptions due", due)
+	}
+
+	if due := repo.FindDueForBilling(at.AddDate(0, 2, 0)); len(due) != 1 || due[0].ID != subscription.ID {
+		t.Error("unexpected subscriptions due", due)
+	}
+}
@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/rental/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/rental/domain"
+)
+
+type waitlistRepositoryInMemory struct {
+	entries map[string]domain.WaitlistEntry
+	*sync.RWMutex
+}
+
+func NewWaitlistRepositoryInMemory(entries []domain.WaitlistEntry) *waitlistRepositoryInMemory {
+	entriesMap := make(map[string]domain.WaitlistEntry)
+	for _, v := range entries {
+		entriesMap[v.ID] = v
+	}
+	return &waitlistRepositoryInMemory{entriesMap, &sync.RWMutex{}}
+}
+
+func (repo waitlistRepositoryInMemory) FindOne(id string) (*domain.WaitlistEntry, error) {
+	repo.RLock()
+	defer repo.RUnlock()
+
+	e, exists := repo.entries[id]
+	if !exists {
+		return nil, application.ErrNotFoundWaitlist
+	}
+
+	return &e, nil
+}
+
+func (repo waitlistRepositoryInMemory) FindWaiting(stationId, carModel string) []domain.WaitlistEntry {
+	return repo.findBy(func(e domain.WaitlistEntry) bool {
+		return e.Status == domain.Waiting && e.StationId == stationId && e.CarModel == carModel
+	})
+}
+
+func (repo waitlistRepositoryInMemory) FindActive() []domain.WaitlistEntry {
+	return repo.findBy(func(e domain.WaitlistEntry) bool {
+		return e.Status == domain.Waiting || e.Status == domain.Held
+	})
+}
+
+func (repo waitlistRepositoryInMemory) FindByHeldCar(carId string) (*domain.WaitlistEntry, error) {
+	entries := repo.findBy(func(e domain.WaitlistEntry) bool {
+		return e.Status == domain.Held && e.HeldCar != nil && e.HeldCar.ID == carId
+	})
+	if len(entries) == 0 {
+		return nil, application.ErrNotFoundWaitlist
+	}
+
+	return &entries[0], nil
+}
+
+func (repo *waitlistRepositoryInMemory) Save(entry domain.WaitlistEntry) error {
+	repo.Lock()
+	defer repo.Unlock()
+
+	entry.Events = nil
+	entry.Position = 0
+	repo.entries[entry.ID] = entry
+
+	return nil
+}
+
+func (repo waitlistRepositoryInMemory) findBy(match func(domain.WaitlistEntry) bool) []domain.WaitlistEntry {
+	repo.RLock()
+	defer repo.RUnlock()
+
+	entries := []domain.WaitlistEntry{}
+	for _, e := range repo.entries {
+		if match(e) {
+			entries = append(entries, e)
+		}
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].CreatedAt.Before(entries[j].CreatedAt)
+	})
+
+	return entries
+}
@@ -0,0 +1,165 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/events"
+	"github.com/thiagotrs/rentalcar-ddd/internal/rental/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/rental/domain"
+)
+
+const (
+	findWaitlistEntry = `
+	SELECT id, status, "stationId", "categoryId", "carModel", "policyId", "dateFrom", "dateTo", contact, "createdAt", "heldUntil", "orderId" FROM waitlist 
+	WHERE id = $1 LIMIT 1`
+	findWaitingEntries = `
+	SELECT id, status, "stationId", "categoryId", "carModel", "policyId", "dateFrom", "dateTo", contact, "createdAt", "heldUntil", "orderId" FROM waitlist 
+	WHERE status = $1 AND "stationId" = $2 AND "carModel" = $3 ORDER BY "createdAt"`
+	findActiveEntries = `
+	SELECT id, status, "stationId", "categoryId", "carModel", "policyId", "dateFrom", "dateTo", contact, "createdAt", "heldUntil", "orderId" FROM waitlist 
+	WHERE status IN ($1, $2) ORDER BY "createdAt"`
+	findEntryByHeldCar = `
+	SELECT waitlist.id FROM waitlist JOIN wcars ON wcars."waitlistId" = waitlist.id 
+	WHERE wcars.id = $1 AND waitlist.status = $2 LIMIT 1`
+
+	upsertWaitlistEntry = `
+	INSERT INTO waitlist (id, status, "stationId", "categoryId", "carModel", "policyId", "dateFrom", "dateTo", contact, "createdAt", "heldUntil", "orderId") 
+	VALUES (:id, :status, :stationId, :categoryId, :carModel, :policyId, :dateFrom, :dateTo, :contact, :createdAt, :heldUntil, :orderId) 
+	ON CONFLICT(id) DO 
+	UPDATE SET status = :status, "heldUntil" = :heldUntil, "orderId" = :orderId 
+	WHERE waitlist.id = :id`
+
+	findCarByWaitlistEntry = `
+	SELECT id, age, plate, document, "carModel", "initialKM", "finalKM", status, "stationId", energy, "initialFuel", "finalFuel" FROM wcars 
+	WHERE "waitlistId" = $1 LIMIT 1`
+	upsertCarWaitlistEntry = `
+	INSERT INTO wcars (id, "waitlistId", age, plate, document, "carModel", "initialKM", "finalKM", status, "stationId", energy, "initialFuel", "finalFuel") 
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+	ON CONFLICT(id, "waitlistId") DO 
+	UPDATE SET age = $3, plate = $4, document = $5, "carModel" = $6, "initialKM" = $7, "finalKM" = $8, status = $9, "stationId" = $10, energy = $11, "initialFuel" = $12, "finalFuel" = $13 
+	WHERE wcars.id = $1 AND wcars."waitlistId" = $2`
+)
+
+type waitlistRepositorySqlx struct {
+	ctx  context.Context
+	DB   *sqlx.DB
+	disp events.Dispatcher
+}
+
+func NewWaitlistRepositorySqlx(ctx context.Context, DB *sqlx.DB, disp events.Dispatcher) *waitlistRepositorySqlx {
+	return &waitlistRepositorySqlx{ctx, DB, disp}
+}
+
+func (repo *waitlistRepositorySqlx) FindOne(id string) (*domain.WaitlistEntry, error) {
+	var entry domain.WaitlistEntry
+
+	if err := repo.DB.GetContext(repo.ctx, &entry, findWaitlistEntry, id); err != nil {
+		return nil, application.ErrNotFoundWaitlist
+	}
+
+	if err := repo.findHeldCar(&entry); err != nil {
+		return nil, application.ErrNotFoundWaitlist
+	}
+
+	return &entry, nil
+}
+
+func (repo *waitlistRepositorySqlx) FindWaiting(stationId, carModel string) []domain.WaitlistEntry {
+	entries := []domain.WaitlistEntry{}
+
+	if err := repo.DB.SelectContext(repo.ctx, &entries, findWaitingEntries, domain.Waiting, stationId, carModel); err != nil {
+		return []domain.WaitlistEntry{}
+	}
+
+	return entries
+}
+
+func (repo *waitlistRepositorySqlx) FindActive() []domain.WaitlistEntry {
+	entries := []domain.WaitlistEntry{}
+
+	if err := repo.DB.SelectContext(repo.ctx, &entries, findActiveEntries, domain.Waiting, domain.Held); err != nil {
+		return []domain.WaitlistEntry{}
+	}
+
+	for i := range entries {
+		if err := repo.findHeldCar(&entries[i]); err != nil {
+			return []domain.WaitlistEntry{}
+		}
+	}
+
+	return entries
+}
+
+func (repo *waitlistRepositorySqlx) FindByHeldCar(carId string) (*domain.WaitlistEntry, error) {
+	var id string
+
+	if err := repo.DB.GetContext(repo.ctx, &id, findEntryByHeldCar, carId, domain.Held); err != nil {
+		return nil, application.ErrNotFoundWaitlist
+	}
+
+	return repo.FindOne(id)
+}
+
+func (repo *waitlistRepositorySqlx) Save(entry domain.WaitlistEntry) error {
+	tx, err := repo.DB.BeginTxx(repo.ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.NamedExecContext(repo.ctx, upsertWaitlistEntry, entry); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if car := entry.HeldCar; car != nil {
+		if _, err := tx.ExecContext(
+			repo.ctx,
+			upsertCarWaitlistEntry,
+			car.ID,
+			entry.ID,
+			car.Age,
+			car.Plate,
+			car.Document,
+			car.CarModel,
+			car.InitialKM,
+			car.FinalKM,
+			car.Status,
+			car.StationId,
+			car.Energy,
+			car.InitialFuel,
+			car.FinalFuel); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	if len(entry.Events) > 0 {
+		if err := repo.disp.Dispatch(entry.Events); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return nil
+}
+
+func (repo *waitlistRepositorySqlx) findHeldCar(entry *domain.WaitlistEntry) error {
+	var car domain.Car
+
+	switch err := repo.DB.GetContext(repo.ctx, &car, findCarByWaitlistEntry, entry.ID); err {
+	case nil:
+		entry.HeldCar = &car
+	case sql.ErrNoRows:
+	default:
+		return err
+	}
+
+	return nil
+}
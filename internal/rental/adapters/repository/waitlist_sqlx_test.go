@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/thiagotrs/rentalcar-ddd/internal/rental/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/rental/domain"
+)
+
+func ClearWaitlistDB(t *testing.T, db *sqlx.DB) {
+	t.Helper()
+	const deleteAllWaitlist = "DELETE FROM wcars; DELETE FROM waitlist"
+
+	if _, err := db.Exec(deleteAllWaitlist); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestWaitlistRepositorySqlx_Save(t *testing.T) {
+	db := GetDBConn(t)
+	defer db.Close()
+
+	ClearWaitlistDB(t, db)
+	defer ClearWaitlistDB(t, db)
+
+	at := time.Date(2022, time.March, 1, 10, 0, 0, 0, time.UTC)
+	car := *newCarFixture()
+
+	newEntry := func(createdAt time.Time) domain.WaitlistEntry {
+		entry, err := domain.NewWaitlistEntry(car.StationId, "479ab9e7-ad16-4864-8e49-29b15e4b390e", car.CarModel, "5ecf09ce-8c41-4faa-a4e5-824af9c80892", "ana@mail.com", at, at.AddDate(0, 0, 5), createdAt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return *entry
+	}
+	second := newEntry(at.Add(time.Hour))
+	first := newEntry(at)
+
+	disp := &dispatcherMock{calls: make(map[string]uint)}
+	repo := NewWaitlistRepositorySqlx(context.Background(), db, disp)
+
+	for _, e := range []domain.WaitlistEntry{second, first} {
+		if err := repo.Save(e); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	waiting := repo.FindWaiting(car.StationId, car.CarModel)
+	if len(waiting) != 2 || waiting[0].ID != first.ID || waiting[1].ID != second.ID {
+		t.Error("unexpected waiting entries", waiting)
+	}
+
+	if err := first.Hold(car, at.Add(time.Hour*2)); err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.Save(first); err != nil {
+		t.Fatal(err)
+	}
+
+	if disp.calls["Dispatch"] != 1 {
+		t.Error("unexpected dispatches", disp.calls["Dispatch"])
+	}
+
+	held, err := repo.FindByHeldCar(car.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if held.ID != first.ID || held.Status != domain.Held || held.HeldCar == nil || held.HeldCar.Plate != car.Plate || !held.HeldUntil.Equal(*first.HeldUntil) {
+		t.Error("unexpected held entry", held)
+	}
+
+	if active := repo.FindActive(); len(active) != 2 || active[0].HeldCar == nil || active[1].HeldCar != nil {
+		t.Error("unexpected active entries", active)
+	}
+
+	if _, err := repo.FindOne("9a0e5c34-1f4b-4d8e-8d4e-7b1f0c2d3e4f"); err != application.ErrNotFoundWaitlist {
+		t.Error("unexpected error", err)
+	}
+}
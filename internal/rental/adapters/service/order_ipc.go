@@ -1,6 +1,8 @@
 package service
 
 import (
+	"time"
+
 	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/ipc"
 	"github.com/thiagotrs/rentalcar-ddd/internal/rental/application"
 	"github.com/thiagotrs/rentalcar-ddd/internal/rental/domain"
@@ -9,10 +11,11 @@ import (
 type orderServiceIPC struct {
 	logistics ipc.LogisticsIPC
 	pricing   ipc.PricingIPC
+	loyalty   ipc.LoyaltyIPC
 }
 
-func NewOrderServiceIPC(logistics ipc.LogisticsIPC, pricing ipc.PricingIPC) *orderServiceIPC {
-	return &orderServiceIPC{logistics, pricing}
+func NewOrderServiceIPC(logistics ipc.LogisticsIPC, pricing ipc.PricingIPC, loyalty ipc.LoyaltyIPC) *orderServiceIPC {
+	return &orderServiceIPC{logistics, pricing, loyalty}
 }
 
 func (svc orderServiceIPC) GetPolicy(categoryId, carModel, policyId string) (*domain.Policy, error) {
@@ -29,23 +32,130 @@ func (svc orderServiceIPC) GetPolicy(categoryId, carModel, policyId string) (*do
 		MinUnit:    policy.MinUnit,
 		CarModel:   carModel,
 		CategoryId: categoryId,
+
+		RefuelPrice:      policy.RefuelPrice,
+		RechargePrice:    policy.RechargePrice,
+		PrepaidFuelPrice: policy.PrepaidFuelPrice,
+		KMAllowance:      policy.KMAllowance,
+		ExcessKMPrice:    policy.ExcessKMPrice,
 	}, nil
 }
 
-func (svc orderServiceIPC) GetCar(stationId, modelId string) (*domain.Car, error) {
-	car, err := svc.logistics.GetCar(stationId, modelId)
+func (svc orderServiceIPC) GetModelCategory(modelId string) (string, error) {
+	categoryId, err := svc.pricing.GetModelCategory(modelId)
+	if err != nil {
+		return "", application.ErrInvalidPolicy
+	}
+
+	return categoryId, nil
+}
+
+func (svc orderServiceIPC) GetCar(stationId, modelId string, upgrade bool) (*domain.Car, error) {
+	car, err := svc.logistics.GetCar(stationId, modelId, upgrade)
+	if err != nil {
+		return nil, application.ErrInvalidCar
+	}
+
+	rentalCar := newCar(*car, modelId)
+
+	return &rentalCar, nil
+}
+
+func (svc orderServiceIPC) GetCars(stationId string, modelIds []string) ([]domain.Car, error) {
+	cars, err := svc.logistics.GetCars(stationId, modelIds)
 	if err != nil {
 		return nil, application.ErrInvalidCar
 	}
 
-	return &domain.Car{
+	rentalCars := []domain.Car{}
+	for i, car := range cars {
+		rentalCars = append(rentalCars, newCar(car, modelIds[i]))
+	}
+
+	return rentalCars, nil
+}
+
+func (svc orderServiceIPC) ReserveCars(reservationId, stationId string, carIds []string) error {
+	if err := svc.logistics.ReserveCars(reservationId, stationId, carIds); err != nil {
+		return application.ErrInvalidCar
+	}
+
+	return nil
+}
+
+func (svc orderServiceIPC) ReleaseCars(reservationId, stationId string, carIds []string) error {
+	if err := svc.logistics.ReleaseCars(reservationId, stationId, carIds); err != nil {
+		return application.ErrInvalidCar
+	}
+
+	return nil
+}
+
+func (svc orderServiceIPC) GetCarKM(carId string) (uint64, error) {
+	km, err := svc.logistics.GetCarKM(carId)
+	if err != nil {
+		return 0, application.ErrInvalidCar
+	}
+
+	return km, nil
+}
+
+func newCar(car ipc.CarData, modelId string) domain.Car {
+	return domain.Car{
 		ID:        car.ID,
 		Age:       car.Age,
 		Plate:     car.Plate,
 		Document:  car.Document,
-		CarModel:  car.Model,
+		CarModel:  car.ModelId,
 		InitialKM: car.KM,
 		Status:    domain.CarStatus(car.Status),
 		StationId: car.StationId,
+
+		Energy:      domain.EnergyType(car.Energy),
+		InitialFuel: car.FuelLevel,
+		Upgraded:    car.ModelId != modelId,
+	}
+}
+
+func (svc orderServiceIPC) IsStationOpen(stationId string, at time.Time, keyDrop bool) (bool, error) {
+	open, err := svc.logistics.IsStationOpen(stationId, at, keyDrop)
+	if err != nil {
+		return false, application.ErrStationClosed
+	}
+
+	return open, nil
+}
+
+func (svc orderServiceIPC) GetStationLocation(stationId string) (float64, float64, error) {
+	latitude, longitude, err := svc.logistics.GetStationLocation(stationId)
+	if err != nil {
+		return 0, 0, application.ErrStationNotLocated
+	}
+
+	return latitude, longitude, nil
+}
+
+func (svc orderServiceIPC) GetMemberBenefits(memberId string) (*application.MemberBenefits, error) {
+	benefits, err := svc.loyalty.GetMemberBenefits(memberId)
+	if err != nil {
+		return nil, application.ErrNotFoundMember
+	}
+
+	return &application.MemberBenefits{
+		FreePrepaidFuel: benefits.FreePrepaidFuel,
+		UpgradePriority: benefits.UpgradePriority,
 	}, nil
 }
+
+func (svc orderServiceIPC) RedeemPoints(memberId, orderId string, points uint, maxAmount float32) (float32, error) {
+	discount, err := svc.loyalty.RedeemPoints(memberId, orderId, points, maxAmount)
+	if err != nil {
+		return 0, application.ErrInvalidRedemption
+	}
+
+	return discount, nil
+}
+
+func (svc orderServiceIPC) RefundPoints(memberId, orderId string) error {
+	return svc.loyalty.RefundPoints(memberId, orderId)
+}
@@ -0,0 +1,135 @@
+package application
+
+import (
+	"time"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/validation"
+	"github.com/thiagotrs/rentalcar-ddd/internal/rental/domain"
+)
+
+type AccountUseCase interface {
+	GetAccounts() []domain.CorporateAccount
+	GetAccountById(id string) (*domain.CorporateAccount, error)
+	CreateAccount(name, document string, creditLimit float32) (*domain.CorporateAccount, error)
+	ChangeCreditLimit(id string, creditLimit float32) error
+	AuthorizeDriver(id, license, name string) error
+	RevokeDriver(id, license string) error
+	SetRate(id, categoryId string, unit domain.Unit, price float32) error
+	DelRate(id, categoryId string, unit domain.Unit) error
+	GetMonthlyInvoice(id string, year int, month time.Month) (*domain.AccountInvoice, error)
+}
+
+type accountUseCase struct {
+	accountRepo AccountRepository
+}
+
+func NewAccountUseCase(accountRepo AccountRepository) *accountUseCase {
+	return &accountUseCase{accountRepo}
+}
+
+func (uc accountUseCase) GetAccounts() []domain.CorporateAccount {
+	return uc.accountRepo.FindAll()
+}
+
+func (uc accountUseCase) GetAccountById(id string) (*domain.CorporateAccount, error) {
+	if err := validation.ValidId(id); err != nil {
+		return nil, ErrInvalidId
+	}
+
+	account, err := uc.accountRepo.FindOne(id)
+	if err != nil {
+		return nil, ErrNotFoundAccount
+	}
+
+	return account, nil
+}
+
+func (uc accountUseCase) CreateAccount(name, document string, creditLimit float32) (*domain.CorporateAccount, error) {
+	account, err := domain.NewCorporateAccount(name, document, creditLimit)
+	if err != nil {
+		return nil, ErrInvalidEntity
+	}
+
+	if err := uc.accountRepo.Save(*account); err != nil {
+		return nil, ErrInvalidAccount
+	}
+
+	return account, nil
+}
+
+func (uc accountUseCase) ChangeCreditLimit(id string, creditLimit float32) error {
+	return uc.updateAccount(id, func(account *domain.CorporateAccount) error {
+		if err := account.SetCreditLimit(creditLimit); err != nil {
+			return ErrInvalidAccount
+		}
+		return nil
+	})
+}
+
+func (uc accountUseCase) AuthorizeDriver(id, license, name string) error {
+	return uc.updateAccount(id, func(account *domain.CorporateAccount) error {
+		if err := account.AuthorizeDriver(domain.Driver{License: license, Name: name}); err != nil {
+			return ErrInvalidDriver
+		}
+		return nil
+	})
+}
+
+func (uc accountUseCase) RevokeDriver(id, license string) error {
+	return uc.updateAccount(id, func(account *domain.CorporateAccount) error {
+		if err := account.RevokeDriver(license); err != nil {
+			return ErrInvalidDriver
+		}
+		return nil
+	})
+}
+
+func (uc accountUseCase) SetRate(id, categoryId string, unit domain.Unit, price float32) error {
+	return uc.updateAccount(id, func(account *domain.CorporateAccount) error {
+		if err := account.SetRate(domain.NegotiatedRate{CategoryId: categoryId, Unit: unit, Price: price}); err != nil {
+			return ErrInvalidRate
+		}
+		return nil
+	})
+}
+
+func (uc accountUseCase) DelRate(id, categoryId string, unit domain.Unit) error {
+	return uc.updateAccount(id, func(account *domain.CorporateAccount) error {
+		if err := account.DelRate(categoryId, unit); err != nil {
+			return ErrInvalidRate
+		}
+		return nil
+	})
+}
+
+func (uc accountUseCase) GetMonthlyInvoice(id string, year int, month time.Month) (*domain.AccountInvoice, error) {
+	if month < time.January || month > time.December {
+		return nil, ErrInvalidEntity
+	}
+
+	account, err := uc.GetAccountById(id)
+	if err != nil {
+		return nil, err
+	}
+
+	invoice := account.MonthlyInvoice(uc.accountRepo.FindOrders(account.ID), year, month)
+
+	return &invoice, nil
+}
+
+func (uc accountUseCase) updateAccount(id string, update func(account *domain.CorporateAccount) error) error {
+	account, err := uc.GetAccountById(id)
+	if err != nil {
+		return err
+	}
+
+	if err := update(account); err != nil {
+		return err
+	}
+
+	if err := uc.accountRepo.Save(*account); err != nil {
+		return ErrInvalidAccount
+	}
+
+	return nil
+}
@@ -0,0 +1,223 @@
+package application
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/rental/domain"
+)
+
+type accountRepositoryMock struct {
+	expectedFindOneAccount *domain.CorporateAccount
+	expectedFindOneErr     error
+	expectedFindOrders     []domain.Order
+	expectedSaveErr        error
+	savedAccount           *domain.CorporateAccount
+	calls                  map[string]uint
+}
+
+func (m *accountRepositoryMock) FindAll() []domain.CorporateAccount {
+	m.calls["FindAll"] = m.calls["FindAll"] + 1
+	return []domain.CorporateAccount{}
+}
+
+func (m *accountRepositoryMock) FindOne(id string) (*domain.CorporateAccount, error) {
+	m.calls["FindOne"] = m.calls["FindOne"] + 1
+	if m.expectedFindOneAccount == nil {
+		return nil, ErrNotFoundAccount
+	}
+	account := *m.expectedFindOneAccount
+	return &account, m.expectedFindOneErr
+}
+
+func (m *accountRepositoryMock) FindOrders(accountId string) []domain.Order {
+	m.calls["FindOrders"] = m.calls["FindOrders"] + 1
+	return m.expectedFindOrders
+}
+
+func (m *accountRepositoryMock) Save(account domain.CorporateAccount) error {
+	m.calls["Save"] = m.calls["Save"] + 1
+	m.savedAccount = &account
+	return m.expectedSaveErr
+}
+
+func newAccountFixture() *domain.CorporateAccount {
+	return &domain.CorporateAccount{
+		ID:          "b0f4c2a6-1d3e-4f5a-9b8c-7d6e5f4a3b2c",
+		Name:        "ACME",
+		Document:    "12.345.678/0001-90",
+		CreditLimit: 1000,
+		Drivers:     []domain.Driver{{License: "04512345678", Name: "Ana Souza"}},
+		Rates:       []domain.NegotiatedRate{{CategoryId: "479ab9e7-ad16-4864-8e49-29b15e4b390e", Unit: domain.PerDay, Price: 25}},
+	}
+}
+
+func TestOrderUseCase_OpenForAccount(t *testing.T) {
+	limitedAccount := newAccountFixture()
+	limitedAccount.CreditLimit = 100
+
+	type want struct {
+		err   error
+		price float32
+		saves uint
+	}
+
+	testCases := []struct {
+		name    string
+		account *domain.CorporateAccount
+		license string
+		saveErr error
+		want    want
+	}{
+		{
+			name:    "correct negotiated price",
+			account: newAccountFixture(),
+			license: "04512345678",
+			want:    want{err: nil, price: 25, saves: 1},
+		},
+		{
+			name:    "incorrect unauthorized driver",
+			account: newAccountFixture(),
+			license: "99999999999",
+			want:    want{err: ErrUnauthorizedDriver, saves: 0},
+		},
+		{
+			name:    "incorrect credit limit exceeded",
+			account: limitedAccount,
+			license: "04512345678",
+			want:    want{err: ErrCreditLimitExceeded, saves: 0},
+		},
+		{
+			name:    "incorrect credit taken by a concurrent order",
+			account: newAccountFixture(),
+			license: "04512345678",
+			saveErr: ErrCreditLimitExceeded,
+			want:    want{err: ErrCreditLimitExceeded, saves: 1},
+		},
+		{
+			name:    "incorrect account",
+			account: nil,
+			license: "04512345678",
+			want:    want{err: ErrNotFoundAccount, saves: 0},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			car := *newCarFixture()
+			car.Status = domain.Parked
+
+			var saved domain.Order
+			orderRepo := &orderSaveRecorder{orderRepositoryMock{expectedSaveChargedErr: tc.saveErr, calls: make(map[string]uint)}, &saved}
+			orderSvc := &orderOrderServiceMock{
+				expectedGetPolicy: newPolicyFixture(),
+				expectedGetCar:    &car,
+				calls:             make(map[string]uint),
+			}
+			accountRepo := &accountRepositoryMock{expectedFindOneAccount: tc.account, calls: make(map[string]uint)}
+			orderUC := NewOrderUseCase(orderRepo, orderSvc, accountRepo, &eligibilityRepositoryMock{calls: make(map[string]uint)}, &waitlistRepositoryMock{calls: make(map[string]uint)})
+
+			err := orderUC.Open(OpenOrderInput{
+				DateReservFrom: time.Now(),
+				DateReservTo:   time.Now().Add(time.Hour * 24 * 5),
+				StationFromId:  "83369771-f9a4-48b7-b87b-463f19f7b187",
+				StationToId:    "2520aade-a397-4e3c-a589-39c6ae5c2eff",
+				CategoryId:     "479ab9e7-ad16-4864-8e49-29b15e4b390e",
+				CarModel:       "UNO",
+				PolicyId:       "5ecf09ce-8c41-4faa-a4e5-824af9c80892",
+				AccountId:      "b0f4c2a6-1d3e-4f5a-9b8c-7d6e5f4a3b2c",
+				Driver:         domain.DriverProfile{License: tc.license},
+			})
+
+			if !errors.Is(err, tc.want.err) {
+				t.Fatal("unexpected error", err)
+			}
+
+			if orderRepo.calls["SaveCharged"] != tc.want.saves {
+				t.Error("unexpected saves", orderRepo.calls["SaveCharged"])
+			}
+
+			if err == nil && (saved.Policy.Price != tc.want.price || saved.AccountId != tc.account.ID || saved.DriverLicense != tc.license) {
+				t.Error("unexpected order", saved)
+			}
+		})
+	}
+}
+
+type orderSaveRecorder struct {
+	orderRepositoryMock
+	saved *domain.Order
+}
+
+func (m *orderSaveRecorder) Save(order domain.Order) error {
+	*m.saved = order
+	return m.orderRepositoryMock.Save(order)
+}
+
+func (m *orderSaveRecorder) SaveCharged(order domain.Order, account domain.CorporateAccount, now time.Time) error {
+	*m.saved = order
+	return m.orderRepositoryMock.SaveCharged(order, account, now)
+}
+
+func TestAccountUseCase_AuthorizeDriver(t *testing.T) {
+	testCases := []struct {
+		name    string
+		account *domain.CorporateAccount
+		license string
+		want    error
+	}{
+		{name: "correct driver", account: newAccountFixture(), license: "07798765432", want: nil},
+		{name: "incorrect repeated driver", account: newAccountFixture(), license: "04512345678", want: ErrInvalidDriver},
+		{name: "incorrect account", account: nil, license: "07798765432", want: ErrNotFoundAccount},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := &accountRepositoryMock{expectedFindOneAccount: tc.account, calls: make(map[string]uint)}
+			uc := NewAccountUseCase(repo)
+
+			err := uc.AuthorizeDriver("b0f4c2a6-1d3e-4f5a-9b8c-7d6e5f4a3b2c", tc.license, "Bruno Lima")
+
+			if !errors.Is(err, tc.want) {
+				t.Fatal("unexpected error", err)
+			}
+
+			if err == nil && (repo.savedAccount == nil || !repo.savedAccount.IsDriverAuthorized(tc.license)) {
+				t.Error("unexpected account", repo.savedAccount)
+			}
+		})
+	}
+}
+
+func TestAccountUseCase_GetMonthlyInvoice(t *testing.T) {
+	dateFrom := time.Date(2021, 3, 1, 10, 0, 0, 0, time.UTC)
+	dateTo := time.Date(2021, 3, 6, 10, 0, 0, 0, time.UTC)
+
+	closed := *newOrderFixture()
+	closed.Status = domain.Closed
+	closed.AccountId = newAccountFixture().ID
+	closed.DateFrom = &dateFrom
+	closed.DateTo = &dateTo
+
+	repo := &accountRepositoryMock{
+		expectedFindOneAccount: newAccountFixture(),
+		expectedFindOrders:     []domain.Order{closed},
+		calls:                  make(map[string]uint),
+	}
+	uc := NewAccountUseCase(repo)
+
+	invoice, err := uc.GetMonthlyInvoice(newAccountFixture().ID, 2021, time.March)
+	if err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	// 5 days at 30.5
+	if len(invoice.Orders) != 1 || invoice.Total != 152.5 {
+		t.Error("unexpected invoice", invoice)
+	}
+
+	if _, err := uc.GetMonthlyInvoice(newAccountFixture().ID, 2021, 13); !errors.Is(err, ErrInvalidEntity) {
+		t.Error("unexpected error", err)
+	}
+}
@@ -0,0 +1,161 @@
+package application
+
+import (
+	"time"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/validation"
+	"github.com/thiagotrs/rentalcar-ddd/internal/rental/domain"
+)
+
+type BookingItem struct {
+	CategoryId string `json:"categoryId"`
+	CarModel   string `json:"carModel"`
+	PolicyId   string `json:"policyId"`
+}
+
+type BookingUseCase interface {
+	GetBookingById(id string) (*domain.Booking, error)
+	GetBookingInvoice(id string) (*domain.Invoice, error)
+	QuoteBooking(dateReservFrom, dateReservTo time.Time, items []BookingItem, prepaidFuel bool) (*domain.Invoice, error)
+	OpenBooking(dateReservFrom, dateReservTo time.Time, stationFromId, stationToId string, items []BookingItem, driver domain.DriverProfile, prepaidFuel, keyDropReturn bool) (*domain.Booking, error)
+}
+
+type bookingUseCase struct {
+	bookingRepo     BookingRepository
+	orderSvc        OrderService
+	eligibilityRepo EligibilityReaderRepository
+}
+
+func NewBookingUseCase(bookingRepo BookingRepository, orderSvc OrderService, eligibilityRepo EligibilityReaderRepository) *bookingUseCase {
+	return &bookingUseCase{
+		bookingRepo:     bookingRepo,
+		orderSvc:        orderSvc,
+		eligibilityRepo: eligibilityRepo,
+	}
+}
+
+func (uc bookingUseCase) GetBookingById(id string) (*domain.Booking, error) {
+	if err := validation.ValidId(id); err != nil {
+		return nil, ErrInvalidId
+	}
+
+	booking, err := uc.bookingRepo.FindOne(id)
+	if err != nil {
+		return nil, ErrNotFoundBooking
+	}
+
+	return booking, nil
+}
+
+func (uc bookingUseCase) GetBookingInvoice(id string) (*domain.Invoice, error) {
+	booking, err := uc.GetBookingById(id)
+	if err != nil {
+		return nil, err
+	}
+
+	invoice := booking.Invoice()
+
+	return &invoice, nil
+}
+
+func (uc bookingUseCase) QuoteBooking(dateReservFrom, dateReservTo time.Time, items []BookingItem, prepaidFuel bool) (*domain.Invoice, error) {
+	policies, err := uc.policies(items)
+	if err != nil {
+		return nil, err
+	}
+
+	if dateReservFrom.After(dateReservTo) {
+		return nil, ErrInvalidEntity
+	}
+
+	lines := []domain.InvoiceLine{}
+	for _, p := range policies {
+		if prepaidFuel && p.PrepaidFuelPrice == 0 {
+			return nil, ErrInvalidEntity
+		}
+		lines = append(lines, domain.QuoteLines(p, dateReservFrom, dateReservTo, prepaidFuel)...)
+	}
+
+	quote := domain.NewQuote(lines)
+
+	return &quote, nil
+}
+
+func (uc bookingUseCase) OpenBooking(dateReservFrom, dateReservTo time.Time, stationFromId, stationToId string, items []BookingItem, driver domain.DriverProfile, prepaidFuel, keyDropReturn bool) (*domain.Booking, error) {
+	if open, err := uc.orderSvc.IsStationOpen(stationFromId, dateReservFrom, false); err != nil || !open {
+		return nil, ErrStationClosed
+	}
+
+	if open, err := uc.orderSvc.IsStationOpen(stationToId, dateReservTo, keyDropReturn); err != nil || !open {
+		return nil, ErrStationClosed
+	}
+
+	policies, err := uc.policies(items)
+	if err != nil {
+		return nil, err
+	}
+
+	categoryIds := []string{}
+	for _, p := range policies {
+		categoryIds = append(categoryIds, p.CategoryId)
+	}
+
+	rules, err := checkEligibility(uc.eligibilityRepo, driver, dateReservFrom, categoryIds...)
+	if err != nil {
+		return nil, err
+	}
+
+	models := []string{}
+	for _, item := range items {
+		models = append(models, item.CarModel)
+	}
+
+	cars, err := uc.orderSvc.GetCars(stationFromId, models)
+	if err != nil {
+		return nil, ErrInvalidCar
+	}
+
+	booking, err := domain.NewBooking(dateReservFrom, dateReservTo, cars, policies, stationFromId, stationToId, prepaidFuel, keyDropReturn)
+	if err != nil {
+		return nil, ErrInvalidBooking
+	}
+
+	for i := range booking.Orders {
+		if err := booking.Orders[i].AssignDriver(driver, rules[i]); err != nil {
+			return nil, ErrInvalidBooking
+		}
+	}
+
+	carIds := []string{}
+	for _, car := range cars {
+		carIds = append(carIds, car.ID)
+	}
+
+	if err := uc.orderSvc.ReserveCars(booking.ID, stationFromId, carIds); err != nil {
+		return nil, ErrInvalidCar
+	}
+
+	if err := uc.bookingRepo.Save(*booking); err != nil {
+		uc.orderSvc.ReleaseCars(booking.ID, stationFromId, carIds)
+		return nil, ErrInvalidBooking
+	}
+
+	return booking, nil
+}
+
+func (uc bookingUseCase) policies(items []BookingItem) ([]domain.Policy, error) {
+	if len(items) == 0 {
+		return nil, ErrInvalidBooking
+	}
+
+	policies := []domain.Policy{}
+	for _, item := range items {
+		policy, err := uc.orderSvc.GetPolicy(item.CategoryId, item.CarModel, item.PolicyId)
+		if err != nil {
+			return nil, ErrInvalidPolicy
+		}
+		policies = append(policies, *policy)
+	}
+
+	return policies, nil
+}
@@ -0,0 +1,238 @@
+package application
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/rental/domain"
+)
+
+type bookingRepositoryMock struct {
+	expectedFindOneBooking *domain.Booking
+	expectedFindOneErr     error
+	expectedSaveErr        error
+	calls                  map[string]uint
+}
+
+func (m *bookingRepositoryMock) FindOne(id string) (*domain.Booking, error) {
+	m.calls["FindOne"] = m.calls["FindOne"] + 1
+	return m.expectedFindOneBooking, m.expectedFindOneErr
+}
+
+func (m *bookingRepositoryMock) Save(booking domain.Booking) error {
+	m.calls["Save"] = m.calls["Save"] + 1
+	return m.expectedSaveErr
+}
+
+func newBookingCarsFixture() []domain.Car {
+	car := *newCarFixture()
+	car.Status = domain.Parked
+
+	otherCar := car
+	otherCar.ID = "2f6a7c1e-8b4d-4e3a-9f5c-6d1b2a3c4e5f"
+
+	return []domain.Car{car, otherCar}
+}
+
+func TestBookingUseCase_OpenBooking(t *testing.T) {
+	items := []BookingItem{
+		{CategoryId: "479ab9e7-ad16-4864-8e49-29b15e4b390e", CarModel: "UNO", PolicyId: "5ecf09ce-8c41-4faa-a4e5-824af9c80892"},
+		{CategoryId: "479ab9e7-ad16-4864-8e49-29b15e4b390e", CarModel: "UNO", PolicyId: "5ecf09ce-8c41-4faa-a4e5-824af9c80892"},
+	}
+
+	rule := &domain.EligibilityRule{CategoryId: "479ab9e7-ad16-4864-8e49-29b15e4b390e", MinAge: 21, YoungDriverAge: 25, YoungDriverFee: 10}
+	errDB := errors.New("db down")
+
+	type setup struct {
+		cars       []domain.Car
+		carsErr    error
+		reserveErr error
+		saveErr    error
+		closed     bool
+		rule       *domain.EligibilityRule
+		findErr    error
+		driverAge  int
+	}
+
+	type want struct {
+		err      error
+		saves    uint
+		releases uint
+		charges  int
+	}
+
+	testCases := []struct {
+		name  string
+		setup setup
+		items []BookingItem
+		want  want
+	}{
+		{
+			name:  "correct booking",
+			setup: setup{cars: newBookingCarsFixture()},
+			items: items,
+			want:  want{err: nil, saves: 1},
+		},
+		{
+			name:  "correct young driver with surcharge on every order",
+			setup: setup{cars: newBookingCarsFixture(), rule: rule, driverAge: 22},
+			items: items,
+			want:  want{err: nil, saves: 1, charges: 2},
+		},
+		{
+			name:  "incorrect underage driver",
+			setup: setup{cars: newBookingCarsFixture(), rule: rule, driverAge: 19},
+			items: items,
+			want:  want{err: domain.ErrIneligibleDriver, saves: 0},
+		},
+		{
+			name:  "incorrect rule not read",
+			setup: setup{cars: newBookingCarsFixture(), findErr: errDB},
+			items: items,
+			want:  want{err: errDB, saves: 0},
+		},
+		{
+			name:  "incorrect not all cars available",
+			setup: setup{carsErr: ErrInvalidCar},
+			items: items,
+			want:  want{err: ErrInvalidCar, saves: 0},
+		},
+		{
+			name:  "incorrect cars not reserved",
+			setup: setup{cars: newBookingCarsFixture(), reserveErr: ErrInvalidCar},
+			items: items,
+			want:  want{err: ErrInvalidCar, saves: 0},
+		},
+		{
+			name:  "incorrect booking not saved releases cars",
+			setup: setup{cars: newBookingCarsFixture(), saveErr: errors.New("db down")},
+			items: items,
+			want:  want{err: ErrInvalidBooking, saves: 1, releases: 1},
+		},
+		{
+			name:  "incorrect same car twice",
+			setup: setup{cars: []domain.Car{newBookingCarsFixture()[0], newBookingCarsFixture()[0]}},
+			items: items,
+			want:  want{err: ErrInvalidBooking, saves: 0},
+		},
+		{
+			name:  "incorrect no items",
+			setup: setup{cars: newBookingCarsFixture()},
+			items: []BookingItem{},
+			want:  want{err: ErrInvalidBooking, saves: 0},
+		},
+		{
+			name:  "incorrect station closed",
+			setup: setup{cars: newBookingCarsFixture(), closed: true},
+			items: items,
+			want:  want{err: ErrStationClosed, saves: 0},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := &bookingRepositoryMock{expectedSaveErr: tc.setup.saveErr, calls: map[string]uint{}}
+			svc := &orderOrderServiceMock{
+				expectedGetPolicy:  newPolicyFixture(),
+				expectedGetCars:    tc.setup.cars,
+				expectedGetCarsErr: tc.setup.carsErr,
+				expectedReserveErr: tc.setup.reserveErr,
+				expectedClosed:     tc.setup.closed,
+				calls:              map[string]uint{},
+			}
+			eligibilityRepo := &eligibilityRepositoryMock{expectedFindRule: tc.setup.rule, expectedFindErr: tc.setup.findErr, calls: map[string]uint{}}
+			uc := NewBookingUseCase(repo, svc, eligibilityRepo)
+
+			driver := domain.DriverProfile{License: "07788990011"}
+			if tc.setup.driverAge > 0 {
+				driver.BirthDate = time.Now().AddDate(-tc.setup.driverAge, 0, -1)
+			}
+
+			booking, err := uc.OpenBooking(
+				time.Now(),
+				time.Now().Add(time.Hour*24*5),
+				"83369771-f9a4-48b7-b87b-463f19f7b187",
+				"2520aade-a397-4e3c-a589-39c6ae5c2eff",
+				tc.items,
+				driver,
+				false,
+				false,
+			)
+
+			if !errors.Is(err, tc.want.err) {
+				t.Fatal("unexpected error", err)
+			}
+
+			if repo.calls["Save"] != tc.want.saves {
+				t.Error("unexpected saves", repo.calls["Save"])
+			}
+
+			if svc.calls["ReleaseCars"] != tc.want.releases {
+				t.Error("unexpected releases", svc.calls["ReleaseCars"])
+			}
+
+			if err == nil && len(booking.Orders) != len(tc.items) {
+				t.Error("unexpected orders", booking.Orders)
+			}
+
+			if err == nil {
+				charges := 0
+				for _, o := range booking.Orders {
+					charges += len(o.Charges)
+					if o.DriverLicense != driver.License {
+						t.Error("unexpected driver", o.DriverLicense)
+					}
+				}
+				if charges != tc.want.charges {
+					t.Error("unexpected charges", charges)
+				}
+			}
+		})
+	}
+}
+
+func TestBookingUseCase_QuoteBooking(t *testing.T) {
+	from := time.Date(2021, 3, 1, 10, 0, 0, 0, time.UTC)
+	items := []BookingItem{
+		{CategoryId: "479ab9e7-ad16-4864-8e49-29b15e4b390e", CarModel: "UNO", PolicyId: "5ecf09ce-8c41-4faa-a4e5-824af9c80892"},
+		{CategoryId: "479ab9e7-ad16-4864-8e49-29b15e4b390e", CarModel: "UNO", PolicyId: "5ecf09ce-8c41-4faa-a4e5-824af9c80892"},
+	}
+
+	testCases := []struct {
+		name      string
+		to        time.Time
+		policyErr error
+		wantErr   error
+		wantTotal float32
+	}{
+		{name: "correct quote", to: from.Add(time.Hour * 24 * 7), wantTotal: 427},
+		{name: "incorrect policy", to: from.Add(time.Hour * 24 * 7), policyErr: ErrInvalidPolicy, wantErr: ErrInvalidPolicy},
+		{name: "incorrect dates", to: from.Add(-time.Hour), wantErr: ErrInvalidEntity},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := &orderOrderServiceMock{
+				expectedGetPolicy:    newPolicyFixture(),
+				expectedGetPolicyErr: tc.policyErr,
+				calls:                map[string]uint{},
+			}
+			uc := NewBookingUseCase(&bookingRepositoryMock{calls: map[string]uint{}}, svc, &eligibilityRepositoryMock{calls: map[string]uint{}})
+
+			quote, err := uc.QuoteBooking(from, tc.to, items, false)
+
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatal("unexpected error", err)
+			}
+
+			if err == nil && (len(quote.Lines) != 2 || quote.Total != tc.wantTotal) {
+				t.Error("unexpected quote", quote)
+			}
+
+			if svc.calls["GetCars"] != 0 {
+				t.Error("unexpected cars reserved")
+			}
+		})
+	}
+}
@@ -0,0 +1,143 @@
+package application
+
+import (
+	"errors"
+	"time"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/validation"
+	"github.com/thiagotrs/rentalcar-ddd/internal/rental/domain"
+)
+
+type EligibilityUseCase interface {
+	GetRules() []domain.EligibilityRule
+	GetRule(categoryId string) (*domain.EligibilityRule, error)
+	SetRule(categoryId string, minAge, minLicenseYears, youngDriverAge uint, youngDriverFee float32, licenseCategories []string) (*domain.EligibilityRule, error)
+	DelRule(categoryId string) error
+	GetBlockedDrivers() []domain.BlockedDriver
+	BlockDriver(license, reason string) (*domain.BlockedDriver, error)
+	UnblockDriver(license string) error
+}
+
+type eligibilityUseCase struct {
+	eligibilityRepo EligibilityRepository
+}
+
+func NewEligibilityUseCase(eligibilityRepo EligibilityRepository) *eligibilityUseCase {
+	return &eligibilityUseCase{eligibilityRepo}
+}
+
+func (uc eligibilityUseCase) GetRules() []domain.EligibilityRule {
+	return uc.eligibilityRepo.FindRules()
+}
+
+func (uc eligibilityUseCase) GetRule(categoryId string) (*domain.EligibilityRule, error) {
+	if err := validation.ValidId(categoryId); err != nil {
+		return nil, ErrInvalidId
+	}
+
+	rule, err := uc.eligibilityRepo.FindRule(categoryId)
+	if err != nil {
+		return nil, ErrNotFoundEligibilityRule
+	}
+
+	return rule, nil
+}
+
+func (uc eligibilityUseCase) SetRule(categoryId string, minAge, minLicenseYears, youngDriverAge uint, youngDriverFee float32, licenseCategories []string) (*domain.EligibilityRule, error) {
+	if err := validation.ValidId(categoryId); err != nil {
+		return nil, ErrInvalidId
+	}
+
+	rule, err := domain.NewEligibilityRule(categoryId, minAge, minLicenseYears, youngDriverAge, youngDriverFee, licenseCategories)
+	if err != nil {
+		return nil, ErrInvalidEligibilityRule
+	}
+
+	if err := uc.eligibilityRepo.SaveRule(*rule); err != nil {
+		return nil, ErrInvalidEligibilityRule
+	}
+
+	return rule, nil
+}
+
+func (uc eligibilityUseCase) DelRule(categoryId string) error {
+	if _, err := uc.GetRule(categoryId); err != nil {
+		return err
+	}
+
+	if err := uc.eligibilityRepo.DeleteRule(categoryId); err != nil {
+		return ErrInvalidEligibilityRule
+	}
+
+	return nil
+}
+
+func (uc eligibilityUseCase) GetBlockedDrivers() []domain.BlockedDriver {
+	return uc.eligibilityRepo.FindBlockedDrivers()
+}
+
+func (uc eligibilityUseCase) BlockDriver(license, reason string) (*domain.BlockedDriver, error) {
+	blocked, err := domain.NewBlockedDriver(license, reason, time.Now())
+	if err != nil {
+		return nil, ErrInvalidBlockedDriver
+	}
+
+	if err := uc.eligibilityRepo.SaveBlockedDriver(*blocked); err != nil {
+		return nil, ErrInvalidBlockedDriver
+	}
+
+	return blocked, nil
+}
+
+func (uc eligibilityUseCase) UnblockDriver(license string) error {
+	if _, err := uc.eligibilityRepo.FindBlockedDriver(license); err != nil {
+		return ErrNotFoundBlockedDriver
+	}
+
+	if err := uc.eligibilityRepo.DeleteBlockedDriver(license); err != nil {
+		return ErrInvalidBlockedDriver
+	}
+
+	return nil
+}
+
+func checkEligibility(eligibilityRepo EligibilityReaderRepository, driver domain.DriverProfile, at time.Time, categoryIds ...string) ([]*domain.EligibilityRule, error) {
+	var blocked *domain.BlockedDriver
+	if driver.License != "" {
+		found, err := eligibilityRepo.FindBlockedDriver(driver.License)
+		switch {
+		case err == nil:
+			blocked = found
+		case !errors.Is(err, ErrNotFoundBlockedDriver):
+			return nil, err
+		}
+	}
+
+	rules := []*domain.EligibilityRule{}
+	for _, categoryId := range categoryIds {
+		rule, err := eligibilityRepo.FindRule(categoryId)
+		switch {
+		case err == nil:
+			rules = append(rules, rule)
+		case errors.Is(err, ErrNotFoundEligibilityRule):
+			rules = append(rules, nil)
+		default:
+			return nil, err
+		}
+	}
+
+	if len(rules) == 0 {
+		return nil, domain.CheckEligibility(driver, nil, blocked, at)
+	}
+
+	for i, rule := range rules {
+		if i > 0 {
+			blocked = nil
+		}
+		if err := domain.CheckEligibility(driver, rule, blocked, at); err != nil {
+			return nil, err
+		}
+	}
+
+	return rules, nil
+}
@@ -0,0 +1,305 @@
+package application
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/rental/domain"
+)
+
+type eligibilityRepositoryMock struct {
+	expectedFindRule    *domain.EligibilityRule
+	expectedFindErr     error
+	expectedFindBlocked *domain.BlockedDriver
+	expectedSaveErr     error
+	savedRule           *domain.EligibilityRule
+	calls               map[string]uint
+}
+
+func (m *eligibilityRepositoryMock) FindRules() []domain.EligibilityRule {
+	m.calls["FindRules"] = m.calls["FindRules"] + 1
+	return []domain.EligibilityRule{}
+}
+
+func (m *eligibilityRepositoryMock) FindRule(categoryId string) (*domain.EligibilityRule, error) {
+	m.calls["FindRule"] = m.calls["FindRule"] + 1
+	if m.expectedFindErr != nil {
+		return nil, m.expectedFindErr
+	}
+	if m.expectedFindRule == nil || m.expectedFindRule.CategoryId != categoryId {
+		return nil, ErrNotFoundEligibilityRule
+	}
+	rule := *m.expectedFindRule
+	return &rule, nil
+}
+
+func (m *eligibilityRepositoryMock) FindBlockedDrivers() []domain.BlockedDriver {
+	m.calls["FindBlockedDrivers"] = m.calls["FindBlockedDrivers"] + 1
+	return []domain.BlockedDriver{}
+}
+
+func (m *eligibilityRepositoryMock) FindBlockedDriver(license string) (*domain.BlockedDriver, error) {
+	m.calls["FindBlockedDriver"] = m.calls["FindBlockedDriver"] + 1
+	if m.expectedFindBlocked == nil || m.expectedFindBlocked.License != license {
+		return nil, ErrNotFoundBlockedDriver
+	}
+	blocked := *m.expectedFindBlocked
+	return &blocked, nil
+}
+
+func (m *eligibilityRepositoryMock) SaveRule(rule domain.EligibilityRule) error {
+	m.calls["SaveRule"] = m.calls["SaveRule"] + 1
+	m.savedRule = &rule
+	return m.expectedSaveErr
+}
+
+func (m *eligibilityRepositoryMock) DeleteRule(categoryId string) error {
+	m.calls["DeleteRule"] = m.calls["DeleteRule"] + 1
+	return nil
+}
+
+func (m *eligibilityRepositoryMock) SaveBlockedDriver(blocked domain.BlockedDriver) error {
+	m.calls["SaveBlockedDriver"] = m.calls["SaveBlockedDriver"] + 1
+	return m.expectedSaveErr
+}
+
+func (m *eligibilityRepositoryMock) DeleteBlockedDriver(license string) error {
+	m.calls["DeleteBlockedDriver"] = m.calls["DeleteBlockedDriver"] + 1
+	return nil
+}
+
+func TestEligibilityUseCase_SetRule(t *testing.T) {
+	type args struct {
+		categoryId        string
+		minAge            uint
+		youngDriverAge    uint
+		youngDriverFee    float32
+		licenseCategories []string
+	}
+
+	type want struct {
+		err   error
+		saves uint
+	}
+
+	testCases := []struct {
+		name string
+		args args
+		want want
+	}{
+		{
+			name: "correct rule",
+			args: args{categoryId: "479ab9e7-ad16-4864-8e49-29b15e4b390e", minAge: 21, youngDriverAge: 25, youngDriverFee: 15, licenseCategories: []string{"d"}},
+			want: want{err: nil, saves: 1},
+		},
+		{
+			name: "incorrect category id",
+			args: args{categoryId: "1", minAge: 21},
+			want: want{err: ErrInvalidId, saves: 0},
+		},
+		{
+			name: "incorrect young driver fee without age",
+			args: args{categoryId: "479ab9e7-ad16-4864-8e49-29b15e4b390e", minAge: 21, youngDriverFee: 15},
+			want: want{err: ErrInvalidEligibilityRule, saves: 0},
+		},
+		{
+			name: "incorrect young driver age under min age",
+			args: args{categoryId: "479ab9e7-ad16-4864-8e49-29b15e4b390e", minAge: 21, youngDriverAge: 18, youngDriverFee: 15},
+			want: want{err: ErrInvalidEligibilityRule, saves: 0},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			eligibilityRepo := &eligibilityRepositoryMock{calls: make(map[string]uint)}
+			eligibilityUC := NewEligibilityUseCase(eligibilityRepo)
+
+			rule, err := eligibilityUC.SetRule(tc.args.categoryId, tc.args.minAge, 0, tc.args.youngDriverAge, tc.args.youngDriverFee, tc.args.licenseCategories)
+
+			if !errors.Is(err, tc.want.err) {
+				t.Fatal("unexpected error", err)
+			}
+
+			if eligibilityRepo.calls["SaveRule"] != tc.want.saves {
+				t.Error("unexpected saves", eligibilityRepo.calls["SaveRule"])
+			}
+
+			if err == nil && !reflect.DeepEqual(rule.LicenseCategories, []string{"D"}) {
+				t.Error("unexpected rule", rule)
+			}
+		})
+	}
+}
+
+func TestEligibilityUseCase_UnblockDriver(t *testing.T) {
+	blocked := &domain.BlockedDriver{License: "04512345678", Reason: "unpaid damages", Date: time.Now()}
+
+	testCases := []struct {
+		name    string
+		license string
+		want    error
+	}{
+		{name: "correct blocked driver", license: "04512345678", want: nil},
+		{name: "incorrect driver not blocked", license: "99999999999", want: ErrNotFoundBlockedDriver},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			eligibilityRepo := &eligibilityRepositoryMock{expectedFindBlocked: blocked, calls: make(map[string]uint)}
+			eligibilityUC := NewEligibilityUseCase(eligibilityRepo)
+
+			if err := eligibilityUC.UnblockDriver(tc.license); !errors.Is(err, tc.want) {
+				t.Error("unexpected error", err)
+			}
+		})
+	}
+}
+
+func TestOrderUseCase_OpenForDriver(t *testing.T) {
+	rule := &domain.EligibilityRule{
+		CategoryId:        "479ab9e7-ad16-4864-8e49-29b15e4b390e",
+		MinAge:            21,
+		MinLicenseYears:   2,
+		YoungDriverAge:    25,
+		YoungDriverFee:    10,
+		LicenseCategories: []string{"B"},
+	}
+	upgradeRule := *rule
+	upgradeRule.CategoryId = "c3d2e1f0-4a5b-4c6d-8e7f-9a0b1c2d3e4f"
+	upgradeRule.MinAge = 25
+	blocked := &domain.BlockedDriver{License: "04512345678", Reason: "unpaid damages", Date: time.Now()}
+	errDB := errors.New("db down")
+
+	driver := func(age, licenseYears int, categories ...string) domain.DriverProfile {
+		return domain.DriverProfile{
+			License:           "07788990011",
+			BirthDate:         time.Now().AddDate(-age, 0, -1),
+			LicensedAt:        time.Now().AddDate(-licenseYears, 0, -1),
+			LicenseCategories: categories,
+		}
+	}
+
+	type want struct {
+		err     error
+		reasons []domain.RefusalCode
+		charges int
+	}
+
+	testCases := []struct {
+		name       string
+		rule       *domain.EligibilityRule
+		findErr    error
+		upgradedTo string
+		driver     domain.DriverProfile
+		want       want
+	}{
+		{
+			name:   "correct without rule",
+			driver: domain.DriverProfile{},
+			want:   want{err: nil, charges: 0},
+		},
+		{
+			name:   "correct driver",
+			rule:   rule,
+			driver: driver(30, 10, "B"),
+			want:   want{err: nil, charges: 0},
+		},
+		{
+			name:   "correct young driver with surcharge",
+			rule:   rule,
+			driver: driver(22, 3, "b"),
+			want:   want{err: nil, charges: 1},
+		},
+		{
+			name:   "incorrect underage driver without license category",
+			rule:   rule,
+			driver: driver(19, 1),
+			want:   want{err: domain.ErrIneligibleDriver, reasons: []domain.RefusalCode{domain.DriverUnderage, domain.LicenseTooRecent, domain.LicenseCategoryMissing}},
+		},
+		{
+			name:   "incorrect driver without data",
+			rule:   rule,
+			driver: domain.DriverProfile{License: "07788990011", LicenseCategories: []string{"B"}},
+			want:   want{err: domain.ErrIneligibleDriver, reasons: []domain.RefusalCode{domain.DriverDataMissing, domain.DriverDataMissing}},
+		},
+		{
+			name:   "incorrect blocked driver",
+			driver: domain.DriverProfile{License: "04512345678"},
+			want:   want{err: domain.ErrIneligibleDriver, reasons: []domain.RefusalCode{domain.DriverBlocked}},
+		},
+		{
+			name:       "correct upgraded car without rule",
+			upgradedTo: upgradeRule.CategoryId,
+			driver:     driver(22, 3, "B"),
+			want:       want{err: nil, charges: 0},
+		},
+		{
+			name:       "incorrect underage driver for upgraded car",
+			rule:       &upgradeRule,
+			upgradedTo: upgradeRule.CategoryId,
+			driver:     driver(22, 3, "B"),
+			want:       want{err: domain.ErrIneligibleDriver, reasons: []domain.RefusalCode{domain.DriverUnderage}},
+		},
+		{
+			name:    "incorrect rule not read",
+			findErr: errDB,
+			driver:  driver(30, 10, "B"),
+			want:    want{err: errDB},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			car := *newCarFixture()
+			car.Status = domain.Parked
+			car.Upgraded = tc.upgradedTo != ""
+
+			var saved domain.Order
+			orderRepo := &orderSaveRecorder{orderRepositoryMock{calls: make(map[string]uint)}, &saved}
+			orderSvc := &orderOrderServiceMock{
+				expectedGetPolicy:  newPolicyFixture(),
+				expectedCategoryId: tc.upgradedTo,
+				expectedGetCar:     &car,
+				calls:              make(map[string]uint),
+			}
+			eligibilityRepo := &eligibilityRepositoryMock{expectedFindRule: tc.rule, expectedFindErr: tc.findErr, expectedFindBlocked: blocked, calls: make(map[string]uint)}
+			orderUC := NewOrderUseCase(orderRepo, orderSvc, &accountRepositoryMock{calls: make(map[string]uint)}, eligibilityRepo, &waitlistRepositoryMock{calls: make(map[string]uint)})
+
+			err := orderUC.Open(OpenOrderInput{
+				DateReservFrom: time.Now(),
+				DateReservTo:   time.Now().Add(time.Hour * 24 * 5),
+				StationFromId:  "83369771-f9a4-48b7-b87b-463f19f7b187",
+				StationToId:    "2520aade-a397-4e3c-a589-39c6ae5c2eff",
+				CategoryId:     "479ab9e7-ad16-4864-8e49-29b15e4b390e",
+				CarModel:       "UNO",
+				PolicyId:       "5ecf09ce-8c41-4faa-a4e5-824af9c80892",
+				Driver:         tc.driver,
+			})
+
+			if !errors.Is(err, tc.want.err) {
+				t.Fatal("unexpected error", err)
+			}
+
+			var refusal *domain.IneligibleDriverError
+			if errors.As(err, &refusal) {
+				codes := []domain.RefusalCode{}
+				for _, r := range refusal.Reasons {
+					codes = append(codes, r.Code)
+				}
+				if !reflect.DeepEqual(codes, tc.want.reasons) {
+					t.Error("unexpected reasons", refusal.Reasons)
+				}
+			}
+
+			if err == nil && (saved.DriverLicense != tc.driver.License || len(saved.Charges) != tc.want.charges) {
+				t.Error("unexpected order", saved)
+			}
+
+			if err != nil && tc.upgradedTo == "" && orderSvc.calls["GetCar"] != 0 {
+				t.Error("unexpected car reserved for an ineligible driver")
+			}
+		})
+	}
+}
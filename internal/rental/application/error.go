@@ -16,4 +16,43 @@ var (
 
 	ErrInvalidPolicy = errors.New("invalid policy")
 	ErrInvalidCar    = errors.New("invalid car")
+	ErrInvalidSwap   = fmt.Errorf("%w", domain.ErrInvalidSwap)
+
+	ErrInvalidBooking  = fmt.Errorf("%w", domain.ErrInvalidBooking)
+	ErrNotFoundBooking = errors.New("not found booking")
+
+	ErrInvalidAccount      = fmt.Errorf("%w", domain.ErrInvalidAccount)
+	ErrNotFoundAccount     = errors.New("not found corporate account")
+	ErrInvalidDriver       = fmt.Errorf("%w", domain.ErrInvalidDriver)
+	ErrUnauthorizedDriver  = fmt.Errorf("%w", domain.ErrUnauthorizedDriver)
+	ErrInvalidRate         = fmt.Errorf("%w", domain.ErrInvalidRate)
+	ErrCreditLimitExceeded = fmt.Errorf("%w", domain.ErrCreditLimitExceeded)
+
+	ErrInvalidEligibilityRule  = fmt.Errorf("%w", domain.ErrInvalidEligibilityRule)
+	ErrNotFoundEligibilityRule = errors.New("not found eligibility rule")
+	ErrInvalidBlockedDriver    = fmt.Errorf("%w", domain.ErrInvalidBlockedDriver)
+	ErrNotFoundBlockedDriver   = errors.New("not found blocked driver")
+
+	ErrNotFoundMember    = errors.New("not found loyalty member")
+	ErrInvalidRedemption = errors.New("invalid points redemption")
+
+	ErrInvalidSubscription  = fmt.Errorf("%w", domain.ErrInvalidSubscription)
+	ErrNotFoundSubscription = errors.New("not found subscription")
+	ErrInvalidBilling       = fmt.Errorf("%w", domain.ErrInvalidBilling)
+	ErrInvalidPause         = fmt.Errorf("%w", domain.ErrInvalidPause)
+	ErrInvalidResume        = fmt.Errorf("%w", domain.ErrInvalidResume)
+	ErrCancelSubscription   = fmt.Errorf("%w", domain.ErrCancelSubscription)
+
+	ErrInvalidWaitlist  = fmt.Errorf("%w", domain.ErrInvalidWaitlist)
+	ErrNotFoundWaitlist = errors.New("not found waitlist entry")
+	ErrInvalidHold      = fmt.Errorf("%w", domain.ErrInvalidHold)
+
+	ErrStationClosed     = errors.New("station closed at reservation time")
+	ErrStationNotLocated = errors.New("station not located")
+
+	ErrInvalidGeofence  = fmt.Errorf("%w", domain.ErrInvalidGeofence)
+	ErrNotFoundGeofence = errors.New("not found geofence")
+	ErrInvalidPosition  = fmt.Errorf("%w", domain.ErrInvalidPosition)
+	ErrCarNotRented     = errors.New("car is not rented")
+	ErrInvalidAlert     = errors.New("invalid geofence alert")
 )
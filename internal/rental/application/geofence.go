@@ -0,0 +1,170 @@
+package application
+
+import (
+	"errors"
+	"time"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/validation"
+	"github.com/thiagotrs/rentalcar-ddd/internal/rental/domain"
+)
+
+type GeofenceUseCase interface {
+	GetGeofences(categoryId, orderId string) []domain.Geofence
+	AddZoneGeofence(name, categoryId, orderId string, polygon []domain.Point) error
+	AddStationGeofence(name, categoryId, orderId, stationId string, radius float64) error
+	DeleteGeofence(id string) error
+	ReportPosition(carId string, latitude, longitude float64, recordedAt time.Time) error
+	CheckOverdueReturns(at time.Time) error
+	GetAlerts(orderId string) []domain.GeofenceAlert
+}
+
+type geofenceUseCase struct {
+	geofenceRepo GeofenceRepository
+	positionRepo PositionRepository
+	alertRepo    AlertRepository
+	orderRepo    OrderReaderRepository
+	stationSvc   StationService
+}
+
+func NewGeofenceUseCase(geofenceRepo GeofenceRepository, positionRepo PositionRepository, alertRepo AlertRepository, orderRepo OrderReaderRepository, stationSvc StationService) *geofenceUseCase {
+	return &geofenceUseCase{
+		geofenceRepo: geofenceRepo,
+		positionRepo: positionRepo,
+		alertRepo:    alertRepo,
+		orderRepo:    orderRepo,
+		stationSvc:   stationSvc,
+	}
+}
+
+func (uc geofenceUseCase) GetGeofences(categoryId, orderId string) []domain.Geofence {
+	if categoryId == "" && orderId == "" {
+		return uc.geofenceRepo.FindAll()
+	}
+
+	return uc.geofenceRepo.FindByScope(categoryId, orderId)
+}
+
+func (uc geofenceUseCase) AddZoneGeofence(name, categoryId, orderId string, polygon []domain.Point) error {
+	geofence, err := domain.NewZoneGeofence(name, categoryId, orderId, polygon)
+	return uc.saveGeofence(geofence, err)
+}
+
+func (uc geofenceUseCase) AddStationGeofence(name, categoryId, orderId, stationId string, radius float64) error {
+	if err := validation.ValidId(stationId); err != nil {
+		return ErrInvalidGeofence
+	}
+
+	latitude, longitude, err := uc.stationSvc.GetStationLocation(stationId)
+	if err != nil {
+		return ErrStationNotLocated
+	}
+
+	geofence, err := domain.NewStationGeofence(name, categoryId, orderId, stationId, latitude, longitude, radius)
+	return uc.saveGeofence(geofence, err)
+}
+
+func (uc geofenceUseCase) saveGeofence(geofence *domain.Geofence, err error) error {
+	if errors.Is(err, domain.ErrInvalidGeofence) {
+		return ErrInvalidGeofence
+	}
+	if err != nil {
+		return ErrInvalidEntity
+	}
+
+	if err := uc.geofenceRepo.Save(*geofence); err != nil {
+		return ErrInvalidGeofence
+	}
+
+	return nil
+}
+
+func (uc geofenceUseCase) DeleteGeofence(id string) error {
+	if err := validation.ValidId(id); err != nil {
+		return ErrInvalidId
+	}
+
+	if err := uc.geofenceRepo.Delete(id); err != nil {
+		return ErrNotFoundGeofence
+	}
+
+	return nil
+}
+
+func (uc geofenceUseCase) ReportPosition(carId string, latitude, longitude float64, recordedAt time.Time) error {
+	if err := validation.ValidId(carId); err != nil {
+		return ErrInvalidId
+	}
+
+	order, err := uc.orderRepo.FindRentedByCar(carId)
+	if err != nil {
+		return ErrCarNotRented
+	}
+
+	position, err := domain.NewCarPosition(order.ID, carId, latitude, longitude, recordedAt)
+	if err != nil {
+		return ErrInvalidPosition
+	}
+
+	previous, err := uc.positionRepo.FindLastByOrder(order.ID)
+	if err != nil {
+		previous = nil
+	}
+
+	fences := uc.geofenceRepo.FindByScope(order.Policy.CategoryId, order.ID)
+	alerts := order.CheckPosition(previous, *position, fences, uc.overdueAlerted(order.ID))
+
+	if err := uc.positionRepo.Save(*position); err != nil {
+		return ErrInvalidPosition
+	}
+
+	for _, a := range alerts {
+		if err := uc.alertRepo.Save(a); err != nil {
+			return ErrInvalidAlert
+		}
+	}
+
+	return nil
+}
+
+func (uc geofenceUseCase) CheckOverdueReturns(at time.Time) error {
+	for _, order := range uc.orderRepo.FindOverdue(at) {
+		if uc.overdueAlerted(order.ID) {
+			continue
+		}
+
+		last, err := uc.positionRepo.FindLastByOrder(order.ID)
+		if err != nil {
+			continue
+		}
+
+		fences := uc.geofenceRepo.FindByScope(order.Policy.CategoryId, order.ID)
+		alert := order.CheckOverdue(*last, fences, at)
+		if alert == nil {
+			continue
+		}
+
+		if err := uc.alertRepo.Save(*alert); err != nil {
+			return ErrInvalidAlert
+		}
+	}
+
+	return nil
+}
+
+func (uc geofenceUseCase) overdueAlerted(orderId string) bool {
+	for _, a := range uc.alertRepo.FindByOrder(orderId) {
+		if a.Kind == domain.OverdueReturnAlert {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (uc geofenceUseCase) GetAlerts(orderId string) []domain.GeofenceAlert {
+	if orderId == "" {
+		return uc.alertRepo.FindAll()
+	}
+
+	return uc.alertRepo.FindByOrder(orderId)
+}
@@ -0,0 +1,412 @@
+package application
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/rental/domain"
+)
+
+type geofenceRepositoryMock struct {
+	expectedFindGeofences []domain.Geofence
+	expectedFindOne       *domain.Geofence
+	expectedFindOneErr    error
+	expectedSaveErr       error
+	expectedDeleteErr     error
+	calls                 map[string]uint
+}
+
+func (m *geofenceRepositoryMock) FindAll() []domain.Geofence {
+	m.calls["FindAll"] = m.calls["FindAll"] + 1
+	return m.expectedFindGeofences
+}
+
+func (m *geofenceRepositoryMock) FindByScope(categoryId, orderId string) []domain.Geofence {
+	m.calls["FindByScope"] = m.calls["FindByScope"] + 1
+	return m.expectedFindGeofences
+}
+
+func (m *geofenceRepositoryMock) FindOne(id string) (*domain.Geofence, error) {
+	m.calls["FindOne"] = m.calls["FindOne"] + 1
+	return m.expectedFindOne, m.expectedFindOneErr
+}
+
+func (m *geofenceRepositoryMock) Save(geofence domain.Geofence) error {
+	m.calls["Save"] = m.calls["Save"] + 1
+	return m.expectedSaveErr
+}
+
+func (m *geofenceRepositoryMock) Delete(id string) error {
+	m.calls["Delete"] = m.calls["Delete"] + 1
+	return m.expectedDeleteErr
+}
+
+type positionRepositoryMock struct {
+	expectedFindLast    *domain.CarPosition
+	expectedFindLastErr error
+	expectedSaveErr     error
+	calls               map[string]uint
+}
+
+func (m *positionRepositoryMock) FindLastByOrder(orderId string) (*domain.CarPosition, error) {
+	m.calls["FindLastByOrder"] = m.calls["FindLastByOrder"] + 1
+	return m.expectedFindLast, m.expectedFindLastErr
+}
+
+func (m *positionRepositoryMock) Save(position domain.CarPosition) error {
+	m.calls["Save"] = m.calls["Save"] + 1
+	return m.expectedSaveErr
+}
+
+type alertRepositoryMock struct {
+	expectedFindAlerts []domain.GeofenceAlert
+	expectedSaveErr    error
+	calls              map[string]uint
+}
+
+func (m *alertRepositoryMock) FindAll() []domain.GeofenceAlert {
+	m.calls["FindAll"] = m.calls["FindAll"] + 1
+	return m.expectedFindAlerts
+}
+
+func (m *alertRepositoryMock) FindByOrder(orderId string) []domain.GeofenceAlert {
+	m.calls["FindByOrder"] = m.calls["FindByOrder"] + 1
+	return m.expectedFindAlerts
+}
+
+func (m *alertRepositoryMock) Save(alert domain.GeofenceAlert) error {
+	m.calls["Save"] = m.calls["Save"] + 1
+	return m.expectedSaveErr
+}
+
+func newGeofencesFixture() []domain.Geofence {
+	return []domain.Geofence{
+		{
+			ID:         "a1b7f0a4-0f5e-4a43-9d3e-1f3a7b6c2d11",
+			Name:       "SP",
+			Kind:       domain.ZoneFence,
+			CategoryId: "479ab9e7-ad16-4864-8e49-29b15e4b390e",
+			Polygon: []domain.Point{
+				{Latitude: -23.0, Longitude: -47.0},
+				{Latitude: -23.0, Longitude: -46.0},
+				{Latitude: -24.0, Longitude: -46.0},
+				{Latitude: -24.0, Longitude: -47.0},
+			},
+		},
+	}
+}
+
+func TestGeofenceUseCase_AddZoneGeofence(t *testing.T) {
+	testCases := []struct {
+		name          string
+		categoryIdArg string
+		orderIdArg    string
+		polygonArg    []domain.Point
+		wantErr       error
+		wantSaveCalls uint
+	}{
+		{
+			name:          "correct input",
+			categoryIdArg: "479ab9e7-ad16-4864-8e49-29b15e4b390e",
+			polygonArg:    newGeofencesFixture()[0].Polygon,
+			wantErr:       nil,
+			wantSaveCalls: 1,
+		},
+		{
+			name:          "incorrect scope input",
+			polygonArg:    newGeofencesFixture()[0].Polygon,
+			wantErr:       ErrInvalidGeofence,
+			wantSaveCalls: 0,
+		},
+		{
+			name:          "incorrect id input",
+			categoryIdArg: "invalid-id",
+			polygonArg:    newGeofencesFixture()[0].Polygon,
+			wantErr:       ErrInvalidEntity,
+			wantSaveCalls: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			geofenceRepo := &geofenceRepositoryMock{calls: make(map[string]uint)}
+			geofenceUC := NewGeofenceUseCase(
+				geofenceRepo,
+				&positionRepositoryMock{calls: make(map[string]uint)},
+				&alertRepositoryMock{calls: make(map[string]uint)},
+				&orderRepositoryMock{calls: make(map[string]uint)},
+				&orderOrderServiceMock{calls: make(map[string]uint)},
+			)
+
+			err := geofenceUC.AddZoneGeofence("SP", tc.categoryIdArg, tc.orderIdArg, tc.polygonArg)
+
+			if !errors.Is(err, tc.wantErr) {
+				t.Error("unexpected error", err)
+			}
+
+			if geofenceRepo.calls["Save"] != tc.wantSaveCalls {
+				t.Error("invalid repo call", geofenceRepo.calls["Save"])
+			}
+		})
+	}
+}
+
+func TestGeofenceUseCase_AddStationGeofence(t *testing.T) {
+	testCases := []struct {
+		name           string
+		stationIdArg   string
+		locationErr    error
+		wantErr        error
+		wantSaveCalls  uint
+		wantLocateCall uint
+	}{
+		{
+			name:           "correct input",
+			stationIdArg:   "f3e1b4a2-7c4d-4e8b-9a61-2b5d8c9e0f17",
+			wantErr:        nil,
+			wantSaveCalls:  1,
+			wantLocateCall: 1,
+		},
+		{
+			name:           "incorrect station id input",
+			stationIdArg:   "invalid-id",
+			wantErr:        ErrInvalidGeofence,
+			wantSaveCalls:  0,
+			wantLocateCall: 0,
+		},
+		{
+			name:           "station not located input",
+			stationIdArg:   "f3e1b4a2-7c4d-4e8b-9a61-2b5d8c9e0f17",
+			locationErr:    errors.New("invalid location"),
+			wantErr:        ErrStationNotLocated,
+			wantSaveCalls:  0,
+			wantLocateCall: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			geofenceRepo := &geofenceRepositoryMock{calls: make(map[string]uint)}
+			stationSvc := &orderOrderServiceMock{expectedLocationErr: tc.locationErr, calls: make(map[string]uint)}
+			geofenceUC := NewGeofenceUseCase(
+				geofenceRepo,
+				&positionRepositoryMock{calls: make(map[string]uint)},
+				&alertRepositoryMock{calls: make(map[string]uint)},
+				&orderRepositoryMock{calls: make(map[string]uint)},
+				stationSvc,
+			)
+
+			err := geofenceUC.AddStationGeofence("Station", "479ab9e7-ad16-4864-8e49-29b15e4b390e", "", tc.stationIdArg, 2)
+
+			if !errors.Is(err, tc.wantErr) {
+				t.Error("unexpected error", err)
+			}
+
+			if geofenceRepo.calls["Save"] != tc.wantSaveCalls {
+				t.Error("invalid repo call", geofenceRepo.calls["Save"])
+			}
+
+			if stationSvc.calls["GetStationLocation"] != tc.wantLocateCall {
+				t.Error("invalid service call", stationSvc.calls["GetStationLocation"])
+			}
+		})
+	}
+}
+
+func TestGeofenceUseCase_ReportPosition(t *testing.T) {
+	rented := newOrderFixture()
+	rented.Status = domain.Confirmed
+
+	type setup struct {
+		repoFindOrder    *domain.Order
+		repoFindOrderErr error
+		repoLastPosition *domain.CarPosition
+	}
+
+	type args struct {
+		carId     string
+		latitude  float64
+		longitude float64
+	}
+
+	type want struct {
+		err               error
+		savePositionCalls uint
+		saveAlertCalls    uint
+	}
+
+	testCases := []struct {
+		name  string
+		setup setup
+		args  args
+		want  want
+	}{
+		{
+			name: "inside zone input",
+			setup: setup{
+				repoFindOrder: rented,
+			},
+			args: args{
+				carId:     rented.Car.ID,
+				latitude:  -23.5,
+				longitude: -46.5,
+			},
+			want: want{
+				err:               nil,
+				savePositionCalls: 1,
+				saveAlertCalls:    0,
+			},
+		},
+		{
+			name: "left zone input",
+			setup: setup{
+				repoFindOrder:    rented,
+				repoLastPosition: &domain.CarPosition{Latitude: -23.5, Longitude: -46.5},
+			},
+			args: args{
+				carId:     rented.Car.ID,
+				latitude:  -22.5,
+				longitude: -46.5,
+			},
+			want: want{
+				err:               nil,
+				savePositionCalls: 1,
+				saveAlertCalls:    1,
+			},
+		},
+		{
+			name: "incorrect id input",
+			args: args{
+				carId: "invalid-id",
+			},
+			want: want{
+				err:               ErrInvalidId,
+				savePositionCalls: 0,
+				saveAlertCalls:    0,
+			},
+		},
+		{
+			name: "not rented car input",
+			setup: setup{
+				repoFindOrderErr: ErrNotFoundOrder,
+			},
+			args: args{
+				carId: rented.Car.ID,
+			},
+			want: want{
+				err:               ErrCarNotRented,
+				savePositionCalls: 0,
+				saveAlertCalls:    0,
+			},
+		},
+		{
+			name: "incorrect position input",
+			setup: setup{
+				repoFindOrder: rented,
+			},
+			args: args{
+				carId:     rented.Car.ID,
+				latitude:  -123.5,
+				longitude: -46.5,
+			},
+			want: want{
+				err:               ErrInvalidPosition,
+				savePositionCalls: 0,
+				saveAlertCalls:    0,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			positionRepo := &positionRepositoryMock{
+				expectedFindLast: tc.setup.repoLastPosition,
+				calls:            make(map[string]uint),
+			}
+			if tc.setup.repoLastPosition == nil {
+				positionRepo.expectedFindLastErr = ErrInvalidPosition
+			}
+			alertRepo := &alertRepositoryMock{calls: make(map[string]uint)}
+			geofenceUC := NewGeofenceUseCase(
+				&geofenceRepositoryMock{expectedFindGeofences: newGeofencesFixture(), calls: make(map[string]uint)},
+				positionRepo,
+				alertRepo,
+				&orderRepositoryMock{
+					expectedFindOneOrder: tc.setup.repoFindOrder,
+					expectedFindOneErr:   tc.setup.repoFindOrderErr,
+					calls:                make(map[string]uint),
+				},
+				&orderOrderServiceMock{calls: make(map[string]uint)},
+			)
+
+			err := geofenceUC.ReportPosition(tc.args.carId, tc.args.latitude, tc.args.longitude, time.Now())
+
+			if !errors.Is(err, tc.want.err) {
+				t.Error("unexpected error", err)
+			}
+
+			if positionRepo.calls["Save"] != tc.want.savePositionCalls {
+				t.Error("invalid repo call", positionRepo.calls["Save"])
+			}
+
+			if alertRepo.calls["Save"] != tc.want.saveAlertCalls {
+				t.Error("invalid repo call", alertRepo.calls["Save"])
+			}
+		})
+	}
+}
+
+func TestGeofenceUseCase_CheckOverdueReturns(t *testing.T) {
+	rented := newOrderFixture()
+	rented.Status = domain.Confirmed
+	at := rented.DateReservTo.Add(time.Hour)
+
+	fences := append(newGeofencesFixture(), domain.Geofence{
+		Kind:      domain.StationFence,
+		StationId: rented.StationToId,
+		Latitude:  -23.5,
+		Longitude: -46.6,
+		Radius:    2,
+	})
+
+	away := &domain.CarPosition{OrderId: rented.ID, CarId: rented.Car.ID, Latitude: -23.5, Longitude: -46.5, RecordedAt: rented.DateReservFrom}
+	atStation := &domain.CarPosition{OrderId: rented.ID, CarId: rented.Car.ID, Latitude: -23.5, Longitude: -46.6, RecordedAt: rented.DateReservFrom}
+
+	testCases := []struct {
+		name           string
+		repoLast       *domain.CarPosition
+		repoAlerts     []domain.GeofenceAlert
+		wantSaveAlerts uint
+	}{
+		{name: "car away from station", repoLast: away, wantSaveAlerts: 1},
+		{name: "car at station", repoLast: atStation, wantSaveAlerts: 0},
+		{name: "car never reported", repoLast: nil, wantSaveAlerts: 0},
+		{name: "overdue already alerted", repoLast: away, repoAlerts: []domain.GeofenceAlert{{Kind: domain.OverdueReturnAlert}}, wantSaveAlerts: 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			positionRepo := &positionRepositoryMock{expectedFindLast: tc.repoLast, calls: make(map[string]uint)}
+			if tc.repoLast == nil {
+				positionRepo.expectedFindLastErr = ErrInvalidPosition
+			}
+			alertRepo := &alertRepositoryMock{expectedFindAlerts: tc.repoAlerts, calls: make(map[string]uint)}
+			geofenceUC := NewGeofenceUseCase(
+				&geofenceRepositoryMock{expectedFindGeofences: fences, calls: make(map[string]uint)},
+				positionRepo,
+				alertRepo,
+				&orderRepositoryMock{expectedFindAllOrders: []domain.Order{*rented}, calls: make(map[string]uint)},
+				&orderOrderServiceMock{calls: make(map[string]uint)},
+			)
+
+			if err := geofenceUC.CheckOverdueReturns(at); err != nil {
+				t.Error("unexpected error", err)
+			}
+
+			if alertRepo.calls["Save"] != tc.wantSaveAlerts {
+				t.Error("invalid repo call", alertRepo.calls["Save"])
+			}
+		})
+	}
+}
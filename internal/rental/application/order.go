@@ -1,29 +1,55 @@
 package application
 
 import (
+	"errors"
 	"time"
 
 	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/validation"
 	"github.com/thiagotrs/rentalcar-ddd/internal/rental/domain"
 )
 
+type OpenOrderInput struct {
+	DateReservFrom time.Time
+	DateReservTo   time.Time
+	StationFromId  string
+	StationToId    string
+	CategoryId     string
+	CarModel       string
+	PolicyId       string
+	AccountId      string
+	Driver         domain.DriverProfile
+	MemberId       string
+	RedeemPoints   uint
+	PrepaidFuel    bool
+	KeyDropReturn  bool
+	AllowUpgrade   bool
+}
+
 type OrderUseCase interface {
 	GetById(id string) (*domain.Order, error)
-	Open(dateReservFrom, dateReservTo time.Time, stationFromId, stationToId, categoryId, carModel, policyId string) error
-	Confirm(id string, dateFrom time.Time) error
-	Close(id string, discount, tax float32, dateTo time.Time, km uint64) error
+	Open(input OpenOrderInput) error
+	Confirm(id string, dateFrom time.Time, fuelLevel *uint8) error
+	Close(id string, discount, tax float32, dateTo time.Time, km uint64, fuelLevel uint8) error
+	OpenFromWaitlist(waitlistId, stationToId, accountId string, driver domain.DriverProfile, memberId string, redeemPoints uint, prepaidFuel, keyDropReturn bool) error
 	Cancel(id string) error
+	SwapCar(id, categoryId, carModel, policyId, reason string) error
 }
 
 type orderUseCase struct {
-	orderRepo OrderRepository
-	orderSvc  OrderService
+	orderRepo       OrderRepository
+	orderSvc        OrderService
+	accountRepo     AccountReaderRepository
+	eligibilityRepo EligibilityReaderRepository
+	waitlistRepo    WaitlistRepository
 }
 
-func NewOrderUseCase(orderRepo OrderRepository, orderSvc OrderService) *orderUseCase {
+func NewOrderUseCase(orderRepo OrderRepository, orderSvc OrderService, accountRepo AccountReaderRepository, eligibilityRepo EligibilityReaderRepository, waitlistRepo WaitlistRepository) *orderUseCase {
 	return &orderUseCase{
-		orderRepo: orderRepo,
-		orderSvc:  orderSvc,
+		orderRepo:       orderRepo,
+		orderSvc:        orderSvc,
+		accountRepo:     accountRepo,
+		eligibilityRepo: eligibilityRepo,
+		waitlistRepo:    waitlistRepo,
 	}
 }
 
@@ -41,37 +67,180 @@ func (uc orderUseCase) GetById(id string) (*domain.Order, error) {
 	return order, nil
 }
 
-func (uc orderUseCase) Open(dateReservFrom, dateReservTo time.Time, stationFromId, stationToId, categoryId, carModel, policyId string) error {
-	policy, err := uc.orderSvc.GetPolicy(categoryId, carModel, policyId)
+func (uc orderUseCase) Open(input OpenOrderInput) error {
+	return uc.open(input, nil)
+}
+
+func (uc orderUseCase) OpenFromWaitlist(waitlistId, stationToId, accountId string, driver domain.DriverProfile, memberId string, redeemPoints uint, prepaidFuel, keyDropReturn bool) error {
+	if err := validation.ValidId(waitlistId); err != nil {
+		return ErrInvalidId
+	}
+
+	entry, err := uc.waitlistRepo.FindOne(waitlistId)
 	if err != nil {
-		return ErrInvalidEntity
+		return ErrNotFoundWaitlist
+	}
+
+	if !entry.IsHeld(time.Now()) {
+		return ErrInvalidHold
+	}
+
+	return uc.open(OpenOrderInput{
+		DateReservFrom: entry.DateFrom,
+		DateReservTo:   entry.DateTo,
+		StationFromId:  entry.StationId,
+		StationToId:    stationToId,
+		CategoryId:     entry.CategoryId,
+		CarModel:       entry.CarModel,
+		PolicyId:       entry.PolicyId,
+		AccountId:      accountId,
+		Driver:         driver,
+		MemberId:       memberId,
+		RedeemPoints:   redeemPoints,
+		PrepaidFuel:    prepaidFuel,
+		KeyDropReturn:  keyDropReturn,
+	}, entry)
+}
+
+func (uc orderUseCase) open(input OpenOrderInput, entry *domain.WaitlistEntry) error {
+	if open, err := uc.orderSvc.IsStationOpen(input.StationFromId, input.DateReservFrom, false); err != nil || !open {
+		return ErrStationClosed
+	}
+
+	if open, err := uc.orderSvc.IsStationOpen(input.StationToId, input.DateReservTo, input.KeyDropReturn); err != nil || !open {
+		return ErrStationClosed
 	}
 
-	car, err := uc.orderSvc.GetCar(stationFromId, carModel)
+	policy, err := uc.orderSvc.GetPolicy(input.CategoryId, input.CarModel, input.PolicyId)
 	if err != nil {
 		return ErrInvalidEntity
 	}
 
-	newOrder, err := domain.NewOrder(dateReservFrom, dateReservTo, *car, stationFromId, stationToId, *policy)
+	rules, err := checkEligibility(uc.eligibilityRepo, input.Driver, input.DateReservFrom, policy.CategoryId)
+	if err != nil {
+		return err
+	}
+
+	var account *domain.CorporateAccount
+	if input.AccountId != "" {
+		if account, err = uc.accountRepo.FindOne(input.AccountId); err != nil {
+			return ErrNotFoundAccount
+		}
+		*policy = account.NegotiatedPolicy(*policy)
+	}
+
+	allowUpgrade := input.AllowUpgrade
+	var benefits *MemberBenefits
+	if input.MemberId != "" {
+		if benefits, err = uc.orderSvc.GetMemberBenefits(input.MemberId); err != nil {
+			return ErrNotFoundMember
+		}
+		allowUpgrade = allowUpgrade || benefits.UpgradePriority
+	} else if input.RedeemPoints > 0 {
+		return ErrInvalidRedemption
+	}
+
+	var car *domain.Car
+	if entry != nil {
+		held := *entry.HeldCar
+		car = &held
+	} else if car, err = uc.orderSvc.GetCar(input.StationFromId, input.CarModel, allowUpgrade); err != nil {
+		return ErrInvalidCar
+	}
+
+	if car.Upgraded {
+		upgradedId, err := uc.orderSvc.GetModelCategory(car.CarModel)
+		if err != nil {
+			return ErrInvalidCar
+		}
+		if _, err := checkEligibility(uc.eligibilityRepo, input.Driver, input.DateReservFrom, upgradedId); err != nil {
+			return err
+		}
+	}
+
+	newOrder, err := domain.NewOrder(input.DateReservFrom, input.DateReservTo, *car, input.StationFromId, input.StationToId, *policy, input.PrepaidFuel, input.KeyDropReturn)
 	if err != nil {
 		return ErrInvalidEntity
 	}
 
-	if err := uc.orderRepo.Save(*newOrder); err != nil {
+	if entry != nil {
+		if err := newOrder.FromWaitlist(*entry); err != nil {
+			return ErrInvalidHold
+		}
+	}
+
+	if err := newOrder.AssignDriver(input.Driver, rules[0]); err != nil {
+		return ErrInvalidEntity
+	}
+
+	if benefits != nil {
+		if err := newOrder.JoinMember(input.MemberId, benefits.FreePrepaidFuel); err != nil {
+			return ErrInvalidEntity
+		}
+	}
+
+	if account != nil {
+		switch err := account.Charge(newOrder, input.Driver.License, uc.accountRepo.FindOrders(account.ID), time.Now()); err {
+		case domain.ErrUnauthorizedDriver:
+			return ErrUnauthorizedDriver
+		case domain.ErrCreditLimitExceeded:
+			return ErrCreditLimitExceeded
+		default:
+			if err != nil {
+				return ErrInvalidOrder
+			}
+		}
+	}
+
+	if input.RedeemPoints > 0 {
+		discount, err := uc.orderSvc.RedeemPoints(input.MemberId, newOrder.ID, input.RedeemPoints, newOrder.Invoice().Total)
+		if err != nil {
+			return ErrInvalidRedemption
+		}
+		if err := newOrder.RedeemPoints(input.RedeemPoints, discount); err != nil {
+			uc.orderSvc.RefundPoints(input.MemberId, newOrder.ID)
+			return ErrInvalidRedemption
+		}
+	}
+
+	if err := uc.saveOpened(*newOrder, account); err != nil {
+		if input.RedeemPoints > 0 {
+			uc.orderSvc.RefundPoints(input.MemberId, newOrder.ID)
+		}
+		if errors.Is(err, ErrCreditLimitExceeded) {
+			return ErrCreditLimitExceeded
+		}
 		return ErrInvalidOrder
 	}
 
+	if entry != nil {
+		if err := entry.Book(newOrder.ID, time.Now()); err != nil {
+			return ErrInvalidHold
+		}
+		if err := uc.waitlistRepo.Save(*entry); err != nil {
+			return ErrInvalidWaitlist
+		}
+	}
+
 	return nil
 }
 
-func (uc orderUseCase) Confirm(id string, dateFrom time.Time) error {
+func (uc orderUseCase) saveOpened(order domain.Order, account *domain.CorporateAccount) error {
+	if account != nil {
+		return uc.orderRepo.SaveCharged(order, *account, time.Now())
+	}
+
+	return uc.orderRepo.Save(order)
+}
+
+func (uc orderUseCase) Confirm(id string, dateFrom time.Time, fuelLevel *uint8) error {
 	order, err := uc.orderRepo.FindOne(id)
 
 	if err != nil {
 		return ErrInvalidOrder
 	}
 
-	if err := order.Confirm(dateFrom); err != nil {
+	if err := order.Confirm(dateFrom, fuelLevel); err != nil {
 		return ErrInvalidOrder
 	}
 
@@ -82,14 +251,14 @@ func (uc orderUseCase) Confirm(id string, dateFrom time.Time) error {
 	return nil
 }
 
-func (uc orderUseCase) Close(id string, discount, tax float32, dateTo time.Time, km uint64) error {
+func (uc orderUseCase) Close(id string, discount, tax float32, dateTo time.Time, km uint64, fuelLevel uint8) error {
 	order, err := uc.orderRepo.FindOne(id)
 
 	if err != nil {
 		return ErrInvalidOrder
 	}
 
-	if err := order.Close(discount, tax, dateTo, km); err != nil {
+	if err := order.Close(discount, tax, dateTo, km, fuelLevel); err != nil {
 		return ErrInvalidOrder
 	}
 
@@ -117,3 +286,41 @@ func (uc orderUseCase) Cancel(id string) error {
 
 	return nil
 }
+
+func (uc orderUseCase) SwapCar(id, categoryId, carModel, policyId, reason string) error {
+	order, err := uc.orderRepo.FindOne(id)
+
+	if err != nil {
+		return ErrInvalidOrder
+	}
+
+	var policy *domain.Policy
+	if policyId != "" {
+		if policy, err = uc.orderSvc.GetPolicy(categoryId, carModel, policyId); err != nil {
+			return ErrInvalidPolicy
+		}
+
+		if order.AccountId != "" {
+			account, err := uc.accountRepo.FindOne(order.AccountId)
+			if err != nil {
+				return ErrNotFoundAccount
+			}
+			*policy = account.NegotiatedPolicy(*policy)
+		}
+	}
+
+	car, err := uc.orderSvc.GetCar(order.StationFromId, carModel, false)
+	if err != nil {
+		return ErrInvalidCar
+	}
+
+	if err := order.SwapCar(*car, policy, reason, time.Now()); err != nil {
+		return ErrInvalidSwap
+	}
+
+	if err := uc.orderRepo.Save(*order); err != nil {
+		return ErrInvalidOrder
+	}
+
+	return nil
+}
@@ -48,12 +48,13 @@ func newOrderFixture() *domain.Order {
 }
 
 type orderRepositoryMock struct {
-	expectedFindAllOrders []domain.Order
-	expectedFindOneOrder  *domain.Order
-	expectedFindOneErr    error
-	expectedSaveErr       error
-	expectedDeleteErr     error
-	calls                 map[string]uint
+	expectedFindAllOrders  []domain.Order
+	expectedFindOneOrder   *domain.Order
+	expectedFindOneErr     error
+	expectedSaveErr        error
+	expectedSaveChargedErr error
+	expectedDeleteErr      error
+	calls                  map[string]uint
 }
 
 func (m *orderRepositoryMock) FindAll() []domain.Order {
@@ -66,11 +67,26 @@ func (m *orderRepositoryMock) FindOne(id string) (*domain.Order, error) {
 	return m.expectedFindOneOrder, m.expectedFindOneErr
 }
 
+func (m *orderRepositoryMock) FindRentedByCar(carId string) (*domain.Order, error) {
+	m.calls["FindRentedByCar"] = m.calls["FindRentedByCar"] + 1
+	return m.expectedFindOneOrder, m.expectedFindOneErr
+}
+
+func (m *orderRepositoryMock) FindOverdue(at time.Time) []domain.Order {
+	m.calls["FindOverdue"] = m.calls["FindOverdue"] + 1
+	return m.expectedFindAllOrders
+}
+
 func (m *orderRepositoryMock) Save(order domain.Order) error {
 	m.calls["Save"] = m.calls["Save"] + 1
 	return m.expectedSaveErr
 }
 
+func (m *orderRepositoryMock) SaveCharged(order domain.Order, account domain.CorporateAccount, now time.Time) error {
+	m.calls["SaveCharged"] = m.calls["SaveCharged"] + 1
+	return m.expectedSaveChargedErr
+}
+
 func (m *orderRepositoryMock) Delete(id string) error {
 	m.calls["Delete"] = m.calls["Delete"] + 1
 	return m.expectedDeleteErr
@@ -79,8 +95,19 @@ func (m *orderRepositoryMock) Delete(id string) error {
 type orderOrderServiceMock struct {
 	expectedGetPolicy    *domain.Policy
 	expectedGetPolicyErr error
+	expectedCategoryId   string
 	expectedGetCar       *domain.Car
 	expectedGetCarErr    error
+	expectedGetCars      []domain.Car
+	expectedGetCarsErr   error
+	expectedReserveErr   error
+	expectedCarKM        uint64
+	expectedCarKMErr     error
+	expectedBenefits     *MemberBenefits
+	expectedRedeem       float32
+	expectedRedeemErr    error
+	expectedClosed       bool
+	expectedLocationErr  error
 	calls                map[string]uint
 }
 
@@ -89,11 +116,64 @@ func (m *orderOrderServiceMock) GetPolicy(categoryId, modelId, policyId string)
 	return m.expectedGetPolicy, m.expectedGetPolicyErr
 }
 
-func (m *orderOrderServiceMock) GetCar(stationId, modelId string) (*domain.Car, error) {
+func (m *orderOrderServiceMock) GetModelCategory(modelId string) (string, error) {
+	m.calls["GetModelCategory"] = m.calls["GetModelCategory"] + 1
+	return m.expectedCategoryId, nil
+}
+
+func (m *orderOrderServiceMock) GetCar(stationId, modelId string, upgrade bool) (*domain.Car, error) {
 	m.calls["GetCar"] = m.calls["GetCar"] + 1
 	return m.expectedGetCar, m.expectedGetCarErr
 }
 
+func (m *orderOrderServiceMock) GetCars(stationId string, modelIds []string) ([]domain.Car, error) {
+	m.calls["GetCars"] = m.calls["GetCars"] + 1
+	return m.expectedGetCars, m.expectedGetCarsErr
+}
+
+func (m *orderOrderServiceMock) ReserveCars(reservationId, stationId string, carIds []string) error {
+	m.calls["ReserveCars"] = m.calls["ReserveCars"] + 1
+	return m.expectedReserveErr
+}
+
+func (m *orderOrderServiceMock) ReleaseCars(reservationId, stationId string, carIds []string) error {
+	m.calls["ReleaseCars"] = m.calls["ReleaseCars"] + 1
+	return nil
+}
+
+func (m *orderOrderServiceMock) GetCarKM(carId string) (uint64, error) {
+	m.calls["GetCarKM"] = m.calls["GetCarKM"] + 1
+	return m.expectedCarKM, m.expectedCarKMErr
+}
+
+func (m *orderOrderServiceMock) IsStationOpen(stationId string, at time.Time, keyDrop bool) (bool, error) {
+	m.calls["IsStationOpen"] = m.calls["IsStationOpen"] + 1
+	return !m.expectedClosed, nil
+}
+
+func (m *orderOrderServiceMock) GetStationLocation(stationId string) (float64, float64, error) {
+	m.calls["GetStationLocation"] = m.calls["GetStationLocation"] + 1
+	return -23.5, -46.6, m.expectedLocationErr
+}
+
+func (m *orderOrderServiceMock) GetMemberBenefits(memberId string) (*MemberBenefits, error) {
+	m.calls["GetMemberBenefits"] = m.calls["GetMemberBenefits"] + 1
+	if m.expectedBenefits == nil {
+		return nil, ErrNotFoundMember
+	}
+	return m.expectedBenefits, nil
+}
+
+func (m *orderOrderServiceMock) RedeemPoints(memberId, orderId string, points uint, maxAmount float32) (float32, error) {
+	m.calls["RedeemPoints"] = m.calls["RedeemPoints"] + 1
+	return m.expectedRedeem, m.expectedRedeemErr
+}
+
+func (m *orderOrderServiceMock) RefundPoints(memberId, orderId string) error {
+	m.calls["RefundPoints"] = m.calls["RefundPoints"] + 1
+	return nil
+}
+
 func TestOrderUseCase_GetById(t *testing.T) {
 	newOrder := newOrderFixture()
 
@@ -170,7 +250,7 @@ func TestOrderUseCase_GetById(t *testing.T) {
 				calls:                make(map[string]uint),
 			}
 			orderSvc := &orderOrderServiceMock{}
-			orderUC := NewOrderUseCase(orderRepo, orderSvc)
+			orderUC := NewOrderUseCase(orderRepo, orderSvc, &accountRepositoryMock{calls: map[string]uint{}}, &eligibilityRepositoryMock{calls: make(map[string]uint)}, &waitlistRepositoryMock{calls: make(map[string]uint)})
 			order, err := orderUC.GetById(tc.args.id)
 
 			if orderRepo.calls["FindOne"] != tc.want.calls {
@@ -199,6 +279,7 @@ func TestOrderUseCase_Open(t *testing.T) {
 		repoGetCar       *domain.Car
 		repoGetCarErr    error
 		repoSaveErr      error
+		stationClosed    bool
 	}
 
 	type args struct {
@@ -319,6 +400,29 @@ func TestOrderUseCase_Open(t *testing.T) {
 				saveCalls:      0,
 			},
 		},
+		{
+			name: "closed station",
+			setup: setup{
+				repoGetPolicy: newPolicyFixture(),
+				repoGetCar:    newCarFixture(),
+				stationClosed: true,
+			},
+			args: args{
+				dateReservFrom: newOrder.DateReservFrom,
+				dateReservTo:   newOrder.DateReservTo,
+				stationFromId:  newOrder.StationFromId,
+				stationToId:    newOrder.StationToId,
+				categoryId:     newOrder.Policy.CategoryId,
+				carModel:       newOrder.Policy.CarModel,
+				policyId:       newOrder.Policy.ID,
+			},
+			want: want{
+				err:            ErrStationClosed,
+				getPolicyCalls: 0,
+				getCarCalls:    0,
+				saveCalls:      0,
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -332,17 +436,19 @@ func TestOrderUseCase_Open(t *testing.T) {
 				expectedGetPolicyErr: tc.setup.repoGetPolicyErr,
 				expectedGetCar:       tc.setup.repoGetCar,
 				expectedGetCarErr:    tc.setup.repoGetCarErr,
+				expectedClosed:       tc.setup.stationClosed,
 				calls:                make(map[string]uint),
 			}
-			orderUC := NewOrderUseCase(orderRepo, orderSvc)
-			err := orderUC.Open(
-				tc.args.dateReservFrom,
-				tc.args.dateReservTo,
-				tc.args.stationFromId,
-				tc.args.stationToId,
-				tc.args.categoryId,
-				tc.args.carModel,
-				tc.args.policyId)
+			orderUC := NewOrderUseCase(orderRepo, orderSvc, &accountRepositoryMock{calls: map[string]uint{}}, &eligibilityRepositoryMock{calls: make(map[string]uint)}, &waitlistRepositoryMock{calls: make(map[string]uint)})
+			err := orderUC.Open(OpenOrderInput{
+				DateReservFrom: tc.args.dateReservFrom,
+				DateReservTo:   tc.args.dateReservTo,
+				StationFromId:  tc.args.stationFromId,
+				StationToId:    tc.args.stationToId,
+				CategoryId:     tc.args.categoryId,
+				CarModel:       tc.args.carModel,
+				PolicyId:       tc.args.policyId,
+			})
 
 			if orderSvc.calls["GetPolicy"] != tc.want.getPolicyCalls {
 				t.Error("invalid repo call", orderSvc.calls["GetPolicy"])
@@ -469,8 +575,9 @@ func TestOrderUseCase_Confirm(t *testing.T) {
 				calls:                make(map[string]uint),
 			}
 			orderSvc := &orderOrderServiceMock{}
-			orderUC := NewOrderUseCase(orderRepo, orderSvc)
-			err := orderUC.Confirm(tc.args.id, tc.args.dateFrom)
+			orderUC := NewOrderUseCase(orderRepo, orderSvc, &accountRepositoryMock{calls: map[string]uint{}}, &eligibilityRepositoryMock{calls: make(map[string]uint)}, &waitlistRepositoryMock{calls: make(map[string]uint)})
+			fuel := domain.FullLevel
+			err := orderUC.Confirm(tc.args.id, tc.args.dateFrom, &fuel)
 
 			if orderRepo.calls["FindOne"] != tc.want.findCalls {
 				t.Error("invalid repo call", orderSvc.calls["FindOne"])
@@ -572,7 +679,7 @@ func TestOrderUseCase_Cancel(t *testing.T) {
 				calls:                make(map[string]uint),
 			}
 			orderSvc := &orderOrderServiceMock{}
-			orderUC := NewOrderUseCase(orderRepo, orderSvc)
+			orderUC := NewOrderUseCase(orderRepo, orderSvc, &accountRepositoryMock{calls: map[string]uint{}}, &eligibilityRepositoryMock{calls: make(map[string]uint)}, &waitlistRepositoryMock{calls: make(map[string]uint)})
 			err := orderUC.Cancel(tc.args.id)
 
 			if orderRepo.calls["FindOne"] != tc.want.findCalls {
@@ -756,8 +863,8 @@ func TestOrderUseCase_Close(t *testing.T) {
 				calls:                make(map[string]uint),
 			}
 			orderSvc := &orderOrderServiceMock{}
-			orderUC := NewOrderUseCase(orderRepo, orderSvc)
-			err := orderUC.Close(tc.args.id, tc.args.discount, tc.args.tax, tc.args.dateTo, tc.args.km)
+			orderUC := NewOrderUseCase(orderRepo, orderSvc, &accountRepositoryMock{calls: map[string]uint{}}, &eligibilityRepositoryMock{calls: make(map[string]uint)}, &waitlistRepositoryMock{calls: make(map[string]uint)})
+			err := orderUC.Close(tc.args.id, tc.args.discount, tc.args.tax, tc.args.dateTo, tc.args.km, domain.FullLevel)
 
 			if orderRepo.calls["FindOne"] != tc.want.findCalls {
 				t.Error("invalid repo call", orderSvc.calls["FindOne"])
@@ -773,3 +880,219 @@ func TestOrderUseCase_Close(t *testing.T) {
 		})
 	}
 }
+
+func TestOrderUseCase_SwapCar(t *testing.T) {
+	swapCar := newCarFixture()
+	swapCar.ID = "0b1b5f2a-3f4e-4b7b-9c41-7d8a6c1f2e3d"
+	swapCar.CarModel = "COROLLA"
+	swapCar.Status = domain.Parked
+
+	swapPolicy := newPolicyFixture()
+	swapPolicy.ID = "9a8b7c6d-5e4f-4a3b-8c2d-1e0f9a8b7c6d"
+	swapPolicy.CarModel = "COROLLA"
+
+	type setup struct {
+		repoOrderErr     error
+		repoGetCarErr    error
+		repoGetPolicyErr error
+	}
+
+	type args struct {
+		policyId string
+		reason   string
+	}
+
+	type want struct {
+		err            error
+		getPolicyCalls uint
+		getCarCalls    uint
+		saveCalls      uint
+	}
+
+	testCases := []struct {
+		name  string
+		setup setup
+		args  args
+		want  want
+	}{
+		{
+			name:  "correct free upgrade",
+			setup: setup{},
+			args:  args{reason: "booked model unavailable"},
+			want:  want{err: nil, getPolicyCalls: 0, getCarCalls: 1, saveCalls: 1},
+		},
+		{
+			name:  "correct paid upgrade",
+			setup: setup{},
+			args:  args{policyId: swapPolicy.ID, reason: "customer asked"},
+			want:  want{err: nil, getPolicyCalls: 1, getCarCalls: 1, saveCalls: 1},
+		},
+		{
+			name:  "not found order",
+			setup: setup{repoOrderErr: ErrNotFoundOrder},
+			args:  args{reason: "booked model unavailable"},
+			want:  want{err: ErrInvalidOrder, getPolicyCalls: 0, getCarCalls: 0, saveCalls: 0},
+		},
+		{
+			name:  "incorrect policy",
+			setup: setup{repoGetPolicyErr: ErrInvalidPolicy},
+			args:  args{policyId: swapPolicy.ID, reason: "customer asked"},
+			want:  want{err: ErrInvalidPolicy, getPolicyCalls: 1, getCarCalls: 0, saveCalls: 0},
+		},
+		{
+			name:  "no car available",
+			setup: setup{repoGetCarErr: ErrInvalidCar},
+			args:  args{reason: "booked model unavailable"},
+			want:  want{err: ErrInvalidCar, getPolicyCalls: 0, getCarCalls: 1, saveCalls: 0},
+		},
+		{
+			name:  "incorrect missing reason",
+			setup: setup{},
+			args:  args{},
+			want:  want{err: ErrInvalidSwap, getPolicyCalls: 0, getCarCalls: 1, saveCalls: 0},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			car := *swapCar
+			orderRepo := &orderRepositoryMock{
+				expectedFindOneOrder: newOrderFixture(),
+				expectedFindOneErr:   tc.setup.repoOrderErr,
+				calls:                make(map[string]uint),
+			}
+			orderSvc := &orderOrderServiceMock{
+				expectedGetPolicy:    swapPolicy,
+				expectedGetPolicyErr: tc.setup.repoGetPolicyErr,
+				expectedGetCar:       &car,
+				expectedGetCarErr:    tc.setup.repoGetCarErr,
+				calls:                make(map[string]uint),
+			}
+			orderUC := NewOrderUseCase(orderRepo, orderSvc, &accountRepositoryMock{calls: map[string]uint{}}, &eligibilityRepositoryMock{calls: make(map[string]uint)}, &waitlistRepositoryMock{calls: make(map[string]uint)})
+			err := orderUC.SwapCar(newOrderFixture().ID, swapPolicy.CategoryId, "COROLLA", tc.args.policyId, tc.args.reason)
+
+			if !errors.Is(err, tc.want.err) {
+				t.Error("unexpected error", err)
+			}
+
+			if orderSvc.calls["GetPolicy"] != tc.want.getPolicyCalls {
+				t.Error("invalid service call", orderSvc.calls["GetPolicy"])
+			}
+
+			if orderSvc.calls["GetCar"] != tc.want.getCarCalls {
+				t.Error("invalid service call", orderSvc.calls["GetCar"])
+			}
+
+			if orderRepo.calls["Save"] != tc.want.saveCalls {
+				t.Error("invalid repo call", orderRepo.calls["Save"])
+			}
+		})
+	}
+}
+
+func TestOrderUseCase_OpenForMember(t *testing.T) {
+	memberId := "0c7e2b4a-6d1f-4e3b-9a8c-5f2d1e0b3a4c"
+
+	type setup struct {
+		benefits  *MemberBenefits
+		redeem    float32
+		redeemErr error
+		saveErr   error
+	}
+
+	type want struct {
+		err     error
+		charges int
+		redeems uint
+		refunds uint
+	}
+
+	testCases := []struct {
+		name     string
+		setup    setup
+		memberId string
+		points   uint
+		want     want
+	}{
+		{
+			name:     "correct member without redemption",
+			setup:    setup{benefits: &MemberBenefits{}},
+			memberId: memberId,
+			want:     want{err: nil, charges: 0, redeems: 0, refunds: 0},
+		},
+		{
+			name:     "correct member with redemption",
+			setup:    setup{benefits: &MemberBenefits{}, redeem: 10},
+			memberId: memberId,
+			points:   1000,
+			want:     want{err: nil, charges: 1, redeems: 1, refunds: 0},
+		},
+		{
+			name:     "incorrect member",
+			memberId: memberId,
+			want:     want{err: ErrNotFoundMember},
+		},
+		{
+			name:   "incorrect redemption without member",
+			points: 1000,
+			want:   want{err: ErrInvalidRedemption},
+		},
+		{
+			name:     "incorrect insufficient points",
+			setup:    setup{benefits: &MemberBenefits{}, redeemErr: ErrInvalidRedemption},
+			memberId: memberId,
+			points:   1000,
+			want:     want{err: ErrInvalidRedemption, redeems: 1, refunds: 0},
+		},
+		{
+			name:     "incorrect save refunds points",
+			setup:    setup{benefits: &MemberBenefits{}, redeem: 10, saveErr: errors.New("save failed")},
+			memberId: memberId,
+			points:   1000,
+			want:     want{err: ErrInvalidOrder, redeems: 1, refunds: 1},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			car := *newCarFixture()
+			car.Status = domain.Parked
+
+			var saved domain.Order
+			orderRepo := &orderSaveRecorder{orderRepositoryMock{expectedSaveErr: tc.setup.saveErr, calls: make(map[string]uint)}, &saved}
+			orderSvc := &orderOrderServiceMock{
+				expectedGetPolicy: newPolicyFixture(),
+				expectedGetCar:    &car,
+				expectedBenefits:  tc.setup.benefits,
+				expectedRedeem:    tc.setup.redeem,
+				expectedRedeemErr: tc.setup.redeemErr,
+				calls:             make(map[string]uint),
+			}
+			orderUC := NewOrderUseCase(orderRepo, orderSvc, &accountRepositoryMock{calls: make(map[string]uint)}, &eligibilityRepositoryMock{calls: make(map[string]uint)}, &waitlistRepositoryMock{calls: make(map[string]uint)})
+
+			err := orderUC.Open(OpenOrderInput{
+				DateReservFrom: time.Now(),
+				DateReservTo:   time.Now().Add(time.Hour * 24 * 5),
+				StationFromId:  "83369771-f9a4-48b7-b87b-463f19f7b187",
+				StationToId:    "2520aade-a397-4e3c-a589-39c6ae5c2eff",
+				CategoryId:     "479ab9e7-ad16-4864-8e49-29b15e4b390e",
+				CarModel:       "UNO",
+				PolicyId:       "5ecf09ce-8c41-4faa-a4e5-824af9c80892",
+				MemberId:       tc.memberId,
+				RedeemPoints:   tc.points,
+			})
+
+			if !errors.Is(err, tc.want.err) {
+				t.Fatal("unexpected error", err)
+			}
+
+			if orderSvc.calls["RedeemPoints"] != tc.want.redeems || orderSvc.calls["RefundPoints"] != tc.want.refunds {
+				t.Error("unexpected loyalty calls", orderSvc.calls)
+			}
+
+			if err == nil && (saved.MemberId != tc.memberId || len(saved.Charges) != tc.want.charges) {
+				t.Error("unexpected order", saved)
+			}
+		})
+	}
+}
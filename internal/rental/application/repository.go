@@ -1,16 +1,143 @@
 package application
 
-import "github.com/thiagotrs/rentalcar-ddd/internal/rental/domain"
+import (
+	"time"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/rental/domain"
+)
 
 type OrderReaderRepository interface {
 	FindOne(id string) (*domain.Order, error)
+	FindRentedByCar(carId string) (*domain.Order, error)
+	FindOverdue(at time.Time) []domain.Order
 }
 
 type OrderWriterRepository interface {
 	Save(order domain.Order) error
+	SaveCharged(order domain.Order, account domain.CorporateAccount, now time.Time) error
 }
 
 type OrderRepository interface {
 	OrderReaderRepository
 	OrderWriterRepository
 }
+
+type GeofenceReaderRepository interface {
+	FindAll() []domain.Geofence
+	FindByScope(categoryId, orderId string) []domain.Geofence
+	FindOne(id string) (*domain.Geofence, error)
+}
+
+type GeofenceWriterRepository interface {
+	Save(geofence domain.Geofence) error
+	Delete(id string) error
+}
+
+type GeofenceRepository interface {
+	GeofenceReaderRepository
+	GeofenceWriterRepository
+}
+
+type PositionReaderRepository interface {
+	FindLastByOrder(orderId string) (*domain.CarPosition, error)
+}
+
+type PositionWriterRepository interface {
+	Save(position domain.CarPosition) error
+}
+
+type PositionRepository interface {
+	PositionReaderRepository
+	PositionWriterRepository
+}
+
+type AlertReaderRepository interface {
+	FindAll() []domain.GeofenceAlert
+	FindByOrder(orderId string) []domain.GeofenceAlert
+}
+
+type AlertWriterRepository interface {
+	Save(alert domain.GeofenceAlert) error
+}
+
+type AlertRepository interface {
+	AlertReaderRepository
+	AlertWriterRepository
+}
+
+type BookingReaderRepository interface {
+	FindOne(id string) (*domain.Booking, error)
+}
+
+type BookingWriterRepository interface {
+	Save(booking domain.Booking) error
+}
+
+type BookingRepository interface {
+	BookingReaderRepository
+	BookingWriterRepository
+}
+
+type AccountReaderRepository interface {
+	FindAll() []domain.CorporateAccount
+	FindOne(id string) (*domain.CorporateAccount, error)
+	FindOrders(accountId string) []domain.Order
+}
+
+type AccountWriterRepository interface {
+	Save(account domain.CorporateAccount) error
+}
+
+type AccountRepository interface {
+	AccountReaderRepository
+	AccountWriterRepository
+}
+
+type SubscriptionReaderRepository interface {
+	FindOne(id string) (*domain.Subscription, error)
+	FindDueForBilling(at time.Time) []domain.Subscription
+}
+
+type SubscriptionWriterRepository interface {
+	Save(subscription domain.Subscription) error
+}
+
+type SubscriptionRepository interface {
+	SubscriptionReaderRepository
+	SubscriptionWriterRepository
+}
+
+type EligibilityReaderRepository interface {
+	FindRules() []domain.EligibilityRule
+	FindRule(categoryId string) (*domain.EligibilityRule, error)
+	FindBlockedDrivers() []domain.BlockedDriver
+	FindBlockedDriver(license string) (*domain.BlockedDriver, error)
+}
+
+type EligibilityWriterRepository interface {
+	SaveRule(rule domain.EligibilityRule) error
+	DeleteRule(categoryId string) error
+	SaveBlockedDriver(blocked domain.BlockedDriver) error
+	DeleteBlockedDriver(license string) error
+}
+
+type EligibilityRepository interface {
+	EligibilityReaderRepository
+	EligibilityWriterRepository
+}
+
+type WaitlistReaderRepository interface {
+	FindOne(id string) (*domain.WaitlistEntry, error)
+	FindWaiting(stationId, carModel string) []domain.WaitlistEntry
+	FindActive() []domain.WaitlistEntry
+	FindByHeldCar(carId string) (*domain.WaitlistEntry, error)
+}
+
+type WaitlistWriterRepository interface {
+	Save(entry domain.WaitlistEntry) error
+}
+
+type WaitlistRepository interface {
+	WaitlistReaderRepository
+	WaitlistWriterRepository
+}
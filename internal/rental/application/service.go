@@ -1,16 +1,43 @@
 package application
 
-import "github.com/thiagotrs/rentalcar-ddd/internal/rental/domain"
+import (
+	"time"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/rental/domain"
+)
 
 type PolicyService interface {
 	GetPolicy(categoryId, modelId, policyId string) (*domain.Policy, error)
+	GetModelCategory(modelId string) (string, error)
 }
 
 type CarService interface {
-	GetCar(stationId, modelId string) (*domain.Car, error)
+	GetCar(stationId, modelId string, upgrade bool) (*domain.Car, error)
+	GetCars(stationId string, modelIds []string) ([]domain.Car, error)
+	ReserveCars(reservationId, stationId string, carIds []string) error
+	ReleaseCars(reservationId, stationId string, carIds []string) error
+	GetCarKM(carId string) (uint64, error)
+}
+
+type StationService interface {
+	IsStationOpen(stationId string, at time.Time, keyDrop bool) (bool, error)
+	GetStationLocation(stationId string) (latitude, longitude float64, err error)
+}
+
+type MemberBenefits struct {
+	FreePrepaidFuel bool
+	UpgradePriority bool
+}
+
+type LoyaltyService interface {
+	GetMemberBenefits(memberId string) (*MemberBenefits, error)
+	RedeemPoints(memberId, orderId string, points uint, maxAmount float32) (float32, error)
+	RefundPoints(memberId, orderId string) error
 }
 
 type OrderService interface {
 	PolicyService
 	CarService
+	StationService
+	LoyaltyService
 }
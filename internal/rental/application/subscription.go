@@ -0,0 +1,191 @@
+package application
+
+import (
+	"time"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/validation"
+	"github.com/thiagotrs/rentalcar-ddd/internal/rental/domain"
+)
+
+type SubscriptionUseCase interface {
+	GetSubscriptionById(id string) (*domain.Subscription, error)
+	GetSubscriptionInvoice(id string) (*domain.Invoice, error)
+	Subscribe(stationId, categoryId, carModel, policyId string, driver domain.DriverProfile) (*domain.Subscription, error)
+	BillSubscription(id string, km uint64) error
+	BillDueSubscriptions(at time.Time) error
+	SwapSubscriptionCar(id, carModel, reason string, km uint64, fuelLevel uint8) error
+	PauseSubscription(id string, km uint64, fuelLevel uint8) error
+	ResumeSubscription(id, carModel string) error
+	CancelSubscription(id string, km uint64, fuelLevel uint8) error
+}
+
+type subscriptionUseCase struct {
+	subscriptionRepo SubscriptionRepository
+	orderSvc         OrderService
+	eligibilityRepo  EligibilityReaderRepository
+}
+
+func NewSubscriptionUseCase(subscriptionRepo SubscriptionRepository, orderSvc OrderService, eligibilityRepo EligibilityReaderRepository) *subscriptionUseCase {
+	return &subscriptionUseCase{
+		subscriptionRepo: subscriptionRepo,
+		orderSvc:         orderSvc,
+		eligibilityRepo:  eligibilityRepo,
+	}
+}
+
+func (uc subscriptionUseCase) GetSubscriptionById(id string) (*domain.Subscription, error) {
+	if err := validation.ValidId(id); err != nil {
+		return nil, ErrInvalidId
+	}
+
+	subscription, err := uc.subscriptionRepo.FindOne(id)
+	if err != nil {
+		return nil, ErrNotFoundSubscription
+	}
+
+	return subscription, nil
+}
+
+func (uc subscriptionUseCase) GetSubscriptionInvoice(id string) (*domain.Invoice, error) {
+	subscription, err := uc.GetSubscriptionById(id)
+	if err != nil {
+		return nil, err
+	}
+
+	invoice := subscription.Invoice()
+
+	return &invoice, nil
+}
+
+func (uc subscriptionUseCase) Subscribe(stationId, categoryId, carModel, policyId string, driver domain.DriverProfile) (*domain.Subscription, error) {
+	policy, err := uc.orderSvc.GetPolicy(categoryId, carModel, policyId)
+	if err != nil {
+		return nil, ErrInvalidPolicy
+	}
+
+	if _, err := checkEligibility(uc.eligibilityRepo, driver, time.Now(), policy.CategoryId); err != nil {
+		return nil, err
+	}
+
+	car, err := uc.orderSvc.GetCar(stationId, carModel, false)
+	if err != nil {
+		return nil, ErrInvalidCar
+	}
+
+	subscription, err := domain.NewSubscription(*car, stationId, *policy, time.Now())
+	switch err {
+	case nil:
+	case domain.ErrInvalidSubscription:
+		return nil, ErrInvalidSubscription
+	default:
+		return nil, ErrInvalidEntity
+	}
+
+	if err := uc.subscriptionRepo.Save(*subscription); err != nil {
+		return nil, ErrInvalidSubscription
+	}
+
+	return subscription, nil
+}
+
+func (uc subscriptionUseCase) BillSubscription(id string, km uint64) error {
+	return uc.updateSubscription(id, func(subscription *domain.Subscription) error {
+		if err := subscription.Bill(time.Now(), km); err != nil {
+			return ErrInvalidBilling
+		}
+		return nil
+	})
+}
+
+func (uc subscriptionUseCase) BillDueSubscriptions(at time.Time) error {
+	var failed error
+	for _, subscription := range uc.subscriptionRepo.FindDueForBilling(at) {
+		km, err := uc.orderSvc.GetCarKM(subscription.Car.ID)
+		if err != nil {
+			failed = ErrInvalidCar
+			continue
+		}
+
+		if err := subscription.Bill(at, km); err != nil {
+			failed = ErrInvalidBilling
+			continue
+		}
+
+		if err := uc.subscriptionRepo.Save(subscription); err != nil {
+			failed = ErrInvalidSubscription
+		}
+	}
+
+	return failed
+}
+
+func (uc subscriptionUseCase) SwapSubscriptionCar(id, carModel, reason string, km uint64, fuelLevel uint8) error {
+	return uc.updateSubscription(id, func(subscription *domain.Subscription) error {
+		car, err := uc.orderSvc.GetCar(subscription.StationId, carModel, false)
+		if err != nil {
+			return ErrInvalidCar
+		}
+
+		if err := subscription.SwapCar(*car, km, fuelLevel, reason, time.Now()); err != nil {
+			return ErrInvalidSwap
+		}
+		return nil
+	})
+}
+
+func (uc subscriptionUseCase) PauseSubscription(id string, km uint64, fuelLevel uint8) error {
+	return uc.updateSubscription(id, func(subscription *domain.Subscription) error {
+		if err := subscription.Pause(time.Now(), km, fuelLevel); err != nil {
+			return ErrInvalidPause
+		}
+		return nil
+	})
+}
+
+func (uc subscriptionUseCase) ResumeSubscription(id, carModel string) error {
+	return uc.updateSubscription(id, func(subscription *domain.Subscription) error {
+		if subscription.Status != domain.PausedSubscription {
+			return ErrInvalidResume
+		}
+
+		if carModel == "" {
+			carModel = subscription.Policy.CarModel
+		}
+
+		car, err := uc.orderSvc.GetCar(subscription.StationId, carModel, false)
+		if err != nil {
+			return ErrInvalidCar
+		}
+
+		if err := subscription.Resume(*car, time.Now()); err != nil {
+			return ErrInvalidResume
+		}
+		return nil
+	})
+}
+
+func (uc subscriptionUseCase) CancelSubscription(id string, km uint64, fuelLevel uint8) error {
+	return uc.updateSubscription(id, func(subscription *domain.Subscription) error {
+		if err := subscription.Cancel(time.Now(), km, fuelLevel); err != nil {
+			return ErrCancelSubscription
+		}
+		return nil
+	})
+}
+
+func (uc subscriptionUseCase) updateSubscription(id string, update func(subscription *domain.Subscription) error) error {
+	subscription, err := uc.GetSubscriptionById(id)
+	if err != nil {
+		return err
+	}
+
+	if err := update(subscription); err != nil {
+		return err
+	}
+
+	if err := uc.subscriptionRepo.Save(*subscription); err != nil {
+		return ErrInvalidSubscription
+	}
+
+	return nil
+}
@@ -0,0 +1,196 @@
+package application
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/rental/domain"
+)
+
+type subscriptionRepositoryMock struct {
+	expectedFindOne *domain.Subscription
+	expectedFindDue []domain.Subscription
+	expectedSaveErr error
+	calls           map[string]uint
+}
+
+func (m *subscriptionRepositoryMock) FindOne(id string) (*domain.Subscription, error) {
+	m.calls["FindOne"] = m.calls["FindOne"] + 1
+	if m.expectedFindOne == nil {
+		return nil, ErrNotFoundSubscription
+	}
+	return m.expectedFindOne, nil
+}
+
+func (m *subscriptionRepositoryMock) FindDueForBilling(at time.Time) []domain.Subscription {
+	m.calls["FindDueForBilling"] = m.calls["FindDueForBilling"] + 1
+	return m.expectedFindDue
+}
+
+func (m *subscriptionRepositoryMock) Save(subscription domain.Subscription) error {
+	m.calls["Save"] = m.calls["Save"] + 1
+	return m.expectedSaveErr
+}
+
+func newMonthlyPolicyFixture() *domain.Policy {
+	policy := newPolicyFixture()
+	policy.Unit = domain.PerMonth
+	policy.MinUnit = 3
+	policy.KMAllowance = 1500
+	policy.ExcessKMPrice = 0.5
+	return policy
+}
+
+func newSubscriptionFixture(t *testing.T) *domain.Subscription {
+	t.Helper()
+	car := *newCarFixture()
+	car.Status = domain.Parked
+	subscription, err := domain.NewSubscription(car, "83369771-f9a4-48b7-b87b-463f19f7b187", *newMonthlyPolicyFixture(), time.Now().AddDate(0, -1, -1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return subscription
+}
+
+func TestSubscriptionUseCase_Subscribe(t *testing.T) {
+	rule := &domain.EligibilityRule{CategoryId: "479ab9e7-ad16-4864-8e49-29b15e4b390e", MinLicenseYears: 2}
+
+	type setup struct {
+		policy *domain.Policy
+		carErr error
+		rule   *domain.EligibilityRule
+	}
+
+	type want struct {
+		err   error
+		saves uint
+	}
+
+	testCases := []struct {
+		name  string
+		setup setup
+		want  want
+	}{
+		{name: "correct input", setup: setup{policy: newMonthlyPolicyFixture()}, want: want{err: nil, saves: 1}},
+		{name: "incorrect daily policy", setup: setup{policy: newPolicyFixture()}, want: want{err: ErrInvalidSubscription, saves: 0}},
+		{name: "incorrect unavailable car", setup: setup{policy: newMonthlyPolicyFixture(), carErr: ErrInvalidCar}, want: want{err: ErrInvalidCar, saves: 0}},
+		{name: "incorrect ineligible driver", setup: setup{policy: newMonthlyPolicyFixture(), rule: rule}, want: want{err: domain.ErrIneligibleDriver, saves: 0}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			car := *newCarFixture()
+			car.Status = domain.Parked
+
+			repo := &subscriptionRepositoryMock{calls: make(map[string]uint)}
+			svc := &orderOrderServiceMock{
+				expectedGetPolicy: tc.setup.policy,
+				expectedGetCar:    &car,
+				expectedGetCarErr: tc.setup.carErr,
+				calls:             make(map[string]uint),
+			}
+			uc := NewSubscriptionUseCase(repo, svc, &eligibilityRepositoryMock{expectedFindRule: tc.setup.rule, calls: make(map[string]uint)})
+
+			subscription, err := uc.Subscribe(
+				"83369771-f9a4-48b7-b87b-463f19f7b187",
+				"479ab9e7-ad16-4864-8e49-29b15e4b390e",
+				"UNO",
+				"5ecf09ce-8c41-4faa-a4e5-824af9c80892",
+				domain.DriverProfile{License: "07788990011"})
+
+			if !errors.Is(err, tc.want.err) {
+				t.Fatal("unexpected error", err)
+			}
+
+			if repo.calls["Save"] != tc.want.saves {
+				t.Error("unexpected saves", repo.calls["Save"])
+			}
+
+			if err == nil && (subscription.Status != domain.ActiveSubscription || subscription.Policy.KMAllowance != 1500) {
+				t.Error("unexpected subscription", subscription)
+			}
+		})
+	}
+}
+
+func TestSubscriptionUseCase_Lifecycle(t *testing.T) {
+	subscription := newSubscriptionFixture(t)
+
+	otherCar := *newCarFixture()
+	otherCar.ID = "2f6a7c1e-8b4d-4e3a-9f5c-6d1b2a3c4e5f"
+	otherCar.Status = domain.Parked
+
+	repo := &subscriptionRepositoryMock{expectedFindOne: subscription, calls: make(map[string]uint)}
+	svc := &orderOrderServiceMock{expectedGetCar: &otherCar, calls: make(map[string]uint)}
+	uc := NewSubscriptionUseCase(repo, svc, &eligibilityRepositoryMock{calls: make(map[string]uint)})
+
+	if err := uc.ResumeSubscription(subscription.ID, ""); !errors.Is(err, ErrInvalidResume) {
+		t.Fatal("unexpected error", err)
+	}
+
+	if err := uc.BillSubscription(subscription.ID, 13000); err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	if err := uc.BillSubscription(subscription.ID, 13000); !errors.Is(err, ErrInvalidBilling) {
+		t.Fatal("unexpected error", err)
+	}
+
+	if err := uc.PauseSubscription(subscription.ID, 13500, 90); err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	if err := uc.ResumeSubscription(subscription.ID, ""); err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	if err := uc.CancelSubscription(subscription.ID, 12500, 90); err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	if err := uc.CancelSubscription(subscription.ID, 12500, 90); !errors.Is(err, ErrCancelSubscription) {
+		t.Fatal("unexpected error", err)
+	}
+
+	if repo.calls["Save"] != 4 || subscription.Status != domain.CanceledSubscription || subscription.TerminationFee != 0 {
+		t.Error("unexpected subscription", repo.calls["Save"], subscription.Status, subscription.TerminationFee)
+	}
+
+	if _, err := uc.GetSubscriptionById("invalid-id"); !errors.Is(err, ErrInvalidId) {
+		t.Error("unexpected error", err)
+	}
+}
+
+func TestSubscriptionUseCase_BillDueSubscriptions(t *testing.T) {
+	testCases := []struct {
+		name      string
+		kmErr     error
+		wantErr   error
+		wantSaves uint
+	}{
+		{name: "correct due subscriptions billed", wantErr: nil, wantSaves: 2},
+		{name: "incorrect km not read", kmErr: ErrInvalidCar, wantErr: ErrInvalidCar, wantSaves: 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			due := []domain.Subscription{*newSubscriptionFixture(t), *newSubscriptionFixture(t)}
+			repo := &subscriptionRepositoryMock{expectedFindDue: due, calls: make(map[string]uint)}
+			svc := &orderOrderServiceMock{
+				expectedCarKM:    due[0].LastKM + 1800,
+				expectedCarKMErr: tc.kmErr,
+				calls:            make(map[string]uint),
+			}
+			uc := NewSubscriptionUseCase(repo, svc, &eligibilityRepositoryMock{calls: make(map[string]uint)})
+
+			if err := uc.BillDueSubscriptions(time.Now()); !errors.Is(err, tc.wantErr) {
+				t.Fatal("unexpected error", err)
+			}
+
+			if repo.calls["Save"] != tc.wantSaves || svc.calls["GetCarKM"] != 2 {
+				t.Error("unexpected calls", repo.calls, svc.calls)
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package application
+
+import (
+	"time"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/validation"
+	"github.com/thiagotrs/rentalcar-ddd/internal/rental/domain"
+)
+
+type WaitlistUseCase interface {
+	GetEntryById(id string) (*domain.WaitlistEntry, error)
+	Join(stationId, categoryId, carModel, policyId, contact string, dateFrom, dateTo time.Time) (*domain.WaitlistEntry, error)
+	Withdraw(id string) error
+	OfferCar(car domain.Car, at time.Time) error
+	ExpireEntries(at time.Time) []domain.WaitlistEntry
+}
+
+type waitlistUseCase struct {
+	waitlistRepo WaitlistRepository
+	orderSvc     PolicyService
+}
+
+func NewWaitlistUseCase(waitlistRepo WaitlistRepository, orderSvc PolicyService) *waitlistUseCase {
+	return &waitlistUseCase{
+		waitlistRepo: waitlistRepo,
+		orderSvc:     orderSvc,
+	}
+}
+
+func (uc waitlistUseCase) GetEntryById(id string) (*domain.WaitlistEntry, error) {
+	if err := validation.ValidId(id); err != nil {
+		return nil, ErrInvalidId
+	}
+
+	entry, err := uc.waitlistRepo.FindOne(id)
+	if err != nil {
+		return nil, ErrNotFoundWaitlist
+	}
+
+	entry.Position = uc.position(*entry)
+
+	return entry, nil
+}
+
+func (uc waitlistUseCase) Join(stationId, categoryId, carModel, policyId, contact string, dateFrom, dateTo time.Time) (*domain.WaitlistEntry, error) {
+	if _, err := uc.orderSvc.GetPolicy(categoryId, carModel, policyId); err != nil {
+		return nil, ErrInvalidPolicy
+	}
+
+	entry, err := domain.NewWaitlistEntry(stationId, categoryId, carModel, policyId, contact, dateFrom, dateTo, time.Now())
+	if err != nil {
+		return nil, ErrInvalidEntity
+	}
+
+	if err := uc.waitlistRepo.Save(*entry); err != nil {
+		return nil, ErrInvalidWaitlist
+	}
+
+	entry.Position = uc.position(*entry)
+
+	return entry, nil
+}
+
+func (uc waitlistUseCase) Withdraw(id string) error {
+	entry, err := uc.GetEntryById(id)
+	if err != nil {
+		return err
+	}
+
+	if err := entry.Withdraw(); err != nil {
+		return ErrInvalidWaitlist
+	}
+
+	if err := uc.waitlistRepo.Save(*entry); err != nil {
+		return ErrInvalidWaitlist
+	}
+
+	return nil
+}
+
+func (uc waitlistUseCase) OfferCar(car domain.Car, at time.Time) error {
+	if _, err := uc.waitlistRepo.FindByHeldCar(car.ID); err == nil {
+		return nil
+	}
+
+	for _, entry := range uc.waitlistRepo.FindWaiting(car.StationId, car.CarModel) {
+		if entry.Expire(at) {
+			if err := uc.waitlistRepo.Save(entry); err != nil {
+				return ErrInvalidWaitlist
+			}
+			continue
+		}
+
+		if !entry.Matches(car, at) {
+			continue
+		}
+
+		if err := entry.Hold(car, at); err != nil {
+			return ErrInvalidHold
+		}
+
+		if err := uc.waitlistRepo.Save(entry); err != nil {
+			return ErrInvalidWaitlist
+		}
+
+		return nil
+	}
+
+	return nil
+}
+
+func (uc waitlistUseCase) ExpireEntries(at time.Time) []domain.WaitlistEntry {
+	expired := []domain.WaitlistEntry{}
+
+	for _, entry := range uc.waitlistRepo.FindActive() {
+		if !entry.Expire(at) {
+			continue
+		}
+
+		if err := uc.waitlistRepo.Save(entry); err != nil {
+			continue
+		}
+
+		expired = append(expired, entry)
+	}
+
+	return expired
+}
+
+func (uc waitlistUseCase) position(entry domain.WaitlistEntry) uint {
+	if entry.Status != domain.Waiting {
+		return 0
+	}
+
+	var position uint
+	for _, e := range uc.waitlistRepo.FindWaiting(entry.StationId, entry.CarModel) {
+		position++
+		if e.ID == entry.ID {
+			return position
+		}
+	}
+
+	return 0
+}
@@ -0,0 +1,242 @@
+package application
+
+import (
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/rental/domain"
+)
+
+func newWaitlistEntryFixture(createdAt time.Time) *domain.WaitlistEntry {
+	entry, _ := domain.NewWaitlistEntry(
+		"83369771-f9a4-48b7-b87b-463f19f7b187",
+		"479ab9e7-ad16-4864-8e49-29b15e4b390e",
+		"UNO",
+		"5ecf09ce-8c41-4faa-a4e5-824af9c80892",
+		"ana@mail.com",
+		time.Now(),
+		time.Now().Add(time.Hour*24*5),
+		createdAt)
+	return entry
+}
+
+type waitlistRepositoryMock struct {
+	entries         []domain.WaitlistEntry
+	expectedSaveErr error
+	saved           []domain.WaitlistEntry
+	calls           map[string]uint
+}
+
+func (m *waitlistRepositoryMock) FindOne(id string) (*domain.WaitlistEntry, error) {
+	m.calls["FindOne"] = m.calls["FindOne"] + 1
+	for _, e := range m.entries {
+		if e.ID == id {
+			return &e, nil
+		}
+	}
+	return nil, ErrNotFoundWaitlist
+}
+
+func (m *waitlistRepositoryMock) FindWaiting(stationId, carModel string) []domain.WaitlistEntry {
+	m.calls["FindWaiting"] = m.calls["FindWaiting"] + 1
+	entries := []domain.WaitlistEntry{}
+	for _, e := range m.entries {
+		if e.Status == domain.Waiting && e.StationId == stationId && e.CarModel == carModel {
+			entries = append(entries, e)
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].CreatedAt.Before(entries[j].CreatedAt)
+	})
+	return entries
+}
+
+func (m *waitlistRepositoryMock) FindActive() []domain.WaitlistEntry {
+	m.calls["FindActive"] = m.calls["FindActive"] + 1
+	entries := []domain.WaitlistEntry{}
+	for _, e := range m.entries {
+		if e.Status == domain.Waiting || e.Status == domain.Held {
+			entries = append(entries, e)
+		}
+	}
+	return entries
+}
+
+func (m *waitlistRepositoryMock) FindByHeldCar(carId string) (*domain.WaitlistEntry, error) {
+	m.calls["FindByHeldCar"] = m.calls["FindByHeldCar"] + 1
+	for _, e := range m.entries {
+		if e.Status == domain.Held && e.HeldCar.ID == carId {
+			return &e, nil
+		}
+	}
+	return nil, ErrNotFoundWaitlist
+}
+
+func (m *waitlistRepositoryMock) Save(entry domain.WaitlistEntry) error {
+	m.calls["Save"] = m.calls["Save"] + 1
+	if m.expectedSaveErr != nil {
+		return m.expectedSaveErr
+	}
+	m.saved = append(m.saved, entry)
+	for i, e := range m.entries {
+		if e.ID == entry.ID {
+			m.entries[i] = entry
+			return nil
+		}
+	}
+	m.entries = append(m.entries, entry)
+	return nil
+}
+
+func TestWaitlistUseCase_Join(t *testing.T) {
+	first := newWaitlistEntryFixture(time.Now().Add(-time.Hour))
+	withdrawn := newWaitlistEntryFixture(time.Now().Add(-time.Hour * 2))
+	withdrawn.Status = domain.Withdrawn
+
+	testCases := []struct {
+		name      string
+		dateFrom  time.Time
+		dateTo    time.Time
+		policyErr error
+		want      error
+		position  uint
+	}{
+		{name: "correct input after a waiting entry", dateFrom: time.Now(), dateTo: time.Now().Add(time.Hour * 24), want: nil, position: 2},
+		{name: "incorrect dates input", dateFrom: time.Now().Add(time.Hour * 24), dateTo: time.Now(), want: ErrInvalidEntity},
+		{name: "incorrect policy input", dateFrom: time.Now(), dateTo: time.Now().Add(time.Hour * 24), policyErr: ErrInvalidPolicy, want: ErrInvalidPolicy},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			waitlistRepo := &waitlistRepositoryMock{entries: []domain.WaitlistEntry{*first, *withdrawn}, calls: make(map[string]uint)}
+			orderSvc := &orderOrderServiceMock{expectedGetPolicy: newPolicyFixture(), expectedGetPolicyErr: tc.policyErr, calls: make(map[string]uint)}
+			waitlistUC := NewWaitlistUseCase(waitlistRepo, orderSvc)
+
+			entry, err := waitlistUC.Join(first.StationId, first.CategoryId, first.CarModel, first.PolicyId, "bob@mail.com", tc.dateFrom, tc.dateTo)
+
+			if !errors.Is(err, tc.want) {
+				t.Fatal("unexpected error", err)
+			}
+
+			if err == nil && entry.Position != tc.position {
+				t.Error("unexpected position", entry.Position)
+			}
+		})
+	}
+}
+
+func TestWaitlistUseCase_OfferCar(t *testing.T) {
+	car := *newCarFixture()
+	car.Status = domain.Parked
+
+	outdated := newWaitlistEntryFixture(time.Now().Add(-time.Hour * 24 * 10))
+	outdated.DateFrom = time.Now().Add(-time.Hour * 24 * 10)
+	outdated.DateTo = time.Now().Add(-time.Hour * 24 * 5)
+	first := newWaitlistEntryFixture(time.Now().Add(-time.Hour * 2))
+	second := newWaitlistEntryFixture(time.Now().Add(-time.Hour))
+
+	held := newWaitlistEntryFixture(time.Now().Add(-time.Hour * 3))
+	held.Hold(car, time.Now())
+
+	testCases := []struct {
+		name     string
+		entries  []domain.WaitlistEntry
+		car      domain.Car
+		wantHeld string
+		heldMsgs int
+	}{
+		{name: "correct car held for the first entry", entries: []domain.WaitlistEntry{*second, *outdated, *first}, car: car, wantHeld: first.ID, heldMsgs: 1},
+		{name: "correct car already held", entries: []domain.WaitlistEntry{*held, *first}, car: car, wantHeld: held.ID, heldMsgs: 0},
+		{name: "correct car of another model", entries: []domain.WaitlistEntry{*first}, car: domain.Car{ID: car.ID, CarModel: "GOL", Status: domain.Parked, StationId: car.StationId}, heldMsgs: 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			waitlistRepo := &waitlistRepositoryMock{entries: tc.entries, calls: make(map[string]uint)}
+			waitlistUC := NewWaitlistUseCase(waitlistRepo, &orderOrderServiceMock{calls: make(map[string]uint)})
+
+			if err := waitlistUC.OfferCar(tc.car, time.Now()); err != nil {
+				t.Fatal("unexpected error", err)
+			}
+
+			heldMsgs := 0
+			for _, saved := range waitlistRepo.saved {
+				for _, e := range saved.Events {
+					if held, ok := e.(domain.WaitlistCarHeld); ok && held.Contact == saved.Contact && held.Plate == tc.car.Plate {
+						heldMsgs++
+					}
+				}
+			}
+			if heldMsgs != tc.heldMsgs {
+				t.Error("unexpected customers told", heldMsgs)
+			}
+
+			holder, err := waitlistRepo.FindByHeldCar(tc.car.ID)
+			if tc.wantHeld == "" && err == nil {
+				t.Error("unexpected hold", holder)
+			}
+			if tc.wantHeld != "" && (err != nil || holder.ID != tc.wantHeld) {
+				t.Error("unexpected hold", holder, err)
+			}
+		})
+	}
+}
+
+func TestOrderUseCase_OpenFromWaitlist(t *testing.T) {
+	car := *newCarFixture()
+	car.Status = domain.Parked
+
+	held := newWaitlistEntryFixture(time.Now())
+	held.Hold(car, time.Now())
+	expired := newWaitlistEntryFixture(time.Now())
+	expired.Hold(car, time.Now().Add(-domain.HoldTime-time.Minute))
+	waiting := newWaitlistEntryFixture(time.Now())
+
+	testCases := []struct {
+		name  string
+		entry *domain.WaitlistEntry
+		id    string
+		want  error
+	}{
+		{name: "correct held entry", entry: held, id: held.ID, want: nil},
+		{name: "incorrect hold expired", entry: expired, id: expired.ID, want: ErrInvalidHold},
+		{name: "incorrect entry waiting", entry: waiting, id: waiting.ID, want: ErrInvalidHold},
+		{name: "incorrect entry not found", entry: held, id: "9e4d6ad8-1c5c-4a8e-9b0e-5a1d1f3b0c2d", want: ErrNotFoundWaitlist},
+		{name: "incorrect id", entry: held, id: "1", want: ErrInvalidId},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var saved domain.Order
+			orderRepo := &orderSaveRecorder{orderRepositoryMock{calls: make(map[string]uint)}, &saved}
+			orderSvc := &orderOrderServiceMock{expectedGetPolicy: newPolicyFixture(), calls: make(map[string]uint)}
+			waitlistRepo := &waitlistRepositoryMock{entries: []domain.WaitlistEntry{*tc.entry}, calls: make(map[string]uint)}
+			orderUC := NewOrderUseCase(orderRepo, orderSvc, &accountRepositoryMock{calls: make(map[string]uint)}, &eligibilityRepositoryMock{calls: make(map[string]uint)}, waitlistRepo)
+
+			err := orderUC.OpenFromWaitlist(tc.id, "83369771-f9a4-48b7-b87b-463f19f7b187", "", domain.DriverProfile{}, "", 0, false, false)
+
+			if !errors.Is(err, tc.want) {
+				t.Fatal("unexpected error", err)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if orderSvc.calls["GetCar"] != 0 {
+				t.Error("unexpected car looked up for a held entry")
+			}
+
+			if saved.Car.ID != car.ID || saved.WaitlistId != tc.entry.ID {
+				t.Error("unexpected order", saved)
+			}
+
+			booked, _ := waitlistRepo.FindOne(tc.entry.ID)
+			if booked.Status != domain.Booked || booked.OrderId != saved.ID {
+				t.Error("unexpected entry", booked)
+			}
+		})
+	}
+}
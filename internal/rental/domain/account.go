@@ -0,0 +1,211 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/validation"
+)
+
+type Driver struct {
+	License string `json:"license" validate:"required" db:"license"`
+	Name    string `json:"name" validate:"required" db:"name"`
+}
+
+type NegotiatedRate struct {
+	CategoryId string  `json:"categoryId" validate:"required,uuid4" db:"categoryId"`
+	Unit       Unit    `json:"unit" validate:"required,gt=0,lte=4" db:"unit"`
+	Price      float32 `json:"price" validate:"gt=0" db:"price"`
+}
+
+type CorporateAccount struct {
+	ID          string           `json:"id" validate:"required,uuid4" db:"id"`
+	Name        string           `json:"name" validate:"required" db:"name"`
+	Document    string           `json:"document" validate:"required" db:"document"`
+	CreditLimit float32          `json:"creditLimit" validate:"gt=0" db:"creditLimit"`
+	Drivers     []Driver         `json:"drivers" validate:"dive"`
+	Rates       []NegotiatedRate `json:"rates" validate:"dive"`
+}
+
+type AccountInvoice struct {
+	AccountId string     `json:"accountId"`
+	Year      int        `json:"year"`
+	Month     time.Month `json:"month"`
+	Orders    []string   `json:"orders"`
+	Invoice
+}
+
+func NewCorporateAccount(name, document string, creditLimit float32) (*CorporateAccount, error) {
+	account := &CorporateAccount{
+		ID:          validation.NewId(),
+		Name:        name,
+		Document:    document,
+		CreditLimit: creditLimit,
+		Drivers:     []Driver{},
+		Rates:       []NegotiatedRate{},
+	}
+
+	if err := validation.ValidateEntity(account); err != nil {
+		return nil, fmt.Errorf("%w\n%v", ErrInvalidEntity, err)
+	}
+
+	return account, nil
+}
+
+func (a *CorporateAccount) AuthorizeDriver(driver Driver) error {
+	if err := validation.ValidateEntity(driver); err != nil {
+		return ErrInvalidDriver
+	}
+
+	if a.IsDriverAuthorized(driver.License) {
+		return ErrInvalidDriver
+	}
+
+	a.Drivers = append(a.Drivers, driver)
+
+	return nil
+}
+
+func (a *CorporateAccount) RevokeDriver(license string) error {
+	for i, d := range a.Drivers {
+		if d.License == license {
+			a.Drivers = append(a.Drivers[:i], a.Drivers[i+1:]...)
+			return nil
+		}
+	}
+
+	return ErrInvalidDriver
+}
+
+func (a CorporateAccount) IsDriverAuthorized(license string) bool {
+	for _, d := range a.Drivers {
+		if d.License == license {
+			return true
+		}
+	}
+	return false
+}
+
+func (a *CorporateAccount) SetRate(rate NegotiatedRate) error {
+	if err := validation.ValidateEntity(rate); err != nil {
+		return ErrInvalidRate
+	}
+
+	for i, r := range a.Rates {
+		if r.CategoryId == rate.CategoryId && r.Unit == rate.Unit {
+			a.Rates[i] = rate
+			return nil
+		}
+	}
+
+	a.Rates = append(a.Rates, rate)
+
+	return nil
+}
+
+func (a *CorporateAccount) DelRate(categoryId string, unit Unit) error {
+	for i, r := range a.Rates {
+		if r.CategoryId == categoryId && r.Unit == unit {
+			a.Rates = append(a.Rates[:i], a.Rates[i+1:]...)
+			return nil
+		}
+	}
+
+	return ErrInvalidRate
+}
+
+func (a *CorporateAccount) SetCreditLimit(creditLimit float32) error {
+	if creditLimit <= 0 {
+		return ErrInvalidAccount
+	}
+
+	a.CreditLimit = creditLimit
+
+	return nil
+}
+
+func (a CorporateAccount) NegotiatedPolicy(policy Policy) Policy {
+	for _, r := range a.Rates {
+		if r.CategoryId == policy.CategoryId && r.Unit == policy.Unit {
+			policy.Price = r.Price
+			break
+		}
+	}
+	return policy
+}
+
+func (a CorporateAccount) Exposure(orders []Order, now time.Time) float32 {
+	var exposure float32
+	for _, o := range orders {
+		if o.AccountId != a.ID {
+			continue
+		}
+
+		switch o.Status {
+		case Opened, Confirmed:
+			exposure += o.Invoice().Total
+		case Closed:
+			if o.DateTo != nil && sameMonth(*o.DateTo, now) {
+				exposure += o.Invoice().Total
+			}
+		}
+	}
+	return roundCents(exposure)
+}
+
+func (a CorporateAccount) Charge(order *Order, license string, orders []Order, now time.Time) error {
+	if !a.IsDriverAuthorized(license) {
+		return ErrUnauthorizedDriver
+	}
+
+	if err := a.CheckCredit(*order, orders, now); err != nil {
+		return err
+	}
+
+	order.AccountId = a.ID
+	order.DriverLicense = license
+
+	return nil
+}
+
+func (a CorporateAccount) CheckCredit(order Order, orders []Order, now time.Time) error {
+	if a.Exposure(orders, now)+order.Invoice().Total > a.CreditLimit {
+		return ErrCreditLimitExceeded
+	}
+
+	return nil
+}
+
+func (a CorporateAccount) MonthlyInvoice(orders []Order, year int, month time.Month) AccountInvoice {
+	monthly := AccountInvoice{
+		AccountId: a.ID,
+		Year:      year,
+		Month:     month,
+		Orders:    []string{},
+		Invoice:   Invoice{Lines: []InvoiceLine{}},
+	}
+
+	closed := []Order{}
+	for _, o := range orders {
+		if o.AccountId == a.ID && o.Status == Closed && o.DateTo != nil &&
+			o.DateTo.Year() == year && o.DateTo.Month() == month {
+			closed = append(closed, o)
+		}
+	}
+
+	sort.Slice(closed, func(i, j int) bool {
+		return closed[i].DateTo.Before(*closed[j].DateTo)
+	})
+
+	for _, o := range closed {
+		monthly.Orders = append(monthly.Orders, o.ID)
+		monthly.merge(o.Invoice())
+	}
+
+	return monthly
+}
+
+func sameMonth(t, u time.Time) bool {
+	return t.Year() == u.Year() && t.Month() == u.Month()
+}
@@ -0,0 +1,178 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func newAccountFixture() *CorporateAccount {
+	return &CorporateAccount{
+		ID:          "b0f4c2a6-1d3e-4f5a-9b8c-7d6e5f4a3b2c",
+		Name:        "ACME",
+		Document:    "12.345.678/0001-90",
+		CreditLimit: 600,
+		Drivers:     []Driver{{License: "04512345678", Name: "Ana Souza"}},
+		Rates:       []NegotiatedRate{},
+	}
+}
+
+func TestCorporateAccount_SetRate(t *testing.T) {
+	account := newAccountFixture()
+	policy := *newPolicyFixture()
+
+	if got := account.NegotiatedPolicy(policy); got.Price != policy.Price {
+		t.Error("unexpected price without rate", got.Price)
+	}
+
+	if err := account.SetRate(NegotiatedRate{CategoryId: policy.CategoryId, Unit: policy.Unit, Price: 25}); err != nil {
+		t.Fatal(err)
+	}
+	if err := account.SetRate(NegotiatedRate{CategoryId: policy.CategoryId, Unit: policy.Unit, Price: 22}); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := account.NegotiatedPolicy(policy); got.Price != 22 || len(account.Rates) != 1 {
+		t.Error("unexpected negotiated price", got.Price, account.Rates)
+	}
+
+	if err := account.SetRate(NegotiatedRate{CategoryId: policy.CategoryId, Unit: PerMonth, Price: 500}); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := account.NegotiatedPolicy(policy); got.Price != 22 || len(account.Rates) != 2 {
+		t.Error("unexpected negotiated price of another unit", got.Price, account.Rates)
+	}
+
+	perKm := policy
+	perKm.Unit = PerKm
+	if got := account.NegotiatedPolicy(perKm); got.Price != policy.Price {
+		t.Error("unexpected price without rate for the unit", got.Price)
+	}
+
+	if err := account.SetRate(NegotiatedRate{CategoryId: policy.CategoryId, Unit: policy.Unit, Price: 0}); !errors.Is(err, ErrInvalidRate) {
+		t.Error("unexpected error", err)
+	}
+
+	if err := account.SetRate(NegotiatedRate{CategoryId: policy.CategoryId, Price: 25}); !errors.Is(err, ErrInvalidRate) {
+		t.Error("unexpected error", err)
+	}
+
+	if err := account.DelRate(policy.CategoryId, policy.Unit); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := account.DelRate(policy.CategoryId, policy.Unit); !errors.Is(err, ErrInvalidRate) {
+		t.Error("unexpected error", err)
+	}
+
+	if got := account.NegotiatedPolicy(policy); got.Price != policy.Price {
+		t.Error("unexpected price after rate removed", got.Price)
+	}
+}
+
+func TestCorporateAccount_Charge(t *testing.T) {
+	now := time.Date(2021, 3, 15, 10, 0, 0, 0, time.UTC)
+	lastMonth := time.Date(2021, 2, 20, 10, 0, 0, 0, time.UTC)
+	thisMonth := time.Date(2021, 3, 10, 10, 0, 0, 0, time.UTC)
+
+	newAccountOrder := func(status OrderStatus, dateTo *time.Time) Order {
+		o := *newOrderFixture()
+		o.AccountId = newAccountFixture().ID
+		o.Status = status
+		if dateTo != nil {
+			from := dateTo.Add(-time.Hour * 24 * 5)
+			o.DateFrom = &from
+			o.DateTo = dateTo
+		}
+		return o
+	}
+
+	// each order is 152.5 once closed, 183 when reserved as the fixture
+	// dates run a bit over 5 days
+	testCases := []struct {
+		name    string
+		license string
+		orders  []Order
+		want    error
+	}{
+		{
+			name:    "correct within limit",
+			license: "04512345678",
+			orders:  []Order{newAccountOrder(Opened, nil), newAccountOrder(Closed, &thisMonth)},
+			want:    nil,
+		},
+		{
+			name:    "correct billed months do not count",
+			license: "04512345678",
+			orders:  []Order{newAccountOrder(Confirmed, nil), newAccountOrder(Closed, &thisMonth), newAccountOrder(Closed, &lastMonth), newAccountOrder(Canceled, nil)},
+			want:    nil,
+		},
+		{
+			name:    "incorrect over limit",
+			license: "04512345678",
+			orders:  []Order{newAccountOrder(Opened, nil), newAccountOrder(Confirmed, nil), newAccountOrder(Closed, &thisMonth)},
+			want:    ErrCreditLimitExceeded,
+		},
+		{
+			name:    "incorrect driver",
+			license: "99999999999",
+			orders:  []Order{},
+			want:    ErrUnauthorizedDriver,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			account := newAccountFixture()
+			order := newOrderFixture()
+
+			err := account.Charge(order, tc.license, tc.orders, now)
+
+			if !errors.Is(err, tc.want) {
+				t.Fatal("unexpected error", err)
+			}
+
+			if err == nil && (order.AccountId != account.ID || order.DriverLicense != tc.license) {
+				t.Error("unexpected order", order)
+			}
+
+			if err != nil && order.AccountId != "" {
+				t.Error("unexpected order charged", order)
+			}
+		})
+	}
+}
+
+func TestCorporateAccount_MonthlyInvoice(t *testing.T) {
+	account := newAccountFixture()
+
+	newClosedOrder := func(id string, dateTo time.Time, accountId string) Order {
+		o := *newOrderFixture()
+		o.ID = id
+		o.AccountId = accountId
+		o.Status = Closed
+		from := dateTo.Add(-time.Hour * 24 * 7)
+		o.DateFrom = &from
+		o.DateTo = &dateTo
+		return o
+	}
+
+	orders := []Order{
+		newClosedOrder("a3e1b1f4-4d8e-4c8a-9d2b-1f3e5a7c9b01", time.Date(2021, 3, 20, 10, 0, 0, 0, time.UTC), account.ID),
+		newClosedOrder("a3e1b1f4-4d8e-4c8a-9d2b-1f3e5a7c9b02", time.Date(2021, 3, 5, 10, 0, 0, 0, time.UTC), account.ID),
+		newClosedOrder("a3e1b1f4-4d8e-4c8a-9d2b-1f3e5a7c9b03", time.Date(2021, 4, 1, 10, 0, 0, 0, time.UTC), account.ID),
+		newClosedOrder("a3e1b1f4-4d8e-4c8a-9d2b-1f3e5a7c9b04", time.Date(2021, 3, 8, 10, 0, 0, 0, time.UTC), "other"),
+	}
+
+	invoice := account.MonthlyInvoice(orders, 2021, time.March)
+
+	if len(invoice.Orders) != 2 || invoice.Orders[0] != orders[1].ID || invoice.Orders[1] != orders[0].ID {
+		t.Error("unexpected orders", invoice.Orders)
+	}
+
+	// 2 orders of 7 days at 30.5
+	if invoice.Total != 427 {
+		t.Error("unexpected total", invoice.Total)
+	}
+}
@@ -0,0 +1,139 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/events"
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/validation"
+)
+
+type CarPosition struct {
+	OrderId    string    `json:"orderId" validate:"required,uuid4" db:"orderId"`
+	CarId      string    `json:"carId" validate:"required,uuid4" db:"carId"`
+	Latitude   float64   `json:"latitude" validate:"gte=-90,lte=90" db:"latitude"`
+	Longitude  float64   `json:"longitude" validate:"gte=-180,lte=180" db:"longitude"`
+	RecordedAt time.Time `json:"recordedAt" validate:"required" db:"recordedAt"`
+}
+
+func NewCarPosition(orderId, carId string, latitude, longitude float64, recordedAt time.Time) (*CarPosition, error) {
+	position := &CarPosition{
+		OrderId:    orderId,
+		CarId:      carId,
+		Latitude:   latitude,
+		Longitude:  longitude,
+		RecordedAt: recordedAt,
+	}
+
+	if err := validation.ValidateEntity(position); err != nil {
+		return nil, fmt.Errorf("%w\n%v", ErrInvalidPosition, err)
+	}
+
+	return position, nil
+}
+
+func (p CarPosition) Point() Point {
+	return Point{p.Latitude, p.Longitude}
+}
+
+type AlertKind uint
+
+const (
+	ZoneExitAlert AlertKind = iota + 1
+	OverdueReturnAlert
+)
+
+type GeofenceAlert struct {
+	ID        string         `json:"id" validate:"required,uuid4" db:"id"`
+	OrderId   string         `json:"orderId" validate:"required,uuid4" db:"orderId"`
+	CarId     string         `json:"carId" validate:"required,uuid4" db:"carId"`
+	Kind      AlertKind      `json:"kind" validate:"required,max=2" db:"kind"`
+	Latitude  float64        `json:"latitude" db:"latitude"`
+	Longitude float64        `json:"longitude" db:"longitude"`
+	Date      time.Time      `json:"date" validate:"required" db:"date"`
+	Events    []events.Event `json:"-" bson:"-"`
+}
+
+func newGeofenceAlert(kind AlertKind, r Order, p CarPosition) GeofenceAlert {
+	alert := GeofenceAlert{
+		ID:        validation.NewId(),
+		OrderId:   r.ID,
+		CarId:     r.Car.ID,
+		Kind:      kind,
+		Latitude:  p.Latitude,
+		Longitude: p.Longitude,
+		Date:      p.RecordedAt,
+	}
+
+	switch kind {
+	case ZoneExitAlert:
+		alert.Events = append(alert.Events, CarLeftZone{
+			ID:        alert.ID,
+			OrderId:   r.ID,
+			CarId:     r.Car.ID,
+			Latitude:  p.Latitude,
+			Longitude: p.Longitude,
+		})
+	case OverdueReturnAlert:
+		alert.Events = append(alert.Events, CarOverdueOutsideStation{
+			ID:        alert.ID,
+			OrderId:   r.ID,
+			CarId:     r.Car.ID,
+			StationId: r.StationToId,
+			Latitude:  p.Latitude,
+			Longitude: p.Longitude,
+		})
+	}
+
+	return alert
+}
+
+func (r Order) CheckPosition(previous *CarPosition, current CarPosition, fences []Geofence, overdueAlerted bool) []GeofenceAlert {
+	alerts := []GeofenceAlert{}
+
+	if r.Status != Confirmed || len(fences) == 0 {
+		return alerts
+	}
+
+	wasInside := previous == nil || insideAny(previous.Point(), fences)
+	if wasInside && !insideAny(current.Point(), fences) {
+		alerts = append(alerts, newGeofenceAlert(ZoneExitAlert, r, current))
+	}
+
+	if !overdueAlerted {
+		if alert := r.CheckOverdue(current, fences, current.RecordedAt); alert != nil {
+			alerts = append(alerts, *alert)
+		}
+	}
+
+	return alerts
+}
+
+func (r Order) CheckOverdue(last CarPosition, fences []Geofence, at time.Time) *GeofenceAlert {
+	if r.Status != Confirmed || !at.After(r.DateReservTo) {
+		return nil
+	}
+
+	stations := []Geofence{}
+	for _, g := range fences {
+		if g.Kind == StationFence && g.StationId == r.StationToId {
+			stations = append(stations, g)
+		}
+	}
+
+	if len(stations) == 0 || insideAny(last.Point(), stations) {
+		return nil
+	}
+
+	alert := newGeofenceAlert(OverdueReturnAlert, r, last)
+	return &alert
+}
+
+func insideAny(p Point, fences []Geofence) bool {
+	for _, g := range fences {
+		if g.Contains(p) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,77 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/validation"
+)
+
+type Booking struct {
+	ID             string    `json:"id" validate:"required,uuid4" db:"id"`
+	DateReservFrom time.Time `json:"dateReservFrom" validate:"required" db:"dateReservFrom"`
+	DateReservTo   time.Time `json:"dateReservTo" validate:"required" db:"dateReservTo"`
+	StationFromId  string    `json:"stationFromId" validate:"required,uuid4" db:"stationFromId"`
+	StationToId    string    `json:"stationToId" validate:"required,uuid4" db:"stationToId"`
+	Orders         []Order   `json:"orders" validate:"required,min=1"`
+}
+
+func NewBooking(
+	dateReservFrom time.Time,
+	dateReservTo time.Time,
+	cars []Car,
+	policies []Policy,
+	stationFromId string,
+	stationToId string,
+	prepaidFuel bool,
+	keyDropReturn bool,
+) (*Booking, error) {
+	if len(cars) == 0 || len(cars) != len(policies) {
+		return nil, ErrInvalidBooking
+	}
+
+	booking := &Booking{
+		ID:             validation.NewId(),
+		DateReservFrom: dateReservFrom,
+		DateReservTo:   dateReservTo,
+		StationFromId:  stationFromId,
+		StationToId:    stationToId,
+		Orders:         []Order{},
+	}
+
+	seen := map[string]bool{}
+	for i, car := range cars {
+		if seen[car.ID] {
+			return nil, ErrInvalidBooking
+		}
+		seen[car.ID] = true
+
+		order, err := NewOrder(dateReservFrom, dateReservTo, car, stationFromId, stationToId, policies[i], prepaidFuel, keyDropReturn)
+		if err != nil {
+			return nil, err
+		}
+
+		order.BookingId = booking.ID
+		for j, e := range order.Events {
+			if opened, ok := e.(OpenedOrder); ok {
+				opened.BookingId = booking.ID
+				order.Events[j] = opened
+			}
+		}
+		booking.Orders = append(booking.Orders, *order)
+	}
+
+	if err := validation.ValidateEntity(booking); err != nil {
+		return nil, fmt.Errorf("%w\n%v", ErrInvalidEntity, err)
+	}
+
+	return booking, nil
+}
+
+func (b Booking) Invoice() Invoice {
+	invoice := Invoice{Lines: []InvoiceLine{}}
+	for _, o := range b.Orders {
+		invoice.merge(o.Invoice())
+	}
+	return invoice
+}
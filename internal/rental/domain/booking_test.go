@@ -0,0 +1,190 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func newBookingCarsFixture() ([]Car, []Policy) {
+	otherCar := *newCarFixture()
+	otherCar.ID = "2f6a7c1e-8b4d-4e3a-9f5c-6d1b2a3c4e5f"
+	otherCar.CarModel = "COROLLA"
+
+	otherPolicy := *newPolicyFixture()
+	otherPolicy.ID = "7d3e1f2a-4b5c-4d6e-8f9a-0b1c2d3e4f5a"
+	otherPolicy.CarModel = "COROLLA"
+	otherPolicy.Price = 80
+
+	return []Car{*newCarFixture(), otherCar}, []Policy{*newPolicyFixture(), otherPolicy}
+}
+
+func TestNewBooking(t *testing.T) {
+	cars, policies := newBookingCarsFixture()
+
+	testCases := []struct {
+		name     string
+		cars     []Car
+		policies []Policy
+		want     error
+	}{
+		{
+			name:     "correct booking",
+			cars:     cars,
+			policies: policies,
+			want:     nil,
+		},
+		{
+			name:     "incorrect missing policy",
+			cars:     cars,
+			policies: policies[:1],
+			want:     ErrInvalidBooking,
+		},
+		{
+			name:     "incorrect repeated car",
+			cars:     []Car{cars[0], cars[0]},
+			policies: []Policy{policies[0], policies[0]},
+			want:     ErrInvalidBooking,
+		},
+		{
+			name:     "incorrect empty booking",
+			cars:     []Car{},
+			policies: []Policy{},
+			want:     ErrInvalidBooking,
+		},
+		{
+			name:     "incorrect policy model",
+			cars:     cars,
+			policies: []Policy{policies[0], policies[0]},
+			want:     ErrInvalidCarStation,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			booking, err := NewBooking(
+				time.Now(),
+				time.Now().Add(time.Hour*24*5),
+				tc.cars,
+				tc.policies,
+				"83369771-f9a4-48b7-b87b-463f19f7b187",
+				"2520aade-a397-4e3c-a589-39c6ae5c2eff",
+				false,
+				false,
+			)
+
+			if !errors.Is(err, tc.want) {
+				t.Fatal("unexpected error", err)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if len(booking.Orders) != len(tc.cars) {
+				t.Fatal("unexpected orders", booking.Orders)
+			}
+
+			for i, o := range booking.Orders {
+				if o.BookingId != booking.ID || o.Car.ID != tc.cars[i].ID || o.Status != Opened || len(o.Events) != 1 {
+					t.Error("unexpected order", o)
+				}
+
+				if opened, ok := o.Events[0].(OpenedOrder); !ok || opened.BookingId != booking.ID {
+					t.Error("unexpected opened order event", o.Events)
+				}
+			}
+		})
+	}
+}
+
+func TestBooking_Invoice(t *testing.T) {
+	cars, policies := newBookingCarsFixture()
+	from := time.Date(2021, 3, 1, 10, 0, 0, 0, time.UTC)
+	to := from.Add(time.Hour * 24 * 7)
+
+	booking, err := NewBooking(from, to, cars, policies, "83369771-f9a4-48b7-b87b-463f19f7b187", "2520aade-a397-4e3c-a589-39c6ae5c2eff", false, false)
+	if err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	// 7 days of UNO at 30.5 and of COROLLA at 80
+	if invoice := booking.Invoice(); len(invoice.Lines) != 2 || invoice.Total != 773.5 {
+		t.Error("unexpected invoice", invoice)
+	}
+
+	booking.Orders[1].Status = Canceled
+
+	if invoice := booking.Invoice(); len(invoice.Lines) != 1 || invoice.Total != 213.5 {
+		t.Error("unexpected invoice", invoice)
+	}
+
+	booking.Orders[0].Status = Closed
+	booking.Orders[0].DateFrom = &from
+	dateTo := from.Add(time.Hour * 24 * 9)
+	booking.Orders[0].DateTo = &dateTo
+	booking.Orders[0].Discount = 10
+	booking.Orders[0].Tax = 5
+	booking.Orders[0].Charges = []Charge{{Kind: RefuelCharge, Description: "refuel 10%", Amount: 12.25}}
+
+	// 9 days at 30.5 plus the charge and tax, less the discount
+	if invoice := booking.Invoice(); len(invoice.Lines) != 2 || invoice.Total != 281.75 {
+		t.Error("unexpected invoice", invoice)
+	}
+}
+
+func TestPolicy_Allowance(t *testing.T) {
+	from := time.Date(2021, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name string
+		unit Unit
+		to   time.Time
+		want uint64
+	}{
+		{name: "days started count whole", unit: PerDay, to: from.Add(time.Hour * (24*6 + 1)), want: 700},
+		{name: "at least min units", unit: PerDay, to: from.Add(time.Hour * 24), want: 500},
+		{name: "km", unit: PerKm, to: from.Add(time.Hour * 24 * 8), want: 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			policy := *newPolicyFixture()
+			policy.Unit = tc.unit
+			policy.KMAllowance = 100
+
+			if got := policy.Allowance(from, tc.to); got != tc.want {
+				t.Error("unexpected allowance", got)
+			}
+		})
+	}
+}
+
+func TestPolicy_Cost(t *testing.T) {
+	from := time.Date(2021, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name string
+		unit Unit
+		to   time.Time
+		km   uint64
+		want float32
+	}{
+		{name: "days started count whole", unit: PerDay, to: from.Add(time.Hour * (24*6 + 1)), want: 213.5},
+		{name: "at least min units", unit: PerDay, to: from.Add(time.Hour * 24), want: 152.5},
+		{name: "weeks", unit: PerWeek, to: from.Add(time.Hour * 24 * 8), want: 152.5},
+		{name: "months of 30 days", unit: PerMonth, to: from.Add(time.Hour * 24 * 186), want: 213.5},
+		{name: "km", unit: PerKm, to: from, km: 10, want: 305},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			policy := *newPolicyFixture()
+			policy.Unit = tc.unit
+
+			if got := policy.Cost(from, tc.to, tc.km); got != tc.want {
+				t.Error("unexpected cost", got)
+			}
+		})
+	}
+}
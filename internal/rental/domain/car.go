@@ -15,6 +15,15 @@ const (
 	Reserved
 )
 
+type EnergyType uint
+
+const (
+	Combustion EnergyType = iota + 1
+	Electric
+)
+
+const FullLevel uint8 = 100
+
 type Car struct {
 	ID        string    `json:"id" validate:"required,uuid4" db:"id"`
 	Age       uint16    `json:"age" validate:"required,min=1900,max=2100" db:"age"`
@@ -25,6 +34,12 @@ type Car struct {
 	FinalKM   uint64    `json:"finalKM,omitempty" db:"finalKM"`
 	Status    CarStatus `json:"status" validate:"required" db:"status"`
 	StationId string    `json:"stationId" validate:"uuid4" db:"stationId"`
+
+	Energy      EnergyType `json:"energy" db:"energy"`
+	InitialFuel uint8      `json:"initialFuel" validate:"max=100" db:"initialFuel"`
+	FinalFuel   uint8      `json:"finalFuel,omitempty" validate:"max=100" db:"finalFuel"`
+
+	Upgraded bool `json:"upgraded,omitempty" db:"upgraded"`
 }
 
 func NewCar(id string, age uint16, plate, document string, carModel string, initialKM, finalKM uint64, status CarStatus, stationId string) (*Car, error) {
@@ -67,7 +82,7 @@ func (c *Car) Reserve() error {
 	return nil
 }
 
-func (c *Car) Park(finalKM uint64, stationId string) error {
+func (c *Car) Park(finalKM uint64, stationId string, finalFuel uint8) error {
 	if c.Status == Parked {
 		return ErrInvalidPark
 	}
@@ -76,9 +91,14 @@ func (c *Car) Park(finalKM uint64, stationId string) error {
 		return ErrInvalidPark
 	}
 
+	if finalFuel > FullLevel {
+		return ErrInvalidPark
+	}
+
 	c.Status = Parked
 	c.FinalKM = finalKM
 	c.StationId = stationId
+	c.FinalFuel = finalFuel
 
 	return nil
 }
@@ -0,0 +1,72 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+type ChargeKind uint
+
+const (
+	RefuelCharge ChargeKind = iota + 1
+	RechargeCharge
+	PrepaidFuelCharge
+	WaivedPrepaidFuelCharge
+	PointsDiscountCharge
+	ExcessKMCharge
+	YoungDriverCharge
+)
+
+type Charge struct {
+	Kind        ChargeKind `json:"kind" db:"kind"`
+	Description string     `json:"description" db:"description"`
+	Amount      float32    `json:"amount" db:"amount"`
+}
+
+func energyCharge(car Car, policy Policy) (Charge, bool) {
+	if car.FinalFuel >= car.InitialFuel {
+		return Charge{}, false
+	}
+
+	missing := float32(car.InitialFuel - car.FinalFuel)
+
+	if car.Energy == Electric {
+		if policy.RechargePrice == 0 {
+			return Charge{}, false
+		}
+		return Charge{
+			Kind:        RechargeCharge,
+			Description: "recharge",
+			Amount:      missing * policy.RechargePrice,
+		}, true
+	}
+
+	if policy.RefuelPrice == 0 {
+		return Charge{}, false
+	}
+
+	return Charge{
+		Kind:        RefuelCharge,
+		Description: "refuel",
+		Amount:      missing * policy.RefuelPrice,
+	}, true
+}
+
+func excessKMCharge(car Car, policy Policy, from, to time.Time) (Charge, bool) {
+	if policy.ExcessKMPrice == 0 || policy.Unit == PerKm || car.FinalKM <= car.InitialKM {
+		return Charge{}, false
+	}
+
+	driven, allowance := car.FinalKM-car.InitialKM, policy.Allowance(from, to)
+	if driven <= allowance {
+		return Charge{}, false
+	}
+
+	excess := driven - allowance
+
+	return Charge{
+		Kind:        ExcessKMCharge,
+		Description: fmt.Sprintf("%d km over allowance", excess),
+		Amount:      roundCents(float32(excess) * policy.ExcessKMPrice),
+	}, true
+}
@@ -0,0 +1,191 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/validation"
+)
+
+type DriverProfile struct {
+	License           string    `json:"license"`
+	BirthDate         time.Time `json:"birthDate"`
+	LicensedAt        time.Time `json:"licensedAt"`
+	LicenseCategories []string  `json:"licenseCategories"`
+}
+
+func (d DriverProfile) Age(at time.Time) uint {
+	return yearsSince(d.BirthDate, at)
+}
+
+func (d DriverProfile) LicenseYears(at time.Time) uint {
+	return yearsSince(d.LicensedAt, at)
+}
+
+func (d DriverProfile) holds(licenseCategory string) bool {
+	for _, c := range d.LicenseCategories {
+		if strings.EqualFold(c, licenseCategory) {
+			return true
+		}
+	}
+	return false
+}
+
+func yearsSince(from, at time.Time) uint {
+	if from.IsZero() || at.Before(from) {
+		return 0
+	}
+
+	years := at.Year() - from.Year()
+	if at.Month() < from.Month() || (at.Month() == from.Month() && at.Day() < from.Day()) {
+		years--
+	}
+
+	return uint(years)
+}
+
+type EligibilityRule struct {
+	CategoryId        string   `json:"categoryId" validate:"required,uuid4" db:"categoryId"`
+	MinAge            uint     `json:"minAge" db:"minAge"`
+	MinLicenseYears   uint     `json:"minLicenseYears" db:"minLicenseYears"`
+	YoungDriverAge    uint     `json:"youngDriverAge" db:"youngDriverAge"`
+	YoungDriverFee    float32  `json:"youngDriverFee" validate:"gte=0" db:"youngDriverFee"`
+	LicenseCategories []string `json:"licenseCategories"`
+}
+
+func NewEligibilityRule(categoryId string, minAge, minLicenseYears, youngDriverAge uint, youngDriverFee float32, licenseCategories []string) (*EligibilityRule, error) {
+	if (youngDriverFee > 0) != (youngDriverAge > 0) || (youngDriverAge > 0 && youngDriverAge <= minAge) {
+		return nil, ErrInvalidEligibilityRule
+	}
+
+	categories := []string{}
+	for _, c := range licenseCategories {
+		if c = strings.TrimSpace(c); c == "" {
+			return nil, ErrInvalidEligibilityRule
+		}
+		categories = append(categories, strings.ToUpper(c))
+	}
+
+	rule := &EligibilityRule{
+		CategoryId:        categoryId,
+		MinAge:            minAge,
+		MinLicenseYears:   minLicenseYears,
+		YoungDriverAge:    youngDriverAge,
+		YoungDriverFee:    youngDriverFee,
+		LicenseCategories: categories,
+	}
+
+	if err := validation.ValidateEntity(rule); err != nil {
+		return nil, fmt.Errorf("%w\n%v", ErrInvalidEntity, err)
+	}
+
+	return rule, nil
+}
+
+type BlockedDriver struct {
+	License string    `json:"license" validate:"required" db:"license"`
+	Reason  string    `json:"reason" validate:"required" db:"reason"`
+	Date    time.Time `json:"date" validate:"required" db:"date"`
+}
+
+func NewBlockedDriver(license, reason string, at time.Time) (*BlockedDriver, error) {
+	blocked := &BlockedDriver{
+		License: license,
+		Reason:  reason,
+		Date:    at,
+	}
+
+	if err := validation.ValidateEntity(blocked); err != nil {
+		return nil, fmt.Errorf("%w\n%v", ErrInvalidBlockedDriver, err)
+	}
+
+	return blocked, nil
+}
+
+type RefusalCode string
+
+const (
+	DriverBlocked          RefusalCode = "driver-blocked"
+	DriverDataMissing      RefusalCode = "driver-data-missing"
+	DriverUnderage         RefusalCode = "driver-underage"
+	LicenseTooRecent       RefusalCode = "license-too-recent"
+	LicenseCategoryMissing RefusalCode = "license-category-missing"
+)
+
+type RefusalReason struct {
+	Code    RefusalCode `json:"code"`
+	Message string      `json:"message"`
+}
+
+type IneligibleDriverError struct {
+	Reasons []RefusalReason `json:"reasons"`
+}
+
+func (e *IneligibleDriverError) Error() string {
+	return ErrIneligibleDriver.Error()
+}
+
+func (e *IneligibleDriverError) Unwrap() error {
+	return ErrIneligibleDriver
+}
+
+func CheckEligibility(driver DriverProfile, rule *EligibilityRule, blocked *BlockedDriver, at time.Time) error {
+	reasons := []RefusalReason{}
+
+	if blocked != nil {
+		reasons = append(reasons, RefusalReason{
+			Code:    DriverBlocked,
+			Message: fmt.Sprintf("driver is blocked: %s", blocked.Reason),
+		})
+	}
+
+	if rule != nil {
+		reasons = append(reasons, rule.refusals(driver, at)...)
+	}
+
+	if len(reasons) > 0 {
+		return &IneligibleDriverError{reasons}
+	}
+
+	return nil
+}
+
+func (r EligibilityRule) refusals(driver DriverProfile, at time.Time) []RefusalReason {
+	reasons := []RefusalReason{}
+
+	if (r.MinAge > 0 || r.YoungDriverAge > 0) && driver.BirthDate.IsZero() {
+		reasons = append(reasons, RefusalReason{DriverDataMissing, "driver birth date is required"})
+	} else if age := driver.Age(at); age < r.MinAge {
+		reasons = append(reasons, RefusalReason{DriverUnderage, fmt.Sprintf("driver must be at least %d years old", r.MinAge)})
+	}
+
+	if r.MinLicenseYears > 0 && driver.LicensedAt.IsZero() {
+		reasons = append(reasons, RefusalReason{DriverDataMissing, "driver license date is required"})
+	} else if years := driver.LicenseYears(at); years < r.MinLicenseYears {
+		reasons = append(reasons, RefusalReason{LicenseTooRecent, fmt.Sprintf("driver must be licensed for at least %d years", r.MinLicenseYears)})
+	}
+
+	for _, c := range r.LicenseCategories {
+		if !driver.holds(c) {
+			reasons = append(reasons, RefusalReason{LicenseCategoryMissing, fmt.Sprintf("driver license category %s is required", c)})
+		}
+	}
+
+	return reasons
+}
+
+func youngDriverCharge(driver DriverProfile, rule EligibilityRule, from, to time.Time) (Charge, bool) {
+	if rule.YoungDriverFee == 0 || driver.BirthDate.IsZero() || driver.Age(from) >= rule.YoungDriverAge {
+		return Charge{}, false
+	}
+
+	days := math.Max(1, math.Ceil(to.Sub(from).Hours()/24))
+
+	return Charge{
+		Kind:        YoungDriverCharge,
+		Description: "young driver",
+		Amount:      roundCents(rule.YoungDriverFee * float32(days)),
+	}, true
+}
@@ -0,0 +1,126 @@
+package domain
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestDriverProfile_Age(t *testing.T) {
+	at := time.Date(2022, time.March, 10, 9, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name      string
+		birthDate time.Time
+		want      uint
+	}{
+		{name: "birthday passed", birthDate: time.Date(2000, time.March, 9, 0, 0, 0, 0, time.UTC), want: 22},
+		{name: "birthday today", birthDate: time.Date(2000, time.March, 10, 0, 0, 0, 0, time.UTC), want: 22},
+		{name: "birthday to come", birthDate: time.Date(2000, time.March, 11, 0, 0, 0, 0, time.UTC), want: 21},
+		{name: "unknown", want: 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := (DriverProfile{BirthDate: tc.birthDate}).Age(at); got != tc.want {
+				t.Error("unexpected age", got)
+			}
+		})
+	}
+}
+
+func TestCheckEligibility(t *testing.T) {
+	at := time.Date(2022, time.March, 10, 9, 0, 0, 0, time.UTC)
+	rule, err := NewEligibilityRule("479ab9e7-ad16-4864-8e49-29b15e4b390e", 25, 3, 0, 0, []string{"d", "B"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	driver := DriverProfile{
+		License:           "04512345678",
+		BirthDate:         time.Date(1990, time.May, 1, 0, 0, 0, 0, time.UTC),
+		LicensedAt:        time.Date(2010, time.May, 1, 0, 0, 0, 0, time.UTC),
+		LicenseCategories: []string{"B", "D"},
+	}
+
+	testCases := []struct {
+		name    string
+		driver  DriverProfile
+		rule    *EligibilityRule
+		blocked *BlockedDriver
+		want    []RefusalCode
+	}{
+		{name: "eligible", driver: driver, rule: rule},
+		{name: "no rule", driver: DriverProfile{}},
+		{
+			name:   "van without its license category",
+			driver: DriverProfile{License: driver.License, BirthDate: driver.BirthDate, LicensedAt: driver.LicensedAt, LicenseCategories: []string{"B"}},
+			rule:   rule,
+			want:   []RefusalCode{LicenseCategoryMissing},
+		},
+		{
+			name:    "blocked and licensed recently",
+			driver:  DriverProfile{License: driver.License, BirthDate: driver.BirthDate, LicensedAt: at.AddDate(-1, 0, 0), LicenseCategories: driver.LicenseCategories},
+			rule:    rule,
+			blocked: &BlockedDriver{License: driver.License, Reason: "fraud", Date: at},
+			want:    []RefusalCode{DriverBlocked, LicenseTooRecent},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := CheckEligibility(tc.driver, tc.rule, tc.blocked, at)
+
+			if tc.want == nil {
+				if err != nil {
+					t.Fatal("unexpected error", err)
+				}
+				return
+			}
+
+			var refusal *IneligibleDriverError
+			if !errors.As(err, &refusal) || !errors.Is(err, ErrIneligibleDriver) {
+				t.Fatal("unexpected error", err)
+			}
+
+			codes := []RefusalCode{}
+			for _, r := range refusal.Reasons {
+				codes = append(codes, r.Code)
+			}
+			if !reflect.DeepEqual(codes, tc.want) {
+				t.Error("unexpected reasons", refusal.Reasons)
+			}
+		})
+	}
+}
+
+func TestOrder_AssignDriver(t *testing.T) {
+	rule := EligibilityRule{CategoryId: "479ab9e7-ad16-4864-8e49-29b15e4b390e", MinAge: 21, YoungDriverAge: 25, YoungDriverFee: 12.5}
+
+	testCases := []struct {
+		name string
+		age  int
+		want []Charge
+	}{
+		{name: "young driver", age: 23, want: []Charge{{Kind: YoungDriverCharge, Description: "young driver", Amount: 62.5}}},
+		{name: "driver", age: 25, want: []Charge{}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			newOrder := newOrderFixture()
+			newOrder.DateReservTo = newOrder.DateReservFrom.Add(time.Hour * 24 * 5)
+			newOrder.Charges = []Charge{}
+			driver := DriverProfile{License: "04512345678", BirthDate: newOrder.DateReservFrom.AddDate(-tc.age, 0, -1)}
+
+			if err := newOrder.AssignDriver(driver, &rule); err != nil {
+				t.Fatal("unexpected error", err)
+			}
+
+			if newOrder.DriverLicense != driver.License || !reflect.DeepEqual(newOrder.Charges, tc.want) {
+				t.Error("unexpected order", newOrder.DriverLicense, newOrder.Charges)
+			}
+		})
+	}
+}
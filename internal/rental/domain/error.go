@@ -20,4 +20,31 @@ var (
 	ErrIvalidCloseTax      = errors.New("close tax is invalid")
 	ErrIvalidCloseDiscount = errors.New("close discount is invalid")
 	ErrCancel              = errors.New("rent order can not be canceled")
+	ErrInvalidFuelLevel    = errors.New("fuel level is invalid")
+	ErrPrepaidFuelDisabled = errors.New("prepaid fuel is not offered for this category")
+	ErrInvalidSwap         = errors.New("rent order car can not be swapped")
+	ErrInvalidBooking      = errors.New("invalid booking")
+
+	ErrInvalidAccount      = errors.New("invalid corporate account")
+	ErrInvalidDriver       = errors.New("invalid driver")
+	ErrUnauthorizedDriver  = errors.New("driver is not authorized by the account")
+	ErrInvalidRate         = errors.New("invalid negotiated rate")
+	ErrCreditLimitExceeded = errors.New("account credit limit exceeded")
+	ErrInvalidMember       = errors.New("invalid loyalty member")
+
+	ErrInvalidEligibilityRule = errors.New("invalid eligibility rule")
+	ErrInvalidBlockedDriver   = errors.New("invalid blocked driver")
+	ErrIneligibleDriver       = errors.New("driver is not eligible to rent this category")
+
+	ErrInvalidSubscription = errors.New("invalid subscription")
+	ErrInvalidBilling      = errors.New("subscription cycle can not be billed")
+	ErrInvalidPause        = errors.New("subscription can not be paused")
+	ErrInvalidResume       = errors.New("subscription can not be resumed")
+	ErrCancelSubscription  = errors.New("subscription can not be canceled")
+
+	ErrInvalidWaitlist = errors.New("invalid waitlist entry")
+	ErrInvalidHold     = errors.New("waitlist entry holds no car")
+
+	ErrInvalidGeofence = errors.New("invalid geofence")
+	ErrInvalidPosition = errors.New("invalid car position")
 )
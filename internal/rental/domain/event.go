@@ -1,13 +1,17 @@
 package domain
 
+import "time"
+
 type Event interface {
 	Name() string
 }
 
 type OpenedOrder struct {
-	ID        string `json:"id"`
-	CarId     string `json:"carId"`
-	StationId string `json:"stationId"`
+	ID         string `json:"id"`
+	CarId      string `json:"carId"`
+	StationId  string `json:"stationId"`
+	WaitlistId string `json:"waitlistId,omitempty"`
+	BookingId  string `json:"bookingId,omitempty"`
 }
 
 func (c OpenedOrder) Name() string {
@@ -24,10 +28,14 @@ func (c ConfirmedOrder) Name() string {
 }
 
 type ClosedOrder struct {
-	ID        string `json:"id"`
-	CarId     string `json:"carId"`
-	StationId string `json:"stationId"`
-	FinalKM   uint64 `json:"finalKM"`
+	ID         string  `json:"id"`
+	CarId      string  `json:"carId"`
+	StationId  string  `json:"stationId"`
+	FinalKM    uint64  `json:"finalKM"`
+	FinalFuel  uint8   `json:"finalFuel"`
+	MemberId   string  `json:"memberId,omitempty"`
+	CategoryId string  `json:"categoryId"`
+	Amount     float32 `json:"amount"`
 }
 
 func (c ClosedOrder) Name() string {
@@ -39,8 +47,159 @@ type CanceledOrder struct {
 	CarId     string `json:"carId"`
 	StationId string `json:"stationId"`
 	FinalKM   uint64 `json:"finalKM"`
+	FinalFuel uint8  `json:"finalFuel"`
+	MemberId  string `json:"memberId,omitempty"`
 }
 
 func (c CanceledOrder) Name() string {
 	return "order.canceled"
 }
+
+type CarSwappedOrder struct {
+	ID        string `json:"id"`
+	Swap      int    `json:"swap"`
+	FromCarId string `json:"fromCarId"`
+	ToCarId   string `json:"toCarId"`
+	StationId string `json:"stationId"`
+	FinalKM   uint64 `json:"finalKM"`
+	FinalFuel uint8  `json:"finalFuel"`
+}
+
+func (c CarSwappedOrder) Name() string {
+	return "order.car-swapped"
+}
+
+type CarLeftZone struct {
+	ID        string  `json:"id"`
+	OrderId   string  `json:"orderId"`
+	CarId     string  `json:"carId"`
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+func (c CarLeftZone) Name() string {
+	return "geofence.car-left-zone"
+}
+
+type CarOverdueOutsideStation struct {
+	ID        string  `json:"id"`
+	OrderId   string  `json:"orderId"`
+	CarId     string  `json:"carId"`
+	StationId string  `json:"stationId"`
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+func (c CarOverdueOutsideStation) Name() string {
+	return "geofence.car-overdue-outside-station"
+}
+
+type SubscriptionStarted struct {
+	ID        string    `json:"id"`
+	CarId     string    `json:"carId"`
+	StationId string    `json:"stationId"`
+	Date      time.Time `json:"date"`
+}
+
+func (c SubscriptionStarted) Name() string {
+	return "subscription.started"
+}
+
+type SubscriptionCarSwapped struct {
+	ID        string    `json:"id"`
+	FromCarId string    `json:"fromCarId"`
+	ToCarId   string    `json:"toCarId"`
+	StationId string    `json:"stationId"`
+	FinalKM   uint64    `json:"finalKM"`
+	FinalFuel uint8     `json:"finalFuel"`
+	Date      time.Time `json:"date"`
+}
+
+func (c SubscriptionCarSwapped) Name() string {
+	return "subscription.car-swapped"
+}
+
+type SubscriptionPaused struct {
+	ID        string    `json:"id"`
+	CarId     string    `json:"carId"`
+	StationId string    `json:"stationId"`
+	FinalKM   uint64    `json:"finalKM"`
+	FinalFuel uint8     `json:"finalFuel"`
+	Date      time.Time `json:"date"`
+}
+
+func (c SubscriptionPaused) Name() string {
+	return "subscription.paused"
+}
+
+type SubscriptionResumed struct {
+	ID        string    `json:"id"`
+	CarId     string    `json:"carId"`
+	StationId string    `json:"stationId"`
+	Date      time.Time `json:"date"`
+}
+
+func (c SubscriptionResumed) Name() string {
+	return "subscription.resumed"
+}
+
+type SubscriptionCanceled struct {
+	ID        string    `json:"id"`
+	CarId     string    `json:"carId"`
+	StationId string    `json:"stationId"`
+	FinalKM   uint64    `json:"finalKM"`
+	FinalFuel uint8     `json:"finalFuel"`
+	Date      time.Time `json:"date"`
+}
+
+func (c SubscriptionCanceled) Name() string {
+	return "subscription.canceled"
+}
+
+type WaitlistCarHeld struct {
+	ID        string    `json:"id"`
+	CarId     string    `json:"carId"`
+	CarModel  string    `json:"carModel"`
+	Plate     string    `json:"plate"`
+	StationId string    `json:"stationId"`
+	Contact   string    `json:"contact"`
+	DateFrom  time.Time `json:"dateFrom"`
+	DateTo    time.Time `json:"dateTo"`
+	HeldUntil time.Time `json:"heldUntil"`
+}
+
+func (c WaitlistCarHeld) Name() string {
+	return "waitlist.car-held"
+}
+
+type WaitlistHoldReleased struct {
+	ID        string `json:"id"`
+	CarId     string `json:"carId"`
+	StationId string `json:"stationId"`
+	FinalKM   uint64 `json:"finalKM"`
+	FinalFuel uint8  `json:"finalFuel"`
+}
+
+func (c WaitlistHoldReleased) Name() string {
+	return "waitlist.hold-released"
+}
+
+type SyncCarAvailable struct {
+	EventId string `json:"eventId"`
+	Car     Car    `json:"car"`
+}
+
+func (c SyncCarAvailable) Name() string {
+	return "sync.car.available"
+}
+
+type SyncCarPositionRecorded struct {
+	CarId      string    `json:"carId"`
+	Latitude   float64   `json:"latitude"`
+	Longitude  float64   `json:"longitude"`
+	RecordedAt time.Time `json:"recordedAt"`
+}
+
+func (c SyncCarPositionRecorded) Name() string {
+	return "sync.car.position-recorded"
+}
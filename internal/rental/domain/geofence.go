@@ -0,0 +1,104 @@
+package domain
+
+import (
+	"fmt"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/geo"
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/validation"
+)
+
+type GeofenceKind uint
+
+const (
+	ZoneFence GeofenceKind = iota + 1
+	StationFence
+)
+
+type Point struct {
+	Latitude  float64 `json:"latitude" validate:"gte=-90,lte=90" db:"latitude"`
+	Longitude float64 `json:"longitude" validate:"gte=-180,lte=180" db:"longitude"`
+}
+
+type Geofence struct {
+	ID         string       `json:"id" validate:"required,uuid4" db:"id"`
+	Name       string       `json:"name" validate:"required" db:"name"`
+	Kind       GeofenceKind `json:"kind" validate:"required,max=2" db:"kind"`
+	CategoryId string       `json:"categoryId,omitempty" validate:"omitempty,uuid4" db:"categoryId"`
+	OrderId    string       `json:"orderId,omitempty" validate:"omitempty,uuid4" db:"orderId"`
+	Polygon    []Point      `json:"polygon,omitempty" validate:"dive"`
+	StationId  string       `json:"stationId,omitempty" validate:"omitempty,uuid4" db:"stationId"`
+	Latitude   float64      `json:"latitude,omitempty" validate:"gte=-90,lte=90" db:"latitude"`
+	Longitude  float64      `json:"longitude,omitempty" validate:"gte=-180,lte=180" db:"longitude"`
+	Radius     float64      `json:"radius,omitempty" validate:"gte=0" db:"radius"`
+}
+
+func NewZoneGeofence(name, categoryId, orderId string, polygon []Point) (*Geofence, error) {
+	if len(polygon) < 3 {
+		return nil, ErrInvalidGeofence
+	}
+
+	return newGeofence(&Geofence{
+		ID:         validation.NewId(),
+		Name:       name,
+		Kind:       ZoneFence,
+		CategoryId: categoryId,
+		OrderId:    orderId,
+		Polygon:    polygon,
+	})
+}
+
+func NewStationGeofence(name, categoryId, orderId, stationId string, latitude, longitude, radius float64) (*Geofence, error) {
+	if stationId == "" || radius <= 0 {
+		return nil, ErrInvalidGeofence
+	}
+
+	return newGeofence(&Geofence{
+		ID:         validation.NewId(),
+		Name:       name,
+		Kind:       StationFence,
+		CategoryId: categoryId,
+		OrderId:    orderId,
+		Polygon:    []Point{},
+		StationId:  stationId,
+		Latitude:   latitude,
+		Longitude:  longitude,
+		Radius:     radius,
+	})
+}
+
+func newGeofence(g *Geofence) (*Geofence, error) {
+	if (g.CategoryId == "") == (g.OrderId == "") {
+		return nil, ErrInvalidGeofence
+	}
+
+	if err := validation.ValidateEntity(g); err != nil {
+		return nil, fmt.Errorf("%w\n%v", ErrInvalidEntity, err)
+	}
+
+	return g, nil
+}
+
+func (g Geofence) Contains(p Point) bool {
+	switch g.Kind {
+	case ZoneFence:
+		return inPolygon(g.Polygon, p)
+	case StationFence:
+		return geo.Distance(g.Latitude, g.Longitude, p.Latitude, p.Longitude) <= g.Radius
+	}
+
+	return false
+}
+
+func inPolygon(polygon []Point, p Point) bool {
+	inside := false
+
+	for i, j := 0, len(polygon)-1; i < len(polygon); j, i = i, i+1 {
+		a, b := polygon[i], polygon[j]
+		if (a.Latitude > p.Latitude) != (b.Latitude > p.Latitude) &&
+			p.Longitude < (b.Longitude-a.Longitude)*(p.Latitude-a.Latitude)/(b.Latitude-a.Latitude)+a.Longitude {
+			inside = !inside
+		}
+	}
+
+	return inside
+}
@@ -0,0 +1,205 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func newZoneFixture() []Point {
+	return []Point{
+		{Latitude: -23.0, Longitude: -47.0},
+		{Latitude: -23.0, Longitude: -46.0},
+		{Latitude: -24.0, Longitude: -46.0},
+		{Latitude: -24.0, Longitude: -47.0},
+	}
+}
+
+func TestNewGeofence(t *testing.T) {
+	categoryId := "479ab9e7-ad16-4864-8e49-29b15e4b390e"
+	orderId := "c6f31fdd-a77a-464b-9475-2d12441963a6"
+	stationId := "2520aade-a397-4e3c-a589-39c6ae5c2eff"
+
+	testCases := []struct {
+		name    string
+		newFunc func() (*Geofence, error)
+		wantErr error
+	}{
+		{
+			name: "correct zone input",
+			newFunc: func() (*Geofence, error) {
+				return NewZoneGeofence("SP", categoryId, "", newZoneFixture())
+			},
+			wantErr: nil,
+		},
+		{
+			name: "correct station input",
+			newFunc: func() (*Geofence, error) {
+				return NewStationGeofence("Station", "", orderId, stationId, -23.5, -46.6, 2)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "incorrect polygon input",
+			newFunc: func() (*Geofence, error) {
+				return NewZoneGeofence("SP", categoryId, "", newZoneFixture()[:2])
+			},
+			wantErr: ErrInvalidGeofence,
+		},
+		{
+			name: "incorrect radius input",
+			newFunc: func() (*Geofence, error) {
+				return NewStationGeofence("Station", "", orderId, stationId, -23.5, -46.6, 0)
+			},
+			wantErr: ErrInvalidGeofence,
+		},
+		{
+			name: "incorrect scope input",
+			newFunc: func() (*Geofence, error) {
+				return NewZoneGeofence("SP", categoryId, orderId, newZoneFixture())
+			},
+			wantErr: ErrInvalidGeofence,
+		},
+		{
+			name: "incorrect entity input",
+			newFunc: func() (*Geofence, error) {
+				return NewZoneGeofence("", categoryId, "", newZoneFixture())
+			},
+			wantErr: ErrInvalidEntity,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g, err := tc.newFunc()
+
+			if !errors.Is(err, tc.wantErr) {
+				t.Error("unexpected error", err)
+			}
+
+			if (g == nil) == (tc.wantErr == nil) {
+				t.Error("unexpected geofence", g)
+			}
+		})
+	}
+}
+
+func TestGeofence_Contains(t *testing.T) {
+	zone := Geofence{Kind: ZoneFence, Polygon: newZoneFixture()}
+	station := Geofence{Kind: StationFence, Latitude: -23.5, Longitude: -46.6, Radius: 2}
+
+	testCases := []struct {
+		name     string
+		geofence Geofence
+		point    Point
+		want     bool
+	}{
+		{name: "inside zone", geofence: zone, point: Point{-23.5, -46.5}, want: true},
+		{name: "outside zone", geofence: zone, point: Point{-22.5, -46.5}, want: false},
+		{name: "inside station radius", geofence: station, point: Point{-23.51, -46.6}, want: true},
+		{name: "outside station radius", geofence: station, point: Point{-23.6, -46.6}, want: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.geofence.Contains(tc.point); got != tc.want {
+				t.Error("unexpected contains", got)
+			}
+		})
+	}
+}
+
+func TestOrder_CheckPosition(t *testing.T) {
+	order := *newOrderFixture()
+	order.Status = Confirmed
+
+	fences := []Geofence{
+		{Kind: ZoneFence, Polygon: newZoneFixture()},
+		{Kind: StationFence, StationId: order.StationToId, Latitude: -23.5, Longitude: -46.6, Radius: 2},
+	}
+
+	inside := CarPosition{OrderId: order.ID, CarId: order.Car.ID, Latitude: -23.5, Longitude: -46.5, RecordedAt: order.DateReservFrom}
+	outside := CarPosition{OrderId: order.ID, CarId: order.Car.ID, Latitude: -22.5, Longitude: -46.5, RecordedAt: order.DateReservFrom}
+	overdue := inside
+	overdue.RecordedAt = order.DateReservTo.Add(time.Hour)
+	atStation := CarPosition{OrderId: order.ID, CarId: order.Car.ID, Latitude: -23.5, Longitude: -46.6, RecordedAt: overdue.RecordedAt}
+
+	testCases := []struct {
+		name           string
+		status         OrderStatus
+		previous       *CarPosition
+		current        CarPosition
+		overdueAlerted bool
+		want           []AlertKind
+	}{
+		{name: "inside zone", status: Confirmed, previous: &inside, current: inside, want: []AlertKind{}},
+		{name: "left zone", status: Confirmed, previous: &inside, current: outside, want: []AlertKind{ZoneExitAlert}},
+		{name: "first position outside zone", status: Confirmed, previous: nil, current: outside, want: []AlertKind{ZoneExitAlert}},
+		{name: "still outside zone", status: Confirmed, previous: &outside, current: outside, want: []AlertKind{}},
+		{name: "overdue outside station", status: Confirmed, previous: &inside, current: overdue, want: []AlertKind{OverdueReturnAlert}},
+		{name: "overdue already alerted", status: Confirmed, previous: &inside, current: overdue, overdueAlerted: true, want: []AlertKind{}},
+		{name: "overdue at station", status: Confirmed, previous: &inside, current: atStation, want: []AlertKind{}},
+		{name: "not rented order", status: Opened, previous: &inside, current: outside, want: []AlertKind{}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			order.Status = tc.status
+			alerts := order.CheckPosition(tc.previous, tc.current, fences, tc.overdueAlerted)
+
+			if len(alerts) != len(tc.want) {
+				t.Fatal("unexpected alerts", alerts)
+			}
+
+			for i, a := range alerts {
+				if a.Kind != tc.want[i] || len(a.Events) != 1 {
+					t.Error("unexpected alert", a)
+				}
+			}
+		})
+	}
+}
+
+func TestOrder_CheckOverdue(t *testing.T) {
+	order := *newOrderFixture()
+	order.Status = Confirmed
+
+	fences := []Geofence{
+		{Kind: ZoneFence, Polygon: newZoneFixture()},
+		{Kind: StationFence, StationId: order.StationToId, Latitude: -23.5, Longitude: -46.6, Radius: 2},
+	}
+
+	away := CarPosition{OrderId: order.ID, CarId: order.Car.ID, Latitude: -23.5, Longitude: -46.5, RecordedAt: order.DateReservFrom}
+	atStation := CarPosition{OrderId: order.ID, CarId: order.Car.ID, Latitude: -23.5, Longitude: -46.6, RecordedAt: order.DateReservFrom}
+	due := order.DateReservTo.Add(time.Hour)
+
+	testCases := []struct {
+		name      string
+		status    OrderStatus
+		last      CarPosition
+		fences    []Geofence
+		at        time.Time
+		wantAlert bool
+	}{
+		{name: "overdue away from station", status: Confirmed, last: away, fences: fences, at: due, wantAlert: true},
+		{name: "overdue at station", status: Confirmed, last: atStation, fences: fences, at: due, wantAlert: false},
+		{name: "not due yet", status: Confirmed, last: away, fences: fences, at: order.DateReservTo, wantAlert: false},
+		{name: "no station fence", status: Confirmed, last: away, fences: fences[:1], at: due, wantAlert: false},
+		{name: "not rented order", status: Closed, last: away, fences: fences, at: due, wantAlert: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			order.Status = tc.status
+			alert := order.CheckOverdue(tc.last, tc.fences, tc.at)
+
+			if (alert != nil) != tc.wantAlert {
+				t.Fatal("unexpected alert", alert)
+			}
+
+			if alert != nil && (alert.Kind != OverdueReturnAlert || len(alert.Events) != 1) {
+				t.Error("unexpected alert", alert)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package domain
+
+import (
+	"math"
+	"time"
+)
+
+type InvoiceLine struct {
+	OrderId     string  `json:"orderId,omitempty"`
+	CarModel    string  `json:"carModel"`
+	Description string  `json:"description"`
+	Amount      float32 `json:"amount"`
+}
+
+type Invoice struct {
+	Lines    []InvoiceLine `json:"lines"`
+	Discount float32       `json:"discount"`
+	Tax      float32       `json:"tax"`
+	Total    float32       `json:"total"`
+}
+
+func (i *Invoice) add(line InvoiceLine) {
+	i.Lines = append(i.Lines, line)
+	i.Total = roundCents(i.Total + line.Amount)
+}
+
+func (i *Invoice) merge(other Invoice) {
+	i.Lines = append(i.Lines, other.Lines...)
+	i.Discount = roundCents(i.Discount + other.Discount)
+	i.Tax = roundCents(i.Tax + other.Tax)
+	i.Total = roundCents(i.Total + other.Total)
+}
+
+func roundCents(amount float32) float32 {
+	return float32(math.Round(float64(amount)*100) / 100)
+}
+
+func QuoteLines(policy Policy, dateReservFrom, dateReservTo time.Time, prepaidFuel bool) []InvoiceLine {
+	lines := []InvoiceLine{{
+		CarModel:    policy.CarModel,
+		Description: policy.Name,
+		Amount:      roundCents(policy.Cost(dateReservFrom, dateReservTo, 0)),
+	}}
+
+	if prepaidFuel {
+		lines = append(lines, InvoiceLine{
+			CarModel:    policy.CarModel,
+			Description: "prepaid fuel",
+			Amount:      policy.PrepaidFuelPrice,
+		})
+	}
+
+	return lines
+}
+
+func NewQuote(lines []InvoiceLine) Invoice {
+	quote := Invoice{Lines: []InvoiceLine{}}
+	for _, l := range lines {
+		quote.add(l)
+	}
+	return quote
+}
@@ -30,6 +30,15 @@ type Order struct {
 	Policy         Policy         `json:"policy" validate:"required"`
 	Discount       float32        `json:"discount,omitempty" db:"discount"`
 	Tax            float32        `json:"tax,omitempty" db:"tax"`
+	PrepaidFuel    bool           `json:"prepaidFuel" db:"prepaidFuel"`
+	KeyDropReturn  bool           `json:"keyDropReturn" db:"keyDropReturn"`
+	BookingId      string         `json:"bookingId,omitempty" db:"bookingId"`
+	AccountId      string         `json:"accountId,omitempty" db:"accountId"`
+	DriverLicense  string         `json:"driverLicense,omitempty" db:"driverLicense"`
+	MemberId       string         `json:"memberId,omitempty" db:"memberId"`
+	WaitlistId     string         `json:"waitlistId,omitempty" db:"waitlistId"`
+	Charges        []Charge       `json:"charges"`
+	CarSwaps       []CarSwap      `json:"carSwaps"`
 	Events         []events.Event `json:"-" bson:"-"`
 }
 
@@ -40,6 +49,8 @@ func NewOrder(
 	stationFromId string,
 	stationToId string,
 	policy Policy,
+	prepaidFuel bool,
+	keyDropReturn bool,
 ) (*Order, error) {
 	if dateReservFrom.After(dateReservTo) {
 		return nil, ErrInvalidReservedDate
@@ -49,10 +60,14 @@ func NewOrder(
 		return nil, ErrInvalidCarStation
 	}
 
-	if car.CarModel != policy.CarModel {
+	if car.CarModel != policy.CarModel && !car.Upgraded {
 		return nil, ErrInvalidCarStation
 	}
 
+	if prepaidFuel && policy.PrepaidFuelPrice == 0 {
+		return nil, ErrPrepaidFuelDisabled
+	}
+
 	if err := car.Reserve(); err != nil {
 		return nil, err
 	}
@@ -66,12 +81,24 @@ func NewOrder(
 		StationFromId:  stationFromId,
 		StationToId:    stationToId,
 		Policy:         policy,
+		PrepaidFuel:    prepaidFuel,
+		KeyDropReturn:  keyDropReturn,
+		Charges:        []Charge{},
+		CarSwaps:       []CarSwap{},
 	}
 
 	if err := validation.ValidateEntity(newOrder); err != nil {
 		return nil, fmt.Errorf("%w\n%v", ErrInvalidEntity, err)
 	}
 
+	if prepaidFuel {
+		newOrder.Charges = append(newOrder.Charges, Charge{
+			Kind:        PrepaidFuelCharge,
+			Description: "prepaid fuel",
+			Amount:      policy.PrepaidFuelPrice,
+		})
+	}
+
 	newOrder.Events = append(newOrder.Events, OpenedOrder{
 		ID:        newOrder.ID,
 		CarId:     car.ID,
@@ -81,7 +108,7 @@ func NewOrder(
 	return newOrder, nil
 }
 
-func (r *Order) Confirm(dateFrom time.Time) error {
+func (r *Order) Confirm(dateFrom time.Time, fuelLevel *uint8) error {
 	if r.Status != Opened {
 		return ErrClose
 	}
@@ -90,12 +117,19 @@ func (r *Order) Confirm(dateFrom time.Time) error {
 		return ErrIvalidConfirmDate
 	}
 
+	if fuelLevel != nil && *fuelLevel > FullLevel {
+		return ErrInvalidFuelLevel
+	}
+
 	if err := r.Car.ToTransit(); err != nil {
 		return err
 	}
 
 	r.Status = Confirmed
 	r.DateFrom = &dateFrom
+	if fuelLevel != nil {
+		r.Car.InitialFuel = *fuelLevel
+	}
 
 	r.Events = append(r.Events, ConfirmedOrder{
 		ID:    r.ID,
@@ -105,7 +139,7 @@ func (r *Order) Confirm(dateFrom time.Time) error {
 	return nil
 }
 
-func (r *Order) Close(discount, tax float32, dateTo time.Time, finalKM uint64) error {
+func (r *Order) Close(discount, tax float32, dateTo time.Time, finalKM uint64, finalFuel uint8) error {
 	if r.Status != Confirmed {
 		return ErrClose
 	}
@@ -124,20 +158,38 @@ func (r *Order) Close(discount, tax float32, dateTo time.Time, finalKM uint64) e
 		return ErrIvalidCloseDiscount
 	}
 
-	if err := r.Car.Park(finalKM, r.StationToId); err != nil {
+	if err := r.Car.Park(finalKM, r.StationToId, finalFuel); err != nil {
 		return err
 	}
 
+	if !r.PrepaidFuel {
+		if charge, ok := energyCharge(r.Car, r.Policy); ok {
+			r.Charges = append(r.Charges, charge)
+		}
+	}
+
+	dateFrom := r.DateReservFrom
+	if r.DateFrom != nil {
+		dateFrom = *r.DateFrom
+	}
+	if charge, ok := excessKMCharge(r.Car, r.Policy, dateFrom, dateTo); ok {
+		r.Charges = append(r.Charges, charge)
+	}
+
 	r.Status = Closed
 	r.DateTo = &dateTo
 	r.Discount = discount
 	r.Tax = tax
 
 	r.Events = append(r.Events, ClosedOrder{
-		ID:        r.ID,
-		CarId:     r.Car.ID,
-		StationId: r.StationToId,
-		FinalKM:   finalKM,
+		ID:         r.ID,
+		CarId:      r.Car.ID,
+		StationId:  r.StationToId,
+		FinalKM:    finalKM,
+		FinalFuel:  finalFuel,
+		MemberId:   r.MemberId,
+		CategoryId: r.Policy.CategoryId,
+		Amount:     r.Invoice().Total,
 	})
 
 	return nil
@@ -148,17 +200,192 @@ func (r *Order) Cancel() error {
 		return ErrClose
 	}
 
-	if err := r.Car.Park(r.Car.InitialKM, r.Car.StationId); err != nil {
+	if err := r.Car.Park(r.Car.InitialKM, r.Car.StationId, r.Car.InitialFuel); err != nil {
 		return err
 	}
 
 	r.Status = Canceled
+	r.Charges = []Charge{}
 
 	r.Events = append(r.Events, CanceledOrder{
 		ID:        r.ID,
 		CarId:     r.Car.ID,
 		StationId: r.Car.StationId,
 		FinalKM:   r.Car.FinalKM,
+		FinalFuel: r.Car.FinalFuel,
+		MemberId:  r.MemberId,
+	})
+
+	return nil
+}
+
+func (r *Order) SwapCar(car Car, policy *Policy, reason string, at time.Time) error {
+	if r.Status != Opened {
+		return ErrInvalidSwap
+	}
+
+	if reason == "" || car.ID == r.Car.ID {
+		return ErrInvalidSwap
+	}
+
+	if car.StationId != r.StationFromId {
+		return ErrInvalidCarStation
+	}
+
+	if policy != nil {
+		if car.CarModel != policy.CarModel {
+			return ErrInvalidSwap
+		}
+		if r.PrepaidFuel && policy.PrepaidFuelPrice == 0 {
+			return ErrPrepaidFuelDisabled
+		}
+	}
+
+	if err := car.Reserve(); err != nil {
+		return err
+	}
+
+	released := r.Car
+	if err := released.Park(released.InitialKM, released.StationId, released.InitialFuel); err != nil {
+		return err
+	}
+
+	r.CarSwaps = append(r.CarSwaps, CarSwap{
+		FromCarId:    released.ID,
+		ToCarId:      car.ID,
+		FromCarModel: released.CarModel,
+		ToCarModel:   car.CarModel,
+		Repriced:     policy != nil,
+		Reason:       reason,
+		Date:         at,
+	})
+
+	if policy != nil {
+		r.Policy = *policy
+		for i, c := range r.Charges {
+			if c.Kind == PrepaidFuelCharge {
+				r.Charges[i].Amount = policy.PrepaidFuelPrice
+			}
+		}
+	}
+
+	car.Upgraded = car.CarModel != r.Policy.CarModel
+	r.Car = car
+
+	r.Events = append(r.Events, CarSwappedOrder{
+		ID:        r.ID,
+		Swap:      len(r.CarSwaps),
+		FromCarId: released.ID,
+		ToCarId:   car.ID,
+		StationId: released.StationId,
+		FinalKM:   released.FinalKM,
+		FinalFuel: released.FinalFuel,
+	})
+
+	return nil
+}
+
+func (r Order) Invoice() Invoice {
+	from, to, km := r.DateReservFrom, r.DateReservTo, uint64(0)
+	if r.Status == Closed && r.DateFrom != nil && r.DateTo != nil {
+		from, to = *r.DateFrom, *r.DateTo
+		if r.Car.FinalKM > r.Car.InitialKM {
+			km = r.Car.FinalKM - r.Car.InitialKM
+		}
+	}
+
+	invoice := Invoice{Lines: []InvoiceLine{}}
+	if r.Status == Canceled {
+		return invoice
+	}
+
+	invoice.add(InvoiceLine{
+		OrderId:     r.ID,
+		CarModel:    r.Car.CarModel,
+		Description: r.Policy.Name,
+		Amount:      roundCents(r.Policy.Cost(from, to, km)),
+	})
+
+	for _, c := range r.Charges {
+		invoice.add(InvoiceLine{
+			OrderId:     r.ID,
+			CarModel:    r.Car.CarModel,
+			Description: c.Description,
+			Amount:      c.Amount,
+		})
+	}
+
+	invoice.Discount = r.Discount
+	invoice.Tax = r.Tax
+	invoice.Total = roundCents(invoice.Total - r.Discount + r.Tax)
+
+	return invoice
+}
+
+func (r *Order) AssignDriver(driver DriverProfile, rule *EligibilityRule) error {
+	if r.Status != Opened {
+		return ErrInvalidDriver
+	}
+
+	r.DriverLicense = driver.License
+
+	if rule != nil {
+		if charge, ok := youngDriverCharge(driver, *rule, r.DateReservFrom, r.DateReservTo); ok {
+			r.Charges = append(r.Charges, charge)
+		}
+	}
+
+	return nil
+}
+
+func (r *Order) FromWaitlist(entry WaitlistEntry) error {
+	if r.Status != Opened || entry.HeldCar == nil || entry.HeldCar.ID != r.Car.ID {
+		return ErrInvalidHold
+	}
+
+	r.WaitlistId = entry.ID
+
+	for i, e := range r.Events {
+		if opened, ok := e.(OpenedOrder); ok {
+			opened.WaitlistId = entry.ID
+			r.Events[i] = opened
+		}
+	}
+
+	return nil
+}
+
+func (r *Order) JoinMember(memberId string, freePrepaidFuel bool) error {
+	if r.Status != Opened || memberId == "" {
+		return ErrInvalidMember
+	}
+
+	r.MemberId = memberId
+
+	if freePrepaidFuel {
+		for i, c := range r.Charges {
+			if c.Kind == PrepaidFuelCharge {
+				r.Charges[i] = Charge{Kind: WaivedPrepaidFuelCharge, Description: "prepaid fuel (member benefit)"}
+			}
+		}
+	}
+
+	return nil
+}
+
+func (r *Order) RedeemPoints(points uint, discount float32) error {
+	if r.Status != Opened || r.MemberId == "" || points == 0 {
+		return ErrInvalidMember
+	}
+
+	if discount <= 0 || discount > r.Invoice().Total {
+		return ErrInvalidMember
+	}
+
+	r.Charges = append(r.Charges, Charge{
+		Kind:        PointsDiscountCharge,
+		Description: fmt.Sprintf("%d loyalty points", points),
+		Amount:      -discount,
 	})
 
 	return nil
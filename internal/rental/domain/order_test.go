@@ -52,6 +52,10 @@ func TestNewOrder(t *testing.T) {
 	otherCar2 := *newCarFixture()
 	otherCar2.Status = Maintenance
 
+	upgradedCar := *newCarFixture()
+	upgradedCar.CarModel = "PORCHE"
+	upgradedCar.Upgraded = true
+
 	type args struct {
 		dateReservFrom time.Time
 		dateReservTo   time.Time
@@ -59,6 +63,7 @@ func TestNewOrder(t *testing.T) {
 		stationFromId  string
 		stationToId    string
 		policy         Policy
+		prepaidFuel    bool
 	}
 
 	type want struct {
@@ -131,6 +136,21 @@ func TestNewOrder(t *testing.T) {
 				err:     ErrInvalidCarStation,
 			},
 		},
+		{
+			name: "correct upgraded model input",
+			args: args{
+				dateReservFrom: time.Now(),
+				dateReservTo:   time.Now().Add(time.Hour * 24 * 5),
+				car:            upgradedCar,
+				stationFromId:  "83369771-f9a4-48b7-b87b-463f19f7b187",
+				stationToId:    "2520aade-a397-4e3c-a589-39c6ae5c2eff",
+				policy:         *newPolicyFixture(),
+			},
+			want: want{
+				isOrder: true,
+				err:     nil,
+			},
+		},
 		{
 			name: "incorrect car status input",
 			args: args{
@@ -146,6 +166,22 @@ func TestNewOrder(t *testing.T) {
 				err:     ErrInvalidReserve,
 			},
 		},
+		{
+			name: "incorrect prepaid fuel input",
+			args: args{
+				dateReservFrom: time.Now(),
+				dateReservTo:   time.Now().Add(time.Hour * 24 * 5),
+				car:            *newCarFixture(),
+				stationFromId:  "83369771-f9a4-48b7-b87b-463f19f7b187",
+				stationToId:    "2520aade-a397-4e3c-a589-39c6ae5c2eff",
+				policy:         *newPolicyFixture(),
+				prepaidFuel:    true,
+			},
+			want: want{
+				isOrder: false,
+				err:     ErrPrepaidFuelDisabled,
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -157,6 +193,8 @@ func TestNewOrder(t *testing.T) {
 				tc.args.stationFromId,
 				tc.args.stationToId,
 				tc.args.policy,
+				tc.args.prepaidFuel,
+				false,
 			)
 
 			if reflect.ValueOf(c).IsNil() == tc.want.isOrder {
@@ -249,7 +287,8 @@ func TestOrder_Confirm(t *testing.T) {
 			newOrder.Status = tc.init.orderStatus
 			newOrder.DateReservFrom = tc.init.dateReservFrom
 
-			err := newOrder.Confirm(tc.args.dateFrom)
+			fuel := FullLevel
+			err := newOrder.Confirm(tc.args.dateFrom, &fuel)
 
 			if newOrder.Status != tc.want.status {
 				t.Error("unexpected result", newOrder.Status)
@@ -266,6 +305,20 @@ func TestOrder_Confirm(t *testing.T) {
 	}
 }
 
+func TestOrder_ConfirmWithoutFuelLevel(t *testing.T) {
+	newOrder := newOrderFixture()
+	newOrder.Car.Status = Reserved
+	newOrder.Car.InitialFuel = 70
+
+	if err := newOrder.Confirm(newOrder.DateReservFrom, nil); err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	if newOrder.Car.InitialFuel != 70 {
+		t.Error("unexpected initial fuel", newOrder.Car.InitialFuel)
+	}
+}
+
 func TestOrder_Cancel(t *testing.T) {
 	type init struct {
 		orderStatus OrderStatus
@@ -455,7 +508,7 @@ func TestOrder_Close(t *testing.T) {
 			newOrder.DateFrom = &dateFrom
 			newOrder.Status = tc.init.orderStatus
 
-			err := newOrder.Close(tc.args.discount, tc.args.tax, tc.args.dateTo, tc.args.finalKM)
+			err := newOrder.Close(tc.args.discount, tc.args.tax, tc.args.dateTo, tc.args.finalKM, FullLevel)
 
 			if newOrder.Status != tc.want.status {
 				t.Error("unexpected result", newOrder.Status)
@@ -479,3 +532,359 @@ func TestOrder_Close(t *testing.T) {
 		})
 	}
 }
+
+func TestOrder_CloseEnergyCharge(t *testing.T) {
+	dateTo := time.Now().Add(time.Hour * 24 * 6)
+
+	type init struct {
+		energy      EnergyType
+		initialFuel uint8
+		prepaidFuel bool
+	}
+
+	type args struct {
+		finalFuel uint8
+	}
+
+	type want struct {
+		charges []Charge
+	}
+
+	testCases := []struct {
+		name string
+		init init
+		args args
+		want want
+	}{
+		{
+			name: "refuel charge",
+			init: init{
+				energy:      Combustion,
+				initialFuel: FullLevel,
+			},
+			args: args{
+				finalFuel: 60,
+			},
+			want: want{
+				charges: []Charge{{Kind: RefuelCharge, Description: "refuel", Amount: 20}},
+			},
+		},
+		{
+			name: "recharge charge",
+			init: init{
+				energy:      Electric,
+				initialFuel: 80,
+			},
+			args: args{
+				finalFuel: 60,
+			},
+			want: want{
+				charges: []Charge{{Kind: RechargeCharge, Description: "recharge", Amount: 40}},
+			},
+		},
+		{
+			name: "returned with higher level",
+			init: init{
+				energy:      Combustion,
+				initialFuel: 60,
+			},
+			args: args{
+				finalFuel: FullLevel,
+			},
+			want: want{
+				charges: []Charge{},
+			},
+		},
+		{
+			name: "prepaid fuel",
+			init: init{
+				energy:      Combustion,
+				initialFuel: FullLevel,
+				prepaidFuel: true,
+			},
+			args: args{
+				finalFuel: 10,
+			},
+			want: want{
+				charges: []Charge{},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			newOrder := newOrderFixture()
+			newOrder.Car.Status = Transit
+			newOrder.Car.Energy = tc.init.energy
+			newOrder.Car.InitialFuel = tc.init.initialFuel
+			newOrder.Policy.RefuelPrice = 0.5
+			newOrder.Policy.RechargePrice = 2
+			dateFrom := newOrder.DateReservFrom.Add(time.Hour)
+			newOrder.DateFrom = &dateFrom
+			newOrder.Status = Confirmed
+			newOrder.PrepaidFuel = tc.init.prepaidFuel
+			newOrder.Charges = []Charge{}
+
+			if err := newOrder.Close(0, 0, dateTo, 12050, tc.args.finalFuel); err != nil {
+				t.Error("unexpected error", err)
+			}
+
+			if !reflect.DeepEqual(newOrder.Charges, tc.want.charges) {
+				t.Error("unexpected charges", newOrder.Charges)
+			}
+
+			if newOrder.Car.FinalFuel != tc.args.finalFuel {
+				t.Error("unexpected final fuel", newOrder.Car.FinalFuel)
+			}
+		})
+	}
+}
+
+func TestOrder_CloseExcessKMCharge(t *testing.T) {
+	testCases := []struct {
+		name          string
+		excessKMPrice float32
+		days          int
+		finalKM       uint64
+		want          []Charge
+	}{
+		{
+			name:          "within allowance",
+			excessKMPrice: 0.8,
+			days:          6,
+			finalKM:       12600,
+			want:          []Charge{},
+		},
+		{
+			name:          "over allowance",
+			excessKMPrice: 0.8,
+			days:          6,
+			finalKM:       12750,
+			want:          []Charge{{Kind: ExcessKMCharge, Description: "150 km over allowance", Amount: 120}},
+		},
+		{
+			name:          "allowance of the min units",
+			excessKMPrice: 0.8,
+			days:          1,
+			finalKM:       12600,
+			want:          []Charge{{Kind: ExcessKMCharge, Description: "100 km over allowance", Amount: 80}},
+		},
+		{
+			name:          "unlimited km",
+			excessKMPrice: 0,
+			days:          6,
+			finalKM:       20000,
+			want:          []Charge{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			newOrder := newOrderFixture()
+			newOrder.Car.Status = Transit
+			newOrder.Policy.KMAllowance = 100
+			newOrder.Policy.ExcessKMPrice = tc.excessKMPrice
+			dateFrom := newOrder.DateReservFrom.Add(time.Hour)
+			newOrder.DateFrom = &dateFrom
+			newOrder.Status = Confirmed
+			newOrder.Charges = []Charge{}
+
+			if err := newOrder.Close(0, 0, dateFrom.Add(time.Hour*24*time.Duration(tc.days)), tc.finalKM, 0); err != nil {
+				t.Fatal("unexpected error", err)
+			}
+
+			if !reflect.DeepEqual(newOrder.Charges, tc.want) {
+				t.Error("unexpected charges", newOrder.Charges)
+			}
+		})
+	}
+}
+
+func TestOrder_SwapCar(t *testing.T) {
+	newSwapCar := func(carModel string) Car {
+		car := *newCarFixture()
+		car.ID = "0b1b5f2a-3f4e-4b7b-9c41-7d8a6c1f2e3d"
+		car.CarModel = carModel
+		return car
+	}
+
+	repricePolicy := *newPolicyFixture()
+	repricePolicy.ID = "9a8b7c6d-5e4f-4a3b-8c2d-1e0f9a8b7c6d"
+	repricePolicy.CarModel = "COROLLA"
+	repricePolicy.Price = 80
+
+	otherStationCar := newSwapCar("COROLLA")
+	otherStationCar.StationId = "7621d238-cf12-4570-8ed1-6c0a38b76b4d"
+
+	type args struct {
+		status OrderStatus
+		car    Car
+		policy *Policy
+		reason string
+	}
+
+	type want struct {
+		err      error
+		price    float32
+		upgraded bool
+		repriced bool
+	}
+
+	testCases := []struct {
+		name string
+		args args
+		want want
+	}{
+		{
+			name: "correct free upgrade",
+			args: args{status: Opened, car: newSwapCar("COROLLA"), reason: "booked model unavailable"},
+			want: want{err: nil, price: 30.5, upgraded: true, repriced: false},
+		},
+		{
+			name: "correct paid upgrade",
+			args: args{status: Opened, car: newSwapCar("COROLLA"), policy: &repricePolicy, reason: "customer asked"},
+			want: want{err: nil, price: 80, upgraded: false, repriced: true},
+		},
+		{
+			name: "incorrect policy model",
+			args: args{status: Opened, car: newSwapCar("ONIX"), policy: &repricePolicy, reason: "customer asked"},
+			want: want{err: ErrInvalidSwap},
+		},
+		{
+			name: "incorrect missing reason",
+			args: args{status: Opened, car: newSwapCar("COROLLA")},
+			want: want{err: ErrInvalidSwap},
+		},
+		{
+			name: "incorrect station",
+			args: args{status: Opened, car: otherStationCar, reason: "booked model unavailable"},
+			want: want{err: ErrInvalidCarStation},
+		},
+		{
+			name: "incorrect order status",
+			args: args{status: Confirmed, car: newSwapCar("COROLLA"), reason: "booked model unavailable"},
+			want: want{err: ErrInvalidSwap},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			order := newOrderFixture()
+			order.Status = tc.args.status
+			order.Car.Status = Reserved
+			original := order.Car
+
+			err := order.SwapCar(tc.args.car, tc.args.policy, tc.args.reason, time.Now())
+
+			if !errors.Is(err, tc.want.err) {
+				t.Fatal("unexpected error", err)
+			}
+
+			if err != nil {
+				if order.Car.ID != original.ID || len(order.Events) != 0 {
+					t.Error("unexpected swap", order.Car.ID, order.Events)
+				}
+				return
+			}
+
+			if order.Car.ID != tc.args.car.ID || order.Car.Status != Reserved || order.Car.Upgraded != tc.want.upgraded {
+				t.Error("unexpected car", order.Car)
+			}
+
+			if order.Policy.Price != tc.want.price {
+				t.Error("unexpected price", order.Policy.Price)
+			}
+
+			if len(order.CarSwaps) != 1 || order.CarSwaps[0].FromCarId != original.ID || order.CarSwaps[0].Repriced != tc.want.repriced || order.CarSwaps[0].Reason != tc.args.reason {
+				t.Error("unexpected swap record", order.CarSwaps)
+			}
+
+			wantEvent := CarSwappedOrder{
+				ID:        order.ID,
+				Swap:      1,
+				FromCarId: original.ID,
+				ToCarId:   tc.args.car.ID,
+				StationId: original.StationId,
+				FinalKM:   original.InitialKM,
+			}
+			if len(order.Events) != 1 || !reflect.DeepEqual(order.Events[0], wantEvent) {
+				t.Error("unexpected events", order.Events)
+			}
+		})
+	}
+}
+
+func TestOrder_JoinMember(t *testing.T) {
+	memberId := "0c7e2b4a-6d1f-4e3b-9a8c-5f2d1e0b3a4c"
+
+	testCases := []struct {
+		name            string
+		status          OrderStatus
+		memberId        string
+		freePrepaidFuel bool
+		want            error
+		wantKind        ChargeKind
+		wantAmount      float32
+	}{
+		{name: "correct member", status: Opened, memberId: memberId, want: nil, wantKind: PrepaidFuelCharge, wantAmount: 60},
+		{name: "correct member with free prepaid fuel", status: Opened, memberId: memberId, freePrepaidFuel: true, want: nil, wantKind: WaivedPrepaidFuelCharge, wantAmount: 0},
+		{name: "incorrect missing member", status: Opened, memberId: "", want: ErrInvalidMember},
+		{name: "incorrect order status", status: Confirmed, memberId: memberId, want: ErrInvalidMember},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			order := newOrderFixture()
+			order.Status = tc.status
+			order.PrepaidFuel = true
+			order.Charges = []Charge{{Kind: PrepaidFuelCharge, Description: "prepaid fuel", Amount: 60}}
+
+			err := order.JoinMember(tc.memberId, tc.freePrepaidFuel)
+
+			if !errors.Is(err, tc.want) {
+				t.Fatal("unexpected error", err)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if order.MemberId != tc.memberId || order.Charges[0].Kind != tc.wantKind || order.Charges[0].Amount != tc.wantAmount {
+				t.Error("unexpected order", order.MemberId, order.Charges)
+			}
+		})
+	}
+}
+
+func TestOrder_RedeemPoints(t *testing.T) {
+	testCases := []struct {
+		name     string
+		memberId string
+		points   uint
+		discount float32
+		want     error
+	}{
+		{name: "correct redemption", memberId: "0c7e2b4a-6d1f-4e3b-9a8c-5f2d1e0b3a4c", points: 1000, discount: 10, want: nil},
+		{name: "incorrect not a member", memberId: "", points: 1000, discount: 10, want: ErrInvalidMember},
+		{name: "incorrect no points", memberId: "0c7e2b4a-6d1f-4e3b-9a8c-5f2d1e0b3a4c", points: 0, discount: 10, want: ErrInvalidMember},
+		{name: "incorrect over the order price", memberId: "0c7e2b4a-6d1f-4e3b-9a8c-5f2d1e0b3a4c", points: 100000, discount: 1000, want: ErrInvalidMember},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			order := newOrderFixture()
+			order.MemberId = tc.memberId
+			total := order.Invoice().Total
+
+			err := order.RedeemPoints(tc.points, tc.discount)
+
+			if !errors.Is(err, tc.want) {
+				t.Fatal("unexpected error", err)
+			}
+
+			if err == nil && order.Invoice().Total != total-tc.discount {
+				t.Error("unexpected total", order.Invoice().Total)
+			}
+		})
+	}
+}
@@ -2,6 +2,8 @@ package domain
 
 import (
 	"fmt"
+	"math"
+	"time"
 
 	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/validation"
 )
@@ -12,6 +14,7 @@ const (
 	PerKm = iota + 1
 	PerDay
 	PerWeek
+	PerMonth
 )
 
 type Policy struct {
@@ -22,6 +25,13 @@ type Policy struct {
 	MinUnit    uint    `json:"minUnit" validate:"required" db:"minUnit"`
 	CarModel   string  `json:"carModel" validate:"required" db:"carModel"`
 	CategoryId string  `json:"categoryId" validate:"required,uuid4" db:"categoryId"`
+
+	RefuelPrice      float32 `json:"refuelPrice" validate:"gte=0" db:"refuelPrice"`
+	RechargePrice    float32 `json:"rechargePrice" validate:"gte=0" db:"rechargePrice"`
+	PrepaidFuelPrice float32 `json:"prepaidFuelPrice" validate:"gte=0" db:"prepaidFuelPrice"`
+
+	KMAllowance   uint64  `json:"kmAllowance" db:"kmAllowance"`
+	ExcessKMPrice float32 `json:"excessKMPrice" validate:"gte=0" db:"excessKMPrice"`
 }
 
 func NewPolicy(id, name string, price float32, unit Unit, minUnit uint, carModel, categoryId string) (*Policy, error) {
@@ -41,3 +51,34 @@ func NewPolicy(id, name string, price float32, unit Unit, minUnit uint, carModel
 
 	return policy, nil
 }
+
+func (p Policy) Cost(from, to time.Time, km uint64) float32 {
+	return p.Price * float32(p.units(from, to, km))
+}
+
+func (p Policy) Allowance(from, to time.Time) uint64 {
+	if p.Unit == PerKm {
+		return 0
+	}
+	return p.KMAllowance * uint64(p.units(from, to, 0))
+}
+
+func (p Policy) units(from, to time.Time, km uint64) uint {
+	var units uint
+	switch p.Unit {
+	case PerKm:
+		units = uint(km)
+	case PerDay:
+		units = uint(math.Ceil(to.Sub(from).Hours() / 24))
+	case PerWeek:
+		units = uint(math.Ceil(to.Sub(from).Hours() / (24 * 7)))
+	case PerMonth:
+		units = uint(math.Ceil(to.Sub(from).Hours() / (24 * 30)))
+	}
+
+	if units < p.MinUnit {
+		units = p.MinUnit
+	}
+
+	return units
+}
@@ -0,0 +1,360 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/events"
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/validation"
+)
+
+type SubscriptionStatus uint
+
+const (
+	ActiveSubscription SubscriptionStatus = iota + 1
+	PausedSubscription
+	CanceledSubscription
+)
+
+const MaxPauses = 2
+
+const EarlyTerminationRate = 0.5
+
+type BillingCycle struct {
+	Number   uint      `json:"number" db:"number"`
+	DateFrom time.Time `json:"dateFrom" db:"dateFrom"`
+	DateTo   time.Time `json:"dateTo" db:"dateTo"`
+	KM       uint64    `json:"km" db:"km"`
+	Fee      float32   `json:"fee" db:"fee"`
+	Overage  float32   `json:"overage" db:"overage"`
+	Billed   bool      `json:"billed" db:"billed"`
+}
+
+type Subscription struct {
+	ID             string             `json:"id" validate:"required,uuid4" db:"id"`
+	Status         SubscriptionStatus `json:"status" validate:"required" db:"status"`
+	DateFrom       time.Time          `json:"dateFrom" validate:"required" db:"dateFrom"`
+	DateTo         *time.Time         `json:"dateTo,omitempty" db:"dateTo"`
+	PausedAt       *time.Time         `json:"pausedAt,omitempty" db:"pausedAt"`
+	Pauses         uint               `json:"pauses" db:"pauses"`
+	StationId      string             `json:"stationId" validate:"required,uuid4" db:"stationId"`
+	Car            Car                `json:"car" validate:"required"`
+	Policy         Policy             `json:"policy" validate:"required"`
+	LastKM         uint64             `json:"lastKM" db:"lastKM"`
+	TerminationFee float32            `json:"terminationFee,omitempty" db:"terminationFee"`
+	Cycles         []BillingCycle     `json:"cycles"`
+	CarSwaps       []CarSwap          `json:"carSwaps"`
+	Events         []events.Event     `json:"-" bson:"-"`
+}
+
+func NewSubscription(car Car, stationId string, policy Policy, at time.Time) (*Subscription, error) {
+	if policy.Unit != PerMonth || car.CarModel != policy.CarModel {
+		return nil, ErrInvalidSubscription
+	}
+
+	if car.StationId != stationId {
+		return nil, ErrInvalidCarStation
+	}
+
+	if err := car.Reserve(); err != nil {
+		return nil, err
+	}
+
+	if err := car.ToTransit(); err != nil {
+		return nil, err
+	}
+
+	subscription := &Subscription{
+		ID:        validation.NewId(),
+		Status:    ActiveSubscription,
+		DateFrom:  at,
+		StationId: stationId,
+		Car:       car,
+		Policy:    policy,
+		LastKM:    car.InitialKM,
+		Cycles:    []BillingCycle{},
+		CarSwaps:  []CarSwap{},
+	}
+
+	if err := validation.ValidateEntity(subscription); err != nil {
+		return nil, fmt.Errorf("%w\n%v", ErrInvalidEntity, err)
+	}
+
+	subscription.startCycle(at)
+
+	subscription.Events = append(subscription.Events, SubscriptionStarted{
+		ID:        subscription.ID,
+		CarId:     car.ID,
+		StationId: stationId,
+		Date:      at,
+	})
+
+	return subscription, nil
+}
+
+func (s *Subscription) Bill(at time.Time, km uint64) error {
+	if s.Status != ActiveSubscription {
+		return ErrInvalidBilling
+	}
+
+	cycle := s.currentCycle()
+	if cycle == nil || at.Before(cycle.DateTo) {
+		return ErrInvalidBilling
+	}
+
+	if err := s.drive(km); err != nil {
+		return err
+	}
+
+	s.endCycle()
+	s.startCycle(cycle.DateTo)
+
+	return nil
+}
+
+func (s Subscription) BillingDue(at time.Time) bool {
+	if s.Status != ActiveSubscription {
+		return false
+	}
+
+	cycle := s.currentCycle()
+	return cycle != nil && !at.Before(cycle.DateTo)
+}
+
+func (s *Subscription) SwapCar(car Car, km uint64, fuel uint8, reason string, at time.Time) error {
+	if s.Status != ActiveSubscription {
+		return ErrInvalidSwap
+	}
+
+	if reason == "" || car.ID == s.Car.ID {
+		return ErrInvalidSwap
+	}
+
+	if car.StationId != s.StationId {
+		return ErrInvalidCarStation
+	}
+
+	if err := car.Reserve(); err != nil {
+		return err
+	}
+
+	if err := car.ToTransit(); err != nil {
+		return err
+	}
+
+	released := s.Car
+	if err := released.Park(km, s.StationId, fuel); err != nil {
+		return err
+	}
+
+	if err := s.drive(km); err != nil {
+		return err
+	}
+
+	s.CarSwaps = append(s.CarSwaps, CarSwap{
+		FromCarId:    released.ID,
+		ToCarId:      car.ID,
+		FromCarModel: released.CarModel,
+		ToCarModel:   car.CarModel,
+		Reason:       reason,
+		Date:         at,
+	})
+
+	car.Upgraded = car.CarModel != s.Policy.CarModel
+	s.Car = car
+	s.LastKM = car.InitialKM
+
+	s.Events = append(s.Events, SubscriptionCarSwapped{
+		ID:        s.ID,
+		FromCarId: released.ID,
+		ToCarId:   car.ID,
+		StationId: s.StationId,
+		FinalKM:   km,
+		FinalFuel: fuel,
+		Date:      at,
+	})
+
+	return nil
+}
+
+func (s *Subscription) Pause(at time.Time, km uint64, fuel uint8) error {
+	if s.Status != ActiveSubscription || s.Pauses >= MaxPauses {
+		return ErrInvalidPause
+	}
+
+	if err := s.returnCar(km, fuel); err != nil {
+		return err
+	}
+
+	s.Status = PausedSubscription
+	s.PausedAt = &at
+	s.Pauses++
+
+	s.Events = append(s.Events, SubscriptionPaused{
+		ID:        s.ID,
+		CarId:     s.Car.ID,
+		StationId: s.StationId,
+		FinalKM:   km,
+		FinalFuel: fuel,
+		Date:      at,
+	})
+
+	return nil
+}
+
+func (s *Subscription) Resume(car Car, at time.Time) error {
+	if s.Status != PausedSubscription {
+		return ErrInvalidResume
+	}
+
+	if s.PausedAt != nil && at.Before(*s.PausedAt) {
+		return ErrInvalidResume
+	}
+
+	if car.StationId != s.StationId {
+		return ErrInvalidCarStation
+	}
+
+	if err := car.Reserve(); err != nil {
+		return err
+	}
+
+	if err := car.ToTransit(); err != nil {
+		return err
+	}
+
+	car.Upgraded = car.CarModel != s.Policy.CarModel
+	s.Car = car
+	s.LastKM = car.InitialKM
+	s.Status = ActiveSubscription
+	s.PausedAt = nil
+
+	s.startCycle(at)
+
+	s.Events = append(s.Events, SubscriptionResumed{
+		ID:        s.ID,
+		CarId:     car.ID,
+		StationId: s.StationId,
+		Date:      at,
+	})
+
+	return nil
+}
+
+func (s *Subscription) Cancel(at time.Time, km uint64, fuel uint8) error {
+	if s.Status != ActiveSubscription && s.Status != PausedSubscription {
+		return ErrCancelSubscription
+	}
+
+	if s.Status == ActiveSubscription {
+		if err := s.returnCar(km, fuel); err != nil {
+			return err
+		}
+
+		s.Events = append(s.Events, SubscriptionCanceled{
+			ID:        s.ID,
+			CarId:     s.Car.ID,
+			StationId: s.StationId,
+			FinalKM:   km,
+			FinalFuel: fuel,
+			Date:      at,
+		})
+	}
+
+	if months := uint(len(s.Cycles)); months < s.Policy.MinUnit {
+		s.TerminationFee = roundCents(float32(s.Policy.MinUnit-months) * s.Policy.Price * EarlyTerminationRate)
+	}
+
+	s.Status = CanceledSubscription
+	s.DateTo = &at
+	s.PausedAt = nil
+
+	return nil
+}
+
+func (s Subscription) Invoice() Invoice {
+	invoice := Invoice{Lines: []InvoiceLine{}}
+
+	for _, c := range s.Cycles {
+		invoice.add(InvoiceLine{
+			CarModel:    s.Policy.CarModel,
+			Description: fmt.Sprintf("%s - month %d", s.Policy.Name, c.Number),
+			Amount:      c.Fee,
+		})
+
+		if c.Overage > 0 {
+			invoice.add(InvoiceLine{
+				CarModel:    s.Policy.CarModel,
+				Description: fmt.Sprintf("%d km over allowance - month %d", c.KM-s.Policy.KMAllowance, c.Number),
+				Amount:      c.Overage,
+			})
+		}
+	}
+
+	if s.TerminationFee > 0 {
+		invoice.add(InvoiceLine{
+			CarModel:    s.Policy.CarModel,
+			Description: "early termination",
+			Amount:      s.TerminationFee,
+		})
+	}
+
+	return invoice
+}
+
+func (s *Subscription) returnCar(km uint64, fuel uint8) error {
+	car := s.Car
+	if err := car.Park(km, s.StationId, fuel); err != nil {
+		return err
+	}
+
+	if err := s.drive(km); err != nil {
+		return err
+	}
+
+	s.Car = car
+	s.endCycle()
+
+	return nil
+}
+
+func (s *Subscription) drive(km uint64) error {
+	if km < s.LastKM {
+		return ErrInvalidBilling
+	}
+
+	if cycle := s.currentCycle(); cycle != nil {
+		cycle.KM += km - s.LastKM
+	}
+	s.LastKM = km
+
+	return nil
+}
+
+func (s *Subscription) currentCycle() *BillingCycle {
+	if len(s.Cycles) == 0 || s.Cycles[len(s.Cycles)-1].Billed {
+		return nil
+	}
+	return &s.Cycles[len(s.Cycles)-1]
+}
+
+func (s *Subscription) startCycle(at time.Time) {
+	s.Cycles = append(s.Cycles, BillingCycle{
+		Number:   uint(len(s.Cycles)) + 1,
+		DateFrom: at,
+		DateTo:   at.AddDate(0, 1, 0),
+		Fee:      s.Policy.Price,
+	})
+}
+
+func (s *Subscription) endCycle() {
+	cycle := s.currentCycle()
+	if cycle == nil {
+		return
+	}
+
+	if s.Policy.ExcessKMPrice > 0 && cycle.KM > s.Policy.KMAllowance {
+		cycle.Overage = roundCents(float32(cycle.KM-s.Policy.KMAllowance) * s.Policy.ExcessKMPrice)
+	}
+	cycle.Billed = true
+}
@@ -0,0 +1,297 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func newMonthlyPolicyFixture() *Policy {
+	policy := newPolicyFixture()
+	policy.Name = "Monthly"
+	policy.Price = 900
+	policy.Unit = PerMonth
+	policy.MinUnit = 3
+	policy.KMAllowance = 1500
+	policy.ExcessKMPrice = 0.5
+	return policy
+}
+
+func newSubscriptionFixture(t *testing.T, at time.Time) *Subscription {
+	t.Helper()
+	subscription, err := NewSubscription(*newCarFixture(), "83369771-f9a4-48b7-b87b-463f19f7b187", *newMonthlyPolicyFixture(), at)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return subscription
+}
+
+func newOtherCarFixture() Car {
+	car := *newCarFixture()
+	car.ID = "0b1b5f2a-3f4e-4b7b-9c41-7d8a6c1f2e3d"
+	car.InitialKM = 30000
+	return car
+}
+
+func TestNewSubscription(t *testing.T) {
+	at := time.Date(2022, time.March, 1, 10, 0, 0, 0, time.UTC)
+
+	otherStationCar := *newCarFixture()
+	otherStationCar.StationId = "7621d238-cf12-4570-8ed1-6c0a38b76b4d"
+
+	reservedCar := *newCarFixture()
+	reservedCar.Status = Reserved
+
+	dailyPolicy := *newPolicyFixture()
+
+	testCases := []struct {
+		name   string
+		car    Car
+		policy Policy
+		want   error
+	}{
+		{name: "correct input", car: *newCarFixture(), policy: *newMonthlyPolicyFixture(), want: nil},
+		{name: "incorrect daily policy", car: *newCarFixture(), policy: dailyPolicy, want: ErrInvalidSubscription},
+		{name: "incorrect car station", car: otherStationCar, policy: *newMonthlyPolicyFixture(), want: ErrInvalidCarStation},
+		{name: "incorrect reserved car", car: reservedCar, policy: *newMonthlyPolicyFixture(), want: ErrInvalidReserve},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			subscription, err := NewSubscription(tc.car, "83369771-f9a4-48b7-b87b-463f19f7b187", tc.policy, at)
+
+			if !errors.Is(err, tc.want) {
+				t.Fatal("unexpected error", err)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if subscription.Status != ActiveSubscription || subscription.Car.Status != Transit || len(subscription.Cycles) != 1 || len(subscription.Events) != 1 {
+				t.Error("unexpected subscription", subscription)
+			}
+
+			if cycle := subscription.Cycles[0]; cycle.Fee != 900 || !cycle.DateTo.Equal(time.Date(2022, time.April, 1, 10, 0, 0, 0, time.UTC)) {
+				t.Error("unexpected cycle", cycle)
+			}
+		})
+	}
+}
+
+func TestSubscription_Bill(t *testing.T) {
+	at := time.Date(2022, time.March, 1, 10, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name        string
+		at          time.Time
+		km          uint64
+		want        error
+		wantOverage float32
+	}{
+		{name: "correct within allowance", at: at.AddDate(0, 1, 0), km: 13000, want: nil, wantOverage: 0},
+		{name: "correct over allowance", at: at.AddDate(0, 1, 2), km: 14000, want: nil, wantOverage: 250},
+		{name: "incorrect cycle not ended", at: at.AddDate(0, 0, 20), km: 13000, want: ErrInvalidBilling},
+		{name: "incorrect km", at: at.AddDate(0, 1, 0), km: 11000, want: ErrInvalidBilling},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			subscription := newSubscriptionFixture(t, at)
+
+			err := subscription.Bill(tc.at, tc.km)
+
+			if !errors.Is(err, tc.want) {
+				t.Fatal("unexpected error", err)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if len(subscription.Cycles) != 2 || !subscription.Cycles[0].Billed || subscription.Cycles[0].Overage != tc.wantOverage {
+				t.Fatal("unexpected cycles", subscription.Cycles)
+			}
+
+			if next := subscription.Cycles[1]; next.Number != 2 || !next.DateFrom.Equal(at.AddDate(0, 1, 0)) || next.KM != 0 {
+				t.Error("unexpected next cycle", next)
+			}
+
+			if got := subscription.Invoice().Total; got != 1800+tc.wantOverage {
+				t.Error("unexpected invoice total", got)
+			}
+		})
+	}
+}
+
+func TestSubscription_SwapCar(t *testing.T) {
+	at := time.Date(2022, time.March, 1, 10, 0, 0, 0, time.UTC)
+
+	otherStationCar := newOtherCarFixture()
+	otherStationCar.StationId = "7621d238-cf12-4570-8ed1-6c0a38b76b4d"
+
+	testCases := []struct {
+		name   string
+		car    Car
+		km     uint64
+		reason string
+		want   error
+	}{
+		{name: "correct swap", car: newOtherCarFixture(), km: 13000, reason: "scheduled maintenance", want: nil},
+		{name: "incorrect missing reason", car: newOtherCarFixture(), km: 13000, want: ErrInvalidSwap},
+		{name: "incorrect station", car: otherStationCar, km: 13000, reason: "scheduled maintenance", want: ErrInvalidCarStation},
+		{name: "incorrect km", car: newOtherCarFixture(), km: 11000, reason: "scheduled maintenance", want: ErrInvalidPark},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			subscription := newSubscriptionFixture(t, at)
+			original := subscription.Car
+
+			err := subscription.SwapCar(tc.car, tc.km, 80, tc.reason, at.AddDate(0, 0, 10))
+
+			if !errors.Is(err, tc.want) {
+				t.Fatal("unexpected error", err)
+			}
+
+			if err != nil {
+				if subscription.Car.ID != original.ID || len(subscription.CarSwaps) != 0 {
+					t.Error("unexpected swap", subscription)
+				}
+				return
+			}
+
+			if subscription.Car.ID != tc.car.ID || subscription.Car.Status != Transit || subscription.LastKM != tc.car.InitialKM {
+				t.Error("unexpected car", subscription.Car)
+			}
+
+			if len(subscription.CarSwaps) != 1 || subscription.Cycles[0].KM != 1000 {
+				t.Error("unexpected swaps or km", subscription.CarSwaps, subscription.Cycles)
+			}
+
+			if err := subscription.Bill(at.AddDate(0, 1, 0), 31000); err != nil {
+				t.Fatal("unexpected error", err)
+			}
+
+			if subscription.Cycles[0].KM != 2000 || subscription.Cycles[0].Overage != 250 {
+				t.Error("unexpected cycle after swap", subscription.Cycles[0])
+			}
+		})
+	}
+}
+
+func TestSubscription_PauseResume(t *testing.T) {
+	at := time.Date(2022, time.March, 1, 10, 0, 0, 0, time.UTC)
+
+	subscription := newSubscriptionFixture(t, at)
+
+	if err := subscription.Resume(newOtherCarFixture(), at); !errors.Is(err, ErrInvalidResume) {
+		t.Fatal("unexpected error", err)
+	}
+
+	for i := 0; i < MaxPauses; i++ {
+		pausedAt := at.AddDate(0, 2*i, 10)
+		if err := subscription.Pause(pausedAt, subscription.LastKM+500, 90); err != nil {
+			t.Fatal("unexpected error", err)
+		}
+
+		if subscription.Status != PausedSubscription || subscription.Car.Status != Parked || subscription.currentCycle() != nil {
+			t.Fatal("unexpected paused subscription", subscription)
+		}
+
+		if err := subscription.Bill(pausedAt.AddDate(0, 1, 0), subscription.LastKM); !errors.Is(err, ErrInvalidBilling) {
+			t.Fatal("unexpected error", err)
+		}
+
+		if err := subscription.Resume(newOtherCarFixture(), pausedAt.AddDate(0, 1, 0)); err != nil {
+			t.Fatal("unexpected error", err)
+		}
+
+		if subscription.Status != ActiveSubscription || subscription.Car.Status != Transit || subscription.currentCycle() == nil {
+			t.Fatal("unexpected resumed subscription", subscription)
+		}
+	}
+
+	if err := subscription.Pause(at.AddDate(0, 5, 0), subscription.LastKM, 90); !errors.Is(err, ErrInvalidPause) {
+		t.Error("unexpected error", err)
+	}
+
+	if len(subscription.Cycles) != 3 || subscription.Pauses != MaxPauses || len(subscription.Events) != 5 {
+		t.Error("unexpected subscription", subscription.Cycles, subscription.Pauses, subscription.Events)
+	}
+}
+
+func TestSubscription_Cancel(t *testing.T) {
+	at := time.Date(2022, time.March, 1, 10, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name       string
+		bills      int
+		pause      bool
+		want       error
+		wantFee    float32
+		wantEvents int
+	}{
+		{name: "correct within commitment", bills: 0, want: nil, wantFee: 900, wantEvents: 2},
+		{name: "correct after commitment", bills: 2, want: nil, wantFee: 0, wantEvents: 2},
+		{name: "correct while paused", bills: 1, pause: true, want: nil, wantFee: 450, wantEvents: 2},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			subscription := newSubscriptionFixture(t, at)
+
+			for i := 0; i < tc.bills; i++ {
+				if err := subscription.Bill(at.AddDate(0, i+1, 0), subscription.LastKM+100); err != nil {
+					t.Fatal("unexpected error", err)
+				}
+			}
+
+			if tc.pause {
+				if err := subscription.Pause(at.AddDate(0, tc.bills, 5), subscription.LastKM, 90); err != nil {
+					t.Fatal("unexpected error", err)
+				}
+			}
+
+			err := subscription.Cancel(at.AddDate(0, 3, 5), subscription.LastKM+100, 90)
+
+			if !errors.Is(err, tc.want) {
+				t.Fatal("unexpected error", err)
+			}
+
+			if subscription.Status != CanceledSubscription || subscription.Car.Status != Parked || subscription.TerminationFee != tc.wantFee {
+				t.Error("unexpected subscription", subscription.Status, subscription.Car.Status, subscription.TerminationFee)
+			}
+
+			if len(subscription.Events) != tc.wantEvents {
+				t.Error("unexpected events", subscription.Events)
+			}
+
+			if err := subscription.Cancel(at.AddDate(0, 4, 0), subscription.LastKM, 90); !errors.Is(err, ErrCancelSubscription) {
+				t.Error("unexpected error", err)
+			}
+		})
+	}
+}
+
+func TestSubscription_BillingDue(t *testing.T) {
+	at := time.Date(2022, time.March, 1, 8, 0, 0, 0, time.UTC)
+
+	subscription := newSubscriptionFixture(t, at)
+
+	if subscription.BillingDue(at.AddDate(0, 1, -1)) {
+		t.Error("unexpected billing due before the cycle ends")
+	}
+
+	if !subscription.BillingDue(at.AddDate(0, 1, 0)) {
+		t.Error("unexpected billing not due once the cycle ends")
+	}
+
+	if err := subscription.Bill(at.AddDate(0, 1, 0), subscription.LastKM+1800); err != nil {
+		t.Fatal(err)
+	}
+
+	if subscription.BillingDue(at.AddDate(0, 1, 0)) || subscription.Cycles[0].Overage != 150 {
+		t.Error("unexpected billed cycle", subscription.Cycles)
+	}
+}
@@ -0,0 +1,13 @@
+package domain
+
+import "time"
+
+type CarSwap struct {
+	FromCarId    string    `json:"fromCarId" db:"fromCarId"`
+	ToCarId      string    `json:"toCarId" db:"toCarId"`
+	FromCarModel string    `json:"fromCarModel" db:"fromCarModel"`
+	ToCarModel   string    `json:"toCarModel" db:"toCarModel"`
+	Repriced     bool      `json:"repriced" db:"repriced"`
+	Reason       string    `json:"reason" db:"reason"`
+	Date         time.Time `json:"date" db:"date"`
+}
@@ -0,0 +1,151 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/events"
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/validation"
+)
+
+type WaitlistStatus uint
+
+const (
+	Waiting WaitlistStatus = iota + 1
+	Held
+	Booked
+	Expired
+	Withdrawn
+)
+
+const HoldTime = 2 * time.Hour
+
+type WaitlistEntry struct {
+	ID         string         `json:"id" validate:"required,uuid4" db:"id"`
+	Status     WaitlistStatus `json:"status" validate:"required" db:"status"`
+	StationId  string         `json:"stationId" validate:"required,uuid4" db:"stationId"`
+	CategoryId string         `json:"categoryId" validate:"required,uuid4" db:"categoryId"`
+	CarModel   string         `json:"carModel" validate:"required" db:"carModel"`
+	PolicyId   string         `json:"policyId" validate:"required,uuid4" db:"policyId"`
+	DateFrom   time.Time      `json:"dateFrom" validate:"required" db:"dateFrom"`
+	DateTo     time.Time      `json:"dateTo" validate:"required" db:"dateTo"`
+	Contact    string         `json:"contact" validate:"required" db:"contact"`
+	CreatedAt  time.Time      `json:"createdAt" db:"createdAt"`
+	HeldCar    *Car           `json:"heldCar,omitempty"`
+	HeldUntil  *time.Time     `json:"heldUntil,omitempty" db:"heldUntil"`
+	OrderId    string         `json:"orderId,omitempty" db:"orderId"`
+	Events     []events.Event `json:"-" bson:"-"`
+
+	Position uint `json:"position,omitempty" db:"-"`
+}
+
+func NewWaitlistEntry(stationId, categoryId, carModel, policyId, contact string, dateFrom, dateTo, at time.Time) (*WaitlistEntry, error) {
+	if !dateFrom.Before(dateTo) || !at.Before(dateTo) {
+		return nil, ErrInvalidReservedDate
+	}
+
+	entry := &WaitlistEntry{
+		ID:         validation.NewId(),
+		Status:     Waiting,
+		StationId:  stationId,
+		CategoryId: categoryId,
+		CarModel:   carModel,
+		PolicyId:   policyId,
+		DateFrom:   dateFrom,
+		DateTo:     dateTo,
+		Contact:    contact,
+		CreatedAt:  at,
+	}
+
+	if err := validation.ValidateEntity(entry); err != nil {
+		return nil, fmt.Errorf("%w\n%v", ErrInvalidEntity, err)
+	}
+
+	return entry, nil
+}
+
+func (w WaitlistEntry) Matches(car Car, at time.Time) bool {
+	return w.Status == Waiting &&
+		car.Status == Parked &&
+		car.CarModel == w.CarModel &&
+		car.StationId == w.StationId &&
+		at.Before(w.DateTo)
+}
+
+func (w *WaitlistEntry) Hold(car Car, at time.Time) error {
+	if !w.Matches(car, at) {
+		return ErrInvalidHold
+	}
+
+	until := at.Add(HoldTime)
+	w.Status = Held
+	w.HeldCar = &car
+	w.HeldUntil = &until
+
+	w.Events = append(w.Events, WaitlistCarHeld{
+		ID:        w.ID,
+		CarId:     car.ID,
+		CarModel:  car.CarModel,
+		Plate:     car.Plate,
+		StationId: w.StationId,
+		Contact:   w.Contact,
+		DateFrom:  w.DateFrom,
+		DateTo:    w.DateTo,
+		HeldUntil: until,
+	})
+
+	return nil
+}
+
+func (w WaitlistEntry) IsHeld(at time.Time) bool {
+	return w.Status == Held && w.HeldUntil != nil && at.Before(*w.HeldUntil)
+}
+
+func (w *WaitlistEntry) Expire(at time.Time) bool {
+	switch {
+	case w.Status == Held && !w.IsHeld(at):
+		w.release()
+	case w.Status == Waiting && !at.Before(w.DateTo):
+	default:
+		return false
+	}
+
+	w.Status = Expired
+
+	return true
+}
+
+func (w *WaitlistEntry) Withdraw() error {
+	if w.Status != Waiting && w.Status != Held {
+		return ErrInvalidWaitlist
+	}
+
+	if w.Status == Held {
+		w.release()
+	}
+
+	w.Status = Withdrawn
+
+	return nil
+}
+
+func (w *WaitlistEntry) Book(orderId string, at time.Time) error {
+	if !w.IsHeld(at) || orderId == "" {
+		return ErrInvalidHold
+	}
+
+	w.Status = Booked
+	w.OrderId = orderId
+
+	return nil
+}
+
+func (w *WaitlistEntry) release() {
+	w.Events = append(w.Events, WaitlistHoldReleased{
+		ID:        w.ID,
+		CarId:     w.HeldCar.ID,
+		StationId: w.StationId,
+		FinalKM:   w.HeldCar.InitialKM,
+		FinalFuel: w.HeldCar.InitialFuel,
+	})
+}
@@ -0,0 +1,103 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func newWaitlistEntryFixture(at time.Time) *WaitlistEntry {
+	entry, _ := NewWaitlistEntry(
+		"83369771-f9a4-48b7-b87b-463f19f7b187",
+		"479ab9e7-ad16-4864-8e49-29b15e4b390e",
+		"UNO",
+		"5ecf09ce-8c41-4faa-a4e5-824af9c80892",
+		"ana@mail.com",
+		at,
+		at.AddDate(0, 0, 5),
+		at)
+	return entry
+}
+
+func TestNewWaitlistEntry(t *testing.T) {
+	at := time.Date(2022, time.March, 1, 10, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name     string
+		dateFrom time.Time
+		dateTo   time.Time
+		contact  string
+		want     error
+	}{
+		{name: "correct input", dateFrom: at, dateTo: at.AddDate(0, 0, 2), contact: "ana@mail.com", want: nil},
+		{name: "incorrect dates input", dateFrom: at.AddDate(0, 0, 2), dateTo: at, contact: "ana@mail.com", want: ErrInvalidReservedDate},
+		{name: "incorrect dates passed input", dateFrom: at.AddDate(0, 0, -3), dateTo: at.AddDate(0, 0, -1), contact: "ana@mail.com", want: ErrInvalidReservedDate},
+		{name: "incorrect contact input", dateFrom: at, dateTo: at.AddDate(0, 0, 2), contact: "", want: ErrInvalidEntity},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewWaitlistEntry("83369771-f9a4-48b7-b87b-463f19f7b187", "479ab9e7-ad16-4864-8e49-29b15e4b390e", "UNO", "5ecf09ce-8c41-4faa-a4e5-824af9c80892", tc.contact, tc.dateFrom, tc.dateTo, at)
+
+			if !errors.Is(err, tc.want) {
+				t.Error("unexpected error", err)
+			}
+		})
+	}
+}
+
+func TestWaitlistEntry_Hold(t *testing.T) {
+	at := time.Date(2022, time.March, 1, 10, 0, 0, 0, time.UTC)
+	car := Car{ID: "e4ce866a-f5b7-4774-8f9d-5eb74c3900cc", CarModel: "UNO", Status: Parked, StationId: "83369771-f9a4-48b7-b87b-463f19f7b187", InitialKM: 12000, InitialFuel: 80}
+
+	entry := newWaitlistEntryFixture(at)
+
+	if err := entry.Hold(Car{ID: car.ID, CarModel: "GOL", Status: Parked, StationId: car.StationId}, at); err != ErrInvalidHold {
+		t.Error("unexpected error", err)
+	}
+
+	if err := entry.Hold(car, at); err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	if !entry.IsHeld(at.Add(HoldTime-time.Minute)) || entry.IsHeld(at.Add(HoldTime)) {
+		t.Error("unexpected hold", entry.HeldUntil)
+	}
+
+	if entry.Expire(at.Add(time.Hour)) {
+		t.Error("unexpected hold expired")
+	}
+
+	if !entry.Expire(at.Add(HoldTime)) || entry.Status != Expired {
+		t.Error("unexpected hold not expired", entry.Status)
+	}
+
+	if len(entry.Events) != 2 {
+		t.Fatal("unexpected events", entry.Events)
+	}
+
+	released, ok := entry.Events[1].(WaitlistHoldReleased)
+	if !ok || released.CarId != car.ID || released.FinalKM != 12000 || released.FinalFuel != 80 {
+		t.Error("unexpected released event", entry.Events[1])
+	}
+}
+
+func TestWaitlistEntry_Book(t *testing.T) {
+	at := time.Date(2022, time.March, 1, 10, 0, 0, 0, time.UTC)
+	car := Car{ID: "e4ce866a-f5b7-4774-8f9d-5eb74c3900cc", CarModel: "UNO", Status: Parked, StationId: "83369771-f9a4-48b7-b87b-463f19f7b187"}
+
+	waiting := newWaitlistEntryFixture(at)
+	if err := waiting.Book("5ce5a1a1-f324-4c8b-8c92-d7e820cbb238", at); err != ErrInvalidHold {
+		t.Error("unexpected error", err)
+	}
+
+	held := newWaitlistEntryFixture(at)
+	held.Hold(car, at)
+	if err := held.Book("5ce5a1a1-f324-4c8b-8c92-d7e820cbb238", at.Add(time.Hour)); err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	if held.Status != Booked || held.Withdraw() != ErrInvalidWaitlist {
+		t.Error("unexpected entry", held)
+	}
+}
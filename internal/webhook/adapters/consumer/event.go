@@ -0,0 +1,82 @@
+package consumer
+
+import (
+	"encoding/json"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/broker"
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/events"
+	"github.com/thiagotrs/rentalcar-ddd/internal/webhook/domain"
+)
+
+type Topic string
+
+const (
+	OrderOpened     Topic = "order.opened"
+	OrderConfirmed  Topic = "order.confirmed"
+	OrderClosed     Topic = "order.closed"
+	OrderCanceled   Topic = "order.canceled"
+	OrderCarSwapped Topic = "order.car-swapped"
+	CarAvailable    Topic = "car.available"
+	CarInTransfer   Topic = "car.in-transfer"
+)
+
+var idFields = map[Topic][]string{
+	OrderOpened:     {"id"},
+	OrderConfirmed:  {"id"},
+	OrderClosed:     {"id"},
+	OrderCanceled:   {"id"},
+	OrderCarSwapped: {"id", "swap"},
+	CarAvailable:    {"eventId"},
+	CarInTransfer:   {"eventId"},
+}
+
+type eventConsumer struct {
+	disp events.Dispatcher
+}
+
+func NewEventConsumer(disp events.Dispatcher) *eventConsumer {
+	return &eventConsumer{disp}
+}
+
+func (c *eventConsumer) ConsumeOpenedOrder(data interface{}) {
+	c.consume(OrderOpened, data)
+}
+
+func (c *eventConsumer) ConsumeConfirmedOrder(data interface{}) {
+	c.consume(OrderConfirmed, data)
+}
+
+func (c *eventConsumer) ConsumeClosedOrder(data interface{}) {
+	c.consume(OrderClosed, data)
+}
+
+func (c *eventConsumer) ConsumeCanceledOrder(data interface{}) {
+	c.consume(OrderCanceled, data)
+}
+
+func (c *eventConsumer) ConsumeCarSwappedOrder(data interface{}) {
+	c.consume(OrderCarSwapped, data)
+}
+
+func (c *eventConsumer) ConsumeAvailableCar(data interface{}) {
+	c.consume(CarAvailable, data)
+}
+
+func (c *eventConsumer) ConsumeCarInTransfer(data interface{}) {
+	c.consume(CarInTransfer, data)
+}
+
+func (c *eventConsumer) consume(topic Topic, data interface{}) {
+	if msgB, ok := data.([]byte); ok {
+		msg := map[string]interface{}{}
+		if err := json.Unmarshal(msgB, &msg); err != nil {
+			return
+		}
+
+		c.disp.Dispatch([]events.Event{domain.SyncBrokerEventReceived{
+			EventId: broker.MessageId(string(topic), msg, idFields[topic]...),
+			Event:   string(topic),
+			Data:    msgB,
+		}})
+	}
+}
@@ -0,0 +1,28 @@
+package eventhandler
+
+import (
+	"errors"
+	"time"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/events"
+	"github.com/thiagotrs/rentalcar-ddd/internal/webhook/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/webhook/domain"
+)
+
+type deliveryEventHandler struct {
+	deliveryUC application.DeliveryUseCase
+}
+
+func NewDeliveryEventHandler(deliveryUC application.DeliveryUseCase) *deliveryEventHandler {
+	return &deliveryEventHandler{deliveryUC}
+}
+
+func (h deliveryEventHandler) HandleSyncBrokerEventReceived(e events.Event) error {
+	event, ok := e.(domain.SyncBrokerEventReceived)
+
+	if !ok {
+		return errors.New("wrong event")
+	}
+
+	return h.deliveryUC.Publish(event.EventId, event.Event, event.Data, time.Now())
+}
@@ -0,0 +1,136 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/thiagotrs/rentalcar-ddd/internal/webhook/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/webhook/domain"
+)
+
+type subscriptionController struct {
+	subscriptionUC application.SubscriptionUseCase
+}
+
+func NewSubscriptionController(subscriptionUC application.SubscriptionUseCase) *subscriptionController {
+	return &subscriptionController{subscriptionUC}
+}
+
+func (c *subscriptionController) GetEventTypes(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	json, _ := json.Marshal(domain.EventTypes)
+	w.WriteHeader(http.StatusOK)
+	w.Write(json)
+}
+
+func (c *subscriptionController) GetSubscriptions(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	subscriptions := c.subscriptionUC.GetSubscriptions()
+	json, _ := json.Marshal(subscriptions)
+	w.WriteHeader(http.StatusOK)
+	w.Write(json)
+}
+
+func (c *subscriptionController) GetSubscriptionById(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	vars := mux.Vars(r)
+	subscription, err := c.subscriptionUC.GetSubscriptionById(vars["id"])
+
+	switch err {
+	case application.ErrInvalidId:
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case application.ErrNotFoundSubscription:
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case nil:
+		json, _ := json.Marshal(subscription)
+		w.WriteHeader(http.StatusOK)
+		w.Write(json)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	}
+}
+
+func (c *subscriptionController) CreateSubscription(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	var params struct {
+		Partner string   `json:"partner"`
+		URL     string   `json:"url"`
+		Secret  string   `json:"secret"`
+		Events  []string `json:"events"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, application.ErrInvalidEntity)
+		return
+	}
+	subscription, err := c.subscriptionUC.AddSubscription(params.Partner, params.URL, params.Secret, params.Events)
+
+	switch err {
+	case application.ErrInvalidSubscription, application.ErrInvalidEventType:
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case nil:
+		json, _ := json.Marshal(subscription)
+		w.WriteHeader(http.StatusCreated)
+		w.Write(json)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	}
+}
+
+func (c *subscriptionController) UpdateSubscription(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	vars := mux.Vars(r)
+	var params struct {
+		URL    string   `json:"url"`
+		Secret string   `json:"secret"`
+		Events []string `json:"events"`
+		Active bool     `json:"active"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, application.ErrInvalidEntity)
+		return
+	}
+	err := c.subscriptionUC.UpdateSubscription(vars["id"], params.URL, params.Secret, params.Events, params.Active)
+
+	switch err {
+	case application.ErrInvalidId, application.ErrInvalidSubscription, application.ErrInvalidEventType:
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case application.ErrNotFoundSubscription:
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case nil:
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	}
+}
+
+func (c *subscriptionController) DeleteSubscription(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	vars := mux.Vars(r)
+	err := c.subscriptionUC.DelSubscription(vars["id"])
+
+	switch err {
+	case application.ErrInvalidId, application.ErrInvalidSubscription:
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case application.ErrNotFoundSubscription:
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	case nil:
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, `{"error":"%v"}`, err)
+	}
+}
@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/webhook/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/webhook/domain"
+)
+
+type deliveryRepositoryInMemory struct {
+	deliveries map[string]domain.Delivery
+	*sync.RWMutex
+}
+
+func NewDeliveryRepositoryInMemory(deliveries []domain.Delivery) *deliveryRepositoryInMemory {
+	deliveriesMap := make(map[string]domain.Delivery)
+	for _, v := range deliveries {
+		deliveriesMap[v.ID] = v
+	}
+	return &deliveryRepositoryInMemory{deliveriesMap, &sync.RWMutex{}}
+}
+
+func (repo deliveryRepositoryInMemory) FindBySubscription(subscriptionId string) []domain.Delivery {
+	deliveries := repo.findBy(func(d domain.Delivery) bool {
+		return d.SubscriptionId == subscriptionId
+	})
+
+	sort.SliceStable(deliveries, func(i, j int) bool {
+		return deliveries[i].CreatedAt.After(deliveries[j].CreatedAt)
+	})
+
+	return deliveries
+}
+
+func (repo deliveryRepositoryInMemory) FindOne(id string) (*domain.Delivery, error) {
+	repo.RLock()
+	defer repo.RUnlock()
+
+	d, exists := repo.deliveries[id]
+	if !exists {
+		return nil, application.ErrNotFoundDelivery
+	}
+
+	return &d, nil
+}
+
+func (repo deliveryRepositoryInMemory) FindByEvent(eventId, subscriptionId string) (*domain.Delivery, error) {
+	deliveries := repo.findBy(func(d domain.Delivery) bool {
+		return d.EventId == eventId && d.SubscriptionId == subscriptionId
+	})
+
+	if len(deliveries) == 0 {
+		return nil, application.ErrNotFoundDelivery
+	}
+
+	return &deliveries[0], nil
+}
+
+func (repo deliveryRepositoryInMemory) FindDue(at time.Time) []domain.Delivery {
+	return repo.findBy(func(d domain.Delivery) bool {
+		return d.Due(at)
+	})
+}
+
+func (repo *deliveryRepositoryInMemory) Save(delivery domain.Delivery) error {
+	repo.Lock()
+	defer repo.Unlock()
+
+	delivery.Log = append([]domain.Attempt{}, delivery.Log...)
+	repo.deliveries[delivery.ID] = delivery
+
+	return nil
+}
+
+func (repo deliveryRepositoryInMemory) findBy(match func(domain.Delivery) bool) []domain.Delivery {
+	repo.RLock()
+	defer repo.RUnlock()
+
+	deliveries := []domain.Delivery{}
+	for _, d := range repo.deliveries {
+		if match(d) {
+			deliveries = append(deliveries, d)
+		}
+	}
+
+	sort.Slice(deliveries, func(i, j int) bool {
+		return deliveries[i].CreatedAt.Before(deliveries[j].CreatedAt)
+	})
+
+	return deliveries
+}
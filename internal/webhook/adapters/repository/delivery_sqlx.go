@@ -0,0 +1,117 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/thiagotrs/rentalcar-ddd/internal/webhook/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/webhook/domain"
+)
+
+const (
+	findDeliveriesBySubscription = `SELECT id, "subscriptionId", "eventId", event, payload, status, attempts, "createdAt", "nextAttempt", "deliveredAt" FROM hdeliveries WHERE "subscriptionId" = $1 ORDER BY "createdAt" DESC`
+	findDelivery                 = `SELECT id, "subscriptionId", "eventId", event, payload, status, attempts, "createdAt", "nextAttempt", "deliveredAt" FROM hdeliveries WHERE id = $1 LIMIT 1`
+	findDeliveryByEvent          = `SELECT id, "subscriptionId", "eventId", event, payload, status, attempts, "createdAt", "nextAttempt", "deliveredAt" FROM hdeliveries WHERE "eventId" = $1 AND "subscriptionId" = $2 LIMIT 1`
+	findDueDeliveries            = `SELECT id, "subscriptionId", "eventId", event, payload, status, attempts, "createdAt", "nextAttempt", "deliveredAt" FROM hdeliveries WHERE status = $1 AND "nextAttempt" <= $2 ORDER BY "nextAttempt"`
+
+	findAttemptsByDelivery = `SELECT "deliveryId", number, at, "statusCode", error FROM hattempts WHERE "deliveryId" = $1 ORDER BY number`
+
+	upsertDelivery = `
+	INSERT INTO hdeliveries (id, "subscriptionId", "eventId", event, payload, status, attempts, "createdAt", "nextAttempt", "deliveredAt") 
+	VALUES (:id, :subscriptionId, :eventId, :event, :payload, :status, :attempts, :createdAt, :nextAttempt, :deliveredAt) 
+	ON CONFLICT(id) DO 
+	UPDATE SET status = :status, attempts = :attempts, "nextAttempt" = :nextAttempt, "deliveredAt" = :deliveredAt 
+	WHERE hdeliveries.id = :id`
+
+	insertAttempt = `
+	INSERT INTO hattempts ("deliveryId", number, at, "statusCode", error) 
+	VALUES (:deliveryId, :number, :at, :statusCode, :error) 
+	ON CONFLICT("deliveryId", number) DO NOTHING`
+)
+
+type deliveryRepositorySqlx struct {
+	ctx context.Context
+	DB  *sqlx.DB
+}
+
+func NewDeliveryRepositorySqlx(ctx context.Context, DB *sqlx.DB) *deliveryRepositorySqlx {
+	return &deliveryRepositorySqlx{ctx, DB}
+}
+
+func (repo *deliveryRepositorySqlx) FindBySubscription(subscriptionId string) []domain.Delivery {
+	return repo.findBy(findDeliveriesBySubscription, subscriptionId)
+}
+
+func (repo *deliveryRepositorySqlx) FindOne(id string) (*domain.Delivery, error) {
+	return repo.findOneBy(findDelivery, id)
+}
+
+func (repo *deliveryRepositorySqlx) FindByEvent(eventId, subscriptionId string) (*domain.Delivery, error) {
+	return repo.findOneBy(findDeliveryByEvent, eventId, subscriptionId)
+}
+
+func (repo *deliveryRepositorySqlx) FindDue(at time.Time) []domain.Delivery {
+	return repo.findBy(findDueDeliveries, domain.Pending, at)
+}
+
+func (repo *deliveryRepositorySqlx) Save(delivery domain.Delivery) error {
+	tx, err := repo.DB.BeginTxx(repo.ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.NamedExecContext(repo.ctx, upsertDelivery, delivery); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	for _, a := range delivery.Log {
+		if _, err := tx.NamedExecContext(repo.ctx, insertAttempt, a); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return nil
+}
+
+func (repo *deliveryRepositorySqlx) findOneBy(query string, args ...interface{}) (*domain.Delivery, error) {
+	var delivery domain.Delivery
+
+	if err := repo.DB.GetContext(repo.ctx, &delivery, query, args...); err != nil {
+		return nil, application.ErrNotFoundDelivery
+	}
+
+	if err := repo.loadLog(&delivery); err != nil {
+		return nil, application.ErrNotFoundDelivery
+	}
+
+	return &delivery, nil
+}
+
+func (repo *deliveryRepositorySqlx) findBy(query string, args ...interface{}) []domain.Delivery {
+	deliveries := []domain.Delivery{}
+
+	if err := repo.DB.SelectContext(repo.ctx, &deliveries, query, args...); err != nil {
+		return []domain.Delivery{}
+	}
+
+	for i := range deliveries {
+		if err := repo.loadLog(&deliveries[i]); err != nil {
+			return []domain.Delivery{}
+		}
+	}
+
+	return deliveries
+}
+
+func (repo *deliveryRepositorySqlx) loadLog(delivery *domain.Delivery) error {
+	delivery.Log = []domain.Attempt{}
+	return repo.DB.SelectContext(repo.ctx, &delivery.Log, findAttemptsByDelivery, delivery.ID)
+}
@@ -0,0 +1,135 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/database/dbtest"
+	"github.com/thiagotrs/rentalcar-ddd/internal/webhook/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/webhook/domain"
+)
+
+func newSubscriptionFixture() domain.Subscription {
+	return domain.Subscription{
+		ID:        "4f3e2d1c-0b9a-4876-a543-210fedcba987",
+		Partner:   "Travel Agency",
+		URL:       "https://agency.example.com/hooks",
+		Secret:    "s3cr3t-s3cr3t-s3cr3t",
+		Events:    []string{"order.opened", "order.canceled"},
+		Active:    true,
+		CreatedAt: time.Date(2022, time.March, 1, 8, 0, 0, 0, time.UTC),
+	}
+}
+
+func GetDBConn(t *testing.T) *sqlx.DB {
+	t.Helper()
+	db, err := sqlx.Open("sqlite3", dbtest.Copy(t, "data/webhook_test.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return db
+}
+
+func ClearDB(t *testing.T, db *sqlx.DB) {
+	t.Helper()
+	const deleteAll = "DELETE FROM hattempts; DELETE FROM hdeliveries; DELETE FROM hevents; DELETE FROM hsubscriptions"
+
+	if _, err := db.Exec(deleteAll); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSubscriptionRepositorySqlx_Save(t *testing.T) {
+	db := GetDBConn(t)
+	defer db.Close()
+
+	defer ClearDB(t, db)
+
+	repo := NewSubscriptionRepositorySqlx(context.Background(), db)
+
+	subscription := newSubscriptionFixture()
+	if err := repo.Save(subscription); err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	found, err := repo.FindOne(subscription.ID)
+	if err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	if found.Secret != subscription.Secret || len(found.Events) != 2 || !found.Active {
+		t.Error("unexpected subscription", found)
+	}
+
+	subscription.Update(subscription.URL, "", []string{"order.opened"}, false)
+	if err := repo.Save(subscription); err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	if found := repo.FindByEvent("order.opened"); len(found) != 0 {
+		t.Error("unexpected paused subscriptions", found)
+	}
+
+	if found := repo.FindAll(); len(found) != 1 || found[0].Active || len(found[0].Events) != 1 {
+		t.Error("unexpected subscriptions", found)
+	}
+}
+
+func TestDeliveryRepositorySqlx_Save(t *testing.T) {
+	db := GetDBConn(t)
+	defer db.Close()
+
+	defer ClearDB(t, db)
+
+	subscriptionRepo := NewSubscriptionRepositorySqlx(context.Background(), db)
+	repo := NewDeliveryRepositorySqlx(context.Background(), db)
+	at := time.Date(2022, time.March, 2, 8, 0, 0, 0, time.UTC)
+
+	subscription := newSubscriptionFixture()
+	if err := subscriptionRepo.Save(subscription); err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	delivery, _ := domain.NewDelivery(subscription, "order.opened:42", "order.opened", json.RawMessage(`{"id":"42"}`), at)
+	delivery.AttemptFailed(503, errors.New("unavailable"), at)
+	if err := repo.Save(*delivery); err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	if due := repo.FindDue(at); len(due) != 0 {
+		t.Error("unexpected due deliveries", due)
+	}
+
+	due := repo.FindDue(at.Add(domain.Backoff.Delay))
+	if len(due) != 1 || len(due[0].Log) != 1 || due[0].Log[0].StatusCode != 503 || due[0].Payload != delivery.Payload {
+		t.Fatal("unexpected due deliveries", due)
+	}
+
+	delivery.Succeeded(200, at.Add(domain.Backoff.Delay))
+	if err := repo.Save(*delivery); err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	found, err := repo.FindByEvent("order.opened:42", subscription.ID)
+	if err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	if found.Status != domain.Delivered || found.DeliveredAt == nil || len(found.Log) != 2 || found.Log[1].Error != "" {
+		t.Error("unexpected delivery", found)
+	}
+
+	if err := subscriptionRepo.Delete(subscription.ID); err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	if _, err := repo.FindOne(delivery.ID); !errors.Is(err, application.ErrNotFoundDelivery) {
+		t.Error("unexpected delivery of deleted subscription", err)
+	}
+}
@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/webhook/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/webhook/domain"
+)
+
+type subscriptionRepositoryInMemory struct {
+	subscriptions map[string]domain.Subscription
+	*sync.RWMutex
+}
+
+func NewSubscriptionRepositoryInMemory(subscriptions []domain.Subscription) *subscriptionRepositoryInMemory {
+	subscriptionsMap := make(map[string]domain.Subscription)
+	for _, v := range subscriptions {
+		subscriptionsMap[v.ID] = v
+	}
+	return &subscriptionRepositoryInMemory{subscriptionsMap, &sync.RWMutex{}}
+}
+
+func (repo subscriptionRepositoryInMemory) FindAll() []domain.Subscription {
+	return repo.findBy(func(s domain.Subscription) bool {
+		return true
+	})
+}
+
+func (repo subscriptionRepositoryInMemory) FindOne(id string) (*domain.Subscription, error) {
+	repo.RLock()
+	defer repo.RUnlock()
+
+	s, exists := repo.subscriptions[id]
+	if !exists {
+		return nil, application.ErrNotFoundSubscription
+	}
+
+	return &s, nil
+}
+
+func (repo subscriptionRepositoryInMemory) FindByEvent(event string) []domain.Subscription {
+	return repo.findBy(func(s domain.Subscription) bool {
+		return s.Subscribes(event)
+	})
+}
+
+func (repo *subscriptionRepositoryInMemory) Save(subscription domain.Subscription) error {
+	repo.Lock()
+	defer repo.Unlock()
+
+	repo.subscriptions[subscription.ID] = subscription
+
+	return nil
+}
+
+func (repo *subscriptionRepositoryInMemory) Delete(id string) error {
+	repo.Lock()
+	defer repo.Unlock()
+
+	delete(repo.subscriptions, id)
+
+	return nil
+}
+
+func (repo subscriptionRepositoryInMemory) findBy(match func(domain.Subscription) bool) []domain.Subscription {
+	repo.RLock()
+	defer repo.RUnlock()
+
+	subscriptions := []domain.Subscription{}
+	for _, s := range repo.subscriptions {
+		if match(s) {
+			subscriptions = append(subscriptions, s)
+		}
+	}
+
+	sort.Slice(subscriptions, func(i, j int) bool {
+		return subscriptions[i].CreatedAt.Before(subscriptions[j].CreatedAt)
+	})
+
+	return subscriptions
+}
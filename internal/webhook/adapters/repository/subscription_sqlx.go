@@ -0,0 +1,136 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/thiagotrs/rentalcar-ddd/internal/webhook/application"
+	"github.com/thiagotrs/rentalcar-ddd/internal/webhook/domain"
+)
+
+const (
+	findSubscriptions        = `SELECT id, partner, url, secret, active, "createdAt" FROM hsubscriptions ORDER BY "createdAt"`
+	findSubscription         = `SELECT id, partner, url, secret, active, "createdAt" FROM hsubscriptions WHERE id = $1 LIMIT 1`
+	findSubscriptionsByEvent = `
+	SELECT hsubscriptions.id, partner, url, secret, active, "createdAt" FROM hsubscriptions JOIN hevents ON hevents."subscriptionId" = hsubscriptions.id 
+	WHERE hevents.event = $1 AND active = $2 ORDER BY "createdAt"`
+
+	findEventsBySubscription = `SELECT event FROM hevents WHERE "subscriptionId" = $1 ORDER BY event`
+
+	upsertSubscription = `
+	INSERT INTO hsubscriptions (id, partner, url, secret, active, "createdAt") 
+	VALUES (:id, :partner, :url, :secret, :active, :createdAt) 
+	ON CONFLICT(id) DO 
+	UPDATE SET url = :url, secret = :secret, active = :active 
+	WHERE hsubscriptions.id = :id`
+
+	deleteSubscription           = `DELETE FROM hsubscriptions WHERE id = $1`
+	deleteEventsSubscription     = `DELETE FROM hevents WHERE "subscriptionId" = $1`
+	insertEventSubscription      = `INSERT INTO hevents ("subscriptionId", event) VALUES ($1, $2)`
+	deleteDeliveriesSubscription = `DELETE FROM hdeliveries WHERE "subscriptionId" = $1`
+	deleteAttemptsSubscription   = `DELETE FROM hattempts WHERE "deliveryId" IN (SELECT id FROM hdeliveries WHERE "subscriptionId" = $1)`
+)
+
+type subscriptionRepositorySqlx struct {
+	ctx context.Context
+	DB  *sqlx.DB
+}
+
+func NewSubscriptionRepositorySqlx(ctx context.Context, DB *sqlx.DB) *subscriptionRepositorySqlx {
+	return &subscriptionRepositorySqlx{ctx, DB}
+}
+
+func (repo *subscriptionRepositorySqlx) FindAll() []domain.Subscription {
+	return repo.findBy(findSubscriptions)
+}
+
+func (repo *subscriptionRepositorySqlx) FindOne(id string) (*domain.Subscription, error) {
+	var subscription domain.Subscription
+
+	if err := repo.DB.GetContext(repo.ctx, &subscription, findSubscription, id); err != nil {
+		return nil, application.ErrNotFoundSubscription
+	}
+
+	if err := repo.loadEvents(&subscription); err != nil {
+		return nil, application.ErrNotFoundSubscription
+	}
+
+	return &subscription, nil
+}
+
+func (repo *subscriptionRepositorySqlx) FindByEvent(event string) []domain.Subscription {
+	return repo.findBy(findSubscriptionsByEvent, event, true)
+}
+
+func (repo *subscriptionRepositorySqlx) Save(subscription domain.Subscription) error {
+	tx, err := repo.DB.BeginTxx(repo.ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.NamedExecContext(repo.ctx, upsertSubscription, subscription); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if _, err := tx.ExecContext(repo.ctx, deleteEventsSubscription, subscription.ID); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	for _, e := range subscription.Events {
+		if _, err := tx.ExecContext(repo.ctx, insertEventSubscription, subscription.ID, e); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return nil
+}
+
+func (repo *subscriptionRepositorySqlx) Delete(id string) error {
+	tx, err := repo.DB.BeginTxx(repo.ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, query := range []string{deleteAttemptsSubscription, deleteDeliveriesSubscription, deleteEventsSubscription, deleteSubscription} {
+		if _, err := tx.ExecContext(repo.ctx, query, id); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return nil
+}
+
+func (repo *subscriptionRepositorySqlx) findBy(query string, args ...interface{}) []domain.Subscription {
+	subscriptions := []domain.Subscription{}
+
+	if err := repo.DB.SelectContext(repo.ctx, &subscriptions, query, args...); err != nil {
+		return []domain.Subscription{}
+	}
+
+	for i := range subscriptions {
+		if err := repo.loadEvents(&subscriptions[i]); err != nil {
+			return []domain.Subscription{}
+		}
+	}
+
+	return subscriptions
+}
+
+func (repo *subscriptionRepositorySqlx) loadEvents(subscription *domain.Subscription) error {
+	subscription.Events = []string{}
+	return repo.DB.SelectContext(repo.ctx, &subscription.Events, findEventsBySubscription, subscription.ID)
+}
@@ -0,0 +1,152 @@
+package application
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/validation"
+	"github.com/thiagotrs/rentalcar-ddd/internal/webhook/domain"
+)
+
+const (
+	DeliveryHeader  = "X-Webhook-Delivery"
+	EventHeader     = "X-Webhook-Event"
+	TimestampHeader = "X-Webhook-Timestamp"
+	SignatureHeader = "X-Webhook-Signature"
+)
+
+type DeliveryUseCase interface {
+	GetDeliveries(subscriptionId string) ([]domain.Delivery, error)
+	GetDeliveryById(id string) (*domain.Delivery, error)
+	Publish(eventId, event string, data json.RawMessage, at time.Time) error
+	DeliverDue(at time.Time) []domain.Delivery
+	Redeliver(id string) error
+}
+
+type deliveryUseCase struct {
+	deliveryRepo     DeliveryRepository
+	subscriptionRepo SubscriptionReaderRepository
+	client           Client
+}
+
+func NewDeliveryUseCase(deliveryRepo DeliveryRepository, subscriptionRepo SubscriptionReaderRepository, client Client) *deliveryUseCase {
+	return &deliveryUseCase{
+		deliveryRepo:     deliveryRepo,
+		subscriptionRepo: subscriptionRepo,
+		client:           client,
+	}
+}
+
+func (uc deliveryUseCase) GetDeliveries(subscriptionId string) ([]domain.Delivery, error) {
+	if err := validation.ValidId(subscriptionId); err != nil {
+		return nil, ErrInvalidId
+	}
+
+	if _, err := uc.subscriptionRepo.FindOne(subscriptionId); err != nil {
+		return nil, ErrNotFoundSubscription
+	}
+
+	return uc.deliveryRepo.FindBySubscription(subscriptionId), nil
+}
+
+func (uc deliveryUseCase) GetDeliveryById(id string) (*domain.Delivery, error) {
+	if err := validation.ValidId(id); err != nil {
+		return nil, ErrInvalidId
+	}
+
+	delivery, err := uc.deliveryRepo.FindOne(id)
+	if err != nil {
+		return nil, ErrNotFoundDelivery
+	}
+
+	return delivery, nil
+}
+
+func (uc deliveryUseCase) Publish(eventId, event string, data json.RawMessage, at time.Time) error {
+	for _, subscription := range uc.subscriptionRepo.FindByEvent(event) {
+		if !subscription.Subscribes(event) {
+			continue
+		}
+
+		if _, err := uc.deliveryRepo.FindByEvent(eventId, subscription.ID); err == nil {
+			continue
+		}
+
+		delivery, err := domain.NewDelivery(subscription, eventId, event, data, at)
+		if err != nil {
+			return ErrInvalidEntity
+		}
+
+		if err := uc.deliveryRepo.Save(*delivery); err != nil {
+			return ErrInvalidDelivery
+		}
+	}
+
+	return nil
+}
+
+func (uc deliveryUseCase) DeliverDue(at time.Time) []domain.Delivery {
+	delivered := []domain.Delivery{}
+
+	for _, delivery := range uc.deliveryRepo.FindDue(at) {
+		subscription, err := uc.subscriptionRepo.FindOne(delivery.SubscriptionId)
+		if err != nil || !subscription.Active {
+			continue
+		}
+
+		if err := uc.deliver(*subscription, &delivery, at); err != nil {
+			continue
+		}
+		delivered = append(delivered, delivery)
+	}
+
+	return delivered
+}
+
+func (uc deliveryUseCase) Redeliver(id string) error {
+	delivery, err := uc.GetDeliveryById(id)
+	if err != nil {
+		return err
+	}
+
+	subscription, err := uc.subscriptionRepo.FindOne(delivery.SubscriptionId)
+	if err != nil {
+		return ErrNotFoundSubscription
+	}
+
+	if err := delivery.Redeliver(time.Now()); err != nil {
+		return ErrNotDeliverable
+	}
+
+	return uc.deliver(*subscription, delivery, time.Now())
+}
+
+func (uc deliveryUseCase) deliver(subscription domain.Subscription, delivery *domain.Delivery, at time.Time) error {
+	body := []byte(delivery.Payload)
+	header := map[string]string{
+		"Content-Type":  "application/json",
+		DeliveryHeader:  delivery.ID,
+		EventHeader:     delivery.Event,
+		TimestampHeader: strconv.FormatInt(at.Unix(), 10),
+		SignatureHeader: "sha256=" + subscription.Sign(body, at),
+	}
+
+	statusCode, err := uc.client.Post(subscription.URL, header, body)
+	if err == nil && (statusCode < 200 || statusCode > 299) {
+		err = fmt.Errorf("%w %d", ErrUnexpectedStatus, statusCode)
+	}
+
+	if err == nil {
+		delivery.Succeeded(statusCode, at)
+	} else {
+		delivery.AttemptFailed(statusCode, err, at)
+	}
+
+	if err := uc.deliveryRepo.Save(*delivery); err != nil {
+		return ErrInvalidDelivery
+	}
+
+	return nil
+}
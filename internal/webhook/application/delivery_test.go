@@ -0,0 +1,250 @@
+package application
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/webhook/domain"
+)
+
+type subscriptionRepositoryMock struct {
+	subscriptions []domain.Subscription
+}
+
+func (m *subscriptionRepositoryMock) FindAll() []domain.Subscription {
+	return m.subscriptions
+}
+
+func (m *subscriptionRepositoryMock) FindOne(id string) (*domain.Subscription, error) {
+	for _, s := range m.subscriptions {
+		if s.ID == id {
+			return &s, nil
+		}
+	}
+	return nil, errors.New("not found")
+}
+
+func (m *subscriptionRepositoryMock) FindByEvent(event string) []domain.Subscription {
+	return m.subscriptions
+}
+
+type deliveryRepositoryMock struct {
+	deliveries map[string]domain.Delivery
+}
+
+func (m *deliveryRepositoryMock) FindBySubscription(subscriptionId string) []domain.Delivery {
+	deliveries := []domain.Delivery{}
+	for _, d := range m.deliveries {
+		if d.SubscriptionId == subscriptionId {
+			deliveries = append(deliveries, d)
+		}
+	}
+	return deliveries
+}
+
+func (m *deliveryRepositoryMock) FindOne(id string) (*domain.Delivery, error) {
+	if d, exists := m.deliveries[id]; exists {
+		return &d, nil
+	}
+	return nil, errors.New("not found")
+}
+
+func (m *deliveryRepositoryMock) FindByEvent(eventId, subscriptionId string) (*domain.Delivery, error) {
+	for _, d := range m.deliveries {
+		if d.EventId == eventId && d.SubscriptionId == subscriptionId {
+			return &d, nil
+		}
+	}
+	return nil, errors.New("not found")
+}
+
+func (m *deliveryRepositoryMock) FindDue(at time.Time) []domain.Delivery {
+	deliveries := []domain.Delivery{}
+	for _, d := range m.deliveries {
+		if d.Due(at) {
+			deliveries = append(deliveries, d)
+		}
+	}
+	return deliveries
+}
+
+func (m *deliveryRepositoryMock) Save(delivery domain.Delivery) error {
+	m.deliveries[delivery.ID] = delivery
+	return nil
+}
+
+type request struct {
+	url    string
+	header map[string]string
+	body   []byte
+}
+
+type clientMock struct {
+	expectedStatusCode int
+	expectedPostErr    error
+	requests           []request
+}
+
+func (m *clientMock) Post(url string, header map[string]string, body []byte) (int, error) {
+	m.requests = append(m.requests, request{url, header, body})
+	return m.expectedStatusCode, m.expectedPostErr
+}
+
+func newSubscriptionsFixture() []domain.Subscription {
+	return []domain.Subscription{
+		{
+			ID:        "4f3e2d1c-0b9a-4876-a543-210fedcba987",
+			Partner:   "Travel Agency",
+			URL:       "https://agency.example.com/hooks",
+			Secret:    "s3cr3t-s3cr3t-s3cr3t",
+			Events:    []string{"order.opened"},
+			Active:    true,
+			CreatedAt: time.Date(2022, time.March, 1, 8, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:        "8a7b6c5d-4e3f-4a2b-9c1d-0e9f8a7b6c5d",
+			Partner:   "Other Agency",
+			URL:       "https://other.example.com/hooks",
+			Secret:    "0th3r-s3cr3t-0th3r-s3cr3t",
+			Events:    []string{"order.closed"},
+			Active:    true,
+			CreatedAt: time.Date(2022, time.March, 1, 9, 0, 0, 0, time.UTC),
+		},
+	}
+}
+
+func TestDeliveryUseCase_Publish(t *testing.T) {
+	at := time.Date(2022, time.March, 2, 8, 0, 0, 0, time.UTC)
+	data := json.RawMessage(`{"id":"42","carId":"7","stationId":"1"}`)
+
+	testCases := []struct {
+		name       string
+		statusCode int
+		postErr    error
+		wantStatus domain.Status
+	}{
+		{name: "delivered", statusCode: 204, postErr: nil, wantStatus: domain.Delivered},
+		{name: "rejected by partner", statusCode: 500, postErr: nil, wantStatus: domain.Pending},
+		{name: "unreachable partner", statusCode: 0, postErr: errors.New("timeout"), wantStatus: domain.Pending},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			subscriptions := newSubscriptionsFixture()
+			deliveryRepo := &deliveryRepositoryMock{map[string]domain.Delivery{}}
+			client := &clientMock{expectedStatusCode: tc.statusCode, expectedPostErr: tc.postErr}
+			uc := NewDeliveryUseCase(deliveryRepo, &subscriptionRepositoryMock{subscriptions}, client)
+
+			for i := 0; i < 2; i++ {
+				if err := uc.Publish("order.opened:42", "order.opened", data, at); err != nil {
+					t.Fatal("unexpected error", err)
+				}
+			}
+
+			if len(client.requests) != 0 || len(deliveryRepo.deliveries) != 1 {
+				t.Fatal("unexpected deliveries", client.requests, deliveryRepo.deliveries)
+			}
+
+			uc.DeliverDue(at)
+
+			if len(client.requests) != 1 {
+				t.Fatal("unexpected requests", client.requests)
+			}
+
+			req := client.requests[0]
+			mac := hmac.New(sha256.New, []byte(subscriptions[0].Secret))
+			mac.Write([]byte(req.header[TimestampHeader] + "." + string(req.body)))
+
+			if req.url != subscriptions[0].URL || req.header[EventHeader] != "order.opened" ||
+				req.header[SignatureHeader] != "sha256="+hex.EncodeToString(mac.Sum(nil)) {
+				t.Error("unexpected request", req.url, req.header)
+			}
+
+			for _, d := range deliveryRepo.deliveries {
+				if d.Status != tc.wantStatus || len(d.Log) != 1 || d.Log[0].StatusCode != tc.statusCode {
+					t.Error("unexpected delivery", d.Status, d.Log)
+				}
+				if req.header[DeliveryHeader] != d.ID {
+					t.Error("unexpected delivery header", req.header[DeliveryHeader])
+				}
+			}
+		})
+	}
+}
+
+func TestDeliveryUseCase_DeliverDue(t *testing.T) {
+	at := time.Date(2022, time.March, 2, 8, 0, 0, 0, time.UTC)
+	subscriptions := newSubscriptionsFixture()
+	paused := subscriptions[1]
+	paused.Active = false
+
+	deliveryRepo := &deliveryRepositoryMock{map[string]domain.Delivery{}}
+	for _, s := range []domain.Subscription{subscriptions[0], paused} {
+		d, _ := domain.NewDelivery(s, "order.closed:42", "order.closed", json.RawMessage(`{}`), at)
+		d.AttemptFailed(502, errors.New("bad gateway"), at)
+		deliveryRepo.Save(*d)
+	}
+
+	client := &clientMock{expectedStatusCode: 200}
+	uc := NewDeliveryUseCase(deliveryRepo, &subscriptionRepositoryMock{[]domain.Subscription{subscriptions[0], paused}}, client)
+
+	if delivered := uc.DeliverDue(at); len(delivered) != 0 {
+		t.Fatal("unexpected delivery before next attempt", delivered)
+	}
+
+	delivered := uc.DeliverDue(at.Add(domain.Backoff.Delay))
+	if len(delivered) != 1 || delivered[0].SubscriptionId != subscriptions[0].ID || delivered[0].Status != domain.Delivered {
+		t.Fatal("unexpected deliveries", delivered)
+	}
+
+	if len(delivered[0].Log) != 2 || delivered[0].Log[1].Number != 2 {
+		t.Error("unexpected log", delivered[0].Log)
+	}
+
+	if pending := deliveryRepo.FindBySubscription(paused.ID); len(pending) != 1 || pending[0].Status != domain.Pending {
+		t.Error("unexpected delivery of paused subscription", pending)
+	}
+}
+
+func TestDeliveryUseCase_Redeliver(t *testing.T) {
+	at := time.Date(2022, time.March, 2, 8, 0, 0, 0, time.UTC)
+	subscriptions := newSubscriptionsFixture()
+
+	failed, _ := domain.NewDelivery(subscriptions[0], "order.opened:42", "order.opened", json.RawMessage(`{}`), at)
+	for i := uint(0); i < domain.Backoff.MaxAttempts; i++ {
+		failed.AttemptFailed(0, errors.New("timeout"), at)
+	}
+	pending, _ := domain.NewDelivery(subscriptions[0], "order.opened:43", "order.opened", json.RawMessage(`{}`), at)
+
+	testCases := []struct {
+		name       string
+		idArg      string
+		errWant    error
+		wantStatus domain.Status
+	}{
+		{name: "correct input", idArg: failed.ID, errWant: nil, wantStatus: domain.Delivered},
+		{name: "pending delivery", idArg: pending.ID, errWant: ErrNotDeliverable, wantStatus: domain.Pending},
+		{name: "incorrect id input", idArg: "42", errWant: ErrInvalidId},
+		{name: "not found delivery", idArg: "5ce5a1a1-f324-4c8b-8c92-d7e820cbb238", errWant: ErrNotFoundDelivery},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			deliveryRepo := &deliveryRepositoryMock{map[string]domain.Delivery{failed.ID: *failed, pending.ID: *pending}}
+			uc := NewDeliveryUseCase(deliveryRepo, &subscriptionRepositoryMock{subscriptions}, &clientMock{expectedStatusCode: 200})
+
+			if err := uc.Redeliver(tc.idArg); !errors.Is(err, tc.errWant) {
+				t.Fatal("wrong err", err, tc.errWant)
+			}
+
+			if got, exists := deliveryRepo.deliveries[tc.idArg]; exists && got.Status != tc.wantStatus {
+				t.Error("unexpected status", got.Status)
+			}
+		})
+	}
+}
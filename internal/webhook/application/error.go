@@ -0,0 +1,21 @@
+package application
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/webhook/domain"
+)
+
+var (
+	ErrInvalidEntity       = fmt.Errorf("%w", domain.ErrInvalidEntity)
+	ErrInvalidEventType    = fmt.Errorf("%w", domain.ErrInvalidEventType)
+	ErrInvalidSubscription = fmt.Errorf("%w", domain.ErrInvalidSubscription)
+	ErrNotDeliverable      = fmt.Errorf("%w", domain.ErrNotDeliverable)
+
+	ErrInvalidId            = errors.New("invalid id")
+	ErrNotFoundSubscription = errors.New("not found webhook subscription")
+	ErrNotFoundDelivery     = errors.New("not found webhook delivery")
+	ErrInvalidDelivery      = errors.New("invalid webhook delivery")
+	ErrUnexpectedStatus     = errors.New("unexpected webhook response status")
+)
@@ -0,0 +1,39 @@
+package application
+
+import (
+	"time"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/webhook/domain"
+)
+
+type SubscriptionReaderRepository interface {
+	FindAll() []domain.Subscription
+	FindOne(id string) (*domain.Subscription, error)
+	FindByEvent(event string) []domain.Subscription
+}
+
+type SubscriptionWriterRepository interface {
+	Save(subscription domain.Subscription) error
+	Delete(id string) error
+}
+
+type SubscriptionRepository interface {
+	SubscriptionReaderRepository
+	SubscriptionWriterRepository
+}
+
+type DeliveryReaderRepository interface {
+	FindBySubscription(subscriptionId string) []domain.Delivery
+	FindOne(id string) (*domain.Delivery, error)
+	FindByEvent(eventId, subscriptionId string) (*domain.Delivery, error)
+	FindDue(at time.Time) []domain.Delivery
+}
+
+type DeliveryWriterRepository interface {
+	Save(delivery domain.Delivery) error
+}
+
+type DeliveryRepository interface {
+	DeliveryReaderRepository
+	DeliveryWriterRepository
+}
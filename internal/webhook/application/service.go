@@ -0,0 +1,5 @@
+package application
+
+type Client interface {
+	Post(url string, header map[string]string, body []byte) (int, error)
+}
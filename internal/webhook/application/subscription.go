@@ -0,0 +1,91 @@
+package application
+
+import (
+	"errors"
+	"time"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/validation"
+	"github.com/thiagotrs/rentalcar-ddd/internal/webhook/domain"
+)
+
+type SubscriptionUseCase interface {
+	GetSubscriptions() []domain.Subscription
+	GetSubscriptionById(id string) (*domain.Subscription, error)
+	AddSubscription(partner, url, secret string, events []string) (*domain.Subscription, error)
+	UpdateSubscription(id, url, secret string, events []string, active bool) error
+	DelSubscription(id string) error
+}
+
+type subscriptionUseCase struct {
+	subscriptionRepo SubscriptionRepository
+}
+
+func NewSubscriptionUseCase(subscriptionRepo SubscriptionRepository) *subscriptionUseCase {
+	return &subscriptionUseCase{subscriptionRepo}
+}
+
+func (uc subscriptionUseCase) GetSubscriptions() []domain.Subscription {
+	return uc.subscriptionRepo.FindAll()
+}
+
+func (uc subscriptionUseCase) GetSubscriptionById(id string) (*domain.Subscription, error) {
+	if err := validation.ValidId(id); err != nil {
+		return nil, ErrInvalidId
+	}
+
+	subscription, err := uc.subscriptionRepo.FindOne(id)
+	if err != nil {
+		return nil, ErrNotFoundSubscription
+	}
+
+	return subscription, nil
+}
+
+func (uc subscriptionUseCase) AddSubscription(partner, url, secret string, events []string) (*domain.Subscription, error) {
+	subscription, err := domain.NewSubscription(partner, url, secret, events, time.Now())
+	if errors.Is(err, domain.ErrInvalidEventType) {
+		return nil, ErrInvalidEventType
+	}
+	if err != nil {
+		return nil, ErrInvalidSubscription
+	}
+
+	if err := uc.subscriptionRepo.Save(*subscription); err != nil {
+		return nil, ErrInvalidSubscription
+	}
+
+	return subscription, nil
+}
+
+func (uc subscriptionUseCase) UpdateSubscription(id, url, secret string, events []string, active bool) error {
+	subscription, err := uc.GetSubscriptionById(id)
+	if err != nil {
+		return err
+	}
+
+	err = subscription.Update(url, secret, events, active)
+	if errors.Is(err, domain.ErrInvalidEventType) {
+		return ErrInvalidEventType
+	}
+	if err != nil {
+		return ErrInvalidSubscription
+	}
+
+	if err := uc.subscriptionRepo.Save(*subscription); err != nil {
+		return ErrInvalidSubscription
+	}
+
+	return nil
+}
+
+func (uc subscriptionUseCase) DelSubscription(id string) error {
+	if _, err := uc.GetSubscriptionById(id); err != nil {
+		return err
+	}
+
+	if err := uc.subscriptionRepo.Delete(id); err != nil {
+		return ErrInvalidSubscription
+	}
+
+	return nil
+}
@@ -0,0 +1,132 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/retry"
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/validation"
+)
+
+type Status uint
+
+const (
+	Pending Status = iota + 1
+	Delivered
+	Failed
+)
+
+var Backoff = retry.Backoff{MaxAttempts: 8, Delay: 30 * time.Second}
+
+type Attempt struct {
+	DeliveryId string    `json:"-" db:"deliveryId"`
+	Number     uint      `json:"number" db:"number"`
+	At         time.Time `json:"at" db:"at"`
+	StatusCode int       `json:"statusCode,omitempty" db:"statusCode"`
+	Error      string    `json:"error,omitempty" db:"error"`
+}
+
+type Delivery struct {
+	ID             string     `json:"id" validate:"required,uuid4" db:"id"`
+	SubscriptionId string     `json:"subscriptionId" validate:"required,uuid4" db:"subscriptionId"`
+	EventId        string     `json:"eventId" validate:"required" db:"eventId"`
+	Event          string     `json:"event" validate:"required" db:"event"`
+	Payload        string     `json:"payload" validate:"required" db:"payload"`
+	Status         Status     `json:"status" validate:"required" db:"status"`
+	Attempts       uint       `json:"attempts" db:"attempts"`
+	CreatedAt      time.Time  `json:"createdAt" db:"createdAt"`
+	NextAttempt    *time.Time `json:"nextAttempt,omitempty" db:"nextAttempt"`
+	DeliveredAt    *time.Time `json:"deliveredAt,omitempty" db:"deliveredAt"`
+	Log            []Attempt  `json:"log"`
+}
+
+type payload struct {
+	ID        string          `json:"id"`
+	Event     string          `json:"event"`
+	CreatedAt time.Time       `json:"createdAt"`
+	Data      json.RawMessage `json:"data"`
+}
+
+func NewDelivery(subscription Subscription, eventId, event string, data json.RawMessage, at time.Time) (*Delivery, error) {
+	body, err := json.Marshal(payload{eventId, event, at, data})
+	if err != nil {
+		return nil, ErrInvalidEntity
+	}
+
+	delivery := &Delivery{
+		ID:             validation.NewId(),
+		SubscriptionId: subscription.ID,
+		EventId:        eventId,
+		Event:          event,
+		Payload:        string(body),
+		Status:         Pending,
+		CreatedAt:      at,
+		NextAttempt:    &at,
+		Log:            []Attempt{},
+	}
+
+	if err := validation.ValidateEntity(delivery); err != nil {
+		return nil, ErrInvalidEntity
+	}
+
+	return delivery, nil
+}
+
+func (d Delivery) Due(at time.Time) bool {
+	return d.Status == Pending && d.NextAttempt != nil && !at.Before(*d.NextAttempt)
+}
+
+func (d *Delivery) Succeeded(statusCode int, at time.Time) error {
+	if d.Status != Pending {
+		return ErrNotDeliverable
+	}
+
+	d.log(statusCode, "", at)
+	d.Status = Delivered
+	d.NextAttempt = nil
+	d.DeliveredAt = &at
+
+	return nil
+}
+
+func (d *Delivery) AttemptFailed(statusCode int, reason error, at time.Time) error {
+	if d.Status != Pending {
+		return ErrNotDeliverable
+	}
+
+	d.log(statusCode, reason.Error(), at)
+
+	next, ok := Backoff.Next(d.Attempts, at)
+	if !ok {
+		d.Status = Failed
+		d.NextAttempt = nil
+		return nil
+	}
+
+	d.NextAttempt = &next
+
+	return nil
+}
+
+func (d *Delivery) Redeliver(at time.Time) error {
+	if d.Status != Failed {
+		return ErrNotDeliverable
+	}
+
+	d.Status = Pending
+	d.Attempts = 0
+	d.NextAttempt = &at
+
+	return nil
+}
+
+func (d *Delivery) log(statusCode int, reason string, at time.Time) {
+	d.Attempts++
+	d.Log = append(d.Log, Attempt{
+		DeliveryId: d.ID,
+		Number:     uint(len(d.Log)) + 1,
+		At:         at,
+		StatusCode: statusCode,
+		Error:      reason,
+	})
+}
@@ -0,0 +1,83 @@
+package domain
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestNewDelivery(t *testing.T) {
+	at := time.Date(2022, time.March, 1, 8, 0, 0, 0, time.UTC)
+	data := json.RawMessage(`{"id":"42","carId":"7"}`)
+
+	delivery, err := NewDelivery(newSubscriptionFixture(), "order.opened:42", "order.opened", data, at)
+	if err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	var body struct {
+		ID    string          `json:"id"`
+		Event string          `json:"event"`
+		Data  json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal([]byte(delivery.Payload), &body); err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	if body.ID != "order.opened:42" || body.Event != "order.opened" || string(body.Data) != string(data) {
+		t.Error("unexpected payload", delivery.Payload)
+	}
+
+	if !delivery.Due(at) {
+		t.Error("expected due delivery")
+	}
+}
+
+func TestDelivery_AttemptFailed(t *testing.T) {
+	at := time.Date(2022, time.March, 1, 8, 0, 0, 0, time.UTC)
+	delivery, _ := NewDelivery(newSubscriptionFixture(), "order.opened:42", "order.opened", json.RawMessage(`{}`), at)
+
+	delay := Backoff.Delay
+	for i := uint(1); i < Backoff.MaxAttempts; i++ {
+		if err := delivery.AttemptFailed(503, errors.New("unavailable"), at); err != nil {
+			t.Fatal("unexpected error", err)
+		}
+
+		if delivery.Status != Pending || !delivery.NextAttempt.Equal(at.Add(delay)) {
+			t.Fatal("unexpected schedule", i, delivery.Status, delivery.NextAttempt)
+		}
+		delay *= 2
+	}
+
+	delivery.AttemptFailed(0, errors.New("timeout"), at)
+
+	if delivery.Status != Failed || delivery.NextAttempt != nil || uint(len(delivery.Log)) != Backoff.MaxAttempts {
+		t.Fatal("unexpected given up delivery", delivery.Status, len(delivery.Log))
+	}
+
+	last := delivery.Log[len(delivery.Log)-1]
+	if last.Number != Backoff.MaxAttempts || last.StatusCode != 0 || last.Error != "timeout" {
+		t.Error("unexpected log", last)
+	}
+
+	if err := delivery.Succeeded(200, at); !errors.Is(err, ErrNotDeliverable) {
+		t.Error("unexpected success of failed delivery", err)
+	}
+
+	if err := delivery.Redeliver(at); err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	if err := delivery.Succeeded(204, at); err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	if delivery.Status != Delivered || delivery.Attempts != 1 || uint(len(delivery.Log)) != Backoff.MaxAttempts+1 || !delivery.DeliveredAt.Equal(at) {
+		t.Error("unexpected delivered delivery", delivery.Status, delivery.Attempts, len(delivery.Log))
+	}
+
+	if err := delivery.Redeliver(at); !errors.Is(err, ErrNotDeliverable) {
+		t.Error("unexpected redelivery of delivered delivery", err)
+	}
+}
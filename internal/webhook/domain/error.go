@@ -0,0 +1,10 @@
+package domain
+
+import "errors"
+
+var (
+	ErrInvalidEntity       = errors.New("invalid entity")
+	ErrInvalidEventType    = errors.New("invalid webhook event type")
+	ErrInvalidSubscription = errors.New("invalid webhook subscription")
+	ErrNotDeliverable      = errors.New("webhook delivery not pending")
+)
@@ -0,0 +1,13 @@
+package domain
+
+import "encoding/json"
+
+type SyncBrokerEventReceived struct {
+	EventId string          `json:"eventId"`
+	Event   string          `json:"event"`
+	Data    json.RawMessage `json:"data"`
+}
+
+func (c SyncBrokerEventReceived) Name() string {
+	return "sync.broker-event.received"
+}
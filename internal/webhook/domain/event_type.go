@@ -0,0 +1,20 @@
+package domain
+
+var EventTypes = []string{
+	"order.opened",
+	"order.confirmed",
+	"order.closed",
+	"order.canceled",
+	"order.car-swapped",
+	"car.available",
+	"car.in-transfer",
+}
+
+func ValidEventType(event string) bool {
+	for _, e := range EventTypes {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,94 @@
+package domain
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/thiagotrs/rentalcar-ddd/internal/pkg/validation"
+)
+
+type Subscription struct {
+	ID        string    `json:"id" validate:"required,uuid4" db:"id"`
+	Partner   string    `json:"partner" validate:"required" db:"partner"`
+	URL       string    `json:"url" validate:"required,url" db:"url"`
+	Secret    string    `json:"-" validate:"required,min=16" db:"secret"`
+	Events    []string  `json:"events" validate:"required,min=1,dive,required"`
+	Active    bool      `json:"active" db:"active"`
+	CreatedAt time.Time `json:"createdAt" db:"createdAt"`
+}
+
+func NewSubscription(partner, url, secret string, events []string, at time.Time) (*Subscription, error) {
+	subscription := &Subscription{
+		ID:        validation.NewId(),
+		Partner:   partner,
+		URL:       url,
+		Secret:    secret,
+		Events:    events,
+		Active:    true,
+		CreatedAt: at,
+	}
+
+	if err := subscription.validate(); err != nil {
+		return nil, err
+	}
+
+	return subscription, nil
+}
+
+func (s *Subscription) Update(url, secret string, events []string, active bool) error {
+	updated := *s
+	updated.URL = url
+	updated.Events = events
+	updated.Active = active
+	if secret != "" {
+		updated.Secret = secret
+	}
+
+	if err := updated.validate(); err != nil {
+		return err
+	}
+
+	*s = updated
+
+	return nil
+}
+
+func (s Subscription) Subscribes(event string) bool {
+	if !s.Active {
+		return false
+	}
+
+	for _, e := range s.Events {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
+
+// Signing the timestamp lets the partner reject replayed deliveries.
+func (s Subscription) Sign(payload []byte, at time.Time) string {
+	mac := hmac.New(sha256.New, []byte(s.Secret))
+	mac.Write([]byte(strconv.FormatInt(at.Unix(), 10)))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (s Subscription) validate() error {
+	if err := validation.ValidateEntity(s); err != nil {
+		return fmt.Errorf("%w\n%v", ErrInvalidSubscription, err)
+	}
+
+	for _, e := range s.Events {
+		if !ValidEventType(e) {
+			return fmt.Errorf("%w: %s", ErrInvalidEventType, e)
+		}
+	}
+
+	return nil
+}
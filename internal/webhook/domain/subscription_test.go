@@ -0,0 +1,90 @@
+package domain
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"testing"
+	"time"
+)
+
+func newSubscriptionFixture() Subscription {
+	return Subscription{
+		ID:        "4f3e2d1c-0b9a-4876-a543-210fedcba987",
+		Partner:   "Travel Agency",
+		URL:       "https://agency.example.com/hooks",
+		Secret:    "s3cr3t-s3cr3t-s3cr3t",
+		Events:    []string{"order.opened", "order.canceled"},
+		Active:    true,
+		CreatedAt: time.Date(2022, time.March, 1, 8, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestNewSubscription(t *testing.T) {
+	testCases := []struct {
+		name    string
+		url     string
+		secret  string
+		events  []string
+		errWant error
+	}{
+		{name: "correct input", url: "https://agency.example.com/hooks", secret: "s3cr3t-s3cr3t-s3cr3t", events: []string{"order.opened", "car.available"}, errWant: nil},
+		{name: "incorrect url input", url: "agency", secret: "s3cr3t-s3cr3t-s3cr3t", events: []string{"order.opened"}, errWant: ErrInvalidSubscription},
+		{name: "short secret input", url: "https://agency.example.com/hooks", secret: "s3cr3t", events: []string{"order.opened"}, errWant: ErrInvalidSubscription},
+		{name: "empty events input", url: "https://agency.example.com/hooks", secret: "s3cr3t-s3cr3t-s3cr3t", events: []string{}, errWant: ErrInvalidSubscription},
+		{name: "incorrect event input", url: "https://agency.example.com/hooks", secret: "s3cr3t-s3cr3t-s3cr3t", events: []string{"waitlist.car-held"}, errWant: ErrInvalidEventType},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			subscription, err := NewSubscription("Travel Agency", tc.url, tc.secret, tc.events, time.Now())
+
+			if !errors.Is(err, tc.errWant) {
+				t.Fatal("unexpected error", err)
+			}
+
+			if err == nil && !subscription.Active {
+				t.Error("expected active subscription")
+			}
+		})
+	}
+}
+
+func TestSubscription_Update(t *testing.T) {
+	subscription := newSubscriptionFixture()
+
+	if err := subscription.Update("https://agency.example.com/v2", "", []string{"car.in-transfer"}, false); err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	if subscription.Secret != "s3cr3t-s3cr3t-s3cr3t" || subscription.Subscribes("car.in-transfer") {
+		t.Error("unexpected update", subscription)
+	}
+
+	if err := subscription.Update("https://agency.example.com/v3", "", []string{"order.*"}, true); !errors.Is(err, ErrInvalidEventType) {
+		t.Fatal("unexpected error", err)
+	}
+
+	if subscription.URL != "https://agency.example.com/v2" {
+		t.Error("unexpected update of invalid subscription", subscription.URL)
+	}
+}
+
+func TestSubscription_Sign(t *testing.T) {
+	subscription := newSubscriptionFixture()
+	payload := []byte(`{"id":"order.opened:42"}`)
+	at := time.Unix(1646121600, 0)
+
+	mac := hmac.New(sha256.New, []byte(subscription.Secret))
+	mac.Write([]byte("1646121600." + string(payload)))
+	want := hex.EncodeToString(mac.Sum(nil))
+
+	if got := subscription.Sign(payload, at); got != want {
+		t.Error("unexpected signature", got, want)
+	}
+
+	if got := subscription.Sign(payload, at.Add(time.Second)); got == want {
+		t.Error("expected signature to depend on the timestamp")
+	}
+}